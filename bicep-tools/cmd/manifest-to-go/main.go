@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/radius-project/radius/bicep-tools/pkg/gosdk"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/spf13/cobra"
+)
+
+var (
+	version = "dev"
+	commit  = "unknown"
+	date    = "unknown"
+)
+
+func main() {
+	cobra.CheckErr(newRootCommand().Execute())
+}
+
+func newRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest-to-go",
+		Short: "Generate a typed Go client package from Radius Resource Provider manifests",
+		Long: `manifest-to-go is a CLI tool that converts Radius Resource Provider
+manifests (YAML) into a Go package with typed clients for the resource types.
+
+The generated package contains a struct for the properties of each resource
+type, a client with create, get, list and delete operations that wait for
+asynchronous operations to complete, and a method for each action. Clients are
+created from a Radius connection (pkg/sdk.Connection).`,
+		SilenceUsage: true,
+	}
+
+	// Add version command
+	cmd.AddCommand(&cobra.Command{
+		Use:   "version",
+		Short: "Print version information",
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Printf("manifest-to-go version %s\n", version)
+			fmt.Printf("commit: %s\n", commit)
+			fmt.Printf("built: %s\n", date)
+		},
+	})
+
+	// Add generate command
+	cmd.AddCommand(newGenerateCommand())
+
+	return cmd
+}
+
+func newGenerateCommand() *cobra.Command {
+	options := gosdk.Options{}
+
+	cmd := &cobra.Command{
+		Use:   "generate <manifest1> [manifest2 ...] <output>",
+		Short: "Generate a typed Go client package from one or more Radius Resource Provider manifests",
+		Long: `Generate a typed Go client package from one or more Radius Resource Provider manifests.
+
+The package is written to ` + gosdk.OutputFileName + ` in the output directory.
+When multiple manifest files are provided, they must share the same namespace and
+their resource types are merged into a single package.
+
+The package name defaults to the lowercase namespace without dots, and the API
+version of each resource type defaults to its default API version.
+
+The last positional argument is always the output directory; all preceding
+arguments are manifest files.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestFiles := args[:len(args)-1]
+			outputDir := args[len(args)-1]
+			return RunGenerate(manifestFiles, outputDir, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.PackageName, "package", "", "Name of the generated Go package")
+	cmd.Flags().StringVar(&options.APIVersion, "api-version", "", "API version to generate the clients for")
+
+	return cmd
+}
+
+// RunGenerate generates a typed Go client package from one or more manifest files and writes it to the output
+// directory. When multiple manifests are provided, they must share the same namespace and their resource types are
+// merged before generation.
+func RunGenerate(manifestFiles []string, outputDir string, options gosdk.Options) error {
+	if len(manifestFiles) == 0 {
+		return fmt.Errorf("at least one manifest file is required")
+	}
+
+	var provider *manifest.ResourceProvider
+	for _, f := range manifestFiles {
+		current, err := manifest.ReadFile(f)
+		if err != nil {
+			return fmt.Errorf("failed to read manifest %s: %w", f, err)
+		}
+
+		if provider == nil {
+			provider = current
+			if provider.Types == nil {
+				provider.Types = map[string]*manifest.ResourceType{}
+			}
+			continue
+		}
+
+		if current.Namespace != provider.Namespace {
+			return fmt.Errorf("all manifests must share the same namespace: got %q (from %s) and %q", current.Namespace, f, provider.Namespace)
+		}
+
+		for typeName, resourceType := range current.Types {
+			if _, exists := provider.Types[typeName]; exists {
+				return fmt.Errorf("duplicate resource type %q found in %s", typeName, f)
+			}
+			provider.Types[typeName] = resourceType
+		}
+	}
+
+	source, err := gosdk.Generate(provider, options)
+	if err != nil {
+		return fmt.Errorf("failed to generate from manifest: %w", err)
+	}
+
+	// Create output directory if it doesn't exist
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	outputPath := filepath.Join(outputDir, gosdk.OutputFileName)
+	fmt.Printf("Writing %s to %s\n", gosdk.OutputFileName, outputPath)
+	if err := os.WriteFile(outputPath, source, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", gosdk.OutputFileName, err)
+	}
+
+	fmt.Printf("Successfully generated Go client package in %s\n", outputDir)
+	return nil
+}
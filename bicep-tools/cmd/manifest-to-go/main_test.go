@@ -0,0 +1,77 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/bicep-tools/pkg/gosdk"
+)
+
+func TestRunGenerate_SingleFile(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "redis")
+
+	err := RunGenerate([]string{"testdata/redis.yaml"}, outputDir, gosdk.Options{PackageName: "redis"})
+	if err != nil {
+		t.Fatalf("RunGenerate returned error: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, gosdk.OutputFileName))
+	if err != nil {
+		t.Fatalf("expected %s to exist: %v", gosdk.OutputFileName, err)
+	}
+	if !strings.Contains(string(content), "package redis") {
+		t.Errorf("expected the generated package to be named redis")
+	}
+	if !strings.Contains(string(content), "func NewRedisCachesClient(") {
+		t.Errorf("expected the generated package to contain NewRedisCachesClient")
+	}
+}
+
+func TestRunGenerate_MultipleFiles_SameNamespace(t *testing.T) {
+	outputDir := t.TempDir()
+
+	err := RunGenerate([]string{"testdata/redis.yaml", "testdata/queues.yaml"}, outputDir, gosdk.Options{})
+	if err != nil {
+		t.Fatalf("RunGenerate returned error: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, gosdk.OutputFileName))
+	if err != nil {
+		t.Fatalf("expected %s to exist: %v", gosdk.OutputFileName, err)
+	}
+	for _, expected := range []string{"package contosoexample", "func NewRedisCachesClient(", "func NewQueuesClient("} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("expected the generated package to contain %q", expected)
+		}
+	}
+}
+
+func TestRunGenerate_MultipleFiles_DifferentNamespaces(t *testing.T) {
+	dir := t.TempDir()
+	other := filepath.Join(dir, "other.yaml")
+	err := os.WriteFile(other, []byte("namespace: Other.Example\ntypes:\n  widgets:\n    apiVersions:\n      '2025-01-01':\n        schema: {}\n"), 0644)
+	if err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	err = RunGenerate([]string{"testdata/redis.yaml", other}, filepath.Join(dir, "out"), gosdk.Options{})
+	if err == nil || !strings.Contains(err.Error(), "all manifests must share the same namespace") {
+		t.Errorf("expected namespace mismatch error, got %v", err)
+	}
+}
+
+func TestRunGenerate_MultipleFiles_DuplicateType(t *testing.T) {
+	err := RunGenerate([]string{"testdata/redis.yaml", "testdata/redis.yaml"}, t.TempDir(), gosdk.Options{})
+	if err == nil || !strings.Contains(err.Error(), "duplicate resource type") {
+		t.Errorf("expected duplicate resource type error, got %v", err)
+	}
+}
+
+func TestRunGenerate_MissingFile(t *testing.T) {
+	err := RunGenerate([]string{"testdata/missing.yaml"}, t.TempDir(), gosdk.Options{})
+	if err == nil || !strings.Contains(err.Error(), "failed to read manifest testdata/missing.yaml") {
+		t.Errorf("expected missing file error, got %v", err)
+	}
+}
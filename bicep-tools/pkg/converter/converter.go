@@ -3,6 +3,7 @@ package converter
 import (
 	"bytes"
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 	"strings"
@@ -280,6 +281,11 @@ func addSchemaTypeInternal(schema *manifest.Schema, name string, typeFactory *fa
 			return nil, fmt.Errorf("failed to add object properties: %w", err)
 		}
 
+		// Connections constrained to resource types must specify the resource ID of their target.
+		if allowed := connectionTypeList(schema.ConnectionTypes); len(allowed) > 0 {
+			objectProperties["source"] = connectionSourceProperty(objectProperties["source"], allowed, typeFactory)
+		}
+
 		// Determine sensitive flag for object
 		var sensitive *bool
 		if schema.IsSensitive != nil && *schema.IsSensitive {
@@ -302,6 +308,28 @@ func addSchemaTypeInternal(schema *manifest.Schema, name string, typeFactory *fa
 				return nil, fmt.Errorf("failed to add additional properties: %w", err)
 			}
 			objectType.AdditionalProperties = additionalPropsRef
+
+			// Named connections constrained to resource types are declared as properties, so that Bicep knows the
+			// resource types they may target.
+			named := connectionTypeMap(schema.ConnectionTypes)
+			for _, connectionName := range slices.Sorted(maps.Keys(named)) {
+				if _, exists := objectProperties[connectionName]; exists {
+					continue
+				}
+
+				connectionSchema := *schema.AdditionalProperties
+				connectionSchema.ConnectionTypes = named[connectionName]
+				connectionRef, err := addSchemaTypeInternal(&connectionSchema, connectionName, typeFactory, inPlatformOptions)
+				if err != nil {
+					return nil, fmt.Errorf("failed to add connection %s: %w", connectionName, err)
+				}
+
+				objectType.Properties[connectionName] = types.ObjectTypeProperty{
+					Type:        connectionRef,
+					Flags:       types.TypePropertyFlagsNone,
+					Description: fmt.Sprintf("The %s connection.", connectionName),
+				}
+			}
 		}
 
 		return typeFactory.GetReference(objectType), nil
@@ -408,3 +436,52 @@ func addObjectProperty(
 		Description: description,
 	}, nil
 }
+
+// connectionSourceProperty returns the source property of a connection constrained to the given resource types.
+func connectionSourceProperty(source types.ObjectTypeProperty, allowed []string, typeFactory *factory.TypeFactory) types.ObjectTypeProperty {
+	if source.Type == nil {
+		source.Type = typeFactory.GetReference(typeFactory.CreateStringType())
+	}
+	if source.Description == "" {
+		source.Description = "The resource ID of the connected resource."
+	}
+
+	source.Flags |= types.TypePropertyFlagsRequired
+	source.Description = strings.TrimSpace(source.Description) + fmt.Sprintf(" Must be a resource of type %s.", strings.Join(allowed, " or "))
+	return source
+}
+
+// connectionTypeList returns the resource types of a x-radius-connection-types annotation declared on a connection.
+func connectionTypeList(value any) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []any:
+		result := []string{}
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// connectionTypeMap returns the resource types of each named connection of a x-radius-connection-types annotation
+// declared on the connections map.
+func connectionTypeMap(value any) map[string][]string {
+	values, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	result := map[string][]string{}
+	for name, v := range values {
+		if allowed := connectionTypeList(v); len(allowed) > 0 {
+			result[name] = allowed
+		}
+	}
+	return result
+}
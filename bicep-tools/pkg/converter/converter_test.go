@@ -1359,3 +1359,103 @@ func TestAddSchemaType_ArrayOfSensitiveObjects(t *testing.T) {
 		t.Error("Expected array item object to be marked as sensitive")
 	}
 }
+
+func TestAddSchemaType_ConnectionTypes(t *testing.T) {
+	schema := &manifest.Schema{
+		Type: "object",
+		ConnectionTypes: map[string]any{
+			"database": []any{"Radius.Data/postgreSqlDatabases", "Radius.Data/mySqlDatabases"},
+		},
+		AdditionalProperties: &manifest.Schema{
+			Type: "object",
+			Properties: map[string]manifest.Schema{
+				"source": {Type: "string", Description: new("The source of the connection.")},
+			},
+		},
+	}
+	typeFactory := factory.NewTypeFactory()
+
+	result, err := addSchemaType(schema, "connections", typeFactory)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	allTypes := typeFactory.GetTypes()
+	typeRef, ok := result.(types.TypeReference)
+	if !ok {
+		t.Fatal("Expected result to be a TypeReference")
+	}
+	connectionsType, ok := allTypes[typeRef.Ref].(*types.ObjectType)
+	if !ok {
+		t.Fatal("Expected result to be an ObjectType")
+	}
+
+	if connectionsType.AdditionalProperties == nil {
+		t.Fatal("Expected additionalProperties to be defined")
+	}
+
+	// Connections without a declaration of their own keep the shape of additionalProperties.
+	additionalPropsRef := connectionsType.AdditionalProperties.(types.TypeReference)
+	additionalPropsType := allTypes[additionalPropsRef.Ref].(*types.ObjectType)
+	if additionalPropsType.Properties["source"].Flags != types.TypePropertyFlagsNone {
+		t.Errorf("Expected source of unconstrained connections to be optional, got flags %v", additionalPropsType.Properties["source"].Flags)
+	}
+
+	database, ok := connectionsType.Properties["database"]
+	if !ok {
+		t.Fatal("Expected database connection to be declared as a property")
+	}
+	if database.Flags != types.TypePropertyFlagsNone {
+		t.Errorf("Expected database connection to be optional, got flags %v", database.Flags)
+	}
+
+	databaseType, ok := allTypes[database.Type.(types.TypeReference).Ref].(*types.ObjectType)
+	if !ok {
+		t.Fatal("Expected database connection to be an ObjectType")
+	}
+
+	source, ok := databaseType.Properties["source"]
+	if !ok {
+		t.Fatal("Expected database connection to have a source property")
+	}
+	if source.Flags != types.TypePropertyFlagsRequired {
+		t.Errorf("Expected source to be required, got flags %v", source.Flags)
+	}
+
+	expectedDescription := "The source of the connection. Must be a resource of type Radius.Data/postgreSqlDatabases or Radius.Data/mySqlDatabases."
+	if source.Description != expectedDescription {
+		t.Errorf("Expected description %q, got %q", expectedDescription, source.Description)
+	}
+}
+
+func TestAddSchemaType_ConnectionTypesWithoutSource(t *testing.T) {
+	schema := &manifest.Schema{
+		Type:            "object",
+		ConnectionTypes: []any{"Radius.Data/*"},
+	}
+	typeFactory := factory.NewTypeFactory()
+
+	result, err := addSchemaType(schema, "connection", typeFactory)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	allTypes := typeFactory.GetTypes()
+	connectionType := allTypes[result.(types.TypeReference).Ref].(*types.ObjectType)
+
+	source, ok := connectionType.Properties["source"]
+	if !ok {
+		t.Fatal("Expected source property to be added")
+	}
+	if _, ok := allTypes[source.Type.(types.TypeReference).Ref].(*types.StringType); !ok {
+		t.Fatal("Expected source to be a StringType")
+	}
+	if source.Flags != types.TypePropertyFlagsRequired {
+		t.Errorf("Expected source to be required, got flags %v", source.Flags)
+	}
+
+	expectedDescription := "The resource ID of the connected resource. Must be a resource of type Radius.Data/*."
+	if source.Description != expectedDescription {
+		t.Errorf("Expected description %q, got %q", expectedDescription, source.Description)
+	}
+}
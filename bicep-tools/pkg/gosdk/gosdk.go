@@ -0,0 +1,595 @@
+package gosdk
+
+//go:generate go run ../../cmd/manifest-to-go generate testdata/contoso.yaml internal/contosoexample
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/pkg/cli/manifest"
+)
+
+// OutputFileName is the name of the file the generated client package is written to.
+const OutputFileName = "zz_generated_clients.go"
+
+// Options configures the generation of a Go client package.
+type Options struct {
+	// PackageName is the name of the generated package. Defaults to the lowercase namespace without dots, e.g.
+	// 'contosoexample' for 'Contoso.Example'.
+	PackageName string
+
+	// APIVersion is the API version the clients are generated for. Defaults to the default API version of each
+	// resource type, or its only API version.
+	APIVersion string
+}
+
+// clientMethods are the methods of clients.ResourceClient. Actions with the same name get an 'Action' suffix so they
+// do not shadow them.
+var clientMethods = []string{
+	"APIVersion", "BeginCreateOrUpdate", "BeginDelete", "BeginInvokeAction", "CreateOrUpdate", "Delete", "Get",
+	"InvokeAction", "List", "NewListPager", "ResourceID", "ResourceType",
+}
+
+// initialisms are the words written in upper case in Go identifiers.
+var initialisms = map[string]bool{
+	"api": true, "cpu": true, "dns": true, "http": true, "https": true, "id": true, "ip": true, "json": true,
+	"tls": true, "ttl": true, "uri": true, "url": true, "uuid": true, "vm": true, "xml": true, "yaml": true,
+}
+
+// Generate generates the source of a Go package with typed clients for the resource types of the resource provider.
+// Each resource type gets a properties struct generated from its schema, a client with CRUD and async polling
+// helpers, and a method per action. The output is deterministic and formatted.
+func Generate(provider *manifest.ResourceProvider, options Options) ([]byte, error) {
+	if provider == nil || provider.Namespace == "" {
+		return nil, fmt.Errorf("resource provider namespace is required")
+	}
+
+	packageName := options.PackageName
+	if packageName == "" {
+		packageName = strings.ToLower(strings.ReplaceAll(provider.Namespace, ".", ""))
+	}
+	if !token.IsIdentifier(packageName) {
+		return nil, fmt.Errorf("package name %q is not a valid Go identifier", packageName)
+	}
+
+	g := &generator{declared: map[string]bool{}}
+	typeNames := make([]string, 0, len(provider.Types))
+	for typeName := range provider.Types {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		if err := g.addResourceType(provider.Namespace, typeName, provider.Types[typeName], options.APIVersion); err != nil {
+			return nil, fmt.Errorf("failed to generate resource type %s/%s: %w", provider.Namespace, typeName, err)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by manifest-to-go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "// Package %s contains typed clients for the resource types of the %s resource provider.\n", packageName, provider.Namespace)
+	fmt.Fprintf(buf, "package %s\n\n", packageName)
+	fmt.Fprintf(buf, "import (\n")
+	if g.usesContext {
+		fmt.Fprintf(buf, "\t\"context\"\n\n")
+	}
+	fmt.Fprintf(buf, "\t\"github.com/radius-project/radius/pkg/sdk\"\n")
+	fmt.Fprintf(buf, "\t\"github.com/radius-project/radius/pkg/sdk/clients\"\n")
+	fmt.Fprintf(buf, ")\n")
+	for _, decl := range g.decls {
+		fmt.Fprintf(buf, "\n%s", decl)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return source, nil
+}
+
+// generator accumulates the declarations of the generated package.
+type generator struct {
+	decls       []string
+	declared    map[string]bool
+	usesContext bool
+}
+
+// declare reserves the name of a type declaration.
+func (g *generator) declare(name string) error {
+	if g.declared[name] {
+		return fmt.Errorf("type %s is declared more than once", name)
+	}
+	g.declared[name] = true
+	return nil
+}
+
+func (g *generator) addResourceType(namespace string, typeName string, resourceType *manifest.ResourceType, apiVersionOption string) error {
+	if resourceType == nil {
+		return fmt.Errorf("resource type definition is missing")
+	}
+
+	apiVersion, err := selectAPIVersion(resourceType, apiVersionOption)
+	if err != nil {
+		return err
+	}
+
+	schema, err := normalizeSchema(resourceType.APIVersions[apiVersion].Schema)
+	if err != nil {
+		return err
+	}
+
+	name := pascalCase(typeName)
+	fullType := namespace + "/" + typeName
+
+	decl := &bytes.Buffer{}
+	fmt.Fprintf(decl, "const (\n")
+	fmt.Fprintf(decl, "\t// %sResourceType is the resource type of %s resources.\n", name, typeName)
+	fmt.Fprintf(decl, "\t%sResourceType = %s\n\n", name, strconv.Quote(fullType))
+	fmt.Fprintf(decl, "\t// %sAPIVersion is the API version %sClient uses.\n", name, name)
+	fmt.Fprintf(decl, "\t%sAPIVersion = %s\n", name, strconv.Quote(apiVersion))
+	fmt.Fprintf(decl, ")\n")
+	g.decls = append(g.decls, decl.String())
+
+	if err := g.declare(name + "Resource"); err != nil {
+		return err
+	}
+	decl = &bytes.Buffer{}
+	writeComment(decl, "", fmt.Sprintf("%sResource is a %s resource.", name, fullType))
+	fmt.Fprintf(decl, "type %sResource = clients.Resource[%sProperties]\n", name, name)
+	g.decls = append(g.decls, decl.String())
+
+	// Every resource reports its provisioning state and status, whether or not the schema declares them.
+	properties, _ := schema["properties"].(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+		schema["properties"] = properties
+	}
+	if _, ok := properties["provisioningState"]; !ok {
+		properties["provisioningState"] = map[string]any{"type": "string", "readOnly": true, "description": "The provisioning state of the resource."}
+	}
+	statusField := ""
+	if _, ok := properties["status"]; !ok {
+		statusField = "\t// Status is the status of the resource.\n\tStatus *clients.ResourceStatus `json:\"status,omitempty\"`\n"
+	}
+	description := fmt.Sprintf("the properties of %s resources.", fullType)
+	if resourceType.Description != nil && *resourceType.Description != "" {
+		description += "\n\n" + *resourceType.Description
+	}
+	schema["description"] = description
+
+	if err := g.addStruct(name+"Properties", schema, statusField); err != nil {
+		return err
+	}
+
+	if err := g.declare(name + "Client"); err != nil {
+		return err
+	}
+	decl = &bytes.Buffer{}
+	writeComment(decl, "", fmt.Sprintf("%sClient is a client for %s resources.", name, fullType))
+	fmt.Fprintf(decl, "type %sClient struct {\n\t*clients.ResourceClient[%sProperties]\n}\n\n", name, name)
+	writeComment(decl, "", fmt.Sprintf("New%sClient creates a new %sClient.", name, name))
+	fmt.Fprintf(decl, "func New%sClient(connection sdk.Connection) (*%sClient, error) {\n", name, name)
+	fmt.Fprintf(decl, "\tclient, err := clients.NewResourceClient[%sProperties](connection, %sResourceType, %sAPIVersion)\n", name, name, name)
+	fmt.Fprintf(decl, "\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	fmt.Fprintf(decl, "\treturn &%sClient{ResourceClient: client}, nil\n}\n", name)
+	g.decls = append(g.decls, decl.String())
+
+	actionNames := make([]string, 0, len(resourceType.Actions))
+	for actionName := range resourceType.Actions {
+		actionNames = append(actionNames, actionName)
+	}
+	sort.Strings(actionNames)
+
+	for _, actionName := range actionNames {
+		if err := g.addAction(name, actionName, resourceType.Actions[actionName]); err != nil {
+			return fmt.Errorf("failed to generate action %q: %w", actionName, err)
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) addAction(typeName string, actionName string, action *manifest.Action) error {
+	if action == nil {
+		return fmt.Errorf("action definition is missing")
+	}
+	g.usesContext = true
+
+	methodName := pascalCase(actionName)
+	if slices.Contains(clientMethods, methodName) {
+		methodName += "Action"
+	}
+
+	inputType := ""
+	if action.InputSchema != nil {
+		inputType = typeName + methodName + "Input"
+		if err := g.addNamedType(inputType, action.InputSchema); err != nil {
+			return err
+		}
+	}
+
+	outputType := ""
+	if action.OutputSchema != nil {
+		outputType = typeName + methodName + "Output"
+		if err := g.addNamedType(outputType, action.OutputSchema); err != nil {
+			return err
+		}
+	}
+
+	decl := &bytes.Buffer{}
+	comment := fmt.Sprintf("%s invokes the %s action on the resource with the given name in the root scope and waits for it to complete.", methodName, actionName)
+	if action.Description != nil && *action.Description != "" {
+		comment += "\n\n" + *action.Description
+	}
+	writeComment(decl, "", comment)
+
+	params := "ctx context.Context, rootScope string, name string"
+	if inputType != "" {
+		params += ", input *" + inputType
+	}
+	result := "*clients.ActionStatus"
+	if outputType != "" {
+		result = "*" + outputType
+	}
+
+	fmt.Fprintf(decl, "func (c *%sClient) %s(%s) (%s, error) {\n", typeName, methodName, params, result)
+	if inputType != "" {
+		// A nil input is not sent rather than sent as 'null'.
+		fmt.Fprintf(decl, "\tvar body any\n\tif input != nil {\n\t\tbody = input\n\t}\n\n")
+		fmt.Fprintf(decl, "\tstatus, err := c.InvokeAction(ctx, rootScope, name, %s, body)\n", strconv.Quote(actionName))
+	} else {
+		fmt.Fprintf(decl, "\tstatus, err := c.InvokeAction(ctx, rootScope, name, %s, nil)\n", strconv.Quote(actionName))
+	}
+	fmt.Fprintf(decl, "\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+	if outputType != "" {
+		fmt.Fprintf(decl, "\treturn clients.DecodeActionOutput[%s](status)\n}\n", outputType)
+	} else {
+		fmt.Fprintf(decl, "\treturn status, nil\n}\n")
+	}
+	g.decls = append(g.decls, decl.String())
+
+	return nil
+}
+
+// addNamedType declares a type with the given name for the schema.
+func (g *generator) addNamedType(name string, schema map[string]any) error {
+	schema, err := normalizeSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	typeExpr, err := g.goType(name, schema)
+	if err != nil {
+		return err
+	}
+
+	// Objects and enums are declared by goType.
+	if typeExpr == name {
+		return nil
+	}
+
+	if err := g.declare(name); err != nil {
+		return err
+	}
+	decl := &bytes.Buffer{}
+	writeComment(decl, "", describe(name, schema))
+	fmt.Fprintf(decl, "type %s %s\n", name, typeExpr)
+	g.decls = append(g.decls, decl.String())
+
+	return nil
+}
+
+// addStruct declares a struct with the given name for the properties of the object schema. Extra is appended to the
+// fields of the struct.
+func (g *generator) addStruct(name string, schema map[string]any, extra string) error {
+	if err := g.declare(name); err != nil {
+		return err
+	}
+
+	// Reserve the position of the struct so it is declared before the types of its fields.
+	index := len(g.decls)
+	g.decls = append(g.decls, "")
+
+	properties, _ := schema["properties"].(map[string]any)
+	propertyNames := make([]string, 0, len(properties))
+	for propertyName := range properties {
+		propertyNames = append(propertyNames, propertyName)
+	}
+	sort.Strings(propertyNames)
+
+	required := map[string]bool{}
+	if values, ok := schema["required"].([]any); ok {
+		for _, value := range values {
+			if s, ok := value.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+
+	decl := &bytes.Buffer{}
+	writeComment(decl, "", describe(name, schema))
+	fmt.Fprintf(decl, "type %s struct {\n", name)
+
+	fieldNames := map[string]string{}
+	for i, propertyName := range propertyNames {
+		property, ok := properties[propertyName].(map[string]any)
+		if !ok {
+			return fmt.Errorf("schema of property %q of %s must be an object", propertyName, name)
+		}
+
+		fieldName := pascalCase(propertyName)
+		if other, ok := fieldNames[fieldName]; ok {
+			return fmt.Errorf("properties %q and %q of %s both map to field %s", other, propertyName, name, fieldName)
+		}
+		fieldNames[fieldName] = propertyName
+
+		fieldType, err := g.goType(name+fieldName, property)
+		if err != nil {
+			return err
+		}
+
+		// Read-only fields are set by the server, so they are optional even when they are required.
+		optional := !required[propertyName] || property["readOnly"] == true
+		tag := propertyName
+		if optional {
+			tag += ",omitempty"
+			if !isReferenceType(fieldType) {
+				fieldType = "*" + fieldType
+			}
+		}
+
+		if i > 0 {
+			fmt.Fprintf(decl, "\n")
+		}
+		if description, ok := property["description"].(string); ok && description != "" {
+			writeComment(decl, "\t", fieldName+" is "+lowerFirst(strings.TrimSpace(description)))
+		}
+		fmt.Fprintf(decl, "\t%s %s `json:%s`\n", fieldName, fieldType, strconv.Quote(tag))
+	}
+
+	if extra != "" {
+		if len(propertyNames) > 0 {
+			fmt.Fprintf(decl, "\n")
+		}
+		fmt.Fprintf(decl, "%s", extra)
+	}
+	fmt.Fprintf(decl, "}\n")
+
+	g.decls[index] = decl.String()
+	return nil
+}
+
+// addEnum declares a string type with the given name and a constant per value.
+func (g *generator) addEnum(name string, schema map[string]any, values []string) error {
+	if err := g.declare(name); err != nil {
+		return err
+	}
+
+	decl := &bytes.Buffer{}
+	writeComment(decl, "", describe(name, schema))
+	fmt.Fprintf(decl, "type %s string\n\n", name)
+	fmt.Fprintf(decl, "const (\n")
+	for _, value := range values {
+		constName := name + pascalCase(value)
+		if err := g.declare(constName); err != nil {
+			return err
+		}
+		fmt.Fprintf(decl, "\t%s %s = %s\n", constName, name, strconv.Quote(value))
+	}
+	fmt.Fprintf(decl, ")\n")
+	g.decls = append(g.decls, decl.String())
+
+	return nil
+}
+
+// goType returns the Go type of the schema, declaring the types of objects and enums with the given name.
+func (g *generator) goType(name string, schema map[string]any) (string, error) {
+	if values, ok := stringEnum(schema); ok {
+		if err := g.addEnum(name, schema, values); err != nil {
+			return "", err
+		}
+		return name, nil
+	}
+
+	switch schema["type"] {
+	case "string":
+		return "string", nil
+	case "integer":
+		return "int64", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		items, ok := schema["items"].(map[string]any)
+		if !ok {
+			return "[]any", nil
+		}
+		itemType, err := g.goType(name+"Item", items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + itemType, nil
+	case "object", nil:
+		if properties, ok := schema["properties"].(map[string]any); ok && len(properties) > 0 {
+			if err := g.addStruct(name, schema, ""); err != nil {
+				return "", err
+			}
+			return name, nil
+		}
+		if additional, ok := schema["additionalProperties"].(map[string]any); ok {
+			valueType, err := g.goType(name+"Value", additional)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + valueType, nil
+		}
+		if schema["type"] == "object" {
+			return "map[string]any", nil
+		}
+	}
+
+	return "any", nil
+}
+
+// selectAPIVersion returns the API version to generate the resource type for.
+func selectAPIVersion(resourceType *manifest.ResourceType, apiVersionOption string) (string, error) {
+	if apiVersionOption != "" {
+		if _, ok := resourceType.APIVersions[apiVersionOption]; !ok {
+			return "", fmt.Errorf("API version %q is not defined", apiVersionOption)
+		}
+		return apiVersionOption, nil
+	}
+
+	if resourceType.DefaultAPIVersion != nil && *resourceType.DefaultAPIVersion != "" {
+		if _, ok := resourceType.APIVersions[*resourceType.DefaultAPIVersion]; !ok {
+			return "", fmt.Errorf("default API version %q is not defined", *resourceType.DefaultAPIVersion)
+		}
+		return *resourceType.DefaultAPIVersion, nil
+	}
+
+	if len(resourceType.APIVersions) == 1 {
+		for apiVersion := range resourceType.APIVersions {
+			return apiVersion, nil
+		}
+	}
+
+	return "", fmt.Errorf("the API version is ambiguous: specify one or set the default API version of the resource type")
+}
+
+// normalizeSchema returns a copy of the schema decoded as JSON, so nested objects are map[string]any and numbers are
+// float64 regardless of how the manifest was parsed.
+func normalizeSchema(schema any) (map[string]any, error) {
+	if schema == nil {
+		return map[string]any{}, nil
+	}
+
+	bs, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(bs, &result); err != nil {
+		return nil, fmt.Errorf("invalid schema: schema must be an object: %w", err)
+	}
+
+	return result, nil
+}
+
+// stringEnum returns the values of the schema when it is an enum of strings.
+func stringEnum(schema map[string]any) ([]string, bool) {
+	enum, ok := schema["enum"].([]any)
+	if !ok || len(enum) == 0 {
+		return nil, false
+	}
+
+	values := make([]string, 0, len(enum))
+	for _, value := range enum {
+		s, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, s)
+	}
+
+	return values, true
+}
+
+// isReferenceType returns true for the Go types whose zero value is nil.
+func isReferenceType(goType string) bool {
+	return goType == "any" || strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[")
+}
+
+// describe returns the doc comment of a type declaration.
+func describe(name string, schema map[string]any) string {
+	if description, ok := schema["description"].(string); ok && strings.TrimSpace(description) != "" {
+		return name + " is " + lowerFirst(strings.TrimSpace(description))
+	}
+	return name + " is generated from the resource type schema."
+}
+
+// writeComment writes the text as a comment with the given indentation.
+func writeComment(buf *bytes.Buffer, indent string, text string) {
+	for line := range strings.SplitSeq(text, "\n") {
+		if line == "" {
+			fmt.Fprintf(buf, "%s//\n", indent)
+			continue
+		}
+		fmt.Fprintf(buf, "%s// %s\n", indent, line)
+	}
+}
+
+// lowerFirst lowers the first letter of a description so it reads as the continuation of a sentence, keeping
+// acronyms such as 'URL of ...' as they are.
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	if len(runes) > 1 && unicode.IsUpper(runes[0]) && unicode.IsUpper(runes[1]) {
+		return s
+	}
+	if len(runes) > 0 {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
+
+// pascalCase converts a name such as 'redisCaches', 'connection-string' or 'api_url' to an exported Go identifier,
+// e.g. 'RedisCaches', 'ConnectionString' or 'APIURL'.
+func pascalCase(name string) string {
+	result := &strings.Builder{}
+	for _, word := range splitWords(name) {
+		if initialisms[strings.ToLower(word)] {
+			result.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		result.WriteString(string(runes))
+	}
+
+	identifier := result.String()
+	if identifier == "" {
+		return "X"
+	}
+	if unicode.IsDigit([]rune(identifier)[0]) {
+		return "X" + identifier
+	}
+	return identifier
+}
+
+// splitWords splits a name into words at characters that are not letters or digits and at lower to upper case
+// transitions.
+func splitWords(name string) []string {
+	words := []string{}
+	current := []rune{}
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, string(current))
+			current = []rune{}
+		}
+	}
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			flush()
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
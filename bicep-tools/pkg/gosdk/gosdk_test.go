@@ -0,0 +1,211 @@
+package gosdk
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/manifest"
+)
+
+func TestGenerate_MatchesCheckedInPackage(t *testing.T) {
+	provider, err := manifest.ReadFile("testdata/contoso.yaml")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+
+	source, err := Generate(provider, Options{})
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	// The checked-in package is compiled with the rest of the module, so this verifies the generated code builds
+	// against pkg/sdk/clients.
+	expected, err := os.ReadFile("internal/contosoexample/" + OutputFileName)
+	if err != nil {
+		t.Fatalf("failed to read checked-in package: %v", err)
+	}
+
+	if string(source) != string(expected) {
+		t.Errorf("generated code does not match internal/contosoexample/%s, run 'go generate ./bicep-tools/pkg/gosdk' to update it", OutputFileName)
+	}
+}
+
+func TestGenerate_Declarations(t *testing.T) {
+	provider, err := manifest.ReadFile("testdata/contoso.yaml")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+
+	source, err := Generate(provider, Options{PackageName: "contoso"})
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), OutputFileName, source, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+
+	if file.Name.Name != "contoso" {
+		t.Errorf("expected package contoso, got %s", file.Name.Name)
+	}
+
+	declared := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.TypeSpec:
+			declared[node.Name.Name] = true
+		case *ast.FuncDecl:
+			declared[node.Name.Name] = true
+		case *ast.ValueSpec:
+			for _, name := range node.Names {
+				declared[name.Name] = true
+			}
+		}
+		return true
+	})
+
+	for _, name := range []string{
+		"RedisCachesResourceType",
+		"RedisCachesAPIVersion",
+		"RedisCachesResource",
+		"RedisCachesProperties",
+		"RedisCachesPropertiesTLS",
+		"RedisCachesPropertiesSize",
+		"RedisCachesPropertiesSizeM",
+		"RedisCachesPropertiesPortsItem",
+		"RedisCachesClient",
+		"NewRedisCachesClient",
+		"RedisCachesBackupInput",
+		"RedisCachesBackupOutput",
+		"Backup",
+		"DeleteAction",
+		"DatabasesClient",
+	} {
+		if !declared[name] {
+			t.Errorf("expected %s to be declared", name)
+		}
+	}
+
+	for _, expected := range []string{
+		"RedisCachesAPIVersion = \"2025-01-01\"",
+		"Environment string `json:\"environment\"`",
+		"Host *string `json:\"host,omitempty\"`",
+		"Labels map[string]string `json:\"labels,omitempty\"`",
+		"Ports []RedisCachesPropertiesPortsItem `json:\"ports,omitempty\"`",
+		"MinVersion *string `json:\"min-version,omitempty\"`",
+		"Status *clients.ResourceStatus `json:\"status,omitempty\"`",
+	} {
+		if !strings.Contains(string(source), expected) {
+			t.Errorf("expected generated code to contain %q", expected)
+		}
+	}
+}
+
+func TestGenerate_APIVersion(t *testing.T) {
+	provider, err := manifest.ReadFile("testdata/contoso.yaml")
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+	delete(provider.Types, "databases")
+
+	source, err := Generate(provider, Options{APIVersion: "2024-06-01-preview"})
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+	if !strings.Contains(string(source), "RedisCachesAPIVersion = \"2024-06-01-preview\"") {
+		t.Errorf("expected the clients to use API version 2024-06-01-preview")
+	}
+	if strings.Contains(string(source), "Replicas") {
+		t.Errorf("expected the properties of API version 2024-06-01-preview")
+	}
+
+	_, err = Generate(provider, Options{APIVersion: "2020-01-01"})
+	if err == nil || !strings.Contains(err.Error(), `API version "2020-01-01" is not defined`) {
+		t.Errorf("expected undefined API version error, got %v", err)
+	}
+
+	provider.Types["redisCaches"].DefaultAPIVersion = nil
+	_, err = Generate(provider, Options{})
+	if err == nil || !strings.Contains(err.Error(), "the API version is ambiguous") {
+		t.Errorf("expected ambiguous API version error, got %v", err)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider *manifest.ResourceProvider
+		options  Options
+		expected string
+	}{
+		{
+			name:     "missing namespace",
+			provider: &manifest.ResourceProvider{},
+			expected: "resource provider namespace is required",
+		},
+		{
+			name: "invalid package name",
+			provider: &manifest.ResourceProvider{
+				Namespace: "Contoso.Example",
+			},
+			options:  Options{PackageName: "contoso-example"},
+			expected: `package name "contoso-example" is not a valid Go identifier`,
+		},
+		{
+			name: "conflicting field names",
+			provider: &manifest.ResourceProvider{
+				Namespace: "Contoso.Example",
+				Types: map[string]*manifest.ResourceType{
+					"databases": {
+						APIVersions: map[string]*manifest.ResourceTypeAPIVersion{
+							"2025-01-01": {
+								Schema: map[string]any{
+									"type": "object",
+									"properties": map[string]any{
+										"connection-string": map[string]any{"type": "string"},
+										"connectionString":  map[string]any{"type": "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `properties "connection-string" and "connectionString" of DatabasesProperties both map to field ConnectionString`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Generate(tt.provider, tt.options)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestPascalCase(t *testing.T) {
+	tests := map[string]string{
+		"redisCaches":       "RedisCaches",
+		"connection-string": "ConnectionString",
+		"api_url":           "APIURL",
+		"resourceId":        "ResourceID",
+		"tls":               "TLS",
+		"2fa":               "X2fa",
+		"S":                 "S",
+		"Standard_LRS":      "StandardLRS",
+		"":                  "X",
+	}
+
+	for input, expected := range tests {
+		if actual := pascalCase(input); actual != expected {
+			t.Errorf("pascalCase(%q) = %q, expected %q", input, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Code generated by manifest-to-go. DO NOT EDIT.
+
+// Package contosoexample contains typed clients for the resource types of the Contoso.Example resource provider.
+package contosoexample
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/sdk/clients"
+)
+
+const (
+	// DatabasesResourceType is the resource type of databases resources.
+	DatabasesResourceType = "Contoso.Example/databases"
+
+	// DatabasesAPIVersion is the API version DatabasesClient uses.
+	DatabasesAPIVersion = "2025-01-01"
+)
+
+// DatabasesResource is a Contoso.Example/databases resource.
+type DatabasesResource = clients.Resource[DatabasesProperties]
+
+// DatabasesProperties is the properties of Contoso.Example/databases resources.
+type DatabasesProperties struct {
+	// ProvisioningState is the provisioning state of the resource.
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+
+	// Status is the status of the resource.
+	Status *clients.ResourceStatus `json:"status,omitempty"`
+}
+
+// DatabasesClient is a client for Contoso.Example/databases resources.
+type DatabasesClient struct {
+	*clients.ResourceClient[DatabasesProperties]
+}
+
+// NewDatabasesClient creates a new DatabasesClient.
+func NewDatabasesClient(connection sdk.Connection) (*DatabasesClient, error) {
+	client, err := clients.NewResourceClient[DatabasesProperties](connection, DatabasesResourceType, DatabasesAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DatabasesClient{ResourceClient: client}, nil
+}
+
+const (
+	// RedisCachesResourceType is the resource type of redisCaches resources.
+	RedisCachesResourceType = "Contoso.Example/redisCaches"
+
+	// RedisCachesAPIVersion is the API version RedisCachesClient uses.
+	RedisCachesAPIVersion = "2025-01-01"
+)
+
+// RedisCachesResource is a Contoso.Example/redisCaches resource.
+type RedisCachesResource = clients.Resource[RedisCachesProperties]
+
+// RedisCachesProperties is the properties of Contoso.Example/redisCaches resources.
+//
+// A Redis cache.
+type RedisCachesProperties struct {
+	// Application is the ID of the Radius application the cache belongs to.
+	Application *string `json:"application,omitempty"`
+
+	// Environment is the ID of the Radius environment the cache is deployed to.
+	Environment string `json:"environment"`
+
+	// Host is the host name of the cache.
+	Host *string `json:"host,omitempty"`
+
+	Labels map[string]string `json:"labels,omitempty"`
+
+	Ports []RedisCachesPropertiesPortsItem `json:"ports,omitempty"`
+
+	// ProvisioningState is the provisioning state of the resource.
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+
+	// Replicas is the number of replicas.
+	Replicas *int64 `json:"replicas,omitempty"`
+
+	// Size is the size of the cache.
+	Size *RedisCachesPropertiesSize `json:"size,omitempty"`
+
+	// TLS is the TLS settings of the cache.
+	TLS *RedisCachesPropertiesTLS `json:"tls,omitempty"`
+
+	// Status is the status of the resource.
+	Status *clients.ResourceStatus `json:"status,omitempty"`
+}
+
+// RedisCachesPropertiesPortsItem is generated from the resource type schema.
+type RedisCachesPropertiesPortsItem struct {
+	Port int64 `json:"port"`
+
+	Protocol *RedisCachesPropertiesPortsItemProtocol `json:"protocol,omitempty"`
+}
+
+// RedisCachesPropertiesPortsItemProtocol is generated from the resource type schema.
+type RedisCachesPropertiesPortsItemProtocol string
+
+const (
+	RedisCachesPropertiesPortsItemProtocolTCP RedisCachesPropertiesPortsItemProtocol = "TCP"
+	RedisCachesPropertiesPortsItemProtocolUDP RedisCachesPropertiesPortsItemProtocol = "UDP"
+)
+
+// RedisCachesPropertiesSize is the size of the cache.
+type RedisCachesPropertiesSize string
+
+const (
+	RedisCachesPropertiesSizeS RedisCachesPropertiesSize = "S"
+	RedisCachesPropertiesSizeM RedisCachesPropertiesSize = "M"
+	RedisCachesPropertiesSizeL RedisCachesPropertiesSize = "L"
+)
+
+// RedisCachesPropertiesTLS is the TLS settings of the cache.
+type RedisCachesPropertiesTLS struct {
+	Enabled bool `json:"enabled"`
+
+	MinVersion *string `json:"min-version,omitempty"`
+}
+
+// RedisCachesClient is a client for Contoso.Example/redisCaches resources.
+type RedisCachesClient struct {
+	*clients.ResourceClient[RedisCachesProperties]
+}
+
+// NewRedisCachesClient creates a new RedisCachesClient.
+func NewRedisCachesClient(connection sdk.Connection) (*RedisCachesClient, error) {
+	client, err := clients.NewResourceClient[RedisCachesProperties](connection, RedisCachesResourceType, RedisCachesAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCachesClient{ResourceClient: client}, nil
+}
+
+// RedisCachesBackupInput is generated from the resource type schema.
+type RedisCachesBackupInput struct {
+	RetentionDays *int64 `json:"retentionDays,omitempty"`
+
+	Target string `json:"target"`
+}
+
+// RedisCachesBackupOutput is generated from the resource type schema.
+type RedisCachesBackupOutput struct {
+	Location *string `json:"location,omitempty"`
+}
+
+// Backup invokes the backup action on the resource with the given name in the root scope and waits for it to complete.
+//
+// Backs up the cache to a storage account.
+func (c *RedisCachesClient) Backup(ctx context.Context, rootScope string, name string, input *RedisCachesBackupInput) (*RedisCachesBackupOutput, error) {
+	var body any
+	if input != nil {
+		body = input
+	}
+
+	status, err := c.InvokeAction(ctx, rootScope, name, "backup", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.DecodeActionOutput[RedisCachesBackupOutput](status)
+}
+
+// DeleteAction invokes the delete action on the resource with the given name in the root scope and waits for it to complete.
+//
+// Flushes and deletes the data of the cache.
+func (c *RedisCachesClient) DeleteAction(ctx context.Context, rootScope string, name string) (*clients.ActionStatus, error) {
+	status, err := c.InvokeAction(ctx, rootScope, name, "delete", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
@@ -39,6 +39,9 @@ type Schema struct {
 	Items                *Schema           `yaml:"items,omitempty" json:"items,omitempty"`
 	Enum                 []string          `yaml:"enum,omitempty" json:"enum,omitempty"`
 	IsSensitive          *bool             `yaml:"x-radius-sensitive,omitempty" json:"x-radius-sensitive,omitempty"`
+	// ConnectionTypes constrains the resource types connections may target: a list of resource types on the schema
+	// of a connection, or a map of connection name to resource types on the schema of the connections map.
+	ConnectionTypes any `yaml:"x-radius-connection-types,omitempty" json:"x-radius-connection-types,omitempty"`
 }
 
 // ParseManifest parses a YAML manifest string into a ResourceProvider struct
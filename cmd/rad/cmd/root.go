@@ -36,6 +36,7 @@ import (
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
+	audit_list "github.com/radius-project/radius/pkg/cli/cmd/audit/list"
 	bicep_generate_kubernetes_manifest "github.com/radius-project/radius/pkg/cli/cmd/bicep/generatekubernetesmanifest"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	bicep_publishextension "github.com/radius-project/radius/pkg/cli/cmd/bicep/publishextension"
@@ -57,25 +58,35 @@ import (
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	lock_create "github.com/radius-project/radius/pkg/cli/cmd/lock/create"
+	lock_delete "github.com/radius-project/radius/pkg/cli/cmd/lock/delete"
+	lock_list "github.com/radius-project/radius/pkg/cli/cmd/lock/list"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	radinit_preview "github.com/radius-project/radius/pkg/cli/cmd/radinit/preview"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	recipe_pack_create "github.com/radius-project/radius/pkg/cli/cmd/recipepack/create"
 	recipe_pack_delete "github.com/radius-project/radius/pkg/cli/cmd/recipepack/delete"
 	recipe_pack_list "github.com/radius-project/radius/pkg/cli/cmd/recipepack/list"
+	recipe_pack_resolve "github.com/radius-project/radius/pkg/cli/cmd/recipepack/resolve"
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_import "github.com/radius-project/radius/pkg/cli/cmd/resource/import"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
+	resourceprovider_diff "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/diff"
+	resourceprovider_export "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/export"
 	resourceprovider_list "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/list"
+	resourceprovider_openapi "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/openapi"
 	resourceprovider_show "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/show"
 	resourcetype_create "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/create"
 	resourcetype_delete "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/delete"
+	resourcetype_import "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/import"
 	resourcetype_list "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/list"
 	resourcetype_show "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/show"
 	"github.com/radius-project/radius/pkg/cli/cmd/rollback"
@@ -89,6 +100,7 @@ import (
 	workspace_create "github.com/radius-project/radius/pkg/cli/cmd/workspace/create"
 	workspace_delete "github.com/radius-project/radius/pkg/cli/cmd/workspace/delete"
 	workspace_list "github.com/radius-project/radius/pkg/cli/cmd/workspace/list"
+	workspace_login "github.com/radius-project/radius/pkg/cli/cmd/workspace/login"
 	workspace_show "github.com/radius-project/radius/pkg/cli/cmd/workspace/show"
 	workspace_switch "github.com/radius-project/radius/pkg/cli/cmd/workspace/switch"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -130,6 +142,8 @@ const (
 )
 
 var applicationCmd = NewAppCommand()
+var auditCmd = NewAuditCommand()
+var lockCmd = NewLockCommand()
 var resourceCmd = NewResourceCommand()
 var resourceProviderCmd = NewResourceProviderCommand()
 var resourceTypeCmd = NewResourceTypeCommand()
@@ -279,6 +293,18 @@ func initSubCommands() {
 	runCmd, _ := run.NewCommand(framework)
 	RootCmd.AddCommand(runCmd)
 
+	auditListCmd, _ := audit_list.NewCommand(framework)
+	auditCmd.AddCommand(auditListCmd)
+
+	lockCreateCmd, _ := lock_create.NewCommand(framework)
+	lockCmd.AddCommand(lockCreateCmd)
+
+	lockListCmd, _ := lock_list.NewCommand(framework)
+	lockCmd.AddCommand(lockListCmd)
+
+	lockDeleteCmd, _ := lock_delete.NewCommand(framework)
+	lockCmd.AddCommand(lockDeleteCmd)
+
 	resourceShowCmd, _ := resource_show.NewCommand(framework)
 	resourceCmd.AddCommand(resourceShowCmd)
 
@@ -288,6 +314,9 @@ func initSubCommands() {
 	resourceCreateCmd, _ := resource_create.NewCommand(framework)
 	resourceCmd.AddCommand(resourceCreateCmd)
 
+	resourceImportCmd, _ := resource_import.NewCommand(framework)
+	resourceCmd.AddCommand(resourceImportCmd)
+
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
@@ -303,6 +332,15 @@ func initSubCommands() {
 	resourceProviderDeleteCmd, _ := resourceprovider_delete.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderDeleteCmd)
 
+	resourceProviderOpenAPICmd, _ := resourceprovider_openapi.NewCommand(framework)
+	resourceProviderCmd.AddCommand(resourceProviderOpenAPICmd)
+
+	resourceProviderExportCmd, _ := resourceprovider_export.NewCommand(framework)
+	resourceProviderCmd.AddCommand(resourceProviderExportCmd)
+
+	resourceProviderDiffCmd, _ := resourceprovider_diff.NewCommand(framework)
+	resourceProviderCmd.AddCommand(resourceProviderDiffCmd)
+
 	resourceTypeShowCmd, _ := resourcetype_show.NewCommand(framework)
 	resourceTypeCmd.AddCommand(resourceTypeShowCmd)
 
@@ -315,6 +353,9 @@ func initSubCommands() {
 	resourceTypeCreateCmd, _ := resourcetype_create.NewCommand(framework)
 	resourceTypeCmd.AddCommand(resourceTypeCreateCmd)
 
+	resourceTypeImportCmd, _ := resourcetype_import.NewCommand(framework)
+	resourceTypeCmd.AddCommand(resourceTypeImportCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -336,6 +377,12 @@ func initSubCommands() {
 	showRecipePackCmd, _ := recipe_pack_show.NewCommand(framework)
 	recipePackCmd.AddCommand(showRecipePackCmd)
 
+	createRecipePackCmd, _ := recipe_pack_create.NewCommand(framework)
+	recipePackCmd.AddCommand(createRecipePackCmd)
+
+	resolveRecipePackCmd, _ := recipe_pack_resolve.NewCommand(framework)
+	recipePackCmd.AddCommand(resolveRecipePackCmd)
+
 	providerCmd := credential.NewCommand(framework)
 	RootCmd.AddCommand(providerCmd)
 
@@ -393,6 +440,9 @@ func initSubCommands() {
 	workspaceListCmd, _ := workspace_list.NewCommand(framework)
 	workspaceCmd.AddCommand(workspaceListCmd)
 
+	workspaceLoginCmd, _ := workspace_login.NewCommand(framework)
+	workspaceCmd.AddCommand(workspaceLoginCmd)
+
 	workspaceShowCmd, _ := workspace_show.NewCommand(framework)
 	workspaceCmd.AddCommand(workspaceShowCmd)
 
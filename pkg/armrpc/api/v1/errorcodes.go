@@ -41,6 +41,12 @@ const (
 	// Used for CodeInvalidAuthenticationInfo.
 	CodeInvalidAuthenticationInfo = "InvalidAuthenticationInfo"
 
+	// Used when the principal of a request isn't authorized to perform the action.
+	CodeAuthorizationFailed = "AuthorizationFailed"
+
+	// Used when a management lock prevents the operation.
+	CodeScopeLocked = "ScopeLocked"
+
 	// Used for the cases when the precondition of a request fails.
 	CodePreconditionFailed = "PreconditionFailed"
 
@@ -61,4 +67,7 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when the chain of audit events is broken because events were modified or removed.
+	CodeAuditChainBroken = "AuditChainBroken"
 )
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// TagNameParameterName is the query string parameter for the name of the tag used to filter list requests.
+	TagNameParameterName = "tagName"
+	// TagValueParameterName is the query string parameter for the value of the tag used to filter list requests.
+	TagValueParameterName = "tagValue"
+
+	// MaxTagCount is the maximum number of tags a resource can have.
+	MaxTagCount = 50
+	// MaxTagNameLength is the maximum length of a tag name.
+	MaxTagNameLength = 512
+	// MaxTagValueLength is the maximum length of a tag value.
+	MaxTagValueLength = 256
+
+	// invalidTagNameCharacters are the characters that can't be used in a tag name.
+	invalidTagNameCharacters = `<>%&\?/`
+)
+
+// ValidateTags validates the tags of a resource. Tags follow the same limits as Azure Resource Manager: a resource
+// can have at most 50 tags, tag names are at most 512 characters and can't contain any of '<>%&\?/', and tag values
+// are at most 256 characters. Tag names are case-insensitive, so a resource can't have two tags whose names only
+// differ by case.
+func ValidateTags(tags map[string]string) error {
+	if len(tags) > MaxTagCount {
+		return fmt.Errorf("a resource can have at most %d tags, got %d", MaxTagCount, len(tags))
+	}
+
+	names := map[string]string{}
+	for name, value := range tags {
+		if err := validateTagName(name); err != nil {
+			return err
+		}
+
+		if existing, ok := names[strings.ToLower(name)]; ok {
+			first, second := min(existing, name), max(existing, name)
+			return fmt.Errorf("the tag names %q and %q only differ by case, tag names are case-insensitive", first, second)
+		}
+		names[strings.ToLower(name)] = name
+
+		if len(value) > MaxTagValueLength {
+			return fmt.Errorf("the value of the tag %q is longer than %d characters", name, MaxTagValueLength)
+		}
+	}
+
+	return nil
+}
+
+func validateTagName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("tag names can't be empty")
+	}
+
+	if len(name) > MaxTagNameLength {
+		return fmt.Errorf("the tag name %q is longer than %d characters", name, MaxTagNameLength)
+	}
+
+	if strings.ContainsAny(name, invalidTagNameCharacters) {
+		return fmt.Errorf("the tag name %q is invalid, tag names can't contain any of '%s'", name, invalidTagNameCharacters)
+	}
+
+	return nil
+}
+
+// TagFilter is the tag filter of a list request. Only the resources that have a tag with the name and value are
+// listed. Like Azure Resource Manager, tag names are compared case-insensitively and tag values case-sensitively.
+type TagFilter struct {
+	// Name is the name of the tag.
+	Name string
+	// Value is the value of the tag.
+	Value string
+}
+
+// ParseTagFilter parses the tag filter from the 'tagName' and 'tagValue' query string parameters of a list request.
+// It returns nil if the request doesn't filter by tag.
+func ParseTagFilter(query url.Values) (*TagFilter, error) {
+	name := query.Get(TagNameParameterName)
+	value, hasValue := query[TagValueParameterName]
+	if name == "" && !hasValue {
+		return nil, nil
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("the %q query parameter is required when %q is specified", TagNameParameterName, TagValueParameterName)
+	}
+
+	if !hasValue {
+		return nil, fmt.Errorf("the %q query parameter is required when %q is specified", TagValueParameterName, TagNameParameterName)
+	}
+
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+
+	return &TagFilter{Name: name, Value: value[0]}, nil
+}
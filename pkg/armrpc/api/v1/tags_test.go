@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTags(t *testing.T) {
+	tooMany := map[string]string{}
+	for i := 0; i <= MaxTagCount; i++ {
+		tooMany[fmt.Sprintf("tag%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name string
+		tags map[string]string
+		err  string
+	}{
+		{
+			name: "nil",
+			tags: nil,
+		},
+		{
+			name: "valid",
+			tags: map[string]string{"team": "payments", "cost-center": "", "app.kubernetes.io": "store"},
+		},
+		{
+			name: "invalid character",
+			tags: map[string]string{"app.kubernetes.io/name": "store"},
+			err:  `the tag name "app.kubernetes.io/name" is invalid, tag names can't contain any of '<>%&\?/'`,
+		},
+		{
+			name: "too many tags",
+			tags: tooMany,
+			err:  "a resource can have at most 50 tags, got 51",
+		},
+		{
+			name: "empty name",
+			tags: map[string]string{" ": "value"},
+			err:  "tag names can't be empty",
+		},
+		{
+			name: "name too long",
+			tags: map[string]string{strings.Repeat("a", MaxTagNameLength+1): "value"},
+			err:  fmt.Sprintf("the tag name %q is longer than 512 characters", strings.Repeat("a", MaxTagNameLength+1)),
+		},
+		{
+			name: "names only differ by case",
+			tags: map[string]string{"Team": "payments", "team": "checkout"},
+			err:  `the tag names "Team" and "team" only differ by case, tag names are case-insensitive`,
+		},
+		{
+			name: "value too long",
+			tags: map[string]string{"team": strings.Repeat("a", MaxTagValueLength+1)},
+			err:  `the value of the tag "team" is longer than 256 characters`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTags(tt.tags)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseTagFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected *TagFilter
+		err      string
+	}{
+		{
+			name:     "no filter",
+			query:    "api-version=2023-10-01-preview",
+			expected: nil,
+		},
+		{
+			name:     "name and value",
+			query:    "tagName=cost-center&tagValue=payments",
+			expected: &TagFilter{Name: "cost-center", Value: "payments"},
+		},
+		{
+			name:     "empty value",
+			query:    "tagName=team&tagValue=",
+			expected: &TagFilter{Name: "team", Value: ""},
+		},
+		{
+			name:  "missing name",
+			query: "tagValue=payments",
+			err:   `the "tagName" query parameter is required when "tagValue" is specified`,
+		},
+		{
+			name:  "missing value",
+			query: "tagName=team",
+			err:   `the "tagValue" query parameter is required when "tagName" is specified`,
+		},
+		{
+			name:  "invalid name",
+			query: "tagName=a%2Fb&tagValue=payments",
+			err:   `the tag name "a/b" is invalid, tag names can't contain any of '<>%&\?/'`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			filter, err := ParseTagFilter(query)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, filter)
+		})
+	}
+}
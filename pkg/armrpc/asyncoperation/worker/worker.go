@@ -79,6 +79,33 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// OutcomeRecorder records the outcome of each operation, e.g. in an audit log. This field is optional.
+	OutcomeRecorder OutcomeRecorder
+}
+
+// OperationOutcome is the outcome of an async operation.
+type OperationOutcome struct {
+	// OperationType is the type of the operation.
+	OperationType string
+
+	// ResourceID is the ID of the resource of the operation.
+	ResourceID string
+
+	// APIVersion is the api-version of the request that started the operation.
+	APIVersion string
+
+	// CorrelationID is the correlation ID of the request that started the operation.
+	CorrelationID string
+
+	// ProvisioningState is the final provisioning state of the operation.
+	ProvisioningState v1.ProvisioningState
+}
+
+// OutcomeRecorder records the outcome of async operations.
+type OutcomeRecorder interface {
+	// RecordOutcome records the outcome of an operation.
+	RecordOutcome(ctx context.Context, outcome *OperationOutcome) error
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -353,6 +380,20 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	}
 
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
+
+	// Operations that are requeued have no outcome yet.
+	if w.options.OutcomeRecorder != nil && !result.Requeue {
+		outcome := &OperationOutcome{
+			OperationType:     req.OperationType,
+			ResourceID:        req.ResourceID,
+			APIVersion:        req.APIVersion,
+			CorrelationID:     req.CorrelationID,
+			ProvisioningState: result.ProvisioningState(),
+		}
+		if err := w.options.OutcomeRecorder.RecordOutcome(ctx, outcome); err != nil {
+			logger.Error(err, "failed to record the outcome of the operation")
+		}
+	}
 }
 
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -439,6 +440,62 @@ func TestRunOperation_Successfully(t *testing.T) {
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_RecordOutcome(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// set up mocks
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	recorder := &testOutcomeRecorder{}
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{OutcomeRecorder: recorder}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	opts := ctrl.Options{
+		DatabaseClient: tCtx.mockSC,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return deployment.NewMockDeploymentProcessor(mctrl)
+		},
+	}
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl)
+
+	req := &ctrl.Request{}
+	err = json.Unmarshal(testMessage.Data, req)
+	require.NoError(t, err)
+	expected := OperationOutcome{
+		OperationType:     req.OperationType,
+		ResourceID:        req.ResourceID,
+		APIVersion:        req.APIVersion,
+		CorrelationID:     req.CorrelationID,
+		ProvisioningState: v1.ProvisioningStateSucceeded,
+	}
+	require.Equal(t, []OperationOutcome{expected}, recorder.outcomes)
+}
+
+type testOutcomeRecorder struct {
+	outcomes []OperationOutcome
+}
+
+func (r *testOutcomeRecorder) RecordOutcome(ctx context.Context, outcome *OperationOutcome) error {
+	r.outcomes = append(r.outcomes, *outcome)
+	return nil
+}
+
 func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
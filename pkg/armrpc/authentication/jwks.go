@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// jwksRefreshInterval is the interval after which the cached keys of an issuer are refreshed.
+	jwksRefreshInterval = 1 * time.Hour
+
+	// jwksMinRefreshInterval is the minimum interval between two refreshes of the keys of an issuer. Tokens with
+	// an unknown key ID trigger a refresh, so this limits the requests to the issuer.
+	jwksMinRefreshInterval = 10 * time.Second
+)
+
+// keySet fetches and caches the JSON Web Key Set of a token issuer. The keys are fetched without holding the lock, so
+// that a slow issuer only delays the requests that need the new keys.
+//
+// The cached keys are served while the issuer is unavailable, and failed fetches are limited like successful ones, so
+// that an unavailable issuer is not sent a request for every token.
+type keySet struct {
+	client *http.Client
+	issuer string
+
+	// group deduplicates the concurrent refreshes of the keys.
+	group singleflight.Group
+
+	mu      sync.RWMutex
+	jwksURL string
+	keys    jose.JSONWebKeySet
+	fetched time.Time
+
+	// attempted is the time of the last fetch, successful or not, and fetchErr its error.
+	attempted time.Time
+	fetchErr  error
+}
+
+// key returns the public key with the given key ID. When the token has no key ID, the key set must have
+// exactly one key.
+func (k *keySet) key(ctx context.Context, kid string) (any, error) {
+	key, fetched := k.find(kid)
+	if key != nil && time.Since(fetched) <= jwksRefreshInterval {
+		return key, nil
+	}
+
+	// The issuer may have rotated its keys since they were fetched.
+	k.mu.RLock()
+	attempted, fetchErr := k.attempted, k.fetchErr
+	k.mu.RUnlock()
+	if time.Since(attempted) > jwksMinRefreshInterval {
+		fetchErr = k.refresh(ctx)
+		if fetchErr == nil {
+			key, _ = k.find(kid)
+		}
+	}
+
+	// The cached key is used when the keys can't be refreshed.
+	if key != nil {
+		return key, nil
+	} else if fetchErr != nil {
+		return nil, fetchErr
+	}
+
+	return nil, fmt.Errorf("the issuer %q has no signing key %q", k.issuer, kid)
+}
+
+// find returns the public key with the given key ID, or nil if there is no such key, and the time the keys were
+// fetched.
+func (k *keySet) find(kid string) (any, time.Time) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if kid == "" {
+		if len(k.keys.Keys) == 1 {
+			return k.keys.Keys[0].Key, k.fetched
+		}
+
+		return nil, k.fetched
+	}
+
+	for _, key := range k.keys.Key(kid) {
+		if key.Use == "" || key.Use == "sig" {
+			return key.Key, k.fetched
+		}
+	}
+
+	return nil, k.fetched
+}
+
+// refresh fetches the keys of the issuer once for all the concurrent callers. The fetch is not cancelled when the
+// caller that started it is cancelled, since other callers may be waiting for it.
+func (k *keySet) refresh(ctx context.Context) error {
+	result := k.group.DoChan("refresh", func() (any, error) {
+		err := k.fetch(context.WithoutCancel(ctx))
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		k.attempted = time.Now()
+		k.fetchErr = err
+
+		return nil, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case r := <-result:
+		return r.Err
+	}
+}
+
+// fetch fetches the keys of the issuer, discovering the URL of the key set from the OpenID configuration of the
+// issuer when it's not configured.
+func (k *keySet) fetch(ctx context.Context) error {
+	k.mu.RLock()
+	jwksURL := k.jwksURL
+	k.mu.RUnlock()
+
+	if jwksURL == "" {
+		configuration := struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}{}
+		err := k.get(ctx, strings.TrimSuffix(k.issuer, "/")+"/.well-known/openid-configuration", &configuration)
+		if err != nil {
+			return fmt.Errorf("failed to discover the OpenID configuration of issuer %q: %w", k.issuer, err)
+		}
+
+		if configuration.Issuer != k.issuer {
+			return fmt.Errorf("the OpenID configuration of issuer %q is for issuer %q", k.issuer, configuration.Issuer)
+		} else if configuration.JWKSURI == "" {
+			return fmt.Errorf("the OpenID configuration of issuer %q has no jwks_uri", k.issuer)
+		}
+
+		jwksURL = configuration.JWKSURI
+	}
+
+	keys := jose.JSONWebKeySet{}
+	err := k.get(ctx, jwksURL, &keys)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the signing keys of issuer %q: %w", k.issuer, err)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.jwksURL = jwksURL
+	k.keys = keys
+	k.fetched = time.Now()
+	return nil
+}
+
+func (k *keySet) get(ctx context.Context, url string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %q", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_KeySet_RefreshDoesNotBlockCachedKeys(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	cached := jose.JSONWebKey{Key: &key.PublicKey, KeyID: "cached", Use: "sig"}
+	rotated := jose.JSONWebKey{Key: &key.PublicKey, KeyID: "rotated", Use: "sig"}
+
+	requested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		requested <- struct{}{}
+		<-release
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{cached, rotated}})
+	}))
+	defer server.Close()
+
+	keys := &keySet{
+		client:  server.Client(),
+		issuer:  server.URL,
+		jwksURL: server.URL,
+		keys:    jose.JSONWebKeySet{Keys: []jose.JSONWebKey{cached}},
+		fetched: time.Now().Add(-time.Minute),
+	}
+
+	// A lookup of an unknown key refreshes the keys.
+	results := make(chan error, 1)
+	go func() {
+		_, err := keys.key(ctx, "rotated")
+		results <- err
+	}()
+	<-requested
+
+	// The cached key is returned while the refresh is in progress.
+	found, err := keys.key(ctx, "cached")
+	require.NoError(t, err)
+	require.Equal(t, &key.PublicKey, found)
+
+	close(release)
+	require.NoError(t, <-results)
+	require.Equal(t, int32(1), requests.Load())
+}
+
+func Test_KeySet_FailedRefresh(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	cached := jose.JSONWebKey{Key: &key.PublicKey, KeyID: "cached", Use: "sig"}
+
+	requests := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	keys := &keySet{
+		client:  server.Client(),
+		issuer:  server.URL,
+		jwksURL: server.URL,
+		keys:    jose.JSONWebKeySet{Keys: []jose.JSONWebKey{cached}},
+		fetched: time.Now().Add(-2 * jwksRefreshInterval),
+	}
+
+	// The stale key is returned when the keys can't be refreshed.
+	found, err := keys.key(ctx, "cached")
+	require.NoError(t, err)
+	require.Equal(t, &key.PublicKey, found)
+	require.Equal(t, int32(1), requests.Load())
+
+	// The failed refresh is not retried before the minimum refresh interval.
+	found, err = keys.key(ctx, "cached")
+	require.NoError(t, err)
+	require.Equal(t, &key.PublicKey, found)
+
+	_, err = keys.key(ctx, "unknown")
+	require.ErrorContains(t, err, "failed to fetch the signing keys")
+	require.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// AuthorizationHeader is the header used to pass the bearer token of a request.
+	AuthorizationHeader = "Authorization"
+
+	defaultUsernameClaim = "sub"
+	defaultGroupsClaim   = "groups"
+
+	// reservedPrefix is the prefix of the names of the Kubernetes users and groups, like the service accounts of the
+	// Radius components and 'system:masters'. The principals of tokens can't use it, so that they're never trusted.
+	reservedPrefix = "system:"
+
+	// tokenLeeway is the allowed clock skew when validating the time claims of a token.
+	tokenLeeway = 1 * time.Minute
+)
+
+// signingMethods are the accepted signing algorithms of tokens. Symmetric algorithms are not accepted, because the
+// keys of the issuers are public.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// OIDCAuthenticator authenticates principals using JWT bearer tokens issued by trusted OpenID Connect providers.
+type OIDCAuthenticator struct {
+	issuers map[string]*oidcIssuer
+}
+
+type oidcIssuer struct {
+	options hostoptions.OIDCIssuerOptions
+	keys    *keySet
+}
+
+// NewOIDCAuthenticator creates an OIDCAuthenticator for the trusted issuers. The client is used to fetch the
+// signing keys of the issuers.
+func NewOIDCAuthenticator(options *hostoptions.OIDCOptions, client *http.Client) (*OIDCAuthenticator, error) {
+	if options == nil || len(options.Issuers) == 0 {
+		return nil, errors.New("OIDC authentication requires at least one issuer")
+	}
+
+	authenticator := &OIDCAuthenticator{issuers: map[string]*oidcIssuer{}}
+	for _, issuer := range options.Issuers {
+		if issuer.Issuer == "" {
+			return nil, errors.New("OIDC issuers must have an issuer URL")
+		} else if len(issuer.Audiences) == 0 {
+			return nil, fmt.Errorf("the OIDC issuer %q must have at least one audience", issuer.Issuer)
+		} else if _, ok := authenticator.issuers[issuer.Issuer]; ok {
+			return nil, fmt.Errorf("the OIDC issuer %q is configured more than once", issuer.Issuer)
+		}
+
+		if issuer.UsernameClaim == "" {
+			issuer.UsernameClaim = defaultUsernameClaim
+		}
+		if issuer.GroupsClaim == "" {
+			issuer.GroupsClaim = defaultGroupsClaim
+		}
+
+		// The names of the principals are qualified by the issuer by default, so that they can't match the names of
+		// other principals.
+		if issuer.UsernamePrefix == "" {
+			issuer.UsernamePrefix = issuer.Issuer + "#"
+		}
+		if issuer.GroupsPrefix == "" {
+			issuer.GroupsPrefix = issuer.Issuer + "#"
+		}
+
+		authenticator.issuers[issuer.Issuer] = &oidcIssuer{
+			options: issuer,
+			keys:    &keySet{client: client, issuer: issuer.Issuer, jwksURL: issuer.JWKSURL},
+		}
+	}
+
+	return authenticator, nil
+}
+
+// Authenticate validates a bearer token and returns the principal it was issued for.
+func (a *OIDCAuthenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	unverified := jwt.MapClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, unverified)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	iss, err := unverified.GetIssuer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	issuer, ok := a.issuers[iss]
+	if !ok {
+		return nil, fmt.Errorf("the token issuer %q is not trusted", iss)
+	}
+
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(iss),
+		jwt.WithAudience(issuer.options.Audiences...),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(tokenLeeway))
+
+	claims := jwt.MapClaims{}
+	_, err = parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return issuer.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate token: %w", err)
+	}
+
+	return issuer.principal(claims)
+}
+
+// principal maps the claims of a validated token to a principal. Tokens whose user or groups have a reserved name
+// are rejected.
+func (i *oidcIssuer) principal(claims jwt.MapClaims) (*Principal, error) {
+	name, ok := claims[i.options.UsernameClaim].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("the token has no %q claim", i.options.UsernameClaim)
+	}
+
+	principal := &Principal{Name: i.options.UsernamePrefix + name}
+	if strings.HasPrefix(principal.Name, reservedPrefix) {
+		return nil, fmt.Errorf("the user %q of the token has a reserved name", principal.Name)
+	}
+
+	groups := []string{}
+	switch claim := claims[i.options.GroupsClaim].(type) {
+	case string:
+		groups = append(groups, claim)
+	case []any:
+		for _, group := range claim {
+			if s, ok := group.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+
+	for _, group := range groups {
+		group = i.options.GroupsPrefix + group
+		if strings.HasPrefix(group, reservedPrefix) {
+			return nil, fmt.Errorf("the group %q of the token has a reserved name", group)
+		}
+		principal.Groups = append(principal.Groups, group)
+	}
+
+	return principal, nil
+}
+
+// BearerTokenValidator authenticates requests with a JWT bearer token in the Authorization header, and adds the
+// principal of the token to the request context. Requests with an invalid token are rejected. Requests without a
+// bearer token keep the principal authenticated by a previous middleware, like the one of a verified aggregation
+// layer, but never the user headers set by the caller.
+func BearerTokenValidator(authenticator *OIDCAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scheme, token, ok := strings.Cut(r.Header.Get(AuthorizationHeader), " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") {
+				r.Header.Del(RemoteUserHeader)
+				r.Header.Del(RemoteGroupHeader)
+
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := authenticator.Authenticate(r.Context(), strings.TrimSpace(token))
+			if err != nil {
+				log := logr.FromContextOrDiscard(r.Context())
+				log.V(ucplog.LevelDebug).Info("Bearer token validation failed", "error", err.Error())
+				handleErr(r.Context(), w, r)
+				return
+			}
+
+			// The principal of the token takes precedence over the headers of the Kubernetes API aggregation layer.
+			r.Header.Del(RemoteUserHeader)
+			r.Header.Del(RemoteGroupHeader)
+
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/radius-project/radius/test/testoidc"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAuthenticator(t *testing.T, issuer *testoidc.Issuer, options hostoptions.OIDCIssuerOptions) *OIDCAuthenticator {
+	options.Issuer = issuer.URL
+	options.Audiences = []string{issuer.ClientID}
+
+	authenticator, err := NewOIDCAuthenticator(&hostoptions.OIDCOptions{Issuers: []hostoptions.OIDCIssuerOptions{options}}, http.DefaultClient)
+	require.NoError(t, err)
+	return authenticator
+}
+
+func Test_NewOIDCAuthenticator_Invalid(t *testing.T) {
+	_, err := NewOIDCAuthenticator(nil, http.DefaultClient)
+	require.ErrorContains(t, err, "at least one issuer")
+
+	_, err = NewOIDCAuthenticator(&hostoptions.OIDCOptions{Issuers: []hostoptions.OIDCIssuerOptions{{Issuer: "https://issuer"}}}, http.DefaultClient)
+	require.ErrorContains(t, err, "at least one audience")
+}
+
+func Test_OIDCAuthenticator_Authenticate(t *testing.T) {
+	ctx := testcontext.New(t)
+	issuer := testoidc.NewIssuer(t)
+	authenticator := newTestAuthenticator(t, issuer, hostoptions.OIDCIssuerOptions{})
+
+	principal, err := authenticator.Authenticate(ctx, issuer.Token(t, jwt.MapClaims{"sub": "alice", "groups": []string{"team-a", "team-b"}}))
+	require.NoError(t, err)
+	require.Equal(t, &Principal{Name: issuer.URL + "#alice", Groups: []string{issuer.URL + "#team-a", issuer.URL + "#team-b"}}, principal)
+
+	// The principals of tokens are qualified by the issuer, so they never match the names of Kubernetes principals.
+	principal, err = authenticator.Authenticate(ctx, issuer.Token(t, jwt.MapClaims{"sub": "system:serviceaccount:radius-system:dynamic-rp", "groups": []string{"system:masters"}}))
+	require.NoError(t, err)
+	require.Equal(t, &Principal{Name: issuer.URL + "#system:serviceaccount:radius-system:dynamic-rp", Groups: []string{issuer.URL + "#system:masters"}}, principal)
+
+	invalid := []struct {
+		name   string
+		token  string
+		errMsg string
+	}{
+		{
+			name:   "malformed",
+			token:  "not-a-token",
+			errMsg: "failed to parse token",
+		},
+		{
+			name:   "untrusted issuer",
+			token:  issuer.Token(t, jwt.MapClaims{"sub": "alice", "iss": "https://untrusted"}),
+			errMsg: `the token issuer "https://untrusted" is not trusted`,
+		},
+		{
+			name:   "wrong audience",
+			token:  issuer.Token(t, jwt.MapClaims{"sub": "alice", "aud": "other"}),
+			errMsg: "token has invalid audience",
+		},
+		{
+			name:   "expired",
+			token:  issuer.Token(t, jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(-time.Hour).Unix()}),
+			errMsg: "token is expired",
+		},
+		{
+			name:   "missing username",
+			token:  issuer.Token(t, jwt.MapClaims{}),
+			errMsg: `the token has no "sub" claim`,
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authenticator.Authenticate(ctx, tt.token)
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func Test_OIDCAuthenticator_Authenticate_ClaimMapping(t *testing.T) {
+	ctx := testcontext.New(t)
+	issuer := testoidc.NewIssuer(t)
+	authenticator := newTestAuthenticator(t, issuer, hostoptions.OIDCIssuerOptions{
+		UsernameClaim:  "email",
+		UsernamePrefix: "oidc:",
+		GroupsClaim:    "roles",
+		GroupsPrefix:   "oidc:",
+	})
+
+	principal, err := authenticator.Authenticate(ctx, issuer.Token(t, jwt.MapClaims{"sub": "1234", "email": "alice@example.com", "roles": "admins"}))
+	require.NoError(t, err)
+	require.Equal(t, &Principal{Name: "oidc:alice@example.com", Groups: []string{"oidc:admins"}}, principal)
+}
+
+func Test_OIDCAuthenticator_Authenticate_ReservedNames(t *testing.T) {
+	ctx := testcontext.New(t)
+	issuer := testoidc.NewIssuer(t)
+	authenticator := newTestAuthenticator(t, issuer, hostoptions.OIDCIssuerOptions{
+		UsernamePrefix: "system:oidc:",
+		GroupsPrefix:   "system:",
+	})
+
+	_, err := authenticator.Authenticate(ctx, issuer.Token(t, jwt.MapClaims{"sub": "alice"}))
+	require.EqualError(t, err, `the user "system:oidc:alice" of the token has a reserved name`)
+
+	authenticator = newTestAuthenticator(t, issuer, hostoptions.OIDCIssuerOptions{
+		UsernamePrefix: "oidc:",
+		GroupsPrefix:   "system:",
+	})
+
+	_, err = authenticator.Authenticate(ctx, issuer.Token(t, jwt.MapClaims{"sub": "alice", "groups": []string{"masters"}}))
+	require.EqualError(t, err, `the group "system:masters" of the token has a reserved name`)
+}
+
+func Test_BearerTokenValidator(t *testing.T) {
+	issuer := testoidc.NewIssuer(t)
+	authenticator := newTestAuthenticator(t, issuer, hostoptions.OIDCIssuerOptions{})
+
+	var principal *Principal
+	var remoteUser string
+	handler := BearerTokenValidator(authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal = PrincipalFromRequest(r)
+		remoteUser = r.Header.Get(RemoteUserHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name          string
+		authorization string
+		authenticated *Principal
+		expected      int
+		principal     *Principal
+	}{
+		{
+			name:          "no token",
+			authenticated: &Principal{Name: "system:serviceaccount:radius-system:dynamic-rp"},
+			expected:      http.StatusOK,
+			principal:     &Principal{Name: "system:serviceaccount:radius-system:dynamic-rp"},
+		},
+		{
+			name:     "no token with user headers",
+			expected: http.StatusOK,
+		},
+		{
+			name:          "valid token",
+			authorization: "Bearer " + issuer.Token(t, jwt.MapClaims{"sub": "alice"}),
+			authenticated: &Principal{Name: "mallory"},
+			expected:      http.StatusOK,
+			principal:     &Principal{Name: issuer.URL + "#alice"},
+		},
+		{
+			name:          "invalid token",
+			authorization: "Bearer " + issuer.Token(t, jwt.MapClaims{"sub": "alice", "aud": "other"}),
+			expected:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal = nil
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			req.Header.Set(RemoteUserHeader, "mallory")
+			if tt.authorization != "" {
+				req.Header.Set(AuthorizationHeader, tt.authorization)
+			}
+			if tt.authenticated != nil {
+				req = req.WithContext(WithPrincipal(req.Context(), tt.authenticated))
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, tt.expected, w.Code)
+			require.Equal(t, tt.principal, principal)
+			require.Empty(t, remoteUser)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// podIPIndex is the name of the index of the pods by IP.
+	podIPIndex = "podIP"
+)
+
+// PodIdentityAuthenticator authenticates in-cluster callers that cannot present credentials, like the deployment
+// engine, as the service account of the pod that opened the connection. The cluster network assigns the pod IPs, so
+// a caller cannot open a TCP connection from the IP of another pod. Pods using the host network are never
+// authenticated because they share the IP of their node.
+//
+// The pods of the namespace are watched and indexed by IP, so that requests don't call the Kubernetes API server.
+type PodIdentityAuthenticator struct {
+	factory         informers.SharedInformerFactory
+	informer        cache.SharedIndexInformer
+	namespace       string
+	serviceAccounts []string
+}
+
+// NewPodIdentityAuthenticator creates a PodIdentityAuthenticator for the pods of the configured service accounts. It
+// must be started before it's used.
+func NewPodIdentityAuthenticator(options *hostoptions.PodIdentityOptions, client kubernetes.Interface) (*PodIdentityAuthenticator, error) {
+	factory := informers.NewSharedInformerFactoryWithOptions(client, 0, informers.WithNamespace(options.Namespace))
+	informer := factory.Core().V1().Pods().Informer()
+	err := informer.AddIndexers(cache.Indexers{podIPIndex: indexPodIP})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PodIdentityAuthenticator{
+		factory:         factory,
+		informer:        informer,
+		namespace:       options.Namespace,
+		serviceAccounts: options.ServiceAccounts,
+	}, nil
+}
+
+// Start starts watching the pods until the context is cancelled, and waits until they're listed.
+func (a *PodIdentityAuthenticator) Start(ctx context.Context) error {
+	a.factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), a.informer.HasSynced) {
+		return fmt.Errorf("failed to list the pods of namespace %q: %w", a.namespace, ctx.Err())
+	}
+
+	return nil
+}
+
+// indexPodIP returns the IP of a pod, which is the key of the pod in the pod IP index.
+func indexPodIP(obj any) ([]string, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Status.PodIP == "" {
+		return nil, nil
+	}
+
+	return []string{pod.Status.PodIP}, nil
+}
+
+// Authenticate returns the principal of the service account of the pod with the IP of the remote address, or nil if
+// the remote address is not the IP of a running pod of the configured service accounts.
+func (a *PodIdentityAuthenticator) Authenticate(ctx context.Context, remoteAddr string) (*Principal, error) {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse remote address %q: %w", remoteAddr, err)
+	}
+
+	pods, err := a.informer.GetIndexer().ByIndex(podIPIndex, host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods with IP %q: %w", host, err)
+	}
+
+	for _, obj := range pods {
+		pod, ok := obj.(*corev1.Pod)
+		if !ok || pod.Status.PodIP != host || pod.Spec.HostNetwork || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		if !slices.Contains(a.serviceAccounts, pod.Spec.ServiceAccountName) {
+			return nil, nil
+		}
+
+		return &Principal{
+			Name: fmt.Sprintf("system:serviceaccount:%s:%s", pod.Namespace, pod.Spec.ServiceAccountName),
+			Groups: []string{
+				"system:serviceaccounts",
+				"system:serviceaccounts:" + pod.Namespace,
+				"system:authenticated",
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+type remoteAddrKey struct{}
+
+// ConnContext returns a copy of the context with the remote address of the connection. It's used as the ConnContext
+// of the HTTP server, because the remote address of the requests is removed before they are handled.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, remoteAddrKey{}, c.RemoteAddr().String())
+}
+
+// PodIdentityValidator adds the principal of the pod that opened the connection to the context of the requests that
+// were not authenticated otherwise.
+func PodIdentityValidator(authenticator *PodIdentityAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if PrincipalFromContext(r.Context()) != nil || r.Header.Get(AuthorizationHeader) != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			remoteAddr, ok := r.Context().Value(remoteAddrKey{}).(string)
+			if !ok {
+				remoteAddr = r.RemoteAddr
+			}
+
+			principal, err := authenticator.Authenticate(r.Context(), remoteAddr)
+			if err != nil {
+				logger := ucplog.FromContextOrDiscard(r.Context())
+				logger.Error(err, "Failed to authenticate the pod of the caller")
+			}
+
+			if principal != nil {
+				r = r.WithContext(WithPrincipal(r.Context(), principal))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testPod(name string, serviceAccount string, ip string, phase corev1.PodPhase, hostNetwork bool) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "radius-system"},
+		Spec:       corev1.PodSpec{ServiceAccountName: serviceAccount, HostNetwork: hostNetwork},
+		Status:     corev1.PodStatus{PodIP: ip, Phase: phase},
+	}
+}
+
+func newTestPodIdentityAuthenticator(t *testing.T) *PodIdentityAuthenticator {
+	clientset := fake.NewClientset(
+		testPod("bicep-de", "bicep-de", "10.0.0.1", corev1.PodRunning, false),
+		testPod("bicep-de-old", "bicep-de", "10.0.0.2", corev1.PodSucceeded, false),
+		testPod("other", "other", "10.0.0.3", corev1.PodRunning, false),
+		testPod("host", "bicep-de", "10.0.0.4", corev1.PodRunning, true),
+	)
+
+	authenticator, err := NewPodIdentityAuthenticator(&hostoptions.PodIdentityOptions{Namespace: "radius-system", ServiceAccounts: []string{"bicep-de"}}, clientset)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.Start(testcontext.New(t)))
+
+	return authenticator
+}
+
+func Test_PodIdentityAuthenticator_Authenticate(t *testing.T) {
+	ctx := testcontext.New(t)
+	authenticator := newTestPodIdentityAuthenticator(t)
+
+	principal, err := authenticator.Authenticate(ctx, "10.0.0.1:51234")
+	require.NoError(t, err)
+	require.Equal(t, &Principal{
+		Name:   "system:serviceaccount:radius-system:bicep-de",
+		Groups: []string{"system:serviceaccounts", "system:serviceaccounts:radius-system", "system:authenticated"},
+	}, principal)
+
+	unauthenticated := []struct {
+		name       string
+		remoteAddr string
+	}{
+		{name: "pod not running", remoteAddr: "10.0.0.2:51234"},
+		{name: "other service account", remoteAddr: "10.0.0.3:51234"},
+		{name: "host network", remoteAddr: "10.0.0.4:51234"},
+		{name: "unknown ip", remoteAddr: "10.0.0.5:51234"},
+	}
+
+	for _, tt := range unauthenticated {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := authenticator.Authenticate(ctx, tt.remoteAddr)
+			require.NoError(t, err)
+			require.Nil(t, principal)
+		})
+	}
+
+	_, err = authenticator.Authenticate(ctx, "invalid")
+	require.ErrorContains(t, err, "failed to parse remote address")
+}
+
+func Test_PodIdentityAuthenticator_WatchesPods(t *testing.T) {
+	ctx := testcontext.New(t)
+	clientset := fake.NewClientset()
+	authenticator, err := NewPodIdentityAuthenticator(&hostoptions.PodIdentityOptions{Namespace: "radius-system", ServiceAccounts: []string{"bicep-de"}}, clientset)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.Start(ctx))
+
+	principal, err := authenticator.Authenticate(ctx, "10.0.0.1:51234")
+	require.NoError(t, err)
+	require.Nil(t, principal)
+
+	// Pods created after the authenticator started are authenticated once they're observed.
+	_, err = clientset.CoreV1().Pods("radius-system").Create(ctx, testPod("bicep-de", "bicep-de", "10.0.0.1", corev1.PodRunning, false), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		principal, err := authenticator.Authenticate(ctx, "10.0.0.1:51234")
+		return err == nil && principal != nil
+	}, 10*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"net/http"
+	"slices"
+)
+
+const (
+	// RemoteUserHeader is the header used by the Kubernetes API aggregation layer to pass the name of the
+	// authenticated user to UCP.
+	RemoteUserHeader = "X-Remote-User"
+
+	// RemoteGroupHeader is the header used by the Kubernetes API aggregation layer to pass the groups of the
+	// authenticated user to UCP. The header is repeated for each group.
+	RemoteGroupHeader = "X-Remote-Group"
+)
+
+// Principal is the authenticated user of a request.
+type Principal struct {
+	// Name is the name of the user.
+	Name string
+
+	// Groups are the groups the user is a member of.
+	Groups []string
+}
+
+// IsMemberOf returns true if the principal is a member of the group.
+func (p *Principal) IsMemberOf(group string) bool {
+	return slices.Contains(p.Groups, group)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of the context with the authenticated principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal of the context, or nil if the request was not
+// authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok {
+		return nil
+	}
+
+	return principal
+}
+
+// PrincipalFromRequest returns the authenticated principal of a request, or nil if the request was not
+// authenticated. The principal is set by the authentication middleware, which only trusts the headers of the
+// Kubernetes API aggregation layer on connections verified against its client CA.
+func PrincipalFromRequest(r *http.Request) *Principal {
+	return PrincipalFromContext(r.Context())
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PrincipalFromRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+	require.Nil(t, PrincipalFromRequest(req))
+
+	// The headers of the aggregation layer are only trusted through the authentication middleware.
+	req.Header.Set(RemoteUserHeader, "alice")
+	req.Header.Add(RemoteGroupHeader, "team-a")
+	require.Nil(t, PrincipalFromRequest(req))
+
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{Name: "bob"}))
+	require.Equal(t, &Principal{Name: "bob"}, PrincipalFromRequest(req))
+}
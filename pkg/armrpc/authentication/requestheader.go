@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// authenticationConfigMapNamespace and authenticationConfigMapName identify the ConfigMap where Kubernetes
+	// publishes the CA bundle of the client certificate used by the API aggregation layer.
+	authenticationConfigMapNamespace = "kube-system"
+	authenticationConfigMapName      = "extension-apiserver-authentication"
+
+	requestHeaderClientCAKey     = "requestheader-client-ca-file"
+	requestHeaderAllowedNamesKey = "requestheader-allowed-names"
+)
+
+// RequestHeaderAuthenticator authenticates the requests proxied by the Kubernetes API aggregation layer, which passes
+// the authenticated user in the X-Remote-User and X-Remote-Group headers. The headers are only trusted when the
+// request was sent with a client certificate signed by the request header CA.
+type RequestHeaderAuthenticator struct {
+	roots        *x509.CertPool
+	allowedNames []string
+}
+
+// NewRequestHeaderAuthenticator creates a RequestHeaderAuthenticator from a PEM encoded CA bundle. When allowedNames
+// is empty, any client certificate signed by the CA is accepted.
+func NewRequestHeaderAuthenticator(caBundle []byte, allowedNames []string) (*RequestHeaderAuthenticator, error) {
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caBundle) {
+		return nil, errors.New("the request header CA bundle has no valid certificate")
+	}
+
+	return &RequestHeaderAuthenticator{roots: roots, allowedNames: allowedNames}, nil
+}
+
+// LoadRequestHeaderAuthenticator creates a RequestHeaderAuthenticator from the options, reading the CA bundle from the
+// configured file or, when unset, from the authentication ConfigMap published by Kubernetes.
+func LoadRequestHeaderAuthenticator(ctx context.Context, options *hostoptions.RequestHeaderOptions, client kubernetes.Interface) (*RequestHeaderAuthenticator, error) {
+	if options.ClientCAFile != "" {
+		caBundle, err := os.ReadFile(options.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the request header CA bundle: %w", err)
+		}
+
+		return NewRequestHeaderAuthenticator(caBundle, options.AllowedNames)
+	}
+
+	if client == nil {
+		return nil, errors.New("a Kubernetes client is required to read the request header CA bundle")
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(authenticationConfigMapNamespace).Get(ctx, authenticationConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the ConfigMap %s/%s: %w", authenticationConfigMapNamespace, authenticationConfigMapName, err)
+	}
+
+	caBundle, ok := configMap.Data[requestHeaderClientCAKey]
+	if !ok {
+		return nil, fmt.Errorf("the ConfigMap %s/%s has no %q", authenticationConfigMapNamespace, authenticationConfigMapName, requestHeaderClientCAKey)
+	}
+
+	allowedNames := options.AllowedNames
+	if len(allowedNames) == 0 && configMap.Data[requestHeaderAllowedNamesKey] != "" {
+		err = json.Unmarshal([]byte(configMap.Data[requestHeaderAllowedNamesKey]), &allowedNames)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q of the ConfigMap %s/%s: %w", requestHeaderAllowedNamesKey, authenticationConfigMapNamespace, authenticationConfigMapName, err)
+		}
+	}
+
+	return NewRequestHeaderAuthenticator([]byte(caBundle), allowedNames)
+}
+
+// Authenticate returns the principal of the user headers when the request was sent by the aggregation layer, or nil
+// otherwise.
+func (a *RequestHeaderAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	name := r.Header.Get(RemoteUserHeader)
+	if name == "" {
+		return nil, nil
+	}
+
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, errors.New("the request has no client certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	cert := r.TLS.PeerCertificates[0]
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         a.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the client certificate: %w", err)
+	}
+
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, cert.Subject.CommonName) {
+		return nil, fmt.Errorf("the client certificate %q is not allowed", cert.Subject.CommonName)
+	}
+
+	return &Principal{
+		Name:   name,
+		Groups: r.Header.Values(RemoteGroupHeader),
+	}, nil
+}
+
+// RequestHeaderValidator adds the principal of the user headers to the request context when the request was sent by
+// the aggregation layer, and removes the user headers from every request so that no caller can claim a principal by
+// setting them. When the authenticator is nil the headers are never trusted.
+func RequestHeaderValidator(authenticator *RequestHeaderAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var principal *Principal
+			if authenticator != nil {
+				var err error
+				principal, err = authenticator.Authenticate(r)
+				if err != nil {
+					logger := ucplog.FromContextOrDiscard(r.Context())
+					logger.V(ucplog.LevelDebug).Info("Ignoring untrusted user headers", "error", err.Error())
+				}
+			}
+
+			r.Header.Del(RemoteUserHeader)
+			r.Header.Del(RemoteGroupHeader)
+
+			if principal != nil {
+				r = r.WithContext(WithPrincipal(r.Context(), principal))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "front-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (ca *testCA) clientCert(t *testing.T, commonName string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func Test_LoadRequestHeaderAuthenticator_FromConfigMap(t *testing.T) {
+	ctx := testcontext.New(t)
+	ca := newTestCA(t)
+
+	clientset := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: authenticationConfigMapName, Namespace: authenticationConfigMapNamespace},
+		Data: map[string]string{
+			requestHeaderClientCAKey:     string(ca.pem),
+			requestHeaderAllowedNamesKey: `["front-proxy-client"]`,
+		},
+	})
+
+	authenticator, err := LoadRequestHeaderAuthenticator(ctx, &hostoptions.RequestHeaderOptions{}, clientset)
+	require.NoError(t, err)
+	require.Equal(t, []string{"front-proxy-client"}, authenticator.allowedNames)
+
+	_, err = LoadRequestHeaderAuthenticator(ctx, &hostoptions.RequestHeaderOptions{}, fake.NewClientset())
+	require.ErrorContains(t, err, "failed to get the ConfigMap")
+}
+
+func Test_RequestHeaderValidator(t *testing.T) {
+	ca := newTestCA(t)
+	authenticator, err := NewRequestHeaderAuthenticator(ca.pem, []string{"front-proxy-client"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		authenticator *RequestHeaderAuthenticator
+		cert          *x509.Certificate
+		principal     *Principal
+	}{
+		{
+			name:          "aggregator",
+			authenticator: authenticator,
+			cert:          ca.clientCert(t, "front-proxy-client"),
+			principal:     &Principal{Name: "alice", Groups: []string{"system:masters"}},
+		},
+		{
+			name:          "no client certificate",
+			authenticator: authenticator,
+		},
+		{
+			name:          "untrusted client certificate",
+			authenticator: authenticator,
+			cert:          newTestCA(t).clientCert(t, "front-proxy-client"),
+		},
+		{
+			name:          "name not allowed",
+			authenticator: authenticator,
+			cert:          ca.clientCert(t, "other"),
+		},
+		{
+			name: "not configured",
+			cert: ca.clientCert(t, "front-proxy-client"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var principal *Principal
+			var headers http.Header
+			handler := RequestHeaderValidator(tt.authenticator)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				principal = PrincipalFromRequest(r)
+				headers = r.Header
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+			req.Header.Set(RemoteUserHeader, "alice")
+			req.Header.Add(RemoteGroupHeader, "system:masters")
+			if tt.cert != nil {
+				req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tt.cert}}
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			require.Equal(t, tt.principal, principal)
+			require.Empty(t, headers.Values(RemoteUserHeader))
+			require.Empty(t, headers.Values(RemoteGroupHeader))
+		})
+	}
+}
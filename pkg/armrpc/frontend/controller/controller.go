@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -28,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -68,6 +70,10 @@ type Options struct {
 
 	// StatusManager is the async operation status manager.
 	StatusManager sm.StatusManager
+
+	// LockChecker checks the management locks of a resource before it's created, updated or deleted. Locks are
+	// not checked when LockChecker is nil.
+	LockChecker LockChecker
 }
 
 func (o Options) Validate() error {
@@ -106,6 +112,10 @@ type ResourceOptions[T any] struct {
 	// UpdateFilters is a slice of filters that execute prior to updating a resource.
 	UpdateFilters []UpdateFilter[T]
 
+	// ResponseFilters is a slice of filters that execute after a resource is saved by an async create or update
+	// operation, prior to converting it for the response.
+	ResponseFilters []ResponseFilter[T]
+
 	// AsyncOperationTimeout is the default timeout duration of async put operation.
 	AsyncOperationTimeout time.Duration
 
@@ -249,3 +259,47 @@ type DeleteFilter[T any] func(ctx context.Context, oldResource *T, options *Opti
 // UpdateFilters should return a rest.Response to handle the request without allowing updates to occur. Any
 // errors returned will be treated as "unhandled" and logged before sending back an HTTP 500.
 type UpdateFilter[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) (rest.Response, error)
+
+// ResponseFilter is a function that is executed as part of the controller lifecycle. ResponseFilters can be used to:
+//
+// - Convert a resource that is saved in a different representation to the representation of the requested API version.
+//
+// ResponseFilters modify the resource returned in the response, not the saved resource. Any errors returned will be
+// treated as "unhandled" and logged before sending back an HTTP 500.
+type ResponseFilter[T any] func(ctx context.Context, resource *T, options *Options) error
+
+// LockOperation is an operation on a resource that can be prevented by a management lock.
+type LockOperation string
+
+const (
+	// LockOperationWrite creates or updates a resource.
+	LockOperationWrite LockOperation = "write"
+
+	// LockOperationDelete deletes a resource.
+	LockOperationDelete LockOperation = "delete"
+)
+
+// ErrLocked is wrapped by the errors returned by a LockChecker when a management lock prevents an operation.
+var ErrLocked = errors.New("the resource is locked")
+
+// LockChecker checks the management locks of resources before they're changed. The locks are stored by UCP, which
+// provides the implementation.
+type LockChecker interface {
+	// Check returns an error that wraps ErrLocked if a lock prevents the operation on the resource with the given ID.
+	// The models are the stored and requested versions of the resource, used to find the application and
+	// environment the resource belongs to. They're optional, and nil values are ignored.
+	Check(ctx context.Context, operation LockOperation, id resources.ID, models ...any) error
+}
+
+// LockOperationForMethod returns the operation performed by a request with the given HTTP method. It returns false
+// if the request can't be prevented by a lock.
+func LockOperationForMethod(method string) (LockOperation, bool) {
+	switch strings.ToUpper(method) {
+	case http.MethodPut, http.MethodPatch:
+		return LockOperationWrite, true
+	case http.MethodDelete:
+		return LockOperationDelete, true
+	default:
+		return "", false
+	}
+}
@@ -173,3 +173,23 @@ func TestOptionsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestLockOperationForMethod(t *testing.T) {
+	operation, ok := LockOperationForMethod("PUT")
+	require.True(t, ok)
+	require.Equal(t, LockOperationWrite, operation)
+
+	operation, ok = LockOperationForMethod("patch")
+	require.True(t, ok)
+	require.Equal(t, LockOperationWrite, operation)
+
+	operation, ok = LockOperationForMethod("DELETE")
+	require.True(t, ok)
+	require.Equal(t, LockOperationDelete, operation)
+
+	_, ok = LockOperationForMethod("POST")
+	require.False(t, ok)
+
+	_, ok = LockOperationForMethod("GET")
+	require.False(t, ok)
+}
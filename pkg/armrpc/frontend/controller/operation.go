@@ -143,6 +143,10 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	}
 
 	if newResource != nil {
+		if err := v1.ValidateTags(P(newResource).GetBaseResource().Tags); err != nil {
+			return rest.NewBadRequestResponse(err.Error()), nil
+		}
+
 		var oldSystemData *v1.SystemData
 		if oldResource != nil {
 			oldSystemData = P(oldResource).GetSystemData()
@@ -157,6 +161,36 @@ func (c *Operation[P, T]) PrepareResource(ctx context.Context, req *http.Request
 	return nil, nil
 }
 
+// ValidateLocks returns a ScopeLocked response if a management lock prevents the request from creating, updating or
+// deleting the resource. The new and old resources are used to find the application and environment the resource
+// belongs to, and may be nil.
+func (c *Operation[P, T]) ValidateLocks(ctx context.Context, req *http.Request, newResource *T, oldResource *T) (rest.Response, error) {
+	operation, ok := LockOperationForMethod(req.Method)
+	if !ok {
+		return nil, nil
+	}
+
+	return c.ValidateOperationLocks(ctx, operation, newResource, oldResource)
+}
+
+// ValidateOperationLocks returns a ScopeLocked response if a management lock prevents the operation on the resource.
+// It's used by the controllers whose HTTP method doesn't determine the operation, like actions that change a resource.
+func (c *Operation[P, T]) ValidateOperationLocks(ctx context.Context, operation LockOperation, newResource *T, oldResource *T) (rest.Response, error) {
+	if c.options.LockChecker == nil {
+		return nil, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	err := c.options.LockChecker.Check(ctx, operation, serviceCtx.ResourceID, newResource, oldResource)
+	if errors.Is(err, ErrLocked) {
+		return rest.NewScopeLockedResponse(serviceCtx.ResourceID.String(), err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 // PrepareAsyncOperation saves the initial state and queue the async operation.
 func (c *Operation[P, T]) PrepareAsyncOperation(ctx context.Context, newResource *T, initialState v1.ProvisioningState, asyncTimeout time.Duration, etag *string) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
@@ -244,6 +278,11 @@ func (b *Operation[P, T]) UpdateFilters() []UpdateFilter[T] {
 	return b.resourceOptions.UpdateFilters
 }
 
+// ResponseFilters returns the set of filters to execute on the saved resource of create or update operations.
+func (b *Operation[P, T]) ResponseFilters() []ResponseFilter[T] {
+	return b.resourceOptions.ResponseFilters
+}
+
 // AsyncOperationTimeout returns the timeput for the operation.
 func (b *Operation[P, T]) AsyncOperationTimeout() time.Duration {
 	if b.resourceOptions.AsyncOperationTimeout == 0 {
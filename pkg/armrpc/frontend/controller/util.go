@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
 )
 
 var (
@@ -144,5 +145,24 @@ func GetNextLinkURL(ctx context.Context, req *http.Request, paginationToken stri
 	qps.Add("skipToken", paginationToken)
 	qps.Add("top", strconv.Itoa(serviceCtx.Top))
 
+	// Preserve the tag filter so that the next page is filtered the same way.
+	query := req.URL.Query()
+	for _, key := range []string{v1.TagNameParameterName, v1.TagValueParameterName} {
+		if values, ok := query[key]; ok {
+			qps[key] = values
+		}
+	}
+
 	return GetURLFromReqWithQueryParameters(req, qps).String()
 }
+
+// TagQueryFilters returns the database query filters that implement the tag filter of a list request. field is
+// the '.' separated path of the tags in the stored resource, for example "tags". No filters are returned if the
+// tag filter is nil.
+func TagQueryFilters(filter *v1.TagFilter, field string) []database.QueryFilter {
+	if filter == nil {
+		return nil
+	}
+
+	return []database.QueryFilter{{Field: field, Key: filter.Name, Value: filter.Value}}
+}
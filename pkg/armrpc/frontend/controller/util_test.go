@@ -25,6 +25,7 @@ import (
 
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/stretchr/testify/require"
 )
 
@@ -184,3 +185,35 @@ func TestValidateEtag_IfNoneMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNextLinkURL(t *testing.T) {
+	ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+		APIVersion: "2023-10-01-preview",
+		Top:        10,
+	})
+
+	t.Run("no pagination token", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/resources?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		require.Empty(t, GetNextLinkURL(ctx, req, ""))
+	})
+
+	t.Run("pagination token", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/resources?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost/resources?api-version=2023-10-01-preview&skipToken=token&top=10", GetNextLinkURL(ctx, req, "token"))
+	})
+
+	t.Run("tag filter is preserved", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/resources?api-version=2023-10-01-preview&tagName=team&tagValue=payments", nil)
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost/resources?api-version=2023-10-01-preview&skipToken=token&tagName=team&tagValue=payments&top=10", GetNextLinkURL(ctx, req, "token"))
+	})
+}
+
+func TestTagQueryFilters(t *testing.T) {
+	require.Nil(t, TagQueryFilters(nil, "tags"))
+
+	filters := TagQueryFilters(&v1.TagFilter{Name: "cost-center", Value: "payments"}, "properties.tags")
+	require.Equal(t, []database.QueryFilter{{Field: "properties.tags", Key: "cost-center", Value: "payments"}}, filters)
+}
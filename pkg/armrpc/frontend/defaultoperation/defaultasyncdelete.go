@@ -55,6 +55,10 @@ func (e *DefaultAsyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWrite
 		return rest.NewNoContentResponse(), nil
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, nil, old); r != nil || err != nil {
+		return r, err
+	}
+
 	force := req.URL.Query().Get("force") == "true"
 	if force {
 		// When force-deleting, skip the provisioning state check but still validate the ETag.
@@ -42,7 +42,8 @@ func NewDefaultAsyncPut[P interface {
 }
 
 // Run executes asynchronous create or update operation by validating new resource metadata, ensuring if it is new resource
-// or updated resource, running custom update filters, and queuing async operation and returns an async response.
+// or updated resource, running custom update filters, and queuing async operation and returns an async response. The
+// response filters run on the saved resource before it's converted for the response.
 func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	newResource, err := e.GetResourceFromRequest(ctx, req)
@@ -55,6 +56,10 @@ func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter,
 		return nil, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
 	if r, err := e.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
 		return r, err
 	}
@@ -69,5 +74,11 @@ func (e *DefaultAsyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter,
 		return r, err
 	}
 
+	for _, filter := range e.ResponseFilters() {
+		if err := filter(ctx, newResource, e.Options()); err != nil {
+			return nil, err
+		}
+	}
+
 	return e.ConstructAsyncResponse(ctx, req.Method, etag, newResource)
 }
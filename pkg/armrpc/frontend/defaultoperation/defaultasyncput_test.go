@@ -332,3 +332,58 @@ func TestDefaultAsyncPut_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultAsyncPut_ResponseFilters(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	reqModel, _, _ := loadTestResurce()
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+		Return(nil, &database.ErrNotFound{}).
+		Times(1)
+
+	var saved []byte
+	mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved, err = json.Marshal(obj.Data)
+			return err
+		}).
+		Times(1)
+	msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+		ResponseFilters: []ctrl.ResponseFilter[TestResourceDataModel]{
+			func(ctx context.Context, resource *TestResourceDataModel, options *ctrl.Options) error {
+				resource.Properties.PropertyA = "converted"
+				return nil
+			},
+		},
+	}
+
+	ctl, err := NewDefaultAsyncPut(ctrl.Options{DatabaseClient: mds, StatusManager: msm}, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusCreated, w.Result().StatusCode)
+
+	// The response is filtered, the saved resource is not.
+	actual := &TestResource{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), actual))
+	require.Equal(t, "converted", *actual.Properties.PropertyA)
+
+	savedModel := &TestResourceDataModel{}
+	require.NoError(t, json.Unmarshal(saved, savedModel))
+	require.NotEqual(t, "converted", savedModel.Properties.PropertyA)
+}
@@ -58,6 +58,10 @@ func (e *DefaultSyncDelete[P, T]) Run(ctx context.Context, w http.ResponseWriter
 		return rest.NewNoContentResponse(), nil
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, nil, old); r != nil || err != nil {
+		return r, err
+	}
+
 	if r, err := e.PrepareResource(ctx, req, nil, old, etag); r != nil || err != nil {
 		return r, err
 	}
@@ -27,6 +27,9 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -115,3 +118,54 @@ func TestDefaultSyncDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSyncDelete_Locked(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	// The resource group of the resource is protected by a 'CanNotDelete' lock.
+	lockClient := inmemory.NewClient()
+	lockID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/System.Authorization/locks/do-not-delete"
+	err := lockClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: lockID},
+		Data: &datamodel.Lock{
+			Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete},
+		},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodDelete, resourceTestHeaderFile, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	_, appDataModel, _ := loadTestResurce()
+
+	mds.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(&database.Object{
+			Metadata: database.Metadata{ID: appDataModel.ID},
+			Data:     appDataModel,
+		}, nil).
+		Times(1)
+
+	opts := ctrl.Options{
+		DatabaseClient: mds,
+		StatusManager:  msm,
+		LockChecker:    locks.NewChecker(lockClient),
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncDelete(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+}
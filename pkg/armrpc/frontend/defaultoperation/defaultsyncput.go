@@ -54,6 +54,10 @@ func (e *DefaultSyncPut[P, T]) Run(ctx context.Context, w http.ResponseWriter, r
 		return nil, err
 	}
 
+	if r, err := e.ValidateLocks(ctx, req, newResource, old); r != nil || err != nil {
+		return r, err
+	}
+
 	if r, err := e.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
 		return r, err
 	}
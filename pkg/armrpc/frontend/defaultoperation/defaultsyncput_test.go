@@ -28,6 +28,10 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	"github.com/radius-project/radius/test/testutil"
 
 	"github.com/stretchr/testify/require"
@@ -239,3 +243,102 @@ func TestDefaultSyncPut_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSyncPut_Locked(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	// The application of the resource is protected by a 'ReadOnly' lock.
+	lockClient := inmemory.NewClient()
+	lockID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0/providers/System.Authorization/locks/read-only"
+	err := lockClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: lockID},
+		Data: &datamodel.Lock{
+			Properties: datamodel.LockProperties{Level: datamodel.LockLevelReadOnly},
+		},
+	})
+	require.NoError(t, err)
+
+	reqModel, _, _ := loadTestResurce()
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mds.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &database.ErrNotFound{}).
+		Times(1)
+
+	opts := ctrl.Options{
+		DatabaseClient: mds,
+		StatusManager:  msm,
+		LockChecker:    locks.NewChecker(lockClient),
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncPut(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+
+	errResp := &v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), errResp)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeScopeLocked, errResp.Error.Code)
+	require.Contains(t, errResp.Error.Message, lockID)
+}
+
+func TestDefaultSyncPut_InvalidTags(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	reqModel, _, _ := loadTestResurce()
+	reqModel.Tags = map[string]*string{"app/name": to.Ptr("store")}
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPut, resourceTestHeaderFile, reqModel)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	mds.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		Return(nil, &database.ErrNotFound{}).
+		Times(1)
+
+	opts := ctrl.Options{
+		DatabaseClient: mds,
+		StatusManager:  msm,
+	}
+
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+	}
+
+	ctl, err := NewDefaultSyncPut(opts, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	err = resp.Apply(ctx, w, req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	errResp := &v1.ErrorResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), errResp)
+	require.NoError(t, err)
+	require.Equal(t, v1.CodeInvalid, errResp.Error.Code)
+	require.Contains(t, errResp.Error.Message, `the tag name "app/name" is invalid`)
+}
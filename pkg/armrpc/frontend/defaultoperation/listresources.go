@@ -43,15 +43,21 @@ func NewListResources[P interface {
 	return &ListResources[P, T]{ctrl.NewOperation[P](opts, ctrlOpts), ctrlOpts.ListRecursiveQuery}, nil
 }
 
-// Run queries the resource data store with a given type and scope and returns the paginated resource list. An internal error
-// is returned if the query fails.
+// Run queries the resource data store with a given type and scope and returns the paginated resource list. If the request
+// filters by tag only the resources that have the tag are returned. An internal error is returned if the query fails.
 func (e *ListResources[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 
+	tagFilter, err := v1.ParseTagFilter(req.URL.Query())
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	query := database.Query{
 		RootScope:      serviceCtx.ResourceID.RootScope(),
 		ResourceType:   serviceCtx.ResourceID.Type(),
 		ScopeRecursive: e.listRecursiveQuery,
+		Filters:        ctrl.TagQueryFilters(tagFilter, "tags"),
 	}
 
 	result, err := e.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
@@ -183,4 +183,79 @@ func TestListResourcesRun(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("list resources with tag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		q := req.URL.Query()
+		q.Add("tagName", "cost-center")
+		q.Add("tagValue", "payments")
+		req.URL.RawQuery = q.Encode()
+
+		ctx := rpctest.NewARMRequestContext(req)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		expectedQuery := database.Query{
+			RootScope:    serviceCtx.ResourceID.RootScope(),
+			ResourceType: serviceCtx.ResourceID.Type(),
+			Filters:      []database.QueryFilter{{Field: "tags", Key: "cost-center", Value: "payments"}},
+		}
+
+		databaseClient.
+			EXPECT().
+			Query(gomock.Any(), expectedQuery, gomock.Any()).
+			Return(&database.ObjectQueryResult{
+				Items: []database.Object{{Metadata: database.Metadata{ID: uuid.New().String()}, Data: testResourceDataModel}},
+			}, nil)
+
+		opts := ctrl.Options{
+			DatabaseClient: databaseClient,
+		}
+
+		ctrlOpts := ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		}
+
+		ctl, err := NewListResources(opts, ctrlOpts)
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actualOutput := &testResourceList{}
+		_ = json.Unmarshal(w.Body.Bytes(), actualOutput)
+		require.Equal(t, []*testVersionedModel{expectedOutput}, actualOutput.Value)
+	})
+
+	t.Run("invalid tag filter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestFromJSON(ctx, http.MethodGet, resourceTestHeaderFile, nil)
+		require.NoError(t, err)
+
+		q := req.URL.Query()
+		q.Add("tagValue", "payments")
+		req.URL.RawQuery = q.Encode()
+
+		ctx := rpctest.NewARMRequestContext(req)
+
+		opts := ctrl.Options{
+			DatabaseClient: databaseClient,
+		}
+
+		ctrlOpts := ctrl.ResourceOptions[testDataModel]{
+			ResponseConverter: resourceToVersioned,
+		}
+
+		ctl, err := NewListResources(opts, ctrlOpts)
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		_ = resp.Apply(ctx, w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
 }
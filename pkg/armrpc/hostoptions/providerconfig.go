@@ -46,6 +46,7 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	Audit            AuditOptions                         `yaml:"audit,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -63,6 +64,16 @@ type ServerOptions struct {
 	// EnableAuth when set the arm client authetication will be performed
 	EnableArmAuth bool `yaml:"enableArmAuth,omitempty"`
 
+	// OIDC configures the validation of JWT bearer tokens when AuthType is OIDC.
+	OIDC *OIDCOptions `yaml:"oidc,omitempty"`
+
+	// RequestHeader configures the trust of the user headers set by the Kubernetes API aggregation layer. When
+	// unset, the headers are never trusted.
+	RequestHeader *RequestHeaderOptions `yaml:"requestHeader,omitempty"`
+
+	// PodIdentity configures the authentication of in-cluster callers by the service account of their pod.
+	PodIdentity *PodIdentityOptions `yaml:"podIdentity,omitempty"`
+
 	// TLSCertificateDirectory is the directory where the TLS certificates are stored.
 	//
 	// The server code will expect to find the following files in this directory:
@@ -76,6 +87,61 @@ func (s ServerOptions) Address() string {
 	return s.Host + ":" + fmt.Sprint(s.Port)
 }
 
+// OIDCOptions includes the options to validate JWT bearer tokens issued by OpenID Connect providers.
+type OIDCOptions struct {
+	// Issuers are the trusted token issuers.
+	Issuers []OIDCIssuerOptions `yaml:"issuers"`
+}
+
+// OIDCIssuerOptions includes the options of a trusted token issuer.
+type OIDCIssuerOptions struct {
+	// Issuer is the URL of the issuer. It must match the 'iss' claim of the tokens.
+	Issuer string `yaml:"issuer"`
+
+	// Audiences are the accepted values of the 'aud' claim of the tokens. A token must have at least one of them.
+	Audiences []string `yaml:"audiences"`
+
+	// JWKSURL is the URL of the JSON Web Key Set used to verify the tokens. It's discovered from the OpenID
+	// configuration of the issuer when unset.
+	JWKSURL string `yaml:"jwksUrl,omitempty"`
+
+	// UsernameClaim is the claim used as the name of the principal. Defaults to 'sub'.
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+
+	// UsernamePrefix is prepended to the name of the principal, e.g. 'oidc:'. Defaults to the issuer URL followed by
+	// '#'. Names starting with 'system:' are rejected.
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+
+	// GroupsClaim is the claim used as the groups of the principal. Defaults to 'groups'.
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+
+	// GroupsPrefix is prepended to the groups of the principal, e.g. 'oidc:'. Defaults to the issuer URL followed by
+	// '#'. Groups starting with 'system:' are rejected.
+	GroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+}
+
+// RequestHeaderOptions includes the options to verify the client certificate of the Kubernetes API aggregation layer.
+type RequestHeaderOptions struct {
+	// ClientCAFile is the path of the CA bundle that signs the client certificate of the aggregation layer. When
+	// unset, the CA bundle and the allowed names are read from the 'extension-apiserver-authentication' ConfigMap
+	// of the 'kube-system' namespace.
+	ClientCAFile string `yaml:"clientCAFile,omitempty"`
+
+	// AllowedNames are the accepted common names of the client certificate. When empty, any client certificate
+	// signed by the CA is accepted.
+	AllowedNames []string `yaml:"allowedNames,omitempty"`
+}
+
+// PodIdentityOptions includes the options to authenticate in-cluster callers that cannot present credentials, like
+// the deployment engine, by the service account of the pod that opened the connection.
+type PodIdentityOptions struct {
+	// Namespace is the namespace of the pods.
+	Namespace string `yaml:"namespace"`
+
+	// ServiceAccounts are the names of the service accounts whose pods are authenticated.
+	ServiceAccounts []string `yaml:"serviceAccounts"`
+}
+
 // WorkerServerOptions includes the worker server options.
 type WorkerServerOptions struct {
 	// Port is the localhost port which provides the system-level info, such as healthprobe and metric port
@@ -86,6 +152,33 @@ type WorkerServerOptions struct {
 	MaxOperationRetryCount *int `yaml:"maxOperationRetryCount,omitempty"`
 }
 
+// AuditSink is the destination of audit events.
+type AuditSink string
+
+const (
+	// AuditSinkDatabase stores audit events in the database.
+	AuditSinkDatabase AuditSink = "database"
+
+	// AuditSinkFile appends audit events to a file.
+	AuditSinkFile AuditSink = "file"
+)
+
+// AuditOptions includes the options to record audit events for mutating requests and async operations.
+type AuditOptions struct {
+	// Enabled enables the recording of audit events.
+	Enabled bool `yaml:"enabled"`
+
+	// Sink is the destination of audit events. Defaults to 'database'.
+	Sink AuditSink `yaml:"sink,omitempty"`
+
+	// FilePath is the path of the file audit events are appended to when Sink is 'file'.
+	FilePath string `yaml:"filePath,omitempty"`
+
+	// KeyFile is the path of the file that contains the secret key of the audit chain. The key must be shared by
+	// every process that records or verifies audit events, and must not be stored with the events.
+	KeyFile string `yaml:"keyFile,omitempty"`
+}
+
 // BicepOptions includes options required for bicep execution.
 type BicepOptions struct {
 	// DeleteRetryCount is the number of times to retry the request.
@@ -601,6 +601,20 @@ func NewConflictResponse(message string) Response {
 	}
 }
 
+// NewScopeLockedResponse creates a ConflictResponse with CodeScopeLocked code for operations that are prevented by
+// a management lock.
+func NewScopeLockedResponse(target string, message string) Response {
+	return &ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeScopeLocked,
+				Message: message,
+				Target:  target,
+			},
+		},
+	}
+}
+
 // Apply renders 409 Conflict HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
 func (r *ConflictResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -727,6 +741,44 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewForbiddenResponse creates a ForbiddenResponse with CodeAuthorizationFailed code and the given target and message.
+func NewForbiddenResponse(target string, message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+				Target:  target,
+			},
+		},
+	}
+}
+
+// Apply renders 403 Forbidden HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
@@ -795,3 +847,41 @@ func (r *MethodNotAllowedResponse) Apply(ctx context.Context, w http.ResponseWri
 
 	return nil
 }
+
+// RequestEntityTooLargeResponse represents an HTTP 413 with an ARM error payload.
+type RequestEntityTooLargeResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewRequestEntityTooLargeResponse creates a RequestEntityTooLargeResponse for requests whose body is larger than
+// the server accepts.
+func NewRequestEntityTooLargeResponse(message string) Response {
+	return &RequestEntityTooLargeResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders a HTTP response by serializing Body in JSON and setting 413 response code and returns an error if it fails.
+func (r *RequestEntityTooLargeResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusRequestEntityTooLarge), logging.LogHTTPStatusCode, http.StatusRequestEntityTooLarge)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
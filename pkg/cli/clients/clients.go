@@ -115,6 +115,7 @@ type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
 	Gateways      []GatewayStatus
+	Resources     []ResourceReadiness
 }
 
 type GatewayStatus struct {
@@ -122,6 +123,15 @@ type GatewayStatus struct {
 	Endpoint string
 }
 
+// ResourceReadiness represents the readiness of a resource of an application, as reported by its Ready condition.
+type ResourceReadiness struct {
+	Name    string
+	Type    string
+	Ready   string
+	Reason  string
+	Message string
+}
+
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
 }
@@ -163,6 +173,15 @@ type ApplicationsManagementClient interface {
 	// ListResourcesOfTypeInApplication lists all resources of a given type in a given application in the configured scope.
 	ListResourcesOfTypeInApplication(ctx context.Context, applicationNameOrID string, resourceType string) ([]generated.GenericResource, error)
 
+	// ListResourcesOfTypeWithTag lists all resources of a given type that have the given tag in the configured scope.
+	ListResourcesOfTypeWithTag(ctx context.Context, resourceType string, tagName string, tagValue string) ([]generated.GenericResource, error)
+
+	// ListResourcesOfTypeInApplicationWithTag lists all resources of a given type that have the given tag in a given application in the configured scope.
+	ListResourcesOfTypeInApplicationWithTag(ctx context.Context, applicationNameOrID string, resourceType string, tagName string, tagValue string) ([]generated.GenericResource, error)
+
+	// ListResourcesWithTag lists all resources of any type that have the given tag in the configured scope (assumes configured scope is a resource group).
+	ListResourcesWithTag(ctx context.Context, tagName string, tagValue string) ([]generated.GenericResource, error)
+
 	// ListResourcesOfTypeInEnvironment lists all resources of a given type in a given environment in the configured scope.
 	ListResourcesOfTypeInEnvironment(ctx context.Context, environmentNameOrID string, resourceType string) ([]generated.GenericResource, error)
 
@@ -219,6 +238,9 @@ type ApplicationsManagementClient interface {
 	// GetRecipePack retrieves a recipe pack by its name (in the configured scope) or resource ID.
 	GetRecipePack(ctx context.Context, recipePackNameOrID string) (radiuscore.RecipePackResource, error)
 
+	// CreateOrUpdateRecipePack creates or updates a recipe pack by its name (in the configured scope) or resource ID.
+	CreateOrUpdateRecipePack(ctx context.Context, recipePackNameOrID string, resource *radiuscore.RecipePackResource) error
+
 	// DeleteRecipePack deletes a recipe pack by its name (in the configured scope) or resource ID.
 	DeleteRecipePack(ctx context.Context, recipePackNameOrID string) (bool, error)
 
@@ -276,6 +298,9 @@ type ApplicationsManagementClient interface {
 	// GetResourceProviderSummary gets the resource provider summary with the specified name in the configured scope.
 	GetResourceProviderSummary(ctx context.Context, planeName string, providerNamespace string) (ucp_v20231001preview.ResourceProviderSummary, error)
 
+	// GetResourceProviderOpenAPIDocument gets the OpenAPI document of the resource types of the resource provider for the specified API version.
+	GetResourceProviderOpenAPIDocument(ctx context.Context, planeName string, providerNamespace string, apiVersion string) (map[string]any, error)
+
 	// CreateOrUpdateResourceType creates or updates a resource type in the configured plane.
 	CreateOrUpdateResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string, resource *ucp_v20231001preview.ResourceTypeResource) (ucp_v20231001preview.ResourceTypeResource, error)
 
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+)
+
+// ResourceCondition represents a condition reported in the status of a resource, such as whether it is ready.
+type ResourceCondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+}
+
+// GetResourceConditions returns the conditions reported under ".properties.status.conditions" of the resource, or
+// nil if the resource does not report conditions.
+func GetResourceConditions(resource generated.GenericResource) []ResourceCondition {
+	status, ok := resource.Properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	obj, ok := status["conditions"]
+	if !ok {
+		return nil
+	}
+
+	bs, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+
+	conditions := []ResourceCondition{}
+	err = json.Unmarshal(bs, &conditions)
+	if err != nil || len(conditions) == 0 {
+		return nil
+	}
+
+	return conditions
+}
+
+// GetResourceReadiness returns the readiness of the resource from its Ready condition. Returns false if the resource
+// does not report a Ready condition.
+func GetResourceReadiness(resource generated.GenericResource) (ResourceReadiness, bool) {
+	for _, condition := range GetResourceConditions(resource) {
+		if !strings.EqualFold(condition.Type, "Ready") {
+			continue
+		}
+
+		readiness := ResourceReadiness{
+			Ready:   condition.Status,
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		}
+		if resource.Name != nil {
+			readiness.Name = *resource.Name
+		}
+		if resource.Type != nil {
+			readiness.Type = *resource.Type
+		}
+
+		return readiness, true
+	}
+
+	return ResourceReadiness{}, false
+}
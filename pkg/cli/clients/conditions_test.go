@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetResourceConditions(t *testing.T) {
+	resource := generated.GenericResource{
+		Name: to.Ptr("orders-db"),
+		Type: to.Ptr("Contoso.Example/databases"),
+		Properties: map[string]any{
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": "False", "reason": "FailingOver", "message": "the primary replica is failing over", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+					map[string]any{"type": "Degraded", "status": "True", "reason": "FailingOver"},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []ResourceCondition{
+		{Type: "Ready", Status: "False", Reason: "FailingOver", Message: "the primary replica is failing over", LastTransitionTime: "2024-01-01T00:00:00Z"},
+		{Type: "Degraded", Status: "True", Reason: "FailingOver"},
+	}, GetResourceConditions(resource))
+
+	readiness, ok := GetResourceReadiness(resource)
+	require.True(t, ok)
+	require.Equal(t, ResourceReadiness{
+		Name:    "orders-db",
+		Type:    "Contoso.Example/databases",
+		Ready:   "False",
+		Reason:  "FailingOver",
+		Message: "the primary replica is failing over",
+	}, readiness)
+}
+
+func Test_GetResourceConditions_NoConditions(t *testing.T) {
+	resource := generated.GenericResource{Properties: map[string]any{"status": map[string]any{}}}
+
+	require.Nil(t, GetResourceConditions(resource))
+
+	_, ok := GetResourceReadiness(resource)
+	require.False(t, ok)
+}
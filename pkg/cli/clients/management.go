@@ -51,6 +51,8 @@ type UCPApplicationsManagementClient struct {
 	resourceTypeClientFactory                  func() (resourceTypeClient, error)
 	apiVersionClientFactory                    func() (apiVersionClient, error)
 	locationClientFactory                      func() (locationClient, error)
+	lockClientFactory                          func() (lockClient, error)
+	resourcesClientFactory                     func() (resourcesClient, error)
 	capture                                    func(ctx context.Context, capture **http.Response) context.Context
 }
 
@@ -58,6 +60,18 @@ var _ ApplicationsManagementClient = (*UCPApplicationsManagementClient)(nil)
 
 // ListResourcesOfType lists all resources of a given type in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListResourcesOfType(ctx context.Context, resourceType string) ([]generated.GenericResource, error) {
+	return amc.listResourcesOfType(ctx, resourceType, &generated.GenericResourcesClientListByRootScopeOptions{})
+}
+
+// ListResourcesOfTypeWithTag lists all resources of a given type that have the given tag in the configured scope.
+func (amc *UCPApplicationsManagementClient) ListResourcesOfTypeWithTag(ctx context.Context, resourceType string, tagName string, tagValue string) ([]generated.GenericResource, error) {
+	return amc.listResourcesOfType(ctx, resourceType, &generated.GenericResourcesClientListByRootScopeOptions{
+		TagName:  &tagName,
+		TagValue: &tagValue,
+	})
+}
+
+func (amc *UCPApplicationsManagementClient) listResourcesOfType(ctx context.Context, resourceType string, options *generated.GenericResourcesClientListByRootScopeOptions) ([]generated.GenericResource, error) {
 	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get API versions for resource type %q: %w", resourceType, err)
@@ -69,7 +83,7 @@ func (amc *UCPApplicationsManagementClient) ListResourcesOfType(ctx context.Cont
 		return nil, err
 	}
 
-	pager := client.NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{})
+	pager := client.NewListByRootScopePager(options)
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
 		if err != nil {
@@ -106,6 +120,72 @@ func (amc *UCPApplicationsManagementClient) ListResourcesOfTypeInApplication(ctx
 	return results, nil
 }
 
+// ListResourcesOfTypeInApplicationWithTag lists all resources of a given type that have the given tag in a given
+// application in the configured scope.
+func (amc *UCPApplicationsManagementClient) ListResourcesOfTypeInApplicationWithTag(ctx context.Context, applicationNameOrID string, resourceType string, tagName string, tagValue string) ([]generated.GenericResource, error) {
+	applicationID, err := amc.fullyQualifyID(applicationNameOrID, "Applications.Core/applications")
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := amc.ListResourcesOfTypeWithTag(ctx, resourceType, tagName, tagValue)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []generated.GenericResource{}
+	for _, resource := range resources {
+		if isResourceInApplication(resource, applicationID) {
+			results = append(results, resource)
+		}
+	}
+
+	return results, nil
+}
+
+// ListResourcesWithTag lists all resources of any type that have the given tag in the configured scope (assumes
+// configured scope is a resource group). Only the ID, name, type and tags of the resources are returned.
+func (amc *UCPApplicationsManagementClient) ListResourcesWithTag(ctx context.Context, tagName string, tagValue string) ([]generated.GenericResource, error) {
+	scope, err := resources.ParseScope(amc.RootScope)
+	if err != nil {
+		return nil, err
+	}
+
+	planeName := scope.FindScope(resources_radius.PlaneTypeRadius)
+	resourceGroupName := scope.FindScope(resources_radius.ScopeResourceGroups)
+	if planeName == "" || resourceGroupName == "" {
+		return nil, fmt.Errorf("listing resources by tag requires a resource group scope, got %q", amc.RootScope)
+	}
+
+	client, err := amc.createResourcesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []generated.GenericResource{}
+	pager := client.NewListPager(planeName, resourceGroupName, &ucpv20231001.ResourcesClientListOptions{
+		TagName:  &tagName,
+		TagValue: &tagValue,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range page.Value {
+			results = append(results, generated.GenericResource{
+				ID:   resource.ID,
+				Name: resource.Name,
+				Type: resource.Type,
+				Tags: resource.Tags,
+			})
+		}
+	}
+
+	return results, nil
+}
+
 // ListResourcesOfTypeInEnvironment lists all resources of a given type in a given environment in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListResourcesOfTypeInEnvironment(ctx context.Context, environmentNameOrID string, resourceType string) ([]generated.GenericResource, error) {
 	environmentID, err := amc.fullyQualifyID(environmentNameOrID, "Applications.Core/environments")
@@ -371,6 +451,23 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 		return false, err
 	}
 
+	// Check for locks before deleting anything so that a locked resource doesn't leave the application
+	// partially deleted.
+	applicationID, err := amc.fullyQualifyID(applicationNameOrID, "Applications.Core/applications")
+	if err != nil {
+		return false, err
+	}
+
+	ids := []string{applicationID}
+	for _, resource := range resources {
+		ids = append(ids, *resource.ID)
+	}
+
+	err = amc.checkLocks(ctx, scope, ids)
+	if err != nil {
+		return false, err
+	}
+
 	// Delete resources in parallel
 	g, groupCtx := errgroup.WithContext(ctx)
 	for _, resource := range resources {
@@ -602,6 +699,29 @@ func (amc *UCPApplicationsManagementClient) GetRecipePack(ctx context.Context, r
 	return resp.RecipePackResource, nil
 }
 
+// CreateOrUpdateRecipePack creates or updates a recipe pack by its name (in the configured scope) or resource ID.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateRecipePack(ctx context.Context, recipePackNameOrID string, resource *corerpv20250801.RecipePackResource) error {
+	scope, name, err := amc.extractScopeAndName(recipePackNameOrID)
+	if err != nil {
+		return err
+	}
+
+	client, err := amc.createRecipePackClient(scope)
+	if err != nil {
+		return err
+	}
+
+	// The server can return invalid system data, which fails to roundtrip when the client does a "GET -> modify -> PUT".
+	resource.SystemData = nil
+
+	_, err = client.CreateOrUpdate(ctx, name, *resource, &corerpv20250801.RecipePacksClientCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DeleteRecipePack deletes a recipe pack by its name (in the configured scope) or resource ID.
 func (amc *UCPApplicationsManagementClient) DeleteRecipePack(ctx context.Context, recipePackNameOrID string) (bool, error) {
 	scope, name, err := amc.extractScopeAndName(recipePackNameOrID)
@@ -683,6 +803,18 @@ func (amc *UCPApplicationsManagementClient) DeleteEnvironment(ctx context.Contex
 		return false, err
 	}
 
+	// Check for locks on the environment before deleting its applications. The locks of the applications are
+	// checked when they are deleted.
+	environmentID, err := amc.fullyQualifyID(environmentNameOrID, "Applications.Core/environments")
+	if err != nil {
+		return false, err
+	}
+
+	err = amc.checkLocks(ctx, scope, []string{environmentID})
+	if err != nil {
+		return false, err
+	}
+
 	for _, application := range applications {
 		_, err := amc.DeleteApplication(ctx, *application.ID, false)
 		if err != nil {
@@ -778,6 +910,13 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceGroup(ctx context.Cont
 		return false, fmt.Errorf("failed to get resource group: %w", err)
 	}
 
+	// Check for locks before deleting anything. Any lock in the resource group prevents it from being deleted.
+	groupScope := fmt.Sprintf("/planes/radius/%s/resourceGroups/%s", planeName, resourceGroupName)
+	err = amc.checkLocks(ctx, groupScope, []string{groupScope})
+	if err != nil {
+		return false, err
+	}
+
 	// Get all resources in the group (we know it exists now)
 	resources, err := amc.ListResourcesInResourceGroup(ctx, planeName, resourceGroupName)
 	if err != nil {
@@ -1079,6 +1218,21 @@ func (amc *UCPApplicationsManagementClient) GetResourceProviderSummary(ctx conte
 	return response.ResourceProviderSummary, nil
 }
 
+// GetResourceProviderOpenAPIDocument gets the OpenAPI document of the resource types of the resource provider for the specified API version.
+func (amc *UCPApplicationsManagementClient) GetResourceProviderOpenAPIDocument(ctx context.Context, planeName string, resourceProviderName string, apiVersion string) (map[string]any, error) {
+	client, err := amc.createResourceProviderClient()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.GetOpenAPIDocument(ctx, planeName, resourceProviderName, apiVersion, &ucpv20231001.ResourceProvidersClientGetOpenAPIDocumentOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Value, nil
+}
+
 // ListAllResourceTypesNames lists the names of all resource types in all resource providers in the configured plane.
 func (amc *UCPApplicationsManagementClient) ListAllResourceTypesNames(ctx context.Context, planeName string) ([]string, error) {
 	// excludedResourceTypesList contains resource types that should be excluded
@@ -1342,6 +1496,85 @@ func (amc *UCPApplicationsManagementClient) createLocationClient() (locationClie
 	return amc.locationClientFactory()
 }
 
+func (amc *UCPApplicationsManagementClient) createResourcesClient() (resourcesClient, error) {
+	if amc.resourcesClientFactory == nil {
+		return ucpv20231001.NewResourcesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.resourcesClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createLockClient() (lockClient, error) {
+	if amc.lockClientFactory == nil {
+		return ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.lockClientFactory()
+}
+
+// checkLocks returns an error if any lock in the scope protects one of the resources with the given IDs from
+// being deleted. A lock protects the resource group or resource it's applied to, the resources that resource
+// group or resource contains, and the resource group or resource that contains it.
+//
+// The server enforces locks as well, this check avoids partially deleting an application or resource group.
+func (amc *UCPApplicationsManagementClient) checkLocks(ctx context.Context, scope string, ids []string) error {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return err
+	}
+
+	pager := client.NewListPager(strings.TrimPrefix(scope, resources.SegmentSeparator), &ucpv20231001.LocksClientListOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if clientv2.Is404Error(err) {
+			// The scope doesn't exist, so there are no locks.
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to list locks: %w", err)
+		}
+
+		for _, lock := range page.Value {
+			if lock == nil || lock.ID == nil {
+				continue
+			}
+
+			lockID, err := resources.Parse(*lock.ID)
+			if err != nil {
+				return err
+			}
+
+			lockScope := lockID.RootScope()
+			if len(lockID.ExtensionSegments()) > 0 {
+				lockScope = lockID.ParentResource()
+			}
+
+			for _, id := range ids {
+				if !isWithinScope(id, lockScope) && !isWithinScope(lockScope, id) {
+					continue
+				}
+
+				level := ""
+				if lock.Properties != nil && lock.Properties.Level != nil {
+					level = string(*lock.Properties.Level)
+				}
+
+				return fmt.Errorf("%q is protected by the %s lock %q on %q, delete the lock with `rad lock delete` to continue", id, level, lockID.Name(), lockScope)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isWithinScope returns true if the ID is the scope or one of the resources it contains.
+func isWithinScope(id string, scope string) bool {
+	if strings.EqualFold(id, scope) {
+		return true
+	}
+
+	return strings.HasPrefix(strings.ToLower(id), strings.ToLower(scope+resources.SegmentSeparator))
+}
+
 func (amc *UCPApplicationsManagementClient) extractScopeAndName(nameOrID string) (string, string, error) {
 	if strings.HasPrefix(nameOrID, resources.SegmentSeparator) {
 		// Treat this as a resource id.
@@ -35,7 +35,7 @@ import (
 // Because these interfaces are non-exported, they MUST be defined in their own file
 // and we MUST use -source on mockgen to generate mocks for them.
 
-//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,radiusCoreEnvironmentResourceClient
+//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,radiusCoreEnvironmentResourceClient,lockClient,resourcesClient
 
 // genericResourceClient is an interface for mocking the generated SDK client for any resource.
 type genericResourceClient interface {
@@ -80,6 +80,7 @@ type resourceProviderClient interface {
 	Get(ctx context.Context, planeName string, resourceProviderName string, options *ucpv20231001.ResourceProvidersClientGetOptions) (ucpv20231001.ResourceProvidersClientGetResponse, error)
 	NewListPager(planeName string, options *ucpv20231001.ResourceProvidersClientListOptions) *runtime.Pager[ucpv20231001.ResourceProvidersClientListResponse]
 	GetProviderSummary(ctx context.Context, planeName string, resourceProviderName string, options *ucpv20231001.ResourceProvidersClientGetProviderSummaryOptions) (ucpv20231001.ResourceProvidersClientGetProviderSummaryResponse, error)
+	GetOpenAPIDocument(ctx context.Context, planeName string, resourceProviderName string, apiVersionName string, options *ucpv20231001.ResourceProvidersClientGetOpenAPIDocumentOptions) (ucpv20231001.ResourceProvidersClientGetOpenAPIDocumentResponse, error)
 	NewListProviderSummariesPager(planeName string, options *ucpv20231001.ResourceProvidersClientListProviderSummariesOptions) *runtime.Pager[ucpv20231001.ResourceProvidersClientListProviderSummariesResponse]
 }
 
@@ -114,3 +115,13 @@ type radiusCoreEnvironmentResourceClient interface {
 	Get(ctx context.Context, environmentName string, options *corerpv20250801.EnvironmentsClientGetOptions) (corerpv20250801.EnvironmentsClientGetResponse, error)
 	NewListByScopePager(options *corerpv20250801.EnvironmentsClientListByScopeOptions) *runtime.Pager[corerpv20250801.EnvironmentsClientListByScopeResponse]
 }
+
+// lockClient is an interface for mocking the generated SDK client for locks.
+type lockClient interface {
+	NewListPager(scope string, options *ucpv20231001.LocksClientListOptions) *runtime.Pager[ucpv20231001.LocksClientListResponse]
+}
+
+// resourcesClient is an interface for mocking the generated SDK client for the resources in a resource group.
+type resourcesClient interface {
+	NewListPager(planeName string, resourceGroupName string, options *ucpv20231001.ResourcesClientListOptions) *runtime.Pager[ucpv20231001.ResourcesClientListResponse]
+}
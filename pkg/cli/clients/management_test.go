@@ -224,6 +224,28 @@ func mockResourceGroupDeletion(mock *MockresourceGroupClient, planeName, rgName
 		})
 }
 
+// mockLocks returns a lock client factory that lists the given locks for any scope.
+func mockLocks(t *testing.T, locks ...*ucp.LockResource) func() (lockClient, error) {
+	ctrl := gomock.NewController(t)
+	client := NewMocklockClient(ctrl)
+	client.EXPECT().
+		NewListPager(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(scope string, options *ucp.LocksClientListOptions) *runtime.Pager[ucp.LocksClientListResponse] {
+			return pager([]ucp.LocksClientListResponse{
+				{
+					LockResourceListResult: ucp.LockResourceListResult{
+						Value:    locks,
+						NextLink: new("0"),
+					},
+				},
+			})
+		}).AnyTimes()
+
+	return func() (lockClient, error) {
+		return client, nil
+	}
+}
+
 // setupResourceGroupMocks creates a client and all necessary mocks for resource group operations
 func setupResourceGroupMocks(t *testing.T) (*UCPApplicationsManagementClient, *MockresourceGroupClient, *MockgenericResourceClient, *MockresourceProviderClient) {
 	ctrl := gomock.NewController(t)
@@ -244,7 +266,8 @@ func setupResourceGroupMocks(t *testing.T) (*UCPApplicationsManagementClient, *M
 		resourceProviderClientFactory: func() (resourceProviderClient, error) {
 			return rpClient, nil
 		},
-		capture: testCapture,
+		lockClientFactory: mockLocks(t),
+		capture:           testCapture,
 	}
 
 	return client, rgClient, genericClient, rpClient
@@ -471,6 +494,75 @@ func Test_Resource(t *testing.T) {
 		require.Equal(t, expectedResourceList, resources)
 	})
 
+	t.Run("ListResourcesOfTypeWithTag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		client := createClient(mock)
+		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
+			return resourceProviderMock, nil
+		}
+		expectedResource := ucp.ResourceProviderSummary{
+			Name: new("Applications.Test"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"testResource": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						version: {},
+					},
+				},
+			},
+		}
+
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: expectedResource}, nil)
+
+		// The tag filter is sent to the server.
+		mock.EXPECT().
+			NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{TagName: new("team"), TagValue: new("payments")}).
+			Return(pager(listPages))
+
+		expectedResourceList := []generated.GenericResource{*listPages[0].Value[0], *listPages[0].Value[1], *listPages[1].Value[0], *listPages[1].Value[1]}
+
+		resources, err := client.ListResourcesOfTypeWithTag(context.Background(), testResourceType, "team", "payments")
+		require.NoError(t, err)
+		require.Equal(t, expectedResourceList, resources)
+	})
+
+	t.Run("ListResourcesOfTypeInApplicationWithTag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		client := createClient(mock)
+		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
+			return resourceProviderMock, nil
+		}
+		expectedResource := ucp.ResourceProviderSummary{
+			Name: new("Applications.Test"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"testResource": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						version: {},
+					},
+				},
+			},
+		}
+
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: expectedResource}, nil)
+
+		mock.EXPECT().
+			NewListByRootScopePager(&generated.GenericResourcesClientListByRootScopeOptions{TagName: new("team"), TagValue: new("payments")}).
+			Return(pager(listPages))
+
+		expectedResourceList := []generated.GenericResource{*listPages[0].Value[0]}
+
+		resources, err := client.ListResourcesOfTypeInApplicationWithTag(context.Background(), "test-application", testResourceType, "team", "payments")
+		require.NoError(t, err)
+		require.Equal(t, expectedResourceList, resources)
+	})
+
 	t.Run("ListResourcesOfTypeInEnvironment", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := NewMockgenericResourceClient(ctrl)
@@ -980,7 +1072,8 @@ func Test_Application(t *testing.T) {
 			applicationResourceClientFactory: func(scope string) (applicationResourceClient, error) {
 				return wrapped, nil
 			},
-			capture: testCapture,
+			lockClientFactory: mockLocks(t),
+			capture:           testCapture,
 		}
 	}
 
@@ -1357,7 +1450,8 @@ func Test_Environment(t *testing.T) {
 			environmentResourceClientFactory: func(scope string) (environmentResourceClient, error) {
 				return wrapped, nil
 			},
-			capture: testCapture,
+			lockClientFactory: mockLocks(t),
+			capture:           testCapture,
 		}
 	}
 
@@ -1693,7 +1787,8 @@ func Test_ResourceGroup(t *testing.T) {
 			resourceGroupClientFactory: func() (resourceGroupClient, error) {
 				return wrapped, nil
 			},
-			capture: testCapture,
+			lockClientFactory: mockLocks(t),
+			capture:           testCapture,
 		}
 	}
 
@@ -1804,7 +1899,8 @@ func Test_ResourceGroup(t *testing.T) {
 			resourceProviderClientFactory: func() (resourceProviderClient, error) {
 				return mockResourceProviderClient, nil
 			},
-			capture: testCapture,
+			lockClientFactory: mockLocks(t),
+			capture:           testCapture,
 		}
 
 		// Expect resource group existence check (called twice: once in DeleteResourceGroup, once in ListResourcesInResourceGroup)
@@ -1914,6 +2010,91 @@ func Test_DeleteResourceGroup(t *testing.T) {
 		require.Contains(t, err.Error(), "failed to delete resources in group")
 		require.False(t, deleted)
 	})
+
+	t.Run("locked resource", func(t *testing.T) {
+		client, rgClient, _, _ := setupResourceGroupMocks(t)
+		client.lockClientFactory = mockLocks(t, &ucp.LockResource{
+			ID: new("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/test-env/providers/System.Authorization/locks/do-not-delete"),
+			Properties: &ucp.LockProperties{
+				Level: to.Ptr(ucp.LockLevelCanNotDelete),
+			},
+		})
+
+		// Nothing is listed or deleted when the group contains a lock.
+		mockResourceGroupExists(rgClient, "local", "test-rg", 1)
+
+		deleted, err := client.DeleteResourceGroup(context.Background(), "local", "test-rg")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "CanNotDelete lock \"do-not-delete\"")
+		require.False(t, deleted)
+	})
+}
+
+func Test_checkLocks(t *testing.T) {
+	t.Parallel()
+
+	groupScope := "/planes/radius/local/resourceGroups/test-rg"
+	lock := func(id string) *ucp.LockResource {
+		return &ucp.LockResource{
+			ID: new(id),
+			Properties: &ucp.LockProperties{
+				Level: to.Ptr(ucp.LockLevelReadOnly),
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		locks   []*ucp.LockResource
+		ids     []string
+		wantErr bool
+	}{
+		{
+			name:    "no locks",
+			ids:     []string{groupScope + "/providers/Applications.Core/applications/app"},
+			wantErr: false,
+		},
+		{
+			name:    "lock on resource group",
+			locks:   []*ucp.LockResource{lock(groupScope + "/providers/System.Authorization/locks/lock")},
+			ids:     []string{groupScope + "/providers/Applications.Core/applications/app"},
+			wantErr: true,
+		},
+		{
+			name:    "lock on resource",
+			locks:   []*ucp.LockResource{lock(groupScope + "/providers/Applications.Core/applications/app/providers/System.Authorization/locks/lock")},
+			ids:     []string{groupScope + "/providers/Applications.Core/applications/APP"},
+			wantErr: true,
+		},
+		{
+			name:    "lock on contained resource",
+			locks:   []*ucp.LockResource{lock(groupScope + "/providers/Applications.Core/applications/app/providers/System.Authorization/locks/lock")},
+			ids:     []string{groupScope},
+			wantErr: true,
+		},
+		{
+			name:    "lock on other resource",
+			locks:   []*ucp.LockResource{lock(groupScope + "/providers/Applications.Core/applications/app-staging/providers/System.Authorization/locks/lock")},
+			ids:     []string{groupScope + "/providers/Applications.Core/applications/app"},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &UCPApplicationsManagementClient{
+				RootScope:         groupScope,
+				lockClientFactory: mockLocks(t, tt.locks...),
+			}
+
+			err := client.checkLocks(context.Background(), groupScope, tt.ids)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 // runListTest is a helper for testing list operations with filters
@@ -2104,6 +2285,56 @@ func runListResourcesOfTypeTest(t *testing.T, client *UCPApplicationsManagementC
 	}
 }
 
+func Test_ListResourcesWithTag(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockresourcesClient(ctrl)
+		client := &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			resourcesClientFactory: func() (resourcesClient, error) {
+				return mock, nil
+			},
+		}
+
+		resource := &ucp.GenericResource{
+			ID:   new(testScope + "/providers/Applications.Core/containers/frontend"),
+			Name: new("frontend"),
+			Type: new("Applications.Core/containers"),
+			Tags: map[string]*string{"team": new("payments")},
+		}
+		mock.EXPECT().
+			NewListPager("local", "my-default-rg", &ucp.ResourcesClientListOptions{TagName: new("team"), TagValue: new("payments")}).
+			Return(pager([]ucp.ResourcesClientListResponse{
+				{
+					GenericResourceListResult: ucp.GenericResourceListResult{
+						Value:    []*ucp.GenericResource{resource},
+						NextLink: new("0"),
+					},
+				},
+			}))
+
+		resources, err := client.ListResourcesWithTag(context.Background(), "team", "payments")
+		require.NoError(t, err)
+		require.Equal(t, []generated.GenericResource{
+			{
+				ID:   resource.ID,
+				Name: resource.Name,
+				Type: resource.Type,
+				Tags: resource.Tags,
+			},
+		}, resources)
+	})
+
+	t.Run("plane scope", func(t *testing.T) {
+		client := &UCPApplicationsManagementClient{
+			RootScope: "/planes/radius/local",
+		}
+
+		_, err := client.ListResourcesWithTag(context.Background(), "team", "payments")
+		require.EqualError(t, err, `listing resources by tag requires a resource group scope, got "/planes/radius/local"`)
+	})
+}
+
 func Test_ListResourcesOfTypeInResourceGroup(t *testing.T) {
 	t.Parallel()
 
@@ -2406,6 +2637,24 @@ func Test_ResourceProvider(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expectedResource, summary)
 	})
+
+	t.Run("GetResourceProviderOpenAPIDocument", func(t *testing.T) {
+		mock := NewMockresourceProviderClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		expectedDocument := map[string]any{
+			"openapi": "3.0.3",
+			"info":    map[string]any{"title": testResourceProviderName, "version": version},
+		}
+
+		mock.EXPECT().
+			GetOpenAPIDocument(gomock.Any(), "local", testResourceProviderName, version, gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetOpenAPIDocumentResponse{Value: expectedDocument}, nil)
+
+		document, err := client.GetResourceProviderOpenAPIDocument(context.Background(), "local", testResourceProviderName, version)
+		require.NoError(t, err)
+		require.Equal(t, expectedDocument, document)
+	})
 }
 
 func Test_ResourceType(t *testing.T) {
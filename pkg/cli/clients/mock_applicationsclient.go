@@ -235,6 +235,44 @@ func (c *MockApplicationsManagementClientCreateOrUpdateLocationCall) DoAndReturn
 	return c
 }
 
+// CreateOrUpdateRecipePack mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateRecipePack(arg0 context.Context, arg1 string, arg2 *v20250801preview.RecipePackResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateRecipePack", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateRecipePack indicates an expected call of CreateOrUpdateRecipePack.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateRecipePack(arg0, arg1, arg2 any) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRecipePack", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateRecipePack), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientCreateOrUpdateRecipePackCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateRecipePackCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateRecipePackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateRecipePackCall) Return(arg0 error) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateRecipePackCall) Do(f func(context.Context, string, *v20250801preview.RecipePackResource) error) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateRecipePackCall) DoAndReturn(f func(context.Context, string, *v20250801preview.RecipePackResource) error) *MockApplicationsManagementClientCreateOrUpdateRecipePackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOrUpdateResource mocks base method.
 func (m *MockApplicationsManagementClient) CreateOrUpdateResource(arg0 context.Context, arg1, arg2 string, arg3 *generated.GenericResource) (generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -975,6 +1013,45 @@ func (c *MockApplicationsManagementClientGetResourceProviderCall) DoAndReturn(f
 	return c
 }
 
+// GetResourceProviderOpenAPIDocument mocks base method.
+func (m *MockApplicationsManagementClient) GetResourceProviderOpenAPIDocument(arg0 context.Context, arg1, arg2, arg3 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceProviderOpenAPIDocument", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceProviderOpenAPIDocument indicates an expected call of GetResourceProviderOpenAPIDocument.
+func (mr *MockApplicationsManagementClientMockRecorder) GetResourceProviderOpenAPIDocument(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceProviderOpenAPIDocument", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetResourceProviderOpenAPIDocument), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall wrap *gomock.Call
+type MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall) Return(arg0 map[string]any, arg1 error) *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall) Do(f func(context.Context, string, string, string) (map[string]any, error)) *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall) DoAndReturn(f func(context.Context, string, string, string) (map[string]any, error)) *MockApplicationsManagementClientGetResourceProviderOpenAPIDocumentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetResourceProviderSummary mocks base method.
 func (m *MockApplicationsManagementClient) GetResourceProviderSummary(arg0 context.Context, arg1, arg2 string) (v20231001preview0.ResourceProviderSummary, error) {
 	m.ctrl.T.Helper()
@@ -1638,6 +1715,45 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInApplicationCall) D
 	return c
 }
 
+// ListResourcesOfTypeInApplicationWithTag mocks base method.
+func (m *MockApplicationsManagementClient) ListResourcesOfTypeInApplicationWithTag(arg0 context.Context, arg1, arg2, arg3, arg4 string) ([]generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourcesOfTypeInApplicationWithTag", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourcesOfTypeInApplicationWithTag indicates an expected call of ListResourcesOfTypeInApplicationWithTag.
+func (mr *MockApplicationsManagementClientMockRecorder) ListResourcesOfTypeInApplicationWithTag(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourcesOfTypeInApplicationWithTag", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListResourcesOfTypeInApplicationWithTag), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall{Call: call}
+}
+
+// MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall wrap *gomock.Call
+type MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall) Return(arg0 []generated.GenericResource, arg1 error) *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall) Do(f func(context.Context, string, string, string, string) ([]generated.GenericResource, error)) *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall) DoAndReturn(f func(context.Context, string, string, string, string) ([]generated.GenericResource, error)) *MockApplicationsManagementClientListResourcesOfTypeInApplicationWithTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResourcesOfTypeInEnvironment mocks base method.
 func (m *MockApplicationsManagementClient) ListResourcesOfTypeInEnvironment(arg0 context.Context, arg1, arg2 string) ([]generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -1754,3 +1870,81 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInResourceGroupFilte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ListResourcesOfTypeWithTag mocks base method.
+func (m *MockApplicationsManagementClient) ListResourcesOfTypeWithTag(arg0 context.Context, arg1, arg2, arg3 string) ([]generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourcesOfTypeWithTag", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourcesOfTypeWithTag indicates an expected call of ListResourcesOfTypeWithTag.
+func (mr *MockApplicationsManagementClientMockRecorder) ListResourcesOfTypeWithTag(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientListResourcesOfTypeWithTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourcesOfTypeWithTag", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListResourcesOfTypeWithTag), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientListResourcesOfTypeWithTagCall{Call: call}
+}
+
+// MockApplicationsManagementClientListResourcesOfTypeWithTagCall wrap *gomock.Call
+type MockApplicationsManagementClientListResourcesOfTypeWithTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListResourcesOfTypeWithTagCall) Return(arg0 []generated.GenericResource, arg1 error) *MockApplicationsManagementClientListResourcesOfTypeWithTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListResourcesOfTypeWithTagCall) Do(f func(context.Context, string, string, string) ([]generated.GenericResource, error)) *MockApplicationsManagementClientListResourcesOfTypeWithTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListResourcesOfTypeWithTagCall) DoAndReturn(f func(context.Context, string, string, string) ([]generated.GenericResource, error)) *MockApplicationsManagementClientListResourcesOfTypeWithTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListResourcesWithTag mocks base method.
+func (m *MockApplicationsManagementClient) ListResourcesWithTag(arg0 context.Context, arg1, arg2 string) ([]generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourcesWithTag", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourcesWithTag indicates an expected call of ListResourcesWithTag.
+func (mr *MockApplicationsManagementClientMockRecorder) ListResourcesWithTag(arg0, arg1, arg2 any) *MockApplicationsManagementClientListResourcesWithTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourcesWithTag", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListResourcesWithTag), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientListResourcesWithTagCall{Call: call}
+}
+
+// MockApplicationsManagementClientListResourcesWithTagCall wrap *gomock.Call
+type MockApplicationsManagementClientListResourcesWithTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListResourcesWithTagCall) Return(arg0 []generated.GenericResource, arg1 error) *MockApplicationsManagementClientListResourcesWithTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListResourcesWithTagCall) Do(f func(context.Context, string, string) ([]generated.GenericResource, error)) *MockApplicationsManagementClientListResourcesWithTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListResourcesWithTagCall) DoAndReturn(f func(context.Context, string, string) ([]generated.GenericResource, error)) *MockApplicationsManagementClientListResourcesWithTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
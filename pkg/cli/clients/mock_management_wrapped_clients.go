@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,radiusCoreEnvironmentResourceClient
+//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,radiusCoreEnvironmentResourceClient,lockClient,resourcesClient
 //
 
 // Package clients is a generated GoMock package.
@@ -951,6 +951,45 @@ func (c *MockresourceProviderClientGetCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetOpenAPIDocument mocks base method.
+func (m *MockresourceProviderClient) GetOpenAPIDocument(ctx context.Context, planeName, resourceProviderName, apiVersionName string, options *v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentOptions) (v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenAPIDocument", ctx, planeName, resourceProviderName, apiVersionName, options)
+	ret0, _ := ret[0].(v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenAPIDocument indicates an expected call of GetOpenAPIDocument.
+func (mr *MockresourceProviderClientMockRecorder) GetOpenAPIDocument(ctx, planeName, resourceProviderName, apiVersionName, options any) *MockresourceProviderClientGetOpenAPIDocumentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenAPIDocument", reflect.TypeOf((*MockresourceProviderClient)(nil).GetOpenAPIDocument), ctx, planeName, resourceProviderName, apiVersionName, options)
+	return &MockresourceProviderClientGetOpenAPIDocumentCall{Call: call}
+}
+
+// MockresourceProviderClientGetOpenAPIDocumentCall wrap *gomock.Call
+type MockresourceProviderClientGetOpenAPIDocumentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourceProviderClientGetOpenAPIDocumentCall) Return(arg0 v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentResponse, arg1 error) *MockresourceProviderClientGetOpenAPIDocumentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourceProviderClientGetOpenAPIDocumentCall) Do(f func(context.Context, string, string, string, *v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentOptions) (v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentResponse, error)) *MockresourceProviderClientGetOpenAPIDocumentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourceProviderClientGetOpenAPIDocumentCall) DoAndReturn(f func(context.Context, string, string, string, *v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentOptions) (v20231001preview0.ResourceProvidersClientGetOpenAPIDocumentResponse, error)) *MockresourceProviderClientGetOpenAPIDocumentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProviderSummary mocks base method.
 func (m *MockresourceProviderClient) GetProviderSummary(ctx context.Context, planeName, resourceProviderName string, options *v20231001preview0.ResourceProvidersClientGetProviderSummaryOptions) (v20231001preview0.ResourceProvidersClientGetProviderSummaryResponse, error) {
 	m.ctrl.T.Helper()
@@ -1646,3 +1685,125 @@ func (c *MockradiusCoreEnvironmentResourceClientNewListByScopePagerCall) DoAndRe
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MocklockClient is a mock of lockClient interface.
+type MocklockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklockClientMockRecorder
+}
+
+// MocklockClientMockRecorder is the mock recorder for MocklockClient.
+type MocklockClientMockRecorder struct {
+	mock *MocklockClient
+}
+
+// NewMocklockClient creates a new mock instance.
+func NewMocklockClient(ctrl *gomock.Controller) *MocklockClient {
+	mock := &MocklockClient{ctrl: ctrl}
+	mock.recorder = &MocklockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklockClient) EXPECT() *MocklockClientMockRecorder {
+	return m.recorder
+}
+
+// NewListPager mocks base method.
+func (m *MocklockClient) NewListPager(scope string, options *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", scope, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.LocksClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MocklockClientMockRecorder) NewListPager(scope, options any) *MocklockClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MocklockClient)(nil).NewListPager), scope, options)
+	return &MocklockClientNewListPagerCall{Call: call}
+}
+
+// MocklockClientNewListPagerCall wrap *gomock.Call
+type MocklockClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientNewListPagerCall) Do(f func(string, *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientNewListPagerCall) DoAndReturn(f func(string, *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockresourcesClient is a mock of resourcesClient interface.
+type MockresourcesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourcesClientMockRecorder
+}
+
+// MockresourcesClientMockRecorder is the mock recorder for MockresourcesClient.
+type MockresourcesClientMockRecorder struct {
+	mock *MockresourcesClient
+}
+
+// NewMockresourcesClient creates a new mock instance.
+func NewMockresourcesClient(ctrl *gomock.Controller) *MockresourcesClient {
+	mock := &MockresourcesClient{ctrl: ctrl}
+	mock.recorder = &MockresourcesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourcesClient) EXPECT() *MockresourcesClientMockRecorder {
+	return m.recorder
+}
+
+// NewListPager mocks base method.
+func (m *MockresourcesClient) NewListPager(planeName, resourceGroupName string, options *v20231001preview0.ResourcesClientListOptions) *runtime.Pager[v20231001preview0.ResourcesClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, resourceGroupName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.ResourcesClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MockresourcesClientMockRecorder) NewListPager(planeName, resourceGroupName, options any) *MockresourcesClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MockresourcesClient)(nil).NewListPager), planeName, resourceGroupName, options)
+	return &MockresourcesClientNewListPagerCall{Call: call}
+}
+
+// MockresourcesClientNewListPagerCall wrap *gomock.Call
+type MockresourcesClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourcesClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.ResourcesClientListResponse]) *MockresourcesClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourcesClientNewListPagerCall) Do(f func(string, string, *v20231001preview0.ResourcesClientListOptions) *runtime.Pager[v20231001preview0.ResourcesClientListResponse]) *MockresourcesClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourcesClientNewListPagerCall) DoAndReturn(f func(string, string, *v20231001preview0.ResourcesClientListOptions) *runtime.Pager[v20231001preview0.ResourcesClientListResponse]) *MockresourcesClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -239,7 +239,25 @@ func (g *GenericResourcesServerTransport) dispatchNewListByRootScopePager(req *h
 		if len(matches) < 3 {
 			return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
 		}
-		resp := g.srv.NewListByRootScopePager(nil)
+		qp := req.URL.Query()
+		tagNameUnescaped, err := url.QueryUnescape(qp.Get("tagName"))
+		if err != nil {
+			return nil, err
+		}
+		tagNameParam := getOptional(tagNameUnescaped)
+		tagValueUnescaped, err := url.QueryUnescape(qp.Get("tagValue"))
+		if err != nil {
+			return nil, err
+		}
+		tagValueParam := getOptional(tagValueUnescaped)
+		var options *generated.GenericResourcesClientListByRootScopeOptions
+		if tagNameParam != nil || tagValueParam != nil {
+			options = &generated.GenericResourcesClientListByRootScopeOptions{
+				TagName:  tagNameParam,
+				TagValue: tagValueParam,
+			}
+		}
+		resp := g.srv.NewListByRootScopePager(options)
 		newListByRootScopePager = &resp
 		g.newListByRootScopePager.add(req, newListByRootScopePager)
 		server.PagerResponderInjectNextLinks(newListByRootScopePager, req, func(page *generated.GenericResourcesClientListByRootScopeResponse, createLink func() string) {
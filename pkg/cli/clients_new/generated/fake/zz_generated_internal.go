@@ -7,6 +7,7 @@ package fake
 import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/fake/server"
 	"net/http"
+	"reflect"
 	"sync"
 )
 
@@ -32,6 +33,13 @@ func contains[T comparable](s []T, v T) bool {
 	return false
 }
 
+func getOptional[T any](v T) *T {
+	if reflect.ValueOf(v).IsZero() {
+		return nil
+	}
+	return &v
+}
+
 func newTracker[T any]() *tracker[T] {
 	return &tracker[T]{
 		items: map[string]*T{},
@@ -272,7 +272,7 @@ func (client *GenericResourcesClient) NewListByRootScopePager(options *GenericRe
 }
 
 // listByRootScopeCreateRequest creates the ListByRootScope request.
-func (client *GenericResourcesClient) listByRootScopeCreateRequest(ctx context.Context, _ *GenericResourcesClientListByRootScopeOptions) (*policy.Request, error) {
+func (client *GenericResourcesClient) listByRootScopeCreateRequest(ctx context.Context, options *GenericResourcesClientListByRootScopeOptions) (*policy.Request, error) {
 	urlPath := "/{rootScope}/providers/{resourceType}"
 	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
 	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
@@ -282,6 +282,12 @@ func (client *GenericResourcesClient) listByRootScopeCreateRequest(ctx context.C
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.TagName != nil {
+		reqQP.Set("tagName", *options.TagName)
+	}
+	if options != nil && options.TagValue != nil {
+		reqQP.Set("tagValue", *options.TagValue)
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
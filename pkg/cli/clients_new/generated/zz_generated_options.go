@@ -25,7 +25,11 @@ type GenericResourcesClientGetOptions struct {
 // GenericResourcesClientListByRootScopeOptions contains the optional parameters for the GenericResourcesClient.NewListByRootScopePager
 // method.
 type GenericResourcesClientListByRootScopeOptions struct {
-	// placeholder for future optional parameters
+	// Only list the resources that have a tag with this name. tagValue must also be specified.
+	TagName *string
+
+	// Only list the resources whose tag named tagName has this value.
+	TagValue *string
 }
 
 // GenericResourcesClientListSecretsOptions contains the optional parameters for the GenericResourcesClient.ListSecrets method.
@@ -50,3 +50,32 @@ func gatewayFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// readinessFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of the readiness of the resources of an application.
+func readinessFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "READY",
+				JSONPath: "{ .Ready }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
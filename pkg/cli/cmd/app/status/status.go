@@ -164,6 +164,10 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		if readiness, ok := clients.GetResourceReadiness(resource); ok {
+			applicationStatus.Resources = append(applicationStatus.Resources, readiness)
+		}
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, statusFormat())
@@ -181,5 +185,15 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.Format == output.FormatTable && len(applicationStatus.Resources) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Resources, readinessFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -184,6 +184,94 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Resources Report Conditions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		application := v20231001preview.ApplicationResource{
+			Name: new("test-app"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+
+		resourceList := []generated.GenericResource{
+			{
+				Name: new("orders-db"),
+				Type: new("Contoso.Example/databases"),
+				ID:   new("/planes/radius/local/resourceGroups/test-group/providers/Contoso.Example/databases/orders-db"),
+				Properties: map[string]any{
+					"status": map[string]any{
+						"conditions": []any{
+							map[string]any{"type": "Ready", "status": "False", "reason": "FailingOver", "message": "the primary replica is failing over"},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            diagnosticsClient,
+			},
+			Workspace:       &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Format:          "table",
+			Output:          outputSink,
+			ApplicationName: "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		applicationStatus := clients.ApplicationStatus{
+			Name:          "test-app",
+			ResourceCount: 1,
+			Resources: []clients.ResourceReadiness{
+				{
+					Name:    "orders-db",
+					Type:    "Contoso.Example/databases",
+					Ready:   "False",
+					Reason:  "FailingOver",
+					Message: "the primary replica is failing over",
+				},
+			},
+		}
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus,
+				Options: statusFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.Resources,
+				Options: readinessFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Error: Application Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
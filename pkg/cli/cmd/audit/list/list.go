@@ -0,0 +1,240 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagResource = "resource"
+	flagSince    = "since"
+)
+
+// NewCommand creates an instance of the `rad audit list` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit events",
+		Long: `List audit events
+
+Audit events record every mutation of the control plane: who made the request, what operation was requested, which resource it targeted and what the outcome was. Events are chained by hash, and the chain is verified when events are listed: the command fails if events of the audit log were modified or removed.
+
+Events are listed in the order they were recorded. Use --resource to only list the events of a resource and the resources it contains, and --since to only list recent events.`,
+		Example: `
+# List all audit events
+rad audit list
+
+# List the audit events of a resource group and the resources it contains
+rad audit list --resource /planes/radius/local/resourceGroups/my-group
+
+# List the audit events of the last hour
+rad audit list --since 1h
+
+# List the audit events since a point in time
+rad audit list --since 2026-10-01T17:00:00Z`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringVar(&runner.Resource, flagResource, "", "The ID of the resource to list the audit events of, including the resources it contains")
+	cmd.Flags().String(flagSince, "", "Only list audit events recorded after this RFC 3339 timestamp or within this duration, for example '1h'")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad audit list` command.
+type Runner struct {
+	UCPClientFactory *v20231001preview.ClientFactory
+	ConfigHolder     *framework.ConfigHolder
+	Output           output.Interface
+	Format           string
+	Workspace        *workspaces.Workspace
+	Resource         string
+	Since            *time.Time
+}
+
+// NewRunner creates an instance of the runner for the `rad audit list` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad audit list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	since, err := cmd.Flags().GetString(flagSince)
+	if err != nil {
+		return err
+	}
+	if since != "" {
+		r.Since, err = parseSince(since, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad audit list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Initialize the client factory if it hasn't been set externally.
+	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
+	if r.UCPClientFactory == nil {
+		err := r.initializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	options := &v20231001preview.AuditEventsClientListOptions{
+		Since: r.Since,
+	}
+	if r.Resource != "" {
+		options.Resource = &r.Resource
+	}
+
+	// Events are listed from the most recent, and the server verifies the audit chain while listing them.
+	events := []*v20231001preview.AuditEventResource{}
+	pager := r.UCPClientFactory.NewAuditEventsClient().NewListPager("local", options)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		responseError := &azcore.ResponseError{}
+		if errors.As(err, &responseError) && responseError.ErrorCode == v1.CodeAuditChainBroken {
+			return clierrors.MessageWithCause(err, "The audit log has been tampered with: events were modified or removed.")
+		} else if err != nil {
+			return err
+		}
+
+		events = append(events, page.Value...)
+	}
+
+	slices.SortFunc(events, func(a, b *v20231001preview.AuditEventResource) int {
+		return cmp.Compare(*a.Properties.Sequence, *b.Properties.Sequence)
+	})
+
+	err := r.Output.WriteFormatted(r.Format, events, auditEventTableFormat())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Runner) initializeClientFactory(ctx context.Context, workspace *workspaces.Workspace) error {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	r.UCPClientFactory = clientFactory
+	return nil
+}
+
+// parseSince parses the value of the --since flag, which is either an RFC 3339 timestamp or a duration
+// relative to now.
+func parseSince(value string, now time.Time) (*time.Time, error) {
+	if duration, err := time.ParseDuration(value); err == nil {
+		if duration <= 0 {
+			return nil, clierrors.Message("The value of --%s must be a positive duration, got %q.", flagSince, value)
+		}
+
+		since := now.Add(-duration).UTC()
+		return &since, nil
+	}
+
+	since, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, clierrors.Message("The value of --%s must be an RFC 3339 timestamp or a duration such as '1h', got %q.", flagSince, value)
+	}
+
+	return &since, nil
+}
+
+func auditEventTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "SEQUENCE",
+				JSONPath: "{ .Properties.Sequence }",
+			},
+			{
+				Heading:  "TIME",
+				JSONPath: "{ .Properties.Timestamp }",
+			},
+			{
+				Heading:  "PRINCIPAL",
+				JSONPath: "{ .Properties.Principal }",
+			},
+			{
+				Heading:  "OPERATION",
+				JSONPath: "{ .Properties.Operation }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Properties.ResourceID }",
+			},
+			{
+				Heading:  "RESULT",
+				JSONPath: "{ .Properties.Result }",
+			},
+		},
+	}
+}
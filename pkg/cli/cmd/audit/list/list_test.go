@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Valid: resource and timestamp",
+			Input:         []string{"--resource", "/planes/radius/local/resourceGroups/test-group", "--since", "2026-10-01T17:00:00Z"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Valid: duration",
+			Input:         []string{"--since", "1h"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid: since",
+			Input:         []string{"--since", "yesterday"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_parseSince(t *testing.T) {
+	now := time.Date(2026, 10, 1, 18, 0, 0, 0, time.UTC)
+
+	t.Run("duration", func(t *testing.T) {
+		since, err := parseSince("90m", now)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, 10, 1, 16, 30, 0, 0, time.UTC), *since)
+	})
+
+	t.Run("timestamp", func(t *testing.T) {
+		since, err := parseSince("2026-10-01T17:00:00Z", now)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2026, 10, 1, 17, 0, 0, 0, time.UTC), *since)
+	})
+
+	t.Run("negative duration", func(t *testing.T) {
+		_, err := parseSince("-1h", now)
+		require.Equal(t, clierrors.Message("The value of --%s must be a positive duration, got %q.", flagSince, "-1h"), err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseSince("yesterday", now)
+		require.Equal(t, clierrors.Message("The value of --%s must be an RFC 3339 timestamp or a duration such as '1h', got %q.", flagSince, "yesterday"), err)
+	})
+}
+
+func Test_Run(t *testing.T) {
+	events := []*v20231001preview.AuditEventResource{
+		{
+			ID:   to.Ptr("/planes/radius/local/providers/System.Audit/events/00000000000000000001"),
+			Name: to.Ptr("00000000000000000001"),
+			Type: to.Ptr("System.Audit/events"),
+			Properties: &v20231001preview.AuditEventProperties{
+				Sequence:   to.Ptr[int64](1),
+				Timestamp:  to.Ptr(time.Date(2026, 10, 1, 17, 0, 0, 0, time.UTC)),
+				Kind:       to.Ptr(v20231001preview.AuditEventKindRequest),
+				Principal:  to.Ptr("alice"),
+				Operation:  to.Ptr("APPLICATIONS.CORE/ENVIRONMENTS|PUT"),
+				ResourceID: to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"),
+				Result:     to.Ptr("Accepted"),
+				Hash:       to.Ptr("abc"),
+			},
+		},
+		{
+			ID:   to.Ptr("/planes/radius/local/providers/System.Audit/events/00000000000000000002"),
+			Name: to.Ptr("00000000000000000002"),
+			Type: to.Ptr("System.Audit/events"),
+			Properties: &v20231001preview.AuditEventProperties{
+				Sequence:     to.Ptr[int64](2),
+				Timestamp:    to.Ptr(time.Date(2026, 10, 1, 17, 1, 0, 0, time.UTC)),
+				Kind:         to.Ptr(v20231001preview.AuditEventKindAsyncOperation),
+				Operation:    to.Ptr("APPLICATIONS.CORE/ENVIRONMENTS|PUT"),
+				ResourceID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"),
+				Result:       to.Ptr("Succeeded"),
+				PreviousHash: to.Ptr("abc"),
+				Hash:         to.Ptr("def"),
+			},
+		},
+	}
+
+	var actualOptions *v20231001preview.AuditEventsClientListOptions
+	server := ucpfake.AuditEventsServer{
+		NewListPager: func(
+			planeName string,
+			options *v20231001preview.AuditEventsClientListOptions,
+		) (resp azfake.PagerResponder[v20231001preview.AuditEventsClientListResponse]) {
+			actualOptions = options
+
+			// Events are listed from the most recent.
+			resp.AddPage(http.StatusOK, v20231001preview.AuditEventsClientListResponse{
+				AuditEventResourceListResult: v20231001preview.AuditEventResourceListResult{
+					Value: []*v20231001preview.AuditEventResource{events[1]},
+				},
+			}, nil)
+			resp.AddPage(http.StatusOK, v20231001preview.AuditEventsClientListResponse{
+				AuditEventResourceListResult: v20231001preview.AuditEventResourceListResult{
+					Value: []*v20231001preview.AuditEventResource{events[0]},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: ucpfake.NewServerFactoryTransport(&ucpfake.ServerFactory{AuditEventsServer: server}),
+		},
+	})
+	require.NoError(t, err)
+
+	since := time.Date(2026, 10, 1, 16, 0, 0, 0, time.UTC)
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		UCPClientFactory: clientFactory,
+		Output:           outputSink,
+		Workspace:        &workspaces.Workspace{},
+		Format:           "table",
+		Resource:         "/planes/radius/local/resourceGroups/test-group",
+		Since:            &since,
+	}
+
+	err = runner.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-group", *actualOptions.Resource)
+	require.True(t, since.Equal(*actualOptions.Since))
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     events,
+			Options: auditEventTableFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
+
+func Test_Run_ChainBroken(t *testing.T) {
+	server := ucpfake.AuditEventsServer{
+		NewListPager: func(
+			planeName string,
+			options *v20231001preview.AuditEventsClientListOptions,
+		) (resp azfake.PagerResponder[v20231001preview.AuditEventsClientListResponse]) {
+			resp.AddResponseError(http.StatusInternalServerError, v1.CodeAuditChainBroken)
+			return
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: ucpfake.NewServerFactoryTransport(&ucpfake.ServerFactory{AuditEventsServer: server}),
+		},
+	})
+	require.NoError(t, err)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		UCPClientFactory: clientFactory,
+		Output:           outputSink,
+		Workspace:        &workspaces.Workspace{},
+		Format:           "table",
+	}
+
+	err = runner.Run(context.Background())
+	require.True(t, clierrors.IsFriendlyError(err))
+	require.Contains(t, err.Error(), "The audit log has been tampered with")
+	require.Empty(t, outputSink.Writes)
+}
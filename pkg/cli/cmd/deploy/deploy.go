@@ -25,6 +25,7 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -44,6 +45,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
@@ -332,6 +334,8 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	r.warnDeprecatedResourceTypes(ctx, template)
+
 	progressText := ""
 	if r.ApplicationName == "" {
 		progressText = fmt.Sprintf(
@@ -439,6 +443,71 @@ func (r *Runner) reportMissingParameters(template map[string]any) error {
 
 // isApplicationsCoreProvider returns true if the provider is Applications.Core based on the environment ID
 // It returns an error if the ID cannot be parsed
+// warnDeprecatedResourceTypes logs a warning for each resource type and API version used by the template that is
+// deprecated. Failures to fetch the resource types are ignored: the deployment reports the resource types that do not
+// exist.
+func (r *Runner) warnDeprecatedResourceTypes(ctx context.Context, template map[string]any) {
+	entries := bicep.ExtractResourceTypes(template)
+	if len(entries) == 0 {
+		return
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return
+	}
+
+	resourceProviders, err := client.ListResourceProviderSummaries(ctx, "local")
+	if err != nil {
+		return
+	}
+
+	for _, warning := range deprecationWarnings(entries, resourceProviders, time.Now()) {
+		r.Output.LogInfo("Warning: %s", warning)
+	}
+}
+
+// deprecationWarnings returns the sorted deprecation warnings of the resource types and API versions used by the
+// template.
+func deprecationWarnings(entries []bicep.ResourceTypeEntry, resourceProviders []ucpv20231001preview.ResourceProviderSummary, now time.Time) []string {
+	warnings := map[string]struct{}{}
+	for _, entry := range entries {
+		namespace, typeName, ok := strings.Cut(entry.Type, "/")
+		if !ok {
+			continue
+		}
+
+		for _, resourceProvider := range resourceProviders {
+			if !strings.EqualFold(to.String(resourceProvider.Name), namespace) {
+				continue
+			}
+
+			for name, resourceType := range resourceProvider.ResourceTypes {
+				if !strings.EqualFold(name, typeName) || resourceType == nil {
+					continue
+				}
+
+				if deprecation := ucpv20231001preview.DeprecationToDataModel(resourceType.Deprecation); deprecation != nil {
+					warnings[deprecation.Warning("Resource type "+entry.Type, now)] = struct{}{}
+				}
+
+				for apiVersion, properties := range resourceType.APIVersions {
+					if !strings.EqualFold(apiVersion, entry.APIVersion) || properties == nil {
+						continue
+					}
+					if deprecation := ucpv20231001preview.DeprecationToDataModel(properties.Deprecation); deprecation != nil {
+						warnings[deprecation.Warning(fmt.Sprintf("API version %s of resource type %s", entry.APIVersion, entry.Type), now)] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	result := maps.Keys(warnings)
+	sort.Strings(result)
+	return result
+}
+
 func isApplicationsCoreProvider(id string) (bool, error) {
 	parsedID, err := resources.Parse(id)
 	if err != nil {
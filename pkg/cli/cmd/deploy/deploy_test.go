@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
@@ -41,6 +42,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	corerpfake "github.com/radius-project/radius/pkg/corerp/api/v20250801preview/fake"
 	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
@@ -1237,6 +1239,104 @@ func Test_injectAutomaticParameters(t *testing.T) {
 	require.Equal(t, expected, runner.Parameters)
 }
 
+func Test_warnDeprecatedResourceTypes(t *testing.T) {
+	sunset := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+	resourceProviders := []ucpv20231001preview.ResourceProviderSummary{
+		{
+			Name: new("Applications.Test"),
+			ResourceTypes: map[string]*ucpv20231001preview.ResourceProviderSummaryResourceType{
+				"oldResources": {
+					Deprecation: &ucpv20231001preview.Deprecation{
+						SunsetDate:  &sunset,
+						Replacement: new("Applications.Test/newResources"),
+					},
+					APIVersions: map[string]*ucpv20231001preview.ResourceTypeSummaryResultAPIVersion{
+						"2023-10-01-preview": {},
+					},
+				},
+				"newResources": {
+					APIVersions: map[string]*ucpv20231001preview.ResourceTypeSummaryResultAPIVersion{
+						"2023-10-01-preview": {
+							Deprecation: &ucpv20231001preview.Deprecation{Message: new("Upgrade to 2024-01-01.")},
+						},
+						"2024-01-01": {},
+					},
+				},
+			},
+		},
+	}
+
+	template := map[string]any{
+		"resources": map[string]any{
+			"old1":    map[string]any{"type": "Applications.Test/oldResources@2023-10-01-preview"},
+			"old2":    map[string]any{"type": "Applications.Test/oldResources@2023-10-01-preview"},
+			"new":     map[string]any{"type": "Applications.Test/newResources@2023-10-01-preview"},
+			"current": map[string]any{"type": "Applications.Test/newResources@2024-01-01"},
+			"other":   map[string]any{"type": "Applications.Core/containers@2023-10-01-preview"},
+		},
+	}
+
+	t.Run("warns about deprecated resource types and API versions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceProviderSummaries(gomock.Any(), "local").
+			Return(resourceProviders, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+		}
+
+		runner.warnDeprecatedResourceTypes(context.Background(), template)
+
+		require.Equal(t, []any{
+			output.LogOutput{
+				Format: "Warning: %s",
+				Params: []any{"API version 2023-10-01-preview of resource type Applications.Test/newResources is deprecated. Upgrade to 2024-01-01."},
+			},
+			output.LogOutput{
+				Format: "Warning: %s",
+				Params: []any{"Resource type Applications.Test/oldResources is deprecated and will be sunset on 2099-01-01. Use Applications.Test/newResources instead."},
+			},
+		}, outputSink.Writes)
+	})
+
+	t.Run("ignores failures to list the resource types", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceProviderSummaries(gomock.Any(), "local").
+			Return(nil, fmt.Errorf("failed")).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+		}
+
+		runner.warnDeprecatedResourceTypes(context.Background(), template)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("no-op when template has no resources", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{},
+			Workspace:         &workspaces.Workspace{},
+			Output:            outputSink,
+		}
+
+		runner.warnDeprecatedResourceTypes(context.Background(), map[string]any{})
+		require.Empty(t, outputSink.Writes)
+	})
+}
+
 func Test_reportMissingParameters(t *testing.T) {
 	template := map[string]any{
 		"parameters": map[string]any{
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// ResourceFlag is the flag used to specify the resource a lock applies to.
+	ResourceFlag = "resource"
+)
+
+// AddScopeFlags adds the flags used to specify the resource group, application, environment or resource a
+// lock applies to.
+func AddScopeFlags(cmd *cobra.Command) {
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	cmd.Flags().String(ResourceFlag, "", "The resource ID or the fully qualified type and name of the resource, for example 'Applications.Datastores/redisCaches/db'")
+}
+
+// RequireScope returns the ID of the resource group, application, environment or resource a lock applies to.
+// The resource group defaults to the resource group of the workspace.
+func RequireScope(cmd *cobra.Command, workspace workspaces.Workspace) (string, error) {
+	scope, err := cli.RequireScope(cmd, workspace)
+	if err != nil {
+		return "", err
+	}
+
+	application, err := cmd.Flags().GetString("application")
+	if err != nil {
+		return "", err
+	}
+
+	environment, err := cmd.Flags().GetString("environment")
+	if err != nil {
+		return "", err
+	}
+
+	resource, err := cmd.Flags().GetString(ResourceFlag)
+	if err != nil {
+		return "", err
+	}
+
+	specified := 0
+	for _, value := range []string{application, environment, resource} {
+		if value != "" {
+			specified++
+		}
+	}
+	if specified > 1 {
+		return "", clierrors.Message("Only one of --application, --environment and --%s can be specified.", ResourceFlag)
+	}
+
+	switch {
+	case application != "":
+		return scope + "/providers/Applications.Core/applications/" + application, nil
+	case environment != "":
+		return scope + "/providers/Applications.Core/environments/" + environment, nil
+	case strings.HasPrefix(resource, resources.SegmentSeparator):
+		id, err := resources.ParseResource(resource)
+		if err != nil || id.IsExtensionResource() {
+			return "", clierrors.Message("The value of --%s must be a resource ID or a fully qualified type and name, got %q.", ResourceFlag, resource)
+		}
+
+		return id.String(), nil
+	case resource != "":
+		index := strings.LastIndex(resource, resources.SegmentSeparator)
+		if index < 0 || strings.Count(resource, resources.SegmentSeparator) != 2 {
+			return "", clierrors.Message("The value of --%s must be a resource ID or a fully qualified type and name, got %q.", ResourceFlag, resource)
+		}
+
+		return fmt.Sprintf("%s/providers/%s/%s", scope, resource[:index], resource[index+1:]), nil
+	default:
+		return scope, nil
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RequireScope(t *testing.T) {
+	workspace := workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"}
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "workspace resource group",
+			args:     []string{},
+			expected: "/planes/radius/local/resourceGroups/test-group",
+		},
+		{
+			name:     "resource group",
+			args:     []string{"--group", "prod"},
+			expected: "/planes/radius/local/resourceGroups/prod",
+		},
+		{
+			name:     "application",
+			args:     []string{"--application", "app"},
+			expected: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app",
+		},
+		{
+			name:     "environment",
+			args:     []string{"--group", "prod", "--environment", "env"},
+			expected: "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/env",
+		},
+		{
+			name:     "resource type and name",
+			args:     []string{"--resource", "Applications.Datastores/redisCaches/db"},
+			expected: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/db",
+		},
+		{
+			name:     "resource ID",
+			args:     []string{"--resource", "/planes/radius/local/resourceGroups/other/providers/Applications.Datastores/redisCaches/db"},
+			expected: "/planes/radius/local/resourceGroups/other/providers/Applications.Datastores/redisCaches/db",
+		},
+		{
+			name:    "application and resource",
+			args:    []string{"--application", "app", "--resource", "Applications.Datastores/redisCaches/db"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid resource type and name",
+			args:    []string{"--resource", "redisCaches/db"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid resource ID",
+			args:    []string{"--resource", "/planes/radius/local/resourceGroups/other"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			AddScopeFlags(cmd)
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			scope, err := RequireScope(cmd, workspace)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, scope)
+		})
+	}
+}
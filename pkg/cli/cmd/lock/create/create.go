@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagLevel = "level"
+	flagNotes = "notes"
+)
+
+// NewCommand creates an instance of the `rad lock create` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create lockname",
+		Short: "Create or update a lock",
+		Long: `Create or update a lock
+
+Locks protect a resource group, application, environment or resource from being deleted or modified by mistake. A lock applies to the resources it protects and to the resources they contain. A lock on an application or environment also applies to the resources of the application or environment.
+
+The level of a lock is one of:
+- CanNotDelete: the resources can be updated but not deleted.
+- ReadOnly: the resources can't be updated or deleted.
+
+Locks are applied to the resource group of the workspace unless --group, --application, --environment or --resource is specified. Delete the lock with 'rad lock delete' to make changes the lock prevents.`,
+		Example: `
+# Prevent the resource group of the workspace and its resources from being deleted
+rad lock create do-not-delete
+
+# Prevent an application and its resources from being updated or deleted
+rad lock create freeze --application my-app --level ReadOnly --notes "Release freeze"
+
+# Prevent a resource from being deleted
+rad lock create keep-db --resource Applications.Datastores/redisCaches/db`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	common.AddScopeFlags(cmd)
+	cmd.Flags().String(flagLevel, string(v20231001preview.LockLevelCanNotDelete), "The level of the lock (supported levels are CanNotDelete, ReadOnly)")
+	cmd.Flags().String(flagNotes, "", "Notes describing why the lock is applied")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad lock create` command.
+type Runner struct {
+	UCPClientFactory *v20231001preview.ClientFactory
+	ConfigHolder     *framework.ConfigHolder
+	Output           output.Interface
+	Format           string
+	Workspace        *workspaces.Workspace
+	Scope            string
+	LockName         string
+	Level            v20231001preview.LockLevel
+	Notes            string
+}
+
+// NewRunner creates an instance of the runner for the `rad lock create` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	scope, err := common.RequireScope(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Scope = scope
+	r.LockName = args[0]
+
+	level, err := cmd.Flags().GetString(flagLevel)
+	if err != nil {
+		return err
+	}
+
+	r.Level = ""
+	for _, supported := range v20231001preview.PossibleLockLevelValues() {
+		if strings.EqualFold(level, string(supported)) {
+			r.Level = supported
+		}
+	}
+	if r.Level == "" {
+		return clierrors.Message("The value of --%s must be one of CanNotDelete, ReadOnly, got %q.", flagLevel, level)
+	}
+
+	r.Notes, err = cmd.Flags().GetString(flagNotes)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad lock create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Initialize the client factory if it hasn't been set externally.
+	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
+	if r.UCPClientFactory == nil {
+		err := r.initializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	lock := v20231001preview.LockResource{
+		Properties: &v20231001preview.LockProperties{
+			Level: to.Ptr(r.Level),
+		},
+	}
+	if r.Notes != "" {
+		lock.Properties.Notes = to.Ptr(r.Notes)
+	}
+
+	client := r.UCPClientFactory.NewLocksClient()
+	response, err := client.CreateOrUpdate(ctx, strings.TrimPrefix(r.Scope, resources.SegmentSeparator), r.LockName, lock, nil)
+	if err != nil {
+		return err
+	}
+
+	err = r.Output.WriteFormatted(r.Format, response.LockResource, common.LockFormat())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Runner) initializeClientFactory(ctx context.Context, workspace *workspaces.Workspace) error {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	r.UCPClientFactory = clientFactory
+	return nil
+}
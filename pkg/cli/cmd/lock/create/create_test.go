@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: resource group",
+			Input:         []string{"do-not-delete"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Scope)
+				require.Equal(t, v20231001preview.LockLevelCanNotDelete, r.Level)
+			},
+		},
+		{
+			Name:          "Valid: application with level",
+			Input:         []string{"freeze", "--application", "test-app", "--level", "readonly", "--notes", "Release freeze"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/applications/test-app", r.Scope)
+				require.Equal(t, v20231001preview.LockLevelReadOnly, r.Level)
+				require.Equal(t, "Release freeze", r.Notes)
+			},
+		},
+		{
+			Name:          "Invalid: level",
+			Input:         []string{"do-not-delete", "--level", "NoUpdates"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid: application and environment",
+			Input:         []string{"do-not-delete", "--application", "test-app", "--environment", "test-env"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid: missing name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	var actualScope string
+	var actualLock v20231001preview.LockResource
+	server := ucpfake.LocksServer{
+		CreateOrUpdate: func(
+			ctx context.Context,
+			scope string,
+			lockName string,
+			resource v20231001preview.LockResource,
+			options *v20231001preview.LocksClientCreateOrUpdateOptions,
+		) (resp azfake.Responder[v20231001preview.LocksClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+			actualScope = scope
+			actualLock = resource
+			resource.ID = to.Ptr("/" + scope + "/providers/System.Authorization/locks/" + lockName)
+			resource.Name = to.Ptr(lockName)
+			resource.Type = to.Ptr("System.Authorization/locks")
+			resp.SetResponse(http.StatusOK, v20231001preview.LocksClientCreateOrUpdateResponse{LockResource: resource}, nil)
+			return
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: ucpfake.NewServerFactoryTransport(&ucpfake.ServerFactory{LocksServer: server}),
+		},
+	})
+	require.NoError(t, err)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		UCPClientFactory: clientFactory,
+		Output:           outputSink,
+		Workspace:        &workspaces.Workspace{},
+		Format:           "table",
+		Scope:            "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+		LockName:         "freeze",
+		Level:            v20231001preview.LockLevelReadOnly,
+		Notes:            "Release freeze",
+	}
+
+	err = runner.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app", actualScope)
+	require.Equal(t, v20231001preview.LockLevelReadOnly, *actualLock.Properties.Level)
+	require.Equal(t, "Release freeze", *actualLock.Properties.Notes)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format: "table",
+			Obj: v20231001preview.LockResource{
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app/providers/System.Authorization/locks/freeze"),
+				Name: to.Ptr("freeze"),
+				Type: to.Ptr("System.Authorization/locks"),
+				Properties: &v20231001preview.LockProperties{
+					Level: to.Ptr(v20231001preview.LockLevelReadOnly),
+					Notes: to.Ptr("Release freeze"),
+				},
+			},
+			Options: common.LockFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	msgLockDeleted    = "Lock %q deleted."
+	msgLockNotFound   = "Lock %q does not exist or has already been deleted."
+	msgLockNotDeleted = "Lock %q NOT deleted."
+)
+
+// NewCommand creates an instance of the `rad lock delete` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "delete lockname",
+		Short: "Delete a lock",
+		Long: `Delete a lock
+
+Deleting a lock allows the resources it protects to be updated and deleted again. The lock is deleted from the resource group of the workspace unless --group, --application, --environment or --resource is specified.
+
+Use the --yes flag to skip the confirmation prompt.`,
+		Example: `
+# Delete a lock from the resource group of the workspace
+rad lock delete do-not-delete
+
+# Delete a lock from an application without a confirmation prompt
+rad lock delete freeze --application my-app --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+	common.AddScopeFlags(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad lock delete` command.
+type Runner struct {
+	UCPClientFactory *v20231001preview.ClientFactory
+	ConfigHolder     *framework.ConfigHolder
+	Output           output.Interface
+	InputPrompter    prompt.Interface
+	Workspace        *workspaces.Workspace
+	Scope            string
+	LockName         string
+	Confirmation     bool
+}
+
+// NewRunner creates an instance of the runner for the `rad lock delete` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:  factory.GetConfigHolder(),
+		Output:        factory.GetOutput(),
+		InputPrompter: factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad lock delete` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := common.RequireScope(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+	r.Scope = scope
+	r.LockName = args[0]
+
+	r.Confirmation, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad lock delete` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if !r.Confirmation {
+		promptMsg := fmt.Sprintf("Are you sure you want to delete the lock %q from %s? The resources it protects can be deleted once the lock is deleted.", r.LockName, r.Scope)
+		confirmed, err := prompt.YesOrNoPrompt(promptMsg, prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			r.Output.LogInfo(msgLockNotDeleted, r.LockName)
+			return nil
+		}
+	}
+
+	// Initialize the client factory if it hasn't been set externally.
+	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
+	if r.UCPClientFactory == nil {
+		err := r.initializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Capture the raw HTTP response so we can check the status code.
+	var response *http.Response
+	ctx = policy.WithCaptureResponse(ctx, &response)
+
+	client := r.UCPClientFactory.NewLocksClient()
+	_, err := client.Delete(ctx, strings.TrimPrefix(r.Scope, resources.SegmentSeparator), r.LockName, nil)
+	if err != nil {
+		return err
+	}
+
+	if response != nil && response.StatusCode == http.StatusNoContent {
+		r.Output.LogInfo(msgLockNotFound, r.LockName)
+	} else {
+		r.Output.LogInfo(msgLockDeleted, r.LockName)
+	}
+
+	return nil
+}
+
+func (r *Runner) initializeClientFactory(ctx context.Context, workspace *workspaces.Workspace) error {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	r.UCPClientFactory = clientFactory
+	return nil
+}
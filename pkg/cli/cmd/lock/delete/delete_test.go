@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"do-not-delete", "--yes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group", r.Scope)
+				require.Equal(t, "do-not-delete", r.LockName)
+				require.True(t, r.Confirmation)
+			},
+		},
+		{
+			Name:          "Valid: resource",
+			Input:         []string{"keep-db", "--resource", "Applications.Datastores/redisCaches/db"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid: missing name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	const scope = "/planes/radius/local/resourceGroups/test-group"
+
+	tests := []struct {
+		name           string
+		confirmation   bool
+		promptResponse string
+		statusCode     int
+		expectDelete   bool
+		expectedOutput string
+	}{
+		{
+			name:           "confirmed with flag",
+			confirmation:   true,
+			statusCode:     http.StatusOK,
+			expectDelete:   true,
+			expectedOutput: msgLockDeleted,
+		},
+		{
+			name:           "confirmed with prompt",
+			promptResponse: prompt.ConfirmYes,
+			statusCode:     http.StatusOK,
+			expectDelete:   true,
+			expectedOutput: msgLockDeleted,
+		},
+		{
+			name:           "not confirmed",
+			promptResponse: prompt.ConfirmNo,
+			expectDelete:   false,
+			expectedOutput: msgLockNotDeleted,
+		},
+		{
+			name:           "not found",
+			confirmation:   true,
+			statusCode:     http.StatusNoContent,
+			expectDelete:   true,
+			expectedOutput: msgLockNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			deleted := false
+			server := ucpfake.LocksServer{
+				Delete: func(
+					ctx context.Context,
+					actualScope string,
+					lockName string,
+					options *v20231001preview.LocksClientDeleteOptions,
+				) (resp azfake.Responder[v20231001preview.LocksClientDeleteResponse], errResp azfake.ErrorResponder) {
+					require.Equal(t, "planes/radius/local/resourceGroups/test-group", actualScope)
+					require.Equal(t, "do-not-delete", lockName)
+					deleted = true
+					resp.SetResponse(tt.statusCode, v20231001preview.LocksClientDeleteResponse{}, nil)
+					return
+				},
+			}
+
+			clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+				ClientOptions: policy.ClientOptions{
+					Transport: ucpfake.NewServerFactoryTransport(&ucpfake.ServerFactory{LocksServer: server}),
+				},
+			})
+			require.NoError(t, err)
+
+			prompter := prompt.NewMockInterface(ctrl)
+			if !tt.confirmation {
+				prompter.EXPECT().
+					GetListInput([]string{prompt.ConfirmNo, prompt.ConfirmYes}, gomock.Any()).
+					Return(tt.promptResponse, nil).
+					Times(1)
+			}
+
+			outputSink := &output.MockOutput{}
+			runner := &Runner{
+				UCPClientFactory: clientFactory,
+				Output:           outputSink,
+				InputPrompter:    prompter,
+				Workspace:        &workspaces.Workspace{},
+				Scope:            scope,
+				LockName:         "do-not-delete",
+				Confirmation:     tt.confirmation,
+			}
+
+			err = runner.Run(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.expectDelete, deleted)
+
+			expected := []any{
+				output.LogOutput{
+					Format: tt.expectedOutput,
+					Params: []any{"do-not-delete"},
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+	}
+}
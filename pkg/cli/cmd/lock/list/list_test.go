@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Valid: environment",
+			Input:         []string{"--environment", "test-env"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/environments/test-env", r.Scope)
+			},
+		},
+		{
+			Name:          "Invalid: resource",
+			Input:         []string{"--resource", "db"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	locks := []*v20231001preview.LockResource{
+		{
+			ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/System.Authorization/locks/do-not-delete"),
+			Name: to.Ptr("do-not-delete"),
+			Type: to.Ptr("System.Authorization/locks"),
+			Properties: &v20231001preview.LockProperties{
+				Level: to.Ptr(v20231001preview.LockLevelCanNotDelete),
+				Notes: to.Ptr("Production"),
+			},
+		},
+	}
+
+	var actualScope string
+	server := ucpfake.LocksServer{
+		NewListPager: func(
+			scope string,
+			options *v20231001preview.LocksClientListOptions,
+		) (resp azfake.PagerResponder[v20231001preview.LocksClientListResponse]) {
+			actualScope = scope
+			resp.AddPage(http.StatusOK, v20231001preview.LocksClientListResponse{
+				LockResourceListResult: v20231001preview.LockResourceListResult{
+					Value: locks,
+				},
+			}, nil)
+			return
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: ucpfake.NewServerFactoryTransport(&ucpfake.ServerFactory{LocksServer: server}),
+		},
+	})
+	require.NoError(t, err)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		UCPClientFactory: clientFactory,
+		Output:           outputSink,
+		Workspace:        &workspaces.Workspace{},
+		Format:           "table",
+		Scope:            "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	err = runner.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "planes/radius/local/resourceGroups/test-group", actualScope)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     locks,
+			Options: common.LockFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
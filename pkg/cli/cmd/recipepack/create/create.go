@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+const (
+	msgCreatingRecipePack     = "Creating recipe pack %s..."
+	msgRecipePackCreated      = "Recipe pack %s created."
+	msgUpdatingRecipePack     = "Updating recipe pack %s..."
+	msgRecipePackUpdated      = "Recipe pack %s updated."
+	msgPublishingRecipe       = "Publishing recipe for %s to %s..."
+	msgDryRunCreate           = "Recipe pack %s does not exist and would be created with the following changes:"
+	msgDryRunUpdate           = "Recipe pack %s exists and would be updated with the following changes:"
+	msgDryRunNoChanges        = "Recipe pack %s is up to date."
+	msgDryRunPublish          = "The recipe for %s would be published to %s."
+	defaultPublishTag         = "latest"
+	publishRegistryFlagName   = "publish-registry"
+	publishTagFlagName        = "publish-tag"
+	publishPlainHTTPFlagName  = "publish-plain-http"
+	dryRunFlagName            = "dry-run"
+	fromFileFlagName          = "from-file"
+	fromFileFlagNameShorthand = "f"
+)
+
+// NewCommand creates a new Cobra command and a Runner object to create or update a recipe pack from a YAML file or a
+// directory of recipe definitions, with flags for workspace, resource group, input, publishing and dry run.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create [recipe-pack-name]",
+		Short: "Create or update a recipe pack",
+		Long: `Create or update a recipe pack from a YAML file or a directory of recipe definitions.
+
+A recipe pack file defines the recipes of the recipe pack keyed by resource type:
+
+  name: my-pack
+  layer: environment
+  recipes:
+    Radius.Data/redisCaches:
+      recipeKind: bicep
+      recipeLocation: ghcr.io/my-org/recipes/redis:1.0
+      parameters:
+        size: small
+
+A recipe pack directory contains one YAML file per recipe, with the resource type set in the resourceType field.
+
+Bicep recipes can reference a local Bicep file with templatePath instead of recipeLocation. The Bicep file is published
+to the registry set with --publish-registry, in a repository named after the resource type (for example
+ghcr.io/my-org/recipes/radius.data/rediscaches), and the recipe location is set to the published artifact.
+
+The recipe pack name argument is optional when the recipe pack file sets the name. Use --dry-run to show the changes
+to the existing recipe pack without publishing recipes or updating the recipe pack.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Create a recipe pack from a YAML file
+rad recipe-pack create my-pack --from-file ./recipepack.yaml
+
+# Create a recipe pack from a directory of recipe definitions
+rad recipe-pack create my-pack --from-file ./recipes
+
+# Publish local Bicep recipes to a registry and create the recipe pack
+rad recipe-pack create my-pack --from-file ./recipes --publish-registry ghcr.io/my-org/recipes --publish-tag 1.0
+
+# Show the changes to an existing recipe pack without applying them
+rad recipe-pack create my-pack --from-file ./recipepack.yaml --dry-run
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().StringP(fromFileFlagName, fromFileFlagNameShorthand, "", "Path to a recipe pack YAML file or a directory of recipe definition YAML files")
+	_ = cmd.MarkFlagRequired(fromFileFlagName)
+	cmd.Flags().String(publishRegistryFlagName, "", "OCI registry path to publish local Bicep recipes to, in the format 'HOST/PATH'")
+	cmd.Flags().String(publishTagFlagName, defaultPublishTag, "Tag of the published Bicep recipes")
+	cmd.Flags().Bool(publishPlainHTTPFlagName, false, "Connect to the publish registry using HTTP (not-HTTPS)")
+	cmd.Flags().Bool(dryRunFlagName, false, "Show the changes to the recipe pack without publishing recipes or updating the recipe pack")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe-pack create` command.
+type Runner struct {
+	Bicep             bicep.Interface
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Format            string
+
+	RecipePackName     string
+	Manifest           *Manifest
+	PublishRegistry    string
+	PublishTag         string
+	PublishPlainHTTP   bool
+	DryRun             bool
+	PublishRecipeFunc  func(ctx context.Context, file string, target string, plainHTTP bool) error
+	recipePackResource *corerpv20250801.RecipePackResource
+}
+
+// NewRunner creates a new instance of the `rad recipe-pack create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	runner := &Runner{
+		Bicep:             factory.GetBicep(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+	runner.PublishRecipeFunc = runner.publishRecipe
+	return runner
+}
+
+// Validate runs validation for the `rad recipe-pack create` command.
+//
+// Validate reads and validates the recipe pack definition, and checks the workspace, scope, recipe pack name and
+// output format.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	fromFile, err := cmd.Flags().GetString(fromFileFlagName)
+	if err != nil {
+		return err
+	}
+
+	r.PublishRegistry, err = cmd.Flags().GetString(publishRegistryFlagName)
+	if err != nil {
+		return err
+	}
+
+	r.PublishTag, err = cmd.Flags().GetString(publishTagFlagName)
+	if err != nil {
+		return err
+	}
+
+	r.PublishPlainHTTP, err = cmd.Flags().GetBool(publishPlainHTTPFlagName)
+	if err != nil {
+		return err
+	}
+
+	r.DryRun, err = cmd.Flags().GetBool(dryRunFlagName)
+	if err != nil {
+		return err
+	}
+
+	r.Manifest, err = ReadManifest(fromFile)
+	if err != nil {
+		return err
+	}
+
+	err = r.Manifest.Validate(r.PublishRegistry != "")
+	if err != nil {
+		return err
+	}
+
+	r.RecipePackName = r.Manifest.Name
+	if len(args) > 0 {
+		r.RecipePackName = args[0]
+	}
+	if r.RecipePackName == "" {
+		return clierrors.Message("No recipe pack name provided. Specify the name as an argument or set the name in the recipe pack file.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe-pack create` command.
+//
+// Run compares the recipe pack definition with the existing recipe pack, publishes local Bicep recipes, and creates
+// or updates the recipe pack. With --dry-run, Run only displays the changes.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	var existing *corerpv20250801.RecipePackResource
+	recipePack, err := client.GetRecipePack(ctx, r.RecipePackName)
+	if err == nil {
+		existing = &recipePack
+	} else if !clients.Is404Error(err) {
+		return err
+	}
+
+	r.recipePackResource = r.Manifest.ToResource(r.PublishRegistry, r.PublishTag)
+	if r.DryRun {
+		return r.displayDiff(existing)
+	}
+
+	for _, resourceType := range slices.Sorted(maps.Keys(r.Manifest.Recipes)) {
+		recipe := r.Manifest.Recipes[resourceType]
+		if recipe.TemplatePath == "" {
+			continue
+		}
+
+		target := publishTarget(r.PublishRegistry, r.PublishTag, resourceType)
+		r.Output.LogInfo(msgPublishingRecipe, resourceType, target)
+		if err := r.PublishRecipeFunc(ctx, recipe.TemplatePath, target, r.PublishPlainHTTP); err != nil {
+			return err
+		}
+	}
+
+	if existing == nil {
+		r.Output.LogInfo(msgCreatingRecipePack, r.RecipePackName)
+	} else {
+		r.Output.LogInfo(msgUpdatingRecipePack, r.RecipePackName)
+
+		// Environments referencing the recipe pack are tracked on the recipe pack and must be preserved.
+		if existing.Properties != nil {
+			r.recipePackResource.Properties.ReferencedBy = existing.Properties.ReferencedBy
+		}
+	}
+
+	err = client.CreateOrUpdateRecipePack(ctx, r.RecipePackName, r.recipePackResource)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to create or update recipe pack %q.", r.RecipePackName)
+	}
+
+	if existing == nil {
+		r.Output.LogInfo(msgRecipePackCreated, r.RecipePackName)
+	} else {
+		r.Output.LogInfo(msgRecipePackUpdated, r.RecipePackName)
+	}
+
+	recipePack, err = client.GetRecipePack(ctx, r.RecipePackName)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, recipePack, objectformats.GetRecipePackTableFormat())
+}
+
+func (r *Runner) displayDiff(existing *corerpv20250801.RecipePackResource) error {
+	var changes []string
+	if existing == nil {
+		r.Output.LogInfo(msgDryRunCreate, r.RecipePackName)
+		changes = diff(nil, r.recipePackResource.Properties)
+	} else {
+		changes = diff(existing.Properties, r.recipePackResource.Properties)
+		if len(changes) == 0 {
+			r.Output.LogInfo(msgDryRunNoChanges, r.RecipePackName)
+			return nil
+		}
+		r.Output.LogInfo(msgDryRunUpdate, r.RecipePackName)
+	}
+
+	for _, change := range changes {
+		r.Output.LogInfo("  %s", change)
+	}
+
+	for _, resourceType := range slices.Sorted(maps.Keys(r.Manifest.Recipes)) {
+		recipe := r.Manifest.Recipes[resourceType]
+		if recipe.TemplatePath != "" {
+			r.Output.LogInfo(msgDryRunPublish, resourceType, publishTarget(r.PublishRegistry, r.PublishTag, resourceType))
+		}
+	}
+
+	return nil
+}
+
+// publishRecipe publishes a local Bicep file to an OCI registry using the `rad bicep publish` implementation.
+func (r *Runner) publishRecipe(ctx context.Context, file string, target string, plainHTTP bool) error {
+	publisher := &publish.Runner{
+		Bicep:     r.Bicep,
+		Output:    r.Output,
+		File:      file,
+		Target:    target,
+		PlainHTTP: plainHTTP,
+	}
+	return publisher.Run(ctx)
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid file with name argument",
+			Input:         []string{"other-pack", "--from-file", "testdata/recipepack.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Valid file with name in manifest",
+			Input:         []string{"--from-file", "testdata/recipepack.yaml", "--dry-run"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Valid directory with publish registry",
+			Input:         []string{"my-pack", "--from-file", "testdata/recipes", "--publish-registry", "ghcr.io/my-org/recipes"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid directory without publish registry",
+			Input:         []string{"my-pack", "--from-file", "testdata/recipes"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid directory without name",
+			Input:         []string{"--from-file", "testdata/recipes", "--publish-registry", "ghcr.io/my-org/recipes"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invalid recipe kind",
+			Input:         []string{"my-pack", "--from-file", "testdata/invalid-kind.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Missing from-file",
+			Input:         []string{"my-pack"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Too many arguments",
+			Input:         []string{"my-pack", "other-pack", "--from-file", "testdata/recipepack.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+	notFound := &azcore.ResponseError{StatusCode: http.StatusNotFound, ErrorCode: "NotFound"}
+
+	t.Run("creates recipe pack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		manifest, err := ReadManifest("testdata/recipepack.yaml")
+		require.NoError(t, err)
+
+		created := corerpv20250801.RecipePackResource{Name: to.Ptr("my-pack")}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		gomock.InOrder(
+			appManagementClient.EXPECT().GetRecipePack(gomock.Any(), "my-pack").Return(corerpv20250801.RecipePackResource{}, notFound),
+			appManagementClient.EXPECT().
+				CreateOrUpdateRecipePack(gomock.Any(), "my-pack", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, resource *corerpv20250801.RecipePackResource) error {
+					require.Equal(t, to.Ptr(corerpv20250801.RecipePackLayerResourceGroup), resource.Properties.Layer)
+					require.Len(t, resource.Properties.Recipes, 2)
+					return nil
+				}),
+			appManagementClient.EXPECT().GetRecipePack(gomock.Any(), "my-pack").Return(created, nil),
+		)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			Format:            "table",
+			RecipePackName:    "my-pack",
+			Manifest:          manifest,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []any{
+			output.LogOutput{Format: msgCreatingRecipePack, Params: []any{"my-pack"}},
+			output.LogOutput{Format: msgRecipePackCreated, Params: []any{"my-pack"}},
+			output.FormattedOutput{Format: "table", Obj: created, Options: objectformats.GetRecipePackTableFormat()},
+		}, outputSink.Writes)
+	})
+
+	t.Run("publishes templates and updates recipe pack", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		manifest, err := ReadManifest("testdata/recipes")
+		require.NoError(t, err)
+
+		existing := corerpv20250801.RecipePackResource{
+			Name: to.Ptr("my-pack"),
+			Properties: &corerpv20250801.RecipePackProperties{
+				Recipes:      map[string]*corerpv20250801.RecipeDefinition{},
+				ReferencedBy: []*string{to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/environments/test-env")},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		gomock.InOrder(
+			appManagementClient.EXPECT().GetRecipePack(gomock.Any(), "my-pack").Return(existing, nil),
+			appManagementClient.EXPECT().
+				CreateOrUpdateRecipePack(gomock.Any(), "my-pack", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, resource *corerpv20250801.RecipePackResource) error {
+					require.Equal(t, "ghcr.io/my-org/recipes/radius.data/rediscaches:1.0", *resource.Properties.Recipes["Radius.Data/redisCaches"].RecipeLocation)
+					require.Equal(t, existing.Properties.ReferencedBy, resource.Properties.ReferencedBy)
+					return nil
+				}),
+			appManagementClient.EXPECT().GetRecipePack(gomock.Any(), "my-pack").Return(existing, nil),
+		)
+
+		published := map[string]string{}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			Format:            "table",
+			RecipePackName:    "my-pack",
+			Manifest:          manifest,
+			PublishRegistry:   "ghcr.io/my-org/recipes",
+			PublishTag:        "1.0",
+			PublishRecipeFunc: func(ctx context.Context, file string, target string, plainHTTP bool) error {
+				published[file] = target
+				return nil
+			},
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]string{filepath.Join("testdata", "bicep", "redis.bicep"): "ghcr.io/my-org/recipes/radius.data/rediscaches:1.0"}, published)
+		require.Equal(t, output.LogOutput{Format: msgPublishingRecipe, Params: []any{"Radius.Data/redisCaches", "ghcr.io/my-org/recipes/radius.data/rediscaches:1.0"}}, outputSink.Writes[0])
+		require.Equal(t, output.LogOutput{Format: msgRecipePackUpdated, Params: []any{"my-pack"}}, outputSink.Writes[2])
+	})
+
+	t.Run("dry run shows diff", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		manifest, err := ReadManifest("testdata/recipepack.yaml")
+		require.NoError(t, err)
+
+		existing := corerpv20250801.RecipePackResource{
+			Name: to.Ptr("my-pack"),
+			Properties: &corerpv20250801.RecipePackProperties{
+				Recipes: map[string]*corerpv20250801.RecipeDefinition{
+					"Radius.Data/redisCaches": {
+						RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+						RecipeLocation: to.Ptr("ghcr.io/my-org/recipes/redis:0.9"),
+						Parameters:     map[string]any{"size": "small"},
+					},
+					"Radius.Data/sqlDatabases": {
+						RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+						RecipeLocation: to.Ptr("ghcr.io/my-org/recipes/sql:1.0"),
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().GetRecipePack(gomock.Any(), "my-pack").Return(existing, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			Format:            "table",
+			RecipePackName:    "my-pack",
+			Manifest:          manifest,
+			DryRun:            true,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []any{
+			output.LogOutput{Format: msgDryRunUpdate, Params: []any{"my-pack"}},
+			output.LogOutput{Format: "  %s", Params: []any{"~ layer: environment -> resourceGroup"}},
+			output.LogOutput{Format: "  %s", Params: []any{"+ Radius.Data/mongoDatabases: terraform https://github.com/my-org/recipes/mongo.zip"}},
+			output.LogOutput{Format: "  %s", Params: []any{"~ Radius.Data/redisCaches: bicep ghcr.io/my-org/recipes/redis:0.9 -> bicep ghcr.io/my-org/recipes/redis:1.0"}},
+			output.LogOutput{Format: "  %s", Params: []any{"- Radius.Data/sqlDatabases: bicep ghcr.io/my-org/recipes/sql:1.0"}},
+		}, outputSink.Writes)
+	})
+
+	t.Run("dry run with no changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		manifest, err := ReadManifest("testdata/recipepack.yaml")
+		require.NoError(t, err)
+
+		existing := *manifest.ToResource("", "")
+		existing.Properties.Recipes["Radius.Data/redisCaches"].Parameters = map[string]any{"size": "small"}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().GetRecipePack(gomock.Any(), "my-pack").Return(existing, nil)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			Format:            "table",
+			RecipePackName:    "my-pack",
+			Manifest:          manifest,
+			DryRun:            true,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []any{
+			output.LogOutput{Format: msgDryRunNoChanges, Params: []any{"my-pack"}},
+		}, outputSink.Writes)
+	})
+}
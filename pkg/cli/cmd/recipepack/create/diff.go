@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+// diff returns the changes that applying the desired recipe pack makes to the existing recipe pack, one line per
+// change. Added recipes are prefixed with '+', removed recipes with '-' and changed properties with '~'. A nil existing
+// recipe pack is an empty recipe pack.
+func diff(existing *corerpv20250801.RecipePackProperties, desired *corerpv20250801.RecipePackProperties) []string {
+	if existing == nil {
+		existing = &corerpv20250801.RecipePackProperties{}
+	}
+
+	changes := []string{}
+	if from, to := layerOf(existing), layerOf(desired); from != to {
+		changes = append(changes, fmt.Sprintf("~ layer: %s -> %s", from, to))
+	}
+
+	if from, to := trustedKeys(existing), trustedKeys(desired); !slices.Equal(from, to) {
+		changes = append(changes, fmt.Sprintf("~ trustPolicy: %d -> %d public key(s)", len(from), len(to)))
+	}
+
+	resourceTypes := map[string]string{}
+	for resourceType := range existing.Recipes {
+		resourceTypes[strings.ToLower(resourceType)] = resourceType
+	}
+	for resourceType := range desired.Recipes {
+		resourceTypes[strings.ToLower(resourceType)] = resourceType
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(resourceTypes)) {
+		resourceType := resourceTypes[key]
+		before := findDefinition(existing.Recipes, resourceType)
+		after := findDefinition(desired.Recipes, resourceType)
+
+		switch {
+		case before == nil:
+			changes = append(changes, fmt.Sprintf("+ %s: %s", resourceType, describe(after)))
+		case after == nil:
+			changes = append(changes, fmt.Sprintf("- %s: %s", resourceType, describe(before)))
+		default:
+			if from, to := describe(before), describe(after); from != to {
+				changes = append(changes, fmt.Sprintf("~ %s: %s -> %s", resourceType, from, to))
+			}
+			if from, to := marshal(before.Parameters), marshal(after.Parameters); from != to {
+				changes = append(changes, fmt.Sprintf("~ %s: parameters %s -> %s", resourceType, from, to))
+			}
+		}
+	}
+
+	return changes
+}
+
+func findDefinition(recipes map[string]*corerpv20250801.RecipeDefinition, resourceType string) *corerpv20250801.RecipeDefinition {
+	for key, definition := range recipes {
+		if strings.EqualFold(key, resourceType) && definition != nil {
+			return definition
+		}
+	}
+	return nil
+}
+
+// describe returns the kind, location and transport of a recipe definition.
+func describe(definition *corerpv20250801.RecipeDefinition) string {
+	description := fmt.Sprintf("%s %s", stringOf(definition.RecipeKind), stringOf(definition.RecipeLocation))
+	if definition.PlainHTTP != nil && *definition.PlainHTTP {
+		description += " (plain HTTP)"
+	}
+	return description
+}
+
+func layerOf(properties *corerpv20250801.RecipePackProperties) string {
+	if properties.Layer == nil {
+		return string(corerpv20250801.RecipePackLayerEnvironment)
+	}
+	return string(*properties.Layer)
+}
+
+func trustedKeys(properties *corerpv20250801.RecipePackProperties) []string {
+	keys := []string{}
+	if properties.TrustPolicy != nil {
+		for _, key := range properties.TrustPolicy.PublicKeys {
+			keys = append(keys, stringOf(key))
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func stringOf[T ~string](value *T) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
+
+// marshal returns the JSON representation of the parameters, so parameters decoded from YAML and from the API compare
+// equal regardless of their numeric types.
+func marshal(parameters map[string]any) string {
+	if len(parameters) == 0 {
+		return "{}"
+	}
+
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return fmt.Sprintf("%v", parameters)
+	}
+	return string(b)
+}
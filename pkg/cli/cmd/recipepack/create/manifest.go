@@ -0,0 +1,290 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	yaml "github.com/goccy/go-yaml"
+	"oras.land/oras-go/v2/registry"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// Manifest is a recipe pack definition read from a YAML file or a directory of recipe definition files.
+type Manifest struct {
+	// Name is the name of the recipe pack. The name argument of the command takes precedence.
+	Name string `yaml:"name,omitempty"`
+
+	// Layer is the layer the recipe pack applies at: organization, resourceGroup or environment.
+	Layer string `yaml:"layer,omitempty"`
+
+	// TrustPolicy is the policy used to verify the signatures of the Bicep recipes in the recipe pack.
+	TrustPolicy *TrustPolicy `yaml:"trustPolicy,omitempty"`
+
+	// Recipes is the map of resource types to their recipe definitions.
+	Recipes map[string]*Recipe `yaml:"recipes"`
+}
+
+// TrustPolicy is the trust policy of a recipe pack manifest.
+type TrustPolicy struct {
+	// PublicKeys is the list of PEM-encoded public keys trusted to sign recipes.
+	PublicKeys []string `yaml:"publicKeys"`
+}
+
+// Recipe is a recipe definition of a recipe pack manifest.
+type Recipe struct {
+	// ResourceType is the resource type of the recipe. It is required in the recipe definition files of a directory,
+	// and not allowed in a manifest file where recipes are keyed by resource type.
+	ResourceType string `yaml:"resourceType,omitempty"`
+
+	// RecipeKind is the kind of the recipe: bicep or terraform.
+	RecipeKind string `yaml:"recipeKind"`
+
+	// RecipeLocation is the location of the recipe.
+	RecipeLocation string `yaml:"recipeLocation,omitempty"`
+
+	// TemplatePath is the path to a local Bicep file to publish to an OCI registry. The recipe location is set to the
+	// published artifact. Relative paths are relative to the file that defines the recipe.
+	TemplatePath string `yaml:"templatePath,omitempty"`
+
+	// Parameters is the map of parameters passed to the recipe.
+	Parameters map[string]any `yaml:"parameters,omitempty"`
+
+	// PlainHTTP connects to the recipe location using HTTP (not HTTPS).
+	PlainHTTP bool `yaml:"plainHTTP,omitempty"`
+}
+
+// ReadManifest reads a recipe pack manifest from a YAML file, or from a directory where each YAML file defines one recipe.
+func ReadManifest(path string) (*Manifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read recipe pack definition %q.", path)
+	}
+
+	if info.IsDir() {
+		return readDirectory(path)
+	}
+
+	return readFile(path)
+}
+
+func readFile(filePath string) (*Manifest, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read recipe pack definition %q.", filePath)
+	}
+
+	manifest := &Manifest{}
+	if err := decode(data, manifest); err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to parse recipe pack definition %q.", filePath)
+	}
+
+	for resourceType, recipe := range manifest.Recipes {
+		if recipe == nil {
+			return nil, clierrors.Message("The recipe for resource type %q in %q is empty.", resourceType, filePath)
+		}
+		if recipe.ResourceType != "" {
+			return nil, clierrors.Message("The recipe for resource type %q in %q must not set resourceType.", resourceType, filePath)
+		}
+		recipe.ResourceType = resourceType
+		recipe.TemplatePath = resolvePath(filepath.Dir(filePath), recipe.TemplatePath)
+	}
+
+	return manifest, nil
+}
+
+func readDirectory(dir string) (*Manifest, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read recipe pack directory %q.", dir)
+	}
+
+	manifest := &Manifest{Recipes: map[string]*Recipe{}}
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		filePath := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, clierrors.MessageWithCause(err, "Failed to read recipe definition %q.", filePath)
+		}
+
+		recipe := &Recipe{}
+		if err := decode(data, recipe); err != nil {
+			return nil, clierrors.MessageWithCause(err, "Failed to parse recipe definition %q.", filePath)
+		}
+
+		if recipe.ResourceType == "" {
+			return nil, clierrors.Message("The recipe definition %q must set resourceType.", filePath)
+		}
+		if _, ok := findRecipe(manifest.Recipes, recipe.ResourceType); ok {
+			return nil, clierrors.Message("The resource type %q is defined by multiple recipe definitions in %q.", recipe.ResourceType, dir)
+		}
+
+		recipe.TemplatePath = resolvePath(dir, recipe.TemplatePath)
+		manifest.Recipes[recipe.ResourceType] = recipe
+	}
+
+	return manifest, nil
+}
+
+func decode(data []byte, out any) error {
+	// Fail on unknown fields to catch typos in recipe definitions.
+	return yaml.NewDecoder(bytes.NewReader(data), yaml.Strict()).Decode(out)
+}
+
+func resolvePath(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+func findRecipe(recipes map[string]*Recipe, resourceType string) (*Recipe, bool) {
+	for key, recipe := range recipes {
+		if strings.EqualFold(key, resourceType) {
+			return recipe, true
+		}
+	}
+	return nil, false
+}
+
+// Validate validates the recipes of the manifest. Recipes with a template path can only be published when a publish
+// registry is provided.
+func (m *Manifest) Validate(canPublish bool) error {
+	if len(m.Recipes) == 0 {
+		return clierrors.Message("The recipe pack definition must define at least one recipe.")
+	}
+
+	if m.Layer != "" && !slices.Contains(corerpv20250801.PossibleRecipePackLayerValues(), corerpv20250801.RecipePackLayer(m.Layer)) {
+		return clierrors.Message("Invalid layer %q. The layer must be one of: organization, resourceGroup, environment.", m.Layer)
+	}
+
+	resourceTypes := map[string]string{}
+	for _, resourceType := range slices.Sorted(maps.Keys(m.Recipes)) {
+		if err := m.Recipes[resourceType].validate(canPublish); err != nil {
+			return clierrors.Message("Invalid recipe for resource type %q: %s", resourceType, err.Error())
+		}
+
+		// Resource types are case-insensitive, and the recipes of a resource type are published to the same artifact.
+		if existing, ok := resourceTypes[strings.ToLower(resourceType)]; ok {
+			return clierrors.Message("The resource types %q and %q are the same resource type.", existing, resourceType)
+		}
+		resourceTypes[strings.ToLower(resourceType)] = resourceType
+	}
+
+	return nil
+}
+
+func (r *Recipe) validate(canPublish bool) error {
+	if strings.Count(r.ResourceType, "/") != 1 {
+		return fmt.Errorf("the resource type must be in the format 'Namespace/type'")
+	}
+
+	if !slices.Contains(recipes.SupportedTemplateKind, r.RecipeKind) {
+		return fmt.Errorf("recipe kind %q is not supported, supported kinds are: %s", r.RecipeKind, strings.Join(recipes.SupportedTemplateKind, ", "))
+	}
+
+	if r.TemplatePath != "" {
+		if r.RecipeLocation != "" {
+			return fmt.Errorf("only one of recipeLocation and templatePath can be set")
+		}
+		if r.RecipeKind != recipes.TemplateKindBicep {
+			return fmt.Errorf("templatePath is only supported for bicep recipes")
+		}
+		if !canPublish {
+			return fmt.Errorf("templatePath requires the --publish-registry flag")
+		}
+		if _, err := os.Stat(r.TemplatePath); err != nil {
+			return fmt.Errorf("template file %q cannot be read: %w", r.TemplatePath, err)
+		}
+		return nil
+	}
+
+	if r.RecipeLocation == "" {
+		return fmt.Errorf("one of recipeLocation and templatePath must be set")
+	}
+
+	if r.RecipeKind == recipes.TemplateKindBicep {
+		if _, err := registry.ParseReference(r.RecipeLocation); err != nil {
+			return fmt.Errorf("recipe location %q is not a valid OCI reference: %w", r.RecipeLocation, err)
+		}
+	}
+
+	return nil
+}
+
+// publishTarget returns the OCI reference the template of the recipe for a resource type is published to, without the
+// 'br:' prefix. The repository is named after the resource type, so that templates with the same file name are published
+// to different artifacts: the recipe for 'Radius.Data/redisCaches' is published to '<registry>/radius.data/rediscaches'.
+func publishTarget(publishRegistry string, tag string, resourceType string) string {
+	return fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(strings.TrimPrefix(publishRegistry, "br:"), "/"), strings.ToLower(resourceType), tag)
+}
+
+// ToResource converts the manifest to a recipe pack resource. Recipes with a template path use the location of the
+// artifact they are published to.
+func (m *Manifest) ToResource(publishRegistry string, tag string) *corerpv20250801.RecipePackResource {
+	resource := &corerpv20250801.RecipePackResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &corerpv20250801.RecipePackProperties{
+			Recipes: map[string]*corerpv20250801.RecipeDefinition{},
+		},
+	}
+
+	if m.Layer != "" {
+		resource.Properties.Layer = to.Ptr(corerpv20250801.RecipePackLayer(m.Layer))
+	}
+
+	if m.TrustPolicy != nil {
+		resource.Properties.TrustPolicy = &corerpv20250801.RecipeTrustPolicy{
+			PublicKeys: to.ArrayofStringPtrs(m.TrustPolicy.PublicKeys),
+		}
+	}
+
+	for resourceType, recipe := range m.Recipes {
+		location := recipe.RecipeLocation
+		if recipe.TemplatePath != "" {
+			location = publishTarget(publishRegistry, tag, resourceType)
+		}
+
+		definition := &corerpv20250801.RecipeDefinition{
+			RecipeKind:     to.Ptr(corerpv20250801.RecipeKind(recipe.RecipeKind)),
+			RecipeLocation: to.Ptr(location),
+			Parameters:     recipe.Parameters,
+		}
+		if recipe.PlainHTTP {
+			definition.PlainHTTP = to.Ptr(true)
+		}
+		resource.Properties.Recipes[resourceType] = definition
+	}
+
+	return resource
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+func Test_ReadManifest(t *testing.T) {
+	t.Run("file", func(t *testing.T) {
+		manifest, err := ReadManifest("testdata/recipepack.yaml")
+		require.NoError(t, err)
+
+		require.Equal(t, "my-pack", manifest.Name)
+		require.Equal(t, "resourceGroup", manifest.Layer)
+		require.Len(t, manifest.Recipes, 2)
+		require.Equal(t, "Radius.Data/redisCaches", manifest.Recipes["Radius.Data/redisCaches"].ResourceType)
+		require.Equal(t, "ghcr.io/my-org/recipes/redis:1.0", manifest.Recipes["Radius.Data/redisCaches"].RecipeLocation)
+		require.NoError(t, manifest.Validate(false))
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		manifest, err := ReadManifest("testdata/recipes")
+		require.NoError(t, err)
+
+		require.Empty(t, manifest.Name)
+		require.Len(t, manifest.Recipes, 2)
+		require.Equal(t, filepath.Join("testdata", "bicep", "redis.bicep"), manifest.Recipes["Radius.Data/redisCaches"].TemplatePath)
+		require.Equal(t, "terraform", manifest.Recipes["Radius.Data/mongoDatabases"].RecipeKind)
+		require.NoError(t, manifest.Validate(true))
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "pack.yaml"), []byte("recipes:\n  Radius.Data/redisCaches:\n    recipeKind: bicep\n    location: ghcr.io/my-org/recipes/redis:1.0\n"), 0644))
+
+		_, err := ReadManifest(filepath.Join(dir, "pack.yaml"))
+		require.Error(t, err)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		_, err := ReadManifest("testdata/does-not-exist.yaml")
+		require.Error(t, err)
+	})
+}
+
+func Test_Manifest_Validate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		manifest   Manifest
+		canPublish bool
+		err        string
+	}{
+		{
+			name:     "no recipes",
+			manifest: Manifest{},
+			err:      "must define at least one recipe",
+		},
+		{
+			name:     "invalid layer",
+			manifest: Manifest{Layer: "cluster", Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", RecipeLocation: "ghcr.io/my-org/redis:1.0"}}},
+			err:      "Invalid layer",
+		},
+		{
+			name:     "unsupported kind",
+			manifest: Manifest{Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "helm", RecipeLocation: "ghcr.io/my-org/redis:1.0"}}},
+			err:      "recipe kind \"helm\" is not supported",
+		},
+		{
+			name:     "missing location",
+			manifest: Manifest{Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep"}}},
+			err:      "one of recipeLocation and templatePath must be set",
+		},
+		{
+			name:     "invalid bicep location",
+			manifest: Manifest{Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", RecipeLocation: "not a reference"}}},
+			err:      "is not a valid OCI reference",
+		},
+		{
+			name:     "template path without publish registry",
+			manifest: Manifest{Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", TemplatePath: "testdata/bicep/redis.bicep"}}},
+			err:      "templatePath requires the --publish-registry flag",
+		},
+		{
+			name:       "template path for terraform",
+			manifest:   Manifest{Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "terraform", TemplatePath: "testdata/bicep/redis.bicep"}}},
+			canPublish: true,
+			err:        "templatePath is only supported for bicep recipes",
+		},
+		{
+			name:       "template path and location",
+			manifest:   Manifest{Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", TemplatePath: "testdata/bicep/redis.bicep", RecipeLocation: "ghcr.io/my-org/redis:1.0"}}},
+			canPublish: true,
+			err:        "only one of recipeLocation and templatePath can be set",
+		},
+		{
+			name:     "invalid resource type",
+			manifest: Manifest{Recipes: map[string]*Recipe{"redisCaches": {ResourceType: "redisCaches", RecipeKind: "bicep", RecipeLocation: "ghcr.io/my-org/redis:1.0"}}},
+			err:      "'Namespace/type'",
+		},
+		{
+			name: "same resource type with different case",
+			manifest: Manifest{Recipes: map[string]*Recipe{
+				"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", RecipeLocation: "ghcr.io/my-org/redis:1.0"},
+				"radius.data/rediscaches": {ResourceType: "radius.data/rediscaches", RecipeKind: "bicep", RecipeLocation: "ghcr.io/my-org/redis:1.0"},
+			}},
+			err: "are the same resource type",
+		},
+		{
+			name:     "valid",
+			manifest: Manifest{Layer: "organization", Recipes: map[string]*Recipe{"Radius.Data/redisCaches": {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", RecipeLocation: "ghcr.io/my-org/redis:1.0"}}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.manifest.Validate(tc.canPublish)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func Test_Manifest_ToResource(t *testing.T) {
+	manifest, err := ReadManifest("testdata/recipes")
+	require.NoError(t, err)
+	manifest.Layer = "organization"
+	manifest.TrustPolicy = &TrustPolicy{PublicKeys: []string{"key"}}
+
+	resource := manifest.ToResource("br:ghcr.io/my-org/recipes/", "1.0")
+
+	require.Equal(t, to.Ptr(corerpv20250801.RecipePackLayerOrganization), resource.Properties.Layer)
+	require.Equal(t, []*string{to.Ptr("key")}, resource.Properties.TrustPolicy.PublicKeys)
+	require.Equal(t, &corerpv20250801.RecipeDefinition{
+		RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+		RecipeLocation: to.Ptr("ghcr.io/my-org/recipes/radius.data/rediscaches:1.0"),
+		Parameters:     map[string]any{"size": "small"},
+	}, resource.Properties.Recipes["Radius.Data/redisCaches"])
+	require.Equal(t, &corerpv20250801.RecipeDefinition{
+		RecipeKind:     to.Ptr(corerpv20250801.RecipeKindTerraform),
+		RecipeLocation: to.Ptr("https://github.com/my-org/recipes/mongo.zip"),
+	}, resource.Properties.Recipes["Radius.Data/mongoDatabases"])
+}
+
+func Test_Manifest_ToResource_SameTemplateFileName(t *testing.T) {
+	manifest := &Manifest{Recipes: map[string]*Recipe{
+		"Radius.Data/redisCaches":    {ResourceType: "Radius.Data/redisCaches", RecipeKind: "bicep", TemplatePath: "redis/main.bicep"},
+		"Radius.Data/mongoDatabases": {ResourceType: "Radius.Data/mongoDatabases", RecipeKind: "bicep", TemplatePath: "mongo/main.bicep"},
+	}}
+
+	resource := manifest.ToResource("ghcr.io/my-org/recipes", "1.0")
+
+	require.Equal(t, "ghcr.io/my-org/recipes/radius.data/rediscaches:1.0", *resource.Properties.Recipes["Radius.Data/redisCaches"].RecipeLocation)
+	require.Equal(t, "ghcr.io/my-org/recipes/radius.data/mongodatabases:1.0", *resource.Properties.Recipes["Radius.Data/mongoDatabases"].RecipeLocation)
+}
@@ -0,0 +1,391 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes/recipepacks"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// NewCommand creates a new Cobra command and a Runner object to resolve the recipes of an environment from its recipe
+// packs, with flags for workspace, resource group, environment name and output.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "resolve",
+		Short: "Show the recipes of an environment resolved from its recipe packs",
+		Long: `Show the recipes of an environment resolved from its recipe packs.
+
+Recipe packs apply at the organization, resource group or environment layer. Organization recipe packs apply to all
+environments in the plane, resource group recipe packs apply to all environments in the resource group of the recipe
+pack, and environment recipe packs apply to the environments that reference them.
+
+For each resource type, the recipe of the most specific layer wins. The output shows the recipe pack that supplied each
+resource type's recipe, and the final parameters of the recipe with the recipe pack (or environment) that supplied
+each value. Resource types defined by multiple recipe packs of the same layer are reported as conflicts.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Resolve the recipes of the current environment
+rad recipe-pack resolve
+
+# Resolve the recipes of the specified environment
+rad recipe-pack resolve my-env
+
+# Resolve the recipes of the specified environment in a specified resource group
+rad recipe-pack resolve my-env --group my-group
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Resolution is the output of the `rad recipe-pack resolve` command.
+type Resolution struct {
+	// Recipes is the list of resolved recipes, sorted by resource type.
+	Recipes []ResolvedRecipe `json:"recipes"`
+
+	// Conflicts is the list of resource types defined by multiple recipe packs of the same layer.
+	Conflicts []Conflict `json:"conflicts,omitempty"`
+}
+
+// ResolvedRecipe is the recipe of a resource type resolved from the recipe packs of the environment.
+type ResolvedRecipe struct {
+	ResourceType     string            `json:"resourceType"`
+	RecipePack       string            `json:"recipePack"`
+	Layer            string            `json:"layer"`
+	RecipeKind       string            `json:"recipeKind"`
+	RecipeLocation   string            `json:"recipeLocation"`
+	Parameters       map[string]any    `json:"parameters,omitempty"`
+	ParameterSources map[string]string `json:"parameterSources,omitempty"`
+	Ignored          []string          `json:"ignored,omitempty"`
+}
+
+// ResolvedParameter is a parameter of a resolved recipe, as displayed in a table.
+type ResolvedParameter struct {
+	ResourceType string
+	Parameter    string
+	Value        string
+	Source       string
+}
+
+// Conflict is a resource type defined by multiple recipe packs of the same layer.
+type Conflict struct {
+	ResourceType string   `json:"resourceType"`
+	Layer        string   `json:"layer"`
+	RecipePacks  []string `json:"recipePacks"`
+}
+
+// Runner is the runner implementation for the `rad recipe-pack resolve` command.
+type Runner struct {
+	ConfigHolder            *framework.ConfigHolder
+	Output                  output.Interface
+	Workspace               *workspaces.Workspace
+	EnvironmentName         string
+	Format                  string
+	RadiusCoreClientFactory *corerpv20250801.ClientFactory
+
+	// RecipePackClientsByScope is the map of recipe pack clients by root scope. Clients are created for the scopes
+	// that are not in the map.
+	RecipePackClientsByScope map[string]*corerpv20250801.RecipePacksClient
+}
+
+// NewRunner creates a new instance of the `rad recipe-pack resolve` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe-pack resolve` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.EnvironmentName, err = cli.RequireEnvironmentNameArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace.Scope, err = cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad recipe-pack resolve` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if r.RadiusCoreClientFactory == nil {
+		clientFactory, err := cmd.InitializeRadiusCoreClientFactory(ctx, r.Workspace, r.Workspace.Scope)
+		if err != nil {
+			return err
+		}
+		r.RadiusCoreClientFactory = clientFactory
+	}
+	if r.RecipePackClientsByScope == nil {
+		r.RecipePackClientsByScope = map[string]*corerpv20250801.RecipePacksClient{}
+	}
+
+	resp, err := r.RadiusCoreClientFactory.NewEnvironmentsClient().Get(ctx, r.EnvironmentName, &corerpv20250801.EnvironmentsClientGetOptions{})
+	if clients.Is404Error(err) {
+		return clierrors.Message("The environment %q does not exist. Please select a new environment and try again.", r.EnvironmentName)
+	} else if err != nil {
+		return err
+	}
+
+	dm, err := resp.EnvironmentResource.ConvertTo()
+	if err != nil {
+		return err
+	}
+	environment := dm.(*datamodel.Environment_v20250801preview)
+
+	packs, err := r.loadRecipePacks(ctx, environment)
+	if err != nil {
+		return err
+	}
+
+	resolution, err := recipepacks.Resolve(environment, packs)
+	if err != nil {
+		return err
+	}
+
+	result := toResolution(resolution)
+	if r.Format == output.FormatJson {
+		return r.Output.WriteFormatted(r.Format, result, output.FormatterOptions{})
+	}
+
+	return r.display(result)
+}
+
+// loadRecipePacks lists the recipe packs of the plane of the environment, and fetches the recipe packs referenced by the
+// environment that live in another plane.
+func (r *Runner) loadRecipePacks(ctx context.Context, environment *datamodel.Environment_v20250801preview) ([]*datamodel.RecipePack, error) {
+	envID, err := resources.ParseResource(environment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	planeScope := envID.PlaneScope()
+	if _, ok := r.RecipePackClientsByScope[planeScope]; !ok {
+		factory, err := cmd.InitializeRadiusCoreClientFactory(ctx, r.Workspace, planeScope)
+		if err != nil {
+			return nil, err
+		}
+		r.RecipePackClientsByScope[planeScope] = factory.NewRecipePacksClient()
+	}
+
+	recipePackResources := []*corerpv20250801.RecipePackResource{}
+	pager := r.RecipePackClientsByScope[planeScope].NewListByScopePager(&corerpv20250801.RecipePacksClientListByScopeOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		recipePackResources = append(recipePackResources, page.Value...)
+	}
+
+	listed := map[string]bool{}
+	for _, resource := range recipePackResources {
+		if resource.ID != nil {
+			listed[strings.ToLower(*resource.ID)] = true
+		}
+	}
+
+	missing := []string{}
+	for _, recipePackID := range environment.Properties.RecipePacks {
+		if !listed[strings.ToLower(recipePackID)] {
+			missing = append(missing, recipePackID)
+		}
+	}
+
+	if err := cmd.PopulateRecipePackClients(ctx, r.Workspace, r.RecipePackClientsByScope, missing); err != nil {
+		return nil, err
+	}
+
+	for _, recipePackID := range missing {
+		id, err := resources.ParseResource(recipePackID)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := r.RecipePackClientsByScope[id.RootScope()].Get(ctx, id.Name(), &corerpv20250801.RecipePacksClientGetOptions{})
+		if clients.Is404Error(err) {
+			return nil, clierrors.Message("The recipe pack %q referenced by the environment %q does not exist.", recipePackID, r.EnvironmentName)
+		} else if err != nil {
+			return nil, err
+		}
+		recipePackResources = append(recipePackResources, &resp.RecipePackResource)
+	}
+
+	packs := []*datamodel.RecipePack{}
+	for _, resource := range recipePackResources {
+		if resource.Properties == nil {
+			continue
+		}
+
+		dm, err := resource.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, dm.(*datamodel.RecipePack))
+	}
+
+	return packs, nil
+}
+
+func toResolution(resolution *recipepacks.Resolution) Resolution {
+	result := Resolution{Recipes: []ResolvedRecipe{}}
+	for _, recipe := range resolution.Recipes {
+		result.Recipes = append(result.Recipes, ResolvedRecipe{
+			ResourceType:     recipe.ResourceType,
+			RecipePack:       recipe.RecipePackID,
+			Layer:            string(recipe.Layer),
+			RecipeKind:       recipe.Definition.RecipeKind,
+			RecipeLocation:   recipe.Definition.RecipeLocation,
+			Parameters:       recipe.Definition.Parameters,
+			ParameterSources: recipe.ParameterSources,
+			Ignored:          recipe.Ignored,
+		})
+	}
+
+	for _, conflict := range resolution.Conflicts {
+		result.Conflicts = append(result.Conflicts, Conflict{
+			ResourceType: conflict.ResourceType,
+			Layer:        string(conflict.Layer),
+			RecipePacks:  conflict.RecipePackIDs,
+		})
+	}
+
+	return result
+}
+
+func (r *Runner) display(resolution Resolution) error {
+	if len(resolution.Recipes) == 0 && len(resolution.Conflicts) == 0 {
+		r.Output.LogInfo("No recipe packs apply to the environment %q.", r.EnvironmentName)
+		return nil
+	}
+
+	err := r.Output.WriteFormatted(r.Format, resolution.Recipes, objectformats.GetResolvedRecipesTableFormat())
+	if err != nil {
+		return err
+	}
+
+	parameters := []ResolvedParameter{}
+	for _, recipe := range resolution.Recipes {
+		for _, name := range slices.Sorted(maps.Keys(recipe.Parameters)) {
+			parameters = append(parameters, ResolvedParameter{
+				ResourceType: recipe.ResourceType,
+				Parameter:    name,
+				Value:        formatValue(recipe.Parameters[name]),
+				Source:       formatSource(recipe.ParameterSources[name]),
+			})
+		}
+	}
+
+	if len(parameters) > 0 {
+		r.Output.LogInfo("")
+		err = r.Output.WriteFormatted(r.Format, parameters, objectformats.GetResolvedRecipeParametersTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, recipe := range resolution.Recipes {
+		if len(recipe.Ignored) == 0 {
+			continue
+		}
+
+		names := []string{}
+		for _, recipePack := range recipe.Ignored {
+			names = append(names, formatSource(recipePack))
+		}
+
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Warning: resource type %q is defined in multiple recipe packs of the environment, the recipe of %s is used and the recipes of %s are ignored.", recipe.ResourceType, formatSource(recipe.RecipePack), strings.Join(names, ", "))
+	}
+
+	for _, conflict := range resolution.Conflicts {
+		names := []string{}
+		for _, recipePack := range conflict.RecipePacks {
+			names = append(names, formatSource(recipePack))
+		}
+
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Conflict: resource type %q is defined in multiple recipe packs of the %s layer: %s. Resources of this type cannot be deployed until the conflict is resolved.", conflict.ResourceType, conflict.Layer, strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// formatValue formats a parameter value for display in a table.
+func formatValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+// formatSource formats the source of a parameter value for display in a table.
+func formatSource(source string) string {
+	id, err := resources.ParseResource(source)
+	if err != nil {
+		return source
+	}
+	return id.Name()
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolve
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/test_client_factory"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview/fake"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	planeScope      = "/planes/radius/local"
+	envID           = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/environments/test-env"
+	orgRecipePackID = "/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/org-pack"
+	envRecipePackID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/recipePacks/env-pack"
+	redisType       = "Radius.Data/redisCaches"
+	sqlType         = "Radius.Data/sqlDatabases"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Resolve Command with default environment",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Resolve Command with positional arg",
+			Input:         []string{"test-env"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Resolve Command with fallback workspace",
+			Input:         []string{"--environment", "test-env", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Resolve Command with too many args",
+			Input:         []string{"foo", "bar"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func environmentServer() fake.EnvironmentsServer {
+	return fake.EnvironmentsServer{
+		Get: func(ctx context.Context, environmentName string, options *corerpv20250801.EnvironmentsClientGetOptions) (resp azfake.Responder[corerpv20250801.EnvironmentsClientGetResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, corerpv20250801.EnvironmentsClientGetResponse{
+				EnvironmentResource: corerpv20250801.EnvironmentResource{
+					ID:   to.Ptr(envID),
+					Name: to.Ptr(environmentName),
+					Properties: &corerpv20250801.EnvironmentProperties{
+						RecipePacks: []*string{to.Ptr(envRecipePackID)},
+						RecipeParameters: map[string]map[string]any{
+							redisType: {"replicas": 3},
+						},
+					},
+				},
+			}, nil)
+			return
+		},
+	}
+}
+
+func recipePackServer(packs ...*corerpv20250801.RecipePackResource) func() fake.RecipePacksServer {
+	return func() fake.RecipePacksServer {
+		return fake.RecipePacksServer{
+			NewListByScopePager: func(options *corerpv20250801.RecipePacksClientListByScopeOptions) (resp azfake.PagerResponder[corerpv20250801.RecipePacksClientListByScopeResponse]) {
+				resp.AddPage(http.StatusOK, corerpv20250801.RecipePacksClientListByScopeResponse{
+					RecipePackResourceListResult: corerpv20250801.RecipePackResourceListResult{Value: packs},
+				}, nil)
+				return
+			},
+		}
+	}
+}
+
+func newRecipePack(id string, layer corerpv20250801.RecipePackLayer, recipes map[string]*corerpv20250801.RecipeDefinition) *corerpv20250801.RecipePackResource {
+	return &corerpv20250801.RecipePackResource{
+		ID: to.Ptr(id),
+		Properties: &corerpv20250801.RecipePackProperties{
+			Layer:   to.Ptr(layer),
+			Recipes: recipes,
+		},
+	}
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name:  "test-workspace",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	orgPack := newRecipePack(orgRecipePackID, corerpv20250801.RecipePackLayerOrganization, map[string]*corerpv20250801.RecipeDefinition{
+		redisType: {
+			RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+			RecipeLocation: to.Ptr("ghcr.io/org/recipes/redis:1.0"),
+			Parameters:     map[string]any{"size": "small", "tls": true},
+		},
+		sqlType: {
+			RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+			RecipeLocation: to.Ptr("ghcr.io/org/recipes/sql:1.0"),
+		},
+	})
+	envPack := newRecipePack(envRecipePackID, corerpv20250801.RecipePackLayerEnvironment, map[string]*corerpv20250801.RecipeDefinition{
+		redisType: {
+			RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+			RecipeLocation: to.Ptr("ghcr.io/org/recipes/redis:1.0"),
+			Parameters:     map[string]any{"size": "large"},
+		},
+	})
+
+	newRunner := func(t *testing.T, format string, packs ...*corerpv20250801.RecipePackResource) (*Runner, *output.MockOutput) {
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, environmentServer, recipePackServer(packs...))
+		require.NoError(t, err)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			RadiusCoreClientFactory:  factory,
+			RecipePackClientsByScope: map[string]*corerpv20250801.RecipePacksClient{planeScope: factory.NewRecipePacksClient()},
+			Workspace:                workspace,
+			EnvironmentName:          "test-env",
+			Format:                   format,
+			Output:                   outputSink,
+		}, outputSink
+	}
+
+	t.Run("table", func(t *testing.T) {
+		runner, outputSink := newRunner(t, "table", orgPack, envPack)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []ResolvedRecipe{
+					{
+						ResourceType:     redisType,
+						RecipePack:       envRecipePackID,
+						Layer:            "environment",
+						RecipeKind:       "bicep",
+						RecipeLocation:   "ghcr.io/org/recipes/redis:1.0",
+						Parameters:       map[string]any{"size": "large", "tls": true, "replicas": float64(3)},
+						ParameterSources: map[string]string{"size": envRecipePackID, "tls": orgRecipePackID, "replicas": "environment"},
+					},
+					{
+						ResourceType:     sqlType,
+						RecipePack:       orgRecipePackID,
+						Layer:            "organization",
+						RecipeKind:       "bicep",
+						RecipeLocation:   "ghcr.io/org/recipes/sql:1.0",
+						Parameters:       map[string]any{},
+						ParameterSources: map[string]string{},
+					},
+				},
+				Options: objectformats.GetResolvedRecipesTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []ResolvedParameter{
+					{ResourceType: redisType, Parameter: "replicas", Value: "3", Source: "environment"},
+					{ResourceType: redisType, Parameter: "size", Value: "large", Source: "env-pack"},
+					{ResourceType: redisType, Parameter: "tls", Value: "true", Source: "org-pack"},
+				},
+				Options: objectformats.GetResolvedRecipeParametersTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		otherOrgPack := newRecipePack(orgRecipePackID+"-2", corerpv20250801.RecipePackLayerOrganization, map[string]*corerpv20250801.RecipeDefinition{
+			sqlType: {
+				RecipeKind:     to.Ptr(corerpv20250801.RecipeKindTerraform),
+				RecipeLocation: to.Ptr("git::https://example.com/sql"),
+			},
+		})
+		runner, outputSink := newRunner(t, "json", orgPack, otherOrgPack, envPack)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		resolution := outputSink.Writes[0].(output.FormattedOutput).Obj.(Resolution)
+		require.Len(t, resolution.Recipes, 1)
+		require.Equal(t, []Conflict{{ResourceType: sqlType, Layer: "organization", RecipePacks: []string{orgRecipePackID, orgRecipePackID + "-2"}}}, resolution.Conflicts)
+	})
+
+	t.Run("no recipes", func(t *testing.T) {
+		emptyPack := newRecipePack(envRecipePackID, corerpv20250801.RecipePackLayerEnvironment, map[string]*corerpv20250801.RecipeDefinition{})
+		runner, outputSink := newRunner(t, "table", emptyPack)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []any{output.LogOutput{Format: "No recipe packs apply to the environment %q.", Params: []any{"test-env"}}}, outputSink.Writes)
+	})
+}
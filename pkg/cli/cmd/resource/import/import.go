@@ -0,0 +1,269 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceimport // import is a reserved word in go, so we can't use it as a package name.
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagResourceID = "resource-id"
+	flagAddress    = "address"
+	flagImportID   = "import-id"
+	flagManaged    = "radius-managed"
+)
+
+// NewCommand creates an instance of the `rad resource import` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "import [resource type] [name] --resource-id [existing resource id]",
+		Short: "Import an existing cloud resource into a recipe-managed resource",
+		Long: `Import an existing cloud resource into a recipe-managed resource
+
+An existing AWS, Azure or Kubernetes resource is adopted by the recipe of the resource instead of being created, so that it's deployed by the recipe without being recreated.
+
+Terraform recipes run 'terraform import' for the resource before applying the recipe. The address of the resource within the recipe module and its provider-specific import ID must be specified with the --address and --import-id flags. Bicep recipes record the resource as an existing output resource of the recipe.
+
+Imported resources are kept when the resource is deleted, unless the --radius-managed flag is set to let Radius manage their lifecycle.
+
+The resource is created from the input file passed with the -f flag, or updated in place if the flag is omitted. The command can be run multiple times to import several existing resources.`,
+		Example: `
+# Import an existing AWS RDS instance into a resource that uses a Terraform recipe
+rad resource import 'Radius.Data/postgreSqlDatabases' orders --resource-id /planes/aws/aws/accounts/000000000000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders --address aws_db_instance.db --import-id orders
+
+# Create a resource that adopts an existing Azure Cache for Redis (from file)
+rad resource import 'Radius.Data/redisCaches' cache -f /path/to/input.json --resource-id /subscriptions/<subscription-id>/resourceGroups/<resource-group>/providers/Microsoft.Cache/redis/cache`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddFromFileFlagVar(cmd, &runner.InputFilePath)
+	_ = cmd.MarkFlagFilename("from-file", "json")
+
+	cmd.Flags().StringVar(&runner.Import.ID, flagResourceID, "", "The fully qualified resource ID of the existing resource to import")
+	cmd.Flags().StringVar(&runner.Import.Address, flagAddress, "", "The address of the resource within the Terraform recipe module, for example aws_db_instance.db. Required for Terraform recipes")
+	cmd.Flags().StringVar(&runner.Import.ImportID, flagImportID, "", "The provider-specific identifier used by 'terraform import'. Required for Terraform recipes")
+	cmd.Flags().BoolVar(&runner.Import.RadiusManaged, flagManaged, false, "Let Radius manage the lifecycle of the existing resource, so that it's deleted with the resource")
+	_ = cmd.MarkFlagRequired(flagResourceID)
+
+	return cmd, runner
+}
+
+// Import is an existing resource to import, in the format of the 'properties.recipe.import' field of a resource.
+type Import struct {
+	ID            string `json:"id"`
+	Address       string `json:"address,omitempty"`
+	ImportID      string `json:"importId,omitempty"`
+	RadiusManaged bool   `json:"radiusManaged,omitempty"`
+}
+
+// Runner is the Runner implementation for the `rad resource import` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	InputFilePath                  string
+	Import                         Import
+
+	// Resource is the resource read from the input file. When nil, the existing resource is updated.
+	Resource *generated.GenericResource
+}
+
+// NewRunner creates an instance of the runner for the `rad resource import` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource import` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	resourceProviderName, resourceTypeName, err := cli.RequireFullyQualifiedResourceType(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = args[1]
+
+	if _, err := resources.ParseResource(r.Import.ID); err != nil {
+		return clierrors.Message("The resource ID %q is not a valid resource ID.", r.Import.ID)
+	}
+
+	if r.Import.Address != "" && r.Import.ImportID == "" {
+		return clierrors.Message("The --%s flag is required to import the resource with Terraform.", flagImportID)
+	}
+
+	if r.InputFilePath != "" {
+		r.Resource, err = readInput(r.InputFilePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readInput(arg string) (*generated.GenericResource, error) {
+	bs, err := os.ReadFile(arg)
+	if err != nil {
+		return nil, clierrors.Message("Failed to read input file: %v", err)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(bs)))
+	decoder.DisallowUnknownFields()
+
+	resource := generated.GenericResource{}
+	err = decoder.Decode(&resource)
+	if err != nil {
+		return nil, clierrors.Message("Invalid input, could not be converted to a resource: %v", err)
+	}
+
+	return &resource, nil
+}
+
+// Run runs the `rad resource import` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource := r.Resource
+	if resource == nil {
+		existing, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+		if clients.Is404Error(err) {
+			return clierrors.Message("The resource %q of type %q does not exist. Use the --from-file flag to create it.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+		} else if err != nil {
+			return err
+		}
+
+		// Only send the writable fields of the existing resource.
+		resource = &generated.GenericResource{
+			Location:   existing.Location,
+			Properties: existing.Properties,
+			Tags:       existing.Tags,
+		}
+	}
+
+	if err := addImport(resource, r.Import); err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Importing resource %q into %q of type %q", r.Import.ID, r.ResourceName, r.FullyQualifiedResourceTypeName)
+
+	response, err := client.CreateOrUpdateResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName, resource)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, response, objectformats.GetGenericResourceTableFormat())
+}
+
+// addImport adds the resource to import to the 'properties.recipe.import' field of the resource, replacing an
+// existing entry for the same resource ID.
+func addImport(resource *generated.GenericResource, imported Import) error {
+	if resource.Properties == nil {
+		resource.Properties = map[string]any{}
+	}
+
+	recipe := map[string]any{}
+	if obj, ok := resource.Properties["recipe"]; ok && obj != nil {
+		recipe, ok = obj.(map[string]any)
+		if !ok {
+			return clierrors.Message("Invalid resource, the 'recipe' property must be an object.")
+		}
+	}
+
+	imports := []any{}
+	if obj, ok := recipe["import"]; ok && obj != nil {
+		existing, ok := obj.([]any)
+		if !ok {
+			return clierrors.Message("Invalid resource, the 'recipe.import' property must be an array.")
+		}
+
+		for _, item := range existing {
+			if entry, ok := item.(map[string]any); ok {
+				if id, _ := entry["id"].(string); strings.EqualFold(id, imported.ID) {
+					continue
+				}
+			}
+			imports = append(imports, item)
+		}
+	}
+
+	entry := map[string]any{"id": imported.ID}
+	if imported.Address != "" {
+		entry["address"] = imported.Address
+	}
+	if imported.ImportID != "" {
+		entry["importId"] = imported.ImportID
+	}
+	if imported.RadiusManaged {
+		entry["radiusManaged"] = true
+	}
+
+	recipe["import"] = append(imports, entry)
+	resource.Properties["recipe"] = recipe
+
+	return nil
+}
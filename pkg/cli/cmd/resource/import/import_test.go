@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceimport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceType = "Applications.Test/exampleResources"
+	testResourceName = "my-example"
+	testImportedID   = "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"message": "Hello, world!",
+		},
+	}
+	b, err := json.Marshal(resource)
+	require.NoError(t, err)
+
+	directory := t.TempDir()
+	err = os.WriteFile(filepath.Join(directory, "valid-resource.json"), b, 0644)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(directory, "invalid-resource.json"), []byte("{askdfe}"), 0644)
+	require.NoError(t, err)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: existing resource",
+			Input:         []string{testResourceType, testResourceName, "--resource-id", testImportedID, "--address", "aws_db_instance.db", "--import-id", "orders"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: Terraform address without import ID",
+			Input:         []string{testResourceType, testResourceName, "--resource-id", testImportedID, "--address", "aws_db_instance.db"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: JSON file",
+			Input:         []string{testResourceType, testResourceName, "--resource-id", testImportedID, "--from-file", filepath.Join(directory, "valid-resource.json")},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: non-JSON file",
+			Input:         []string{testResourceType, testResourceName, "--resource-id", testImportedID, "--from-file", filepath.Join(directory, "invalid-resource.json")},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: missing resource ID",
+			Input:         []string{testResourceType, testResourceName},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: invalid resource ID",
+			Input:         []string{testResourceType, testResourceName, "--resource-id", "orders"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{testResourceType, testResourceName, "extra", "--resource-id", testImportedID},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success: existing resource updated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		existing := generated.GenericResource{
+			ID:       to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/exampleResources/my-example"),
+			Name:     to.Ptr(testResourceName),
+			Type:     to.Ptr(testResourceType),
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				"recipe": map[string]any{
+					"name": "default",
+					"import": []any{
+						map[string]any{"id": "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/ORDERS"},
+						map[string]any{"id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache"},
+					},
+				},
+			},
+		}
+
+		expectedResource := &generated.GenericResource{
+			Location: to.Ptr("global"),
+			Properties: map[string]any{
+				"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env",
+				"recipe": map[string]any{
+					"name": "default",
+					"import": []any{
+						map[string]any{"id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache"},
+						map[string]any{"id": testImportedID, "address": "aws_db_instance.db", "importId": "orders"},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(existing, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), testResourceType, testResourceName, expectedResource).
+			Return(*expectedResource, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Import:                         Import{ID: testImportedID, Address: "aws_db_instance.db", ImportID: "orders"},
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expectedOutput := []any{
+			output.LogOutput{
+				Format: "Importing resource %q into %q of type %q",
+				Params: []any{testImportedID, testResourceName, testResourceType},
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     *expectedResource,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expectedOutput, outputSink.Writes)
+	})
+
+	t.Run("Success: resource created from file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		expectedResource := &generated.GenericResource{
+			Properties: map[string]any{
+				"message": "Hello, world!",
+				"recipe": map[string]any{
+					"import": []any{
+						map[string]any{"id": testImportedID, "radiusManaged": true},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResource(gomock.Any(), testResourceType, testResourceName, expectedResource).
+			Return(*expectedResource, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         &output.MockOutput{},
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Import:                         Import{ID: testImportedID, RadiusManaged: true},
+			Resource: &generated.GenericResource{
+				Properties: map[string]any{
+					"message": "Hello, world!",
+				},
+			},
+			Format: "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Error: resource does not exist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), testResourceType, testResourceName).
+			Return(generated.GenericResource{}, &azcore.ResponseError{StatusCode: http.StatusNotFound, ErrorCode: "NotFound"}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         &output.MockOutput{},
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: testResourceType,
+			ResourceName:                   testResourceName,
+			Import:                         Import{ID: testImportedID},
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource %q of type %q does not exist. Use the --from-file flag to create it.", testResourceName, testResourceType), err)
+	})
+}
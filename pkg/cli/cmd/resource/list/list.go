@@ -18,6 +18,7 @@ package list
 
 import (
 	"context"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -46,7 +47,10 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "list [resourceType]",
 		Short: "Lists resources",
-		Long:  "List all resources of specified type",
+		Long: `List all resources of specified type.
+
+Use '--tag name=value' to only list the resources that have the tag. The resource type is optional when listing
+resources by tag, in which case the resources of all types in the resource group are listed.`,
 		Example: `
 sample list of resourceType: Applications.Core/containers, Applications.Core/gateways, Applications.Dapr/daprPubSubBrokers, Applications.Core/extenders, Applications.Datastores/mongoDatabases, Applications.Messaging/rabbitMQMessageQueues, Applications.Datastores/redisCaches, Applications.Datastores/sqlDatabases, Applications.Dapr/daprStateStores, Applications.Dapr/daprSecretStores
 
@@ -60,8 +64,14 @@ rad resource list Applications.Core/containers --application icecream-store
 
 # list all resources of a specified type in an application (shorthand flag)
 rad resource list Applications.Core/containers -a icecream-store
+
+# list all resources of a specified type that have a tag
+rad resource list Applications.Core/containers --tag team=payments
+
+# list all resources of any type that have a tag
+rad resource list --tag team=payments
 `,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -69,6 +79,7 @@ rad resource list Applications.Core/containers -a icecream-store
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String("tag", "", "Only list the resources that have this tag, in the format 'name=value'")
 
 	return cmd, runner
 }
@@ -85,6 +96,8 @@ type Runner struct {
 	ResourceType              string
 	ResourceTypeSuffix        string
 	ResourceProviderNamespace string
+	TagName                   string
+	TagValue                  string
 }
 
 // NewRunner creates a new instance of the `rad resource list` runner.
@@ -99,8 +112,8 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate runs validation for the `rad resource list` command.
 //
 
-// Validate checks the command line args, workspace, scope, application name, resource type and output format, and
-// returns an error if any of these are invalid.
+// Validate checks the command line args, workspace, scope, application name, output format, tag and resource type, and
+// returns an error if any of these are invalid. The resource type is optional when a tag is given.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	// Validate command line args and
 	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
@@ -121,17 +134,38 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.ApplicationName = applicationName
 
-	r.ResourceProviderNamespace, r.ResourceTypeSuffix, err = cli.RequireFullyQualifiedResourceType(args)
+	format, err := cli.RequireOutput(cmd)
 	if err != nil {
 		return err
 	}
-	r.ResourceType = r.ResourceProviderNamespace + "/" + r.ResourceTypeSuffix
+	r.Format = format
 
-	format, err := cli.RequireOutput(cmd)
+	tag, err := cmd.Flags().GetString("tag")
 	if err != nil {
 		return err
 	}
-	r.Format = format
+	if tag != "" {
+		name, value, found := strings.Cut(tag, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			return clierrors.Message("The tag %q is invalid. Specify the tag in the format 'name=value'.", tag)
+		}
+		r.TagName, r.TagValue = name, value
+	}
+
+	// The resource type is optional when listing resources by tag, unless the resources of an application are listed.
+	if len(args) == 0 && r.TagName != "" {
+		if r.ApplicationName != "" {
+			return clierrors.Message("A resource type is required when listing the resources of an application by tag.")
+		}
+
+		return nil
+	}
+
+	r.ResourceProviderNamespace, r.ResourceTypeSuffix, err = cli.RequireFullyQualifiedResourceType(args)
+	if err != nil {
+		return err
+	}
+	r.ResourceType = r.ResourceProviderNamespace + "/" + r.ResourceTypeSuffix
 
 	return nil
 }
@@ -141,8 +175,9 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run checks if an application name is provided and if so, checks if the application exists in the workspace, then
 // lists all resources of the specified type in the application, and finally writes the resources to the output in the
-// specified format. If no application name is provided, it lists all resources of the specified type. An error is
-// returned if the application does not exist in the workspace.
+// specified format. If no application name is provided, it lists all resources of the specified type. If a tag is
+// provided only the resources that have the tag are listed, and if no resource type is provided the resources of all
+// types are listed. An error is returned if the application does not exist in the workspace.
 func (r *Runner) Run(ctx context.Context) error {
 	// Initialize the client factory if it hasn't been set externally.
 	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
@@ -154,9 +189,11 @@ func (r *Runner) Run(ctx context.Context) error {
 		r.UCPClientFactory = clientFactory
 	}
 
-	_, err := common.GetResourceTypeDetails(ctx, r.ResourceProviderNamespace, r.ResourceTypeSuffix, r.UCPClientFactory)
-	if err != nil {
-		return err
+	if r.ResourceType != "" {
+		_, err := common.GetResourceTypeDetails(ctx, r.ResourceProviderNamespace, r.ResourceTypeSuffix, r.UCPClientFactory)
+		if err != nil {
+			return err
+		}
 	}
 
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -164,7 +201,17 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 	var resourceList []generated.GenericResource
-	if r.ApplicationName == "" {
+	if r.ResourceType == "" {
+		resourceList, err = client.ListResourcesWithTag(ctx, r.TagName, r.TagValue)
+		if err != nil {
+			return err
+		}
+	} else if r.ApplicationName == "" && r.TagName != "" {
+		resourceList, err = client.ListResourcesOfTypeWithTag(ctx, r.ResourceType, r.TagName, r.TagValue)
+		if err != nil {
+			return err
+		}
+	} else if r.ApplicationName == "" {
 		resourceList, err = client.ListResourcesOfType(ctx, r.ResourceType)
 		if err != nil {
 			return err
@@ -177,7 +224,11 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		resourceList, err = client.ListResourcesOfTypeInApplication(ctx, r.ApplicationName, r.ResourceType)
+		if r.TagName != "" {
+			resourceList, err = client.ListResourcesOfTypeInApplicationWithTag(ctx, r.ApplicationName, r.ResourceType, r.TagName, r.TagValue)
+		} else {
+			resourceList, err = client.ListResourcesOfTypeInApplication(ctx, r.ApplicationName, r.ResourceType)
+		}
 		if err != nil {
 			return err
 		}
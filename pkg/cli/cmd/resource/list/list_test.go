@@ -87,6 +87,54 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "Valid List Command with tag",
+			Input:         []string{"Applications.Core/containers", "--tag", "team=payments"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Core/containers", r.ResourceType)
+				require.Equal(t, "team", r.TagName)
+				require.Equal(t, "payments", r.TagValue)
+			},
+		},
+		{
+			Name:          "Valid List Command with tag and no resource type",
+			Input:         []string{"--tag", "cost-center="},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Empty(t, r.ResourceType)
+				require.Equal(t, "cost-center", r.TagName)
+				require.Empty(t, r.TagValue)
+			},
+		},
+		{
+			Name:          "List Command with tag and application and no resource type",
+			Input:         []string{"--tag", "team=payments", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with invalid tag",
+			Input:         []string{"Applications.Core/containers", "--tag", "team"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "List Command with too many args",
 			Input:         []string{"invalidResourceType", "foo"},
@@ -220,6 +268,135 @@ func Test_Run(t *testing.T) {
 			err = runner.Run(context.Background())
 			require.NoError(t, err)
 
+			expected := []any{
+				output.FormattedOutput{
+					Format:  "table",
+					Obj:     resources,
+					Options: objectformats.GetGenericResourceTableFormat(),
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+	})
+	t.Run("List resources by tag", func(t *testing.T) {
+		t.Run("Success without resource type", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			resources := []generated.GenericResource{
+				radcli.CreateResource("MyCompany.Resources/testResources", "A"),
+				radcli.CreateResource("Applications.Core/containers", "B"),
+			}
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				ListResourcesWithTag(gomock.Any(), "team", "payments").
+				Return(resources, nil).Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
+			require.NoError(t, err)
+			runner := &Runner{
+				ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				UCPClientFactory:  clientFactory,
+				Output:            outputSink,
+				Workspace:         &workspaces.Workspace{},
+				Format:            "table",
+				TagName:           "team",
+				TagValue:          "payments",
+			}
+
+			err = runner.Run(context.Background())
+			require.NoError(t, err)
+
+			expected := []any{
+				output.FormattedOutput{
+					Format:  "table",
+					Obj:     resources,
+					Options: objectformats.GetGenericResourceTableFormat(),
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+
+		t.Run("Success with resource type", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			resources := []generated.GenericResource{
+				radcli.CreateResource("MyCompany.Resources/testResources", "A"),
+			}
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				ListResourcesOfTypeWithTag(gomock.Any(), "MyCompany.Resources/testResources", "team", "payments").
+				Return(resources, nil).Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
+			require.NoError(t, err)
+			runner := &Runner{
+				ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				UCPClientFactory:          clientFactory,
+				Output:                    outputSink,
+				Workspace:                 &workspaces.Workspace{},
+				ResourceType:              "MyCompany.Resources/testResources",
+				Format:                    "table",
+				ResourceTypeSuffix:        "testResources",
+				ResourceProviderNamespace: "MyCompany.Resources",
+				TagName:                   "team",
+				TagValue:                  "payments",
+			}
+
+			err = runner.Run(context.Background())
+			require.NoError(t, err)
+
+			expected := []any{
+				output.FormattedOutput{
+					Format:  "table",
+					Obj:     resources,
+					Options: objectformats.GetGenericResourceTableFormat(),
+				},
+			}
+			require.Equal(t, expected, outputSink.Writes)
+		})
+
+		t.Run("Success with resource type in application", func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			resources := []generated.GenericResource{
+				radcli.CreateResource("MyCompany.Resources/testResources", "A"),
+			}
+
+			appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+			appManagementClient.EXPECT().
+				GetApplication(gomock.Any(), "test-app").
+				Return(v20231001preview.ApplicationResource{}, nil).Times(1)
+			appManagementClient.EXPECT().
+				ListResourcesOfTypeInApplicationWithTag(gomock.Any(), "test-app", "MyCompany.Resources/testResources", "team", "payments").
+				Return(resources, nil).Times(1)
+
+			outputSink := &output.MockOutput{}
+
+			clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
+			require.NoError(t, err)
+			runner := &Runner{
+				ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+				UCPClientFactory:          clientFactory,
+				Output:                    outputSink,
+				Workspace:                 &workspaces.Workspace{},
+				ApplicationName:           "test-app",
+				ResourceType:              "MyCompany.Resources/testResources",
+				Format:                    "table",
+				ResourceTypeSuffix:        "testResources",
+				ResourceProviderNamespace: "MyCompany.Resources",
+				TagName:                   "team",
+				TagValue:                  "payments",
+			}
+
+			err = runner.Run(context.Background())
+			require.NoError(t, err)
+
 			expected := []any{
 				output.FormattedOutput{
 					Format:  "table",
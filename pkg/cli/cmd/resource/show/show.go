@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -137,5 +138,22 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	return r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	err = r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	if err != nil {
+		return err
+	}
+
+	// The conditions are part of the resource in the other formats.
+	conditions := clients.GetResourceConditions(resourceDetails)
+	if r.Format == output.FormatTable && len(conditions) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, conditions, objectformats.GetResourceConditionsTableFormat())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -127,4 +127,54 @@ func Test_Run(t *testing.T) {
 		}
 		require.Equal(t, expected, outputSink.Writes)
 	})
+	t.Run("Validate rad resource show renders conditions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("contoso.example/databases", "orders-db")
+		resource.Properties = map[string]any{
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": "True", "reason": "ResourcesReady", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "contoso.example/databases", "orders-db").
+			Return(resource, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "contoso.example/databases",
+			ResourceName:                   "orders-db",
+			Format:                         "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []clients.ResourceCondition{
+					{Type: "Ready", Status: "True", Reason: "ResourcesReady", LastTransitionTime: "2024-01-01T00:00:00Z"},
+				},
+				Options: objectformats.GetResourceConditionsTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
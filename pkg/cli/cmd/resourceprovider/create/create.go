@@ -21,6 +21,7 @@ import (
 
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/common"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -46,6 +47,8 @@ Resource providers are the entities that implement resource types such as 'Appli
 Creating a resource provider defines new resource types that can be used in applications.
 
 Input can be passed in using a JSON or YAML file using the --from-file option.
+
+Creating a resource provider that already exists updates it. Use 'rad resource-provider diff' to review the changes before applying them.
 `,
 		Example: `
 # Create a resource provider from YAML file
@@ -126,6 +129,13 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	_, err := r.UCPClientFactory.NewResourceProvidersClient().Get(ctx, "local", r.ResourceProvider.Namespace, nil)
+	if err == nil {
+		r.Logger("Resource provider %s already exists and will be updated. Use 'rad resource-provider diff --from-file %s' to review the changes.", r.ResourceProvider.Namespace, r.ResourceProviderManifestFilePath)
+	} else if !clients.Is404Error(err) {
+		return err
+	}
+
 	// Proceed with registering manifests
 	if err := manifest.RegisterFile(ctx, r.UCPClientFactory, "local", r.ResourceProviderManifestFilePath, r.Logger); err != nil {
 		return err
@@ -100,5 +100,6 @@ func Test_Run(t *testing.T) {
 		logOutput := logBuffer.String()
 		require.Contains(t, logOutput, fmt.Sprintf("Creating resource type %s/%s", resourceProviderData.Namespace, expectedResourceType))
 		require.Contains(t, logOutput, fmt.Sprintf("Creating API Version %s/%s@%s", resourceProviderData.Namespace, expectedResourceType, expectedAPIVersion))
+		require.Contains(t, logOutput, fmt.Sprintf("Resource provider %s already exists and will be updated.", resourceProviderData.Namespace))
 	})
 }
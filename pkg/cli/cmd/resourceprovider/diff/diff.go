@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagExitCode = "exit-code"
+)
+
+// NewCommand creates an instance of the `rad resource-provider diff` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare a resource provider manifest with the registered resource provider",
+		Long: `Compare a resource provider manifest with the registered resource provider
+
+Shows the differences between a resource provider manifest and the resource provider registered in Radius: the locations, and the capabilities, API versions, schemas, conversions, actions and deprecations of the resource types. Use it to review the changes 'rad resource-provider create' would make before applying them, or to check that the registered resource providers match the manifests in source control.
+
+Each difference is one of:
+  - added: the value is in the manifest but not registered.
+  - removed: the value is registered but not in the manifest.
+  - changed: the value is registered with a different value than in the manifest.
+
+Note that 'rad resource-provider create' does not delete the resource types and API versions that are not in the manifest.`,
+		Example: `
+# Compare a manifest with the registered resource provider
+rad resource-provider diff --from-file /path/to/manifest.yaml
+
+# Fail when the registered resource provider does not match the manifest
+rad resource-provider diff --from-file /path/to/manifest.yaml --exit-code`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddFromFileFlagVar(cmd, &runner.ResourceProviderManifestFilePath)
+	_ = cmd.MarkFlagRequired("from-file")
+	_ = cmd.MarkFlagFilename("from-file", "yaml", "json")
+	cmd.Flags().BoolVar(&runner.ExitCode, flagExitCode, false, "Exit with an error if the registered resource provider does not match the manifest")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-provider diff` command.
+type Runner struct {
+	UCPClientFactory *v20231001preview.ClientFactory
+	ConfigHolder     *framework.ConfigHolder
+	Output           output.Interface
+	Format           string
+	Workspace        *workspaces.Workspace
+
+	ResourceProviderManifestFilePath string
+	ResourceProvider                 *manifest.ResourceProvider
+	ExitCode                         bool
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-provider diff` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource-provider diff` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.ResourceProvider, err = manifest.ReadFile(r.ResourceProviderManifestFilePath)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource-provider diff` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Initialize the client factory if it hasn't been set externally.
+	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
+	if r.UCPClientFactory == nil {
+		err := r.initializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	registered, err := manifest.ExportResourceProvider(ctx, r.UCPClientFactory, "local", r.ResourceProvider.Namespace)
+	if clients.Is404Error(err) {
+		// Everything in the manifest will be added.
+		registered = &manifest.ResourceProvider{Namespace: r.ResourceProvider.Namespace}
+	} else if err != nil {
+		return err
+	}
+
+	differences, err := manifest.Diff(registered, r.ResourceProvider)
+	if err != nil {
+		return err
+	}
+
+	if len(differences) == 0 {
+		r.Output.LogInfo("Resource provider %q matches the manifest %s.", r.ResourceProvider.Namespace, r.ResourceProviderManifestFilePath)
+		return nil
+	}
+
+	result := []DifferenceOutputFormat{}
+	for _, difference := range differences {
+		result = append(result, DifferenceOutputFormat{
+			Change:     difference.Change,
+			Path:       difference.Path,
+			Registered: formatValue(difference.Registered),
+			Manifest:   formatValue(difference.Manifest),
+		})
+	}
+
+	err = r.Output.WriteFormatted(r.Format, result, differenceTableFormat())
+	if err != nil {
+		return err
+	}
+
+	if r.ExitCode {
+		return clierrors.Message("The resource provider %q does not match the manifest %s.", r.ResourceProvider.Namespace, r.ResourceProviderManifestFilePath)
+	}
+
+	return nil
+}
+
+// DifferenceOutputFormat is used to format the output of the resource provider diff command.
+type DifferenceOutputFormat struct {
+	// Change is the kind of difference: added, removed or changed.
+	Change string
+	// Path is the path of the value in the manifest.
+	Path string
+	// Registered is the registered value, formatted as JSON.
+	Registered string
+	// Manifest is the value in the manifest, formatted as JSON.
+	Manifest string
+}
+
+// differenceTableFormat returns the fields to output from a difference.
+func differenceTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CHANGE",
+				JSONPath: "{ .Change }",
+			},
+			{
+				Heading:  "PATH",
+				JSONPath: "{ .Path }",
+			},
+			{
+				Heading:  "REGISTERED",
+				JSONPath: "{ .Registered }",
+			},
+			{
+				Heading:  "MANIFEST",
+				JSONPath: "{ .Manifest }",
+			},
+		},
+	}
+}
+
+// formatValue formats a value of the manifest as compact JSON. Missing values are formatted as an empty string.
+func formatValue(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(bs)
+}
+
+func (r *Runner) initializeClientFactory(ctx context.Context, workspace *workspaces.Workspace) error {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	r.UCPClientFactory = clientFactory
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"--from-file", "testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: exit code",
+			Input:         []string{"--from-file", "testdata/valid.yaml", "--exit-code"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: missing from-file",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: manifest does not exist",
+			Input:         []string{"--from-file", "testdata/missing.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"abcd", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	newRunner := func(t *testing.T, filePath string, exitCode bool) (*Runner, *output.MockOutput) {
+		clientFactory, err := manifest.NewRegisteredTestClientFactory()
+		require.NoError(t, err)
+
+		resourceProvider, err := manifest.ReadFile(filePath)
+		require.NoError(t, err)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			UCPClientFactory:                 clientFactory,
+			Output:                           outputSink,
+			Workspace:                        &workspaces.Workspace{},
+			Format:                           "table",
+			ResourceProviderManifestFilePath: filePath,
+			ResourceProvider:                 resourceProvider,
+			ExitCode:                         exitCode,
+		}, outputSink
+	}
+
+	t.Run("Success: no differences", func(t *testing.T) {
+		runner, outputSink := newRunner(t, "testdata/valid.yaml", true)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Resource provider %q matches the manifest %s.",
+				Params: []any{"MyCompany.Resources", "testdata/valid.yaml"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: differences", func(t *testing.T) {
+		runner, outputSink := newRunner(t, "testdata/changed.yaml", false)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []DifferenceOutputFormat{
+					{
+						Change:   manifest.DifferenceAdded,
+						Path:     "types.testResources.apiVersions.2026-01-01",
+						Manifest: `{"schema":{}}`,
+					},
+					{
+						Change:     manifest.DifferenceChanged,
+						Path:       "types.testResources.capabilities",
+						Registered: `["ManualResourceProvisioning"]`,
+						Manifest:   `[]`,
+					},
+				},
+				Options: differenceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: differences with exit code", func(t *testing.T) {
+		runner, _ := newRunner(t, "testdata/changed.yaml", true)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource provider %q does not match the manifest %s.", "MyCompany.Resources", "testdata/changed.yaml"), err)
+	})
+
+	t.Run("Success: resource provider not registered", func(t *testing.T) {
+		runner, outputSink := newRunner(t, "testdata/valid.yaml", false)
+
+		clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNotFoundError)
+		require.NoError(t, err)
+		runner.UCPClientFactory = clientFactory
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		differences := outputSink.Writes[0].(output.FormattedOutput).Obj.([]DifferenceOutputFormat)
+		require.Equal(t, []string{manifest.DifferenceChanged, manifest.DifferenceAdded}, []string{differences[0].Change, differences[1].Change})
+		require.Equal(t, []string{"location.global", "types.testResources"}, []string{differences[0].Path, differences[1].Path})
+	})
+}
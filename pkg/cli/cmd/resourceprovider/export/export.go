@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"strings"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagDestinationFile = "destination-file"
+)
+
+// NewCommand creates an instance of the `rad resource-provider export` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export [resource provider namespace]",
+		Short: "Export a resource provider as a manifest",
+		Long: `Export a resource provider as a manifest
+
+Reads a registered resource provider, with its locations, resource types and API versions, and writes it as a resource provider manifest. The manifest can be registered with 'rad resource-provider create', and compared with the registered resource provider with 'rad resource-provider diff'.
+
+The manifest is printed unless a destination file is specified.`,
+		Example: `
+# Print the manifest of a resource provider
+rad resource-provider export Contoso.Example
+
+# Write the manifest of a resource provider to a file
+rad resource-provider export Contoso.Example --destination-file contoso.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringVarP(&runner.DestinationFile, flagDestinationFile, "d", "", "Path of the file the manifest is written to. Defaults to printing the manifest")
+	_ = cmd.MarkFlagFilename(flagDestinationFile, "yaml", "yml")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-provider export` command.
+type Runner struct {
+	UCPClientFactory          *v20231001preview.ClientFactory
+	ConfigHolder              *framework.ConfigHolder
+	Output                    output.Interface
+	FileSystem                filesystem.FileSystem
+	Workspace                 *workspaces.Workspace
+	ResourceProviderNamespace string
+	DestinationFile           string
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-provider export` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+		FileSystem:   filesystem.NewOSFS(),
+	}
+}
+
+// Validate runs validation for the `rad resource-provider export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.ResourceProviderNamespace = args[0]
+
+	return nil
+}
+
+// Run runs the `rad resource-provider export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	// Initialize the client factory if it hasn't been set externally.
+	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
+	if r.UCPClientFactory == nil {
+		err := r.initializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+	}
+
+	resourceProvider, err := manifest.ExportResourceProvider(ctx, r.UCPClientFactory, "local", r.ResourceProviderNamespace)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource provider %q was not found or has been deleted.", r.ResourceProviderNamespace)
+	} else if err != nil {
+		return err
+	}
+
+	bs, err := manifest.Marshal(resourceProvider)
+	if err != nil {
+		return err
+	}
+
+	if r.DestinationFile == "" {
+		r.Output.LogInfo("%s", strings.TrimSuffix(string(bs), "\n"))
+		return nil
+	}
+
+	err = r.FileSystem.WriteFile(r.DestinationFile, bs, 0644)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Manifest of resource provider %q written to %s", r.ResourceProviderNamespace, r.DestinationFile)
+	return nil
+}
+
+func (r *Runner) initializeClientFactory(ctx context.Context, workspace *workspaces.Workspace) error {
+	connection, err := workspace.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	r.UCPClientFactory = clientFactory
+	return nil
+}
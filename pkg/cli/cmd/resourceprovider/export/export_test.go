@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const expectedManifest = `namespace: MyCompany.Resources
+location:
+  global: http://localhost:8080
+types:
+  testResources:
+    capabilities:
+      - ManualResourceProvisioning
+    apiVersions:
+      2025-01-01-preview:
+        schema: {}
+    description: This is a test resource type.`
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"MyCompany.Resources"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: destination file",
+			Input:         []string{"MyCompany.Resources", "--destination-file", "manifest.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: missing namespace",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"MyCompany.Resources", "MyCompany.Other"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success: print manifest", func(t *testing.T) {
+		clientFactory, err := manifest.NewRegisteredTestClientFactory()
+		require.NoError(t, err)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			UCPClientFactory:          clientFactory,
+			Output:                    outputSink,
+			Workspace:                 &workspaces.Workspace{},
+			ResourceProviderNamespace: "MyCompany.Resources",
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{expectedManifest},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: write manifest", func(t *testing.T) {
+		clientFactory, err := manifest.NewRegisteredTestClientFactory()
+		require.NoError(t, err)
+
+		outputSink := &output.MockOutput{}
+		fileSystem := filesystem.NewMemMapFileSystem()
+		runner := &Runner{
+			UCPClientFactory:          clientFactory,
+			Output:                    outputSink,
+			FileSystem:                fileSystem,
+			Workspace:                 &workspaces.Workspace{},
+			ResourceProviderNamespace: "MyCompany.Resources",
+			DestinationFile:           "manifest.yaml",
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		contents, err := fileSystem.ReadFile("manifest.yaml")
+		require.NoError(t, err)
+		require.Equal(t, expectedManifest+"\n", string(contents))
+
+		// The exported manifest is valid.
+		_, err = manifest.ReadBytes(contents)
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Manifest of resource provider %q written to %s",
+				Params: []any{"MyCompany.Resources", "manifest.yaml"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: resource provider not found", func(t *testing.T) {
+		clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNotFoundError)
+		require.NoError(t, err)
+
+		runner := &Runner{
+			UCPClientFactory:          clientFactory,
+			Output:                    &output.MockOutput{},
+			Workspace:                 &workspaces.Workspace{},
+			ResourceProviderNamespace: "MyCompany.Resources",
+		}
+
+		err = runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource provider %q was not found or has been deleted.", "MyCompany.Resources"), err)
+	})
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	flagAPIVersion      = "api-version"
+	flagDestinationFile = "destination-file"
+)
+
+// NewCommand creates an instance of the `rad resource-provider openapi` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "openapi [resource provider namespace]",
+		Short: "Export the OpenAPI document of a resource provider",
+		Long: `Export the OpenAPI document of a resource provider
+
+Generates an OpenAPI 3 document describing the resource types of a resource provider for an API version. The document covers the operations of each resource type, including actions, the headers of asynchronous operations and the shape of errors. It can be used to generate clients and API documentation.
+
+The document is printed as JSON unless a destination file is specified. Destination files ending in '.yaml' or '.yml' are written as YAML.
+
+If the API version is not specified, the default API version of the resource types is used when they all share the same one.`,
+		Example: `
+# Print the OpenAPI document of a resource provider
+rad resource-provider openapi Contoso.Example --api-version 2025-01-01
+
+# Write the OpenAPI document of a resource provider to a file
+rad resource-provider openapi Contoso.Example --api-version 2025-01-01 --destination-file contoso.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringVar(&runner.APIVersion, flagAPIVersion, "", "The API version of the resource types to describe. Defaults to the default API version of the resource types")
+	cmd.Flags().StringVarP(&runner.DestinationFile, flagDestinationFile, "d", "", "Path of the file the OpenAPI document is written to. Defaults to printing the document")
+	_ = cmd.MarkFlagFilename(flagDestinationFile, "json", "yaml", "yml")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-provider openapi` command.
+type Runner struct {
+	ConnectionFactory         connections.Factory
+	ConfigHolder              *framework.ConfigHolder
+	Output                    output.Interface
+	FileSystem                filesystem.FileSystem
+	Workspace                 *workspaces.Workspace
+	ResourceProviderNamespace string
+	APIVersion                string
+	DestinationFile           string
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-provider openapi` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		FileSystem:        filesystem.NewOSFS(),
+	}
+}
+
+// Validate runs validation for the `rad resource-provider openapi` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.ResourceProviderNamespace = args[0]
+
+	switch filepath.Ext(r.DestinationFile) {
+	case "", ".json", ".yaml", ".yml":
+	default:
+		return clierrors.Message("The destination file must be a '.json', '.yaml' or '.yml' file.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource-provider openapi` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if r.APIVersion == "" {
+		r.APIVersion, err = r.defaultAPIVersion(ctx, client)
+		if err != nil {
+			return err
+		}
+	}
+
+	document, err := client.GetResourceProviderOpenAPIDocument(ctx, "local", r.ResourceProviderNamespace, r.APIVersion)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource provider %q was not found or has no resource types with API version %q.", r.ResourceProviderNamespace, r.APIVersion)
+	} else if err != nil {
+		return err
+	}
+
+	if r.DestinationFile == "" {
+		return r.Output.WriteFormatted(output.FormatJson, document, output.FormatterOptions{})
+	}
+
+	var bs []byte
+	if ext := filepath.Ext(r.DestinationFile); ext == ".yaml" || ext == ".yml" {
+		bs, err = yaml.Marshal(document)
+	} else {
+		bs, err = json.MarshalIndent(document, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	err = r.FileSystem.WriteFile(r.DestinationFile, bs, 0644)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("OpenAPI document of resource provider %q for API version %q written to %s", r.ResourceProviderNamespace, r.APIVersion, r.DestinationFile)
+	return nil
+}
+
+// defaultAPIVersion returns the default API version shared by the resource types of the resource provider.
+func (r *Runner) defaultAPIVersion(ctx context.Context, client clients.ApplicationsManagementClient) (string, error) {
+	summary, err := client.GetResourceProviderSummary(ctx, "local", r.ResourceProviderNamespace)
+	if clients.Is404Error(err) {
+		return "", clierrors.Message("The resource provider %q was not found or has been deleted.", r.ResourceProviderNamespace)
+	} else if err != nil {
+		return "", err
+	}
+
+	versions := []string{}
+	for _, resourceType := range summary.ResourceTypes {
+		if resourceType == nil || to.String(resourceType.DefaultAPIVersion) == "" {
+			continue
+		}
+		if !slices.Contains(versions, to.String(resourceType.DefaultAPIVersion)) {
+			versions = append(versions, to.String(resourceType.DefaultAPIVersion))
+		}
+	}
+
+	if len(versions) != 1 {
+		return "", clierrors.Message("The resource types of resource provider %q do not share a default API version. Specify one with --%s.", r.ResourceProviderNamespace, flagAPIVersion)
+	}
+
+	return versions[0], nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"Contoso.Example", "--api-version", "2025-01-01"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: destination file",
+			Input:         []string{"Contoso.Example", "--destination-file", "contoso.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: destination file extension",
+			Input:         []string{"Contoso.Example", "--destination-file", "contoso.txt"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: not enough arguments",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	document := map[string]any{
+		"openapi": "3.0.3",
+		"info":    map[string]any{"title": "Contoso.Example", "version": "2025-01-01"},
+	}
+
+	t.Run("Success: print document", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderOpenAPIDocument(gomock.Any(), "local", "Contoso.Example", "2025-01-01").
+			Return(document, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Output:                    outputSink,
+			ResourceProviderNamespace: "Contoso.Example",
+			APIVersion:                "2025-01-01",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: output.FormatJson,
+				Obj:    document,
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: write document with default API version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Contoso.Example").
+			Return(v20231001preview.ResourceProviderSummary{
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"databases":   {DefaultAPIVersion: new("2025-01-01")},
+					"redisCaches": {DefaultAPIVersion: new("2025-01-01")},
+				},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetResourceProviderOpenAPIDocument(gomock.Any(), "local", "Contoso.Example", "2025-01-01").
+			Return(document, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		fileSystem := filesystem.NewMemMapFileSystem()
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Output:                    outputSink,
+			FileSystem:                fileSystem,
+			ResourceProviderNamespace: "Contoso.Example",
+			DestinationFile:           "contoso.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		contents, err := fileSystem.ReadFile("contoso.yaml")
+		require.NoError(t, err)
+		require.Equal(t, "info:\n  title: Contoso.Example\n  version: \"2025-01-01\"\nopenapi: 3.0.3\n", string(contents))
+
+		expected := []any{
+			output.LogOutput{
+				Format: "OpenAPI document of resource provider %q for API version %q written to %s",
+				Params: []any{"Contoso.Example", "2025-01-01", "contoso.yaml"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Error: no shared default API version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Contoso.Example").
+			Return(v20231001preview.ResourceProviderSummary{
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"databases":   {DefaultAPIVersion: new("2025-01-01")},
+					"redisCaches": {DefaultAPIVersion: new("2025-06-01")},
+				},
+			}, nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Output:                    &output.MockOutput{},
+			ResourceProviderNamespace: "Contoso.Example",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource types of resource provider \"Contoso.Example\" do not share a default API version. Specify one with --api-version."), err)
+	})
+
+	t.Run("Error: not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderOpenAPIDocument(gomock.Any(), "local", "Contoso.Example", "2020-01-01").
+			Return(nil, radcli.Create404Error()).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Output:                    outputSink,
+			ResourceProviderNamespace: "Contoso.Example",
+			APIVersion:                "2020-01-01",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The resource provider \"Contoso.Example\" was not found or has no resource types with API version \"2020-01-01\"."), err)
+		require.Empty(t, outputSink.Writes)
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 // ResourceType is used by the CLI for display of resource types.
@@ -36,12 +37,29 @@ type ResourceType struct {
 	ResourceProviderNamespace string
 	// APIVersions is the list of API versions supported by the resource type.
 	APIVersions map[string]*APIVersionProperties
+	// Deprecation is the deprecation of the resource type, or nil if it is not deprecated.
+	Deprecation *datamodel.Deprecation
 }
 
 // APIVersionProperties is used to store the schema of the resource type for the api version.
 type APIVersionProperties struct {
 	// Schema is the schema of the resource type.
 	Schema map[string]any
+	// Deprecation is the deprecation of the api version, or nil if it is not deprecated.
+	Deprecation *datamodel.Deprecation
+}
+
+// IsDeprecated returns true if the resource type or one of its API versions is deprecated.
+func (rt ResourceType) IsDeprecated() bool {
+	if rt.Deprecation != nil {
+		return true
+	}
+	for _, properties := range rt.APIVersions {
+		if properties != nil && properties.Deprecation != nil {
+			return true
+		}
+	}
+	return false
 }
 
 // ResourceTypeListOutputFormat is used to format the output of the resource type list and create commands.
@@ -51,6 +69,22 @@ type ResourceTypeListOutputFormat struct {
 	APIVersionList []string
 }
 
+// DeprecatedResourceTypeOutputFormat is used to format the output of the resource type list command for deprecated
+// resource types.
+type DeprecatedResourceTypeOutputFormat struct {
+	// Name is the fully-qualified name of the resource type.
+	Name string
+	// Deprecated is what is deprecated: 'all' if the resource type is deprecated, or the list of its deprecated API
+	// versions.
+	Deprecated string
+	// SunsetDate is the earliest sunset date of the resource type and its deprecated API versions.
+	SunsetDate string
+	// Replacement is the resource type or API versions replacing the deprecated ones.
+	Replacement string
+	// Usage is the number of resources of the resource type in all the resource groups of the plane.
+	Usage int
+}
+
 // ResourceTypesForProvider returns a list of resource types for a given provider.
 func ResourceTypesForProvider(provider *v20231001preview.ResourceProviderSummary) []ResourceType {
 	resourceTypes := []ResourceType{}
@@ -63,11 +97,13 @@ func ResourceTypesForProvider(provider *v20231001preview.ResourceProviderSummary
 		if resourceType.Description != nil {
 			rt.Description = *resourceType.Description
 		}
+		rt.Deprecation = v20231001preview.DeprecationToDataModel(resourceType.Deprecation)
 
 		rt.APIVersions = make(map[string]*APIVersionProperties)
 		for apiVersion, properties := range resourceType.APIVersions {
 			rt.APIVersions[apiVersion] = &APIVersionProperties{
-				Schema: properties.Schema,
+				Schema:      properties.Schema,
+				Deprecation: v20231001preview.DeprecationToDataModel(properties.Deprecation),
 			}
 		}
 
@@ -87,6 +123,34 @@ func GetResourceTypeTableFormat() output.FormatterOptions {
 	return formatterOptions
 }
 
+// GetDeprecatedResourceTypeTableFormat returns the fields to output from a deprecated resource type object.
+func GetDeprecatedResourceTypeTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "DEPRECATED",
+				JSONPath: "{ .Deprecated }",
+			},
+			{
+				Heading:  "SUNSET",
+				JSONPath: "{ .SunsetDate }",
+			},
+			{
+				Heading:  "REPLACEMENT",
+				JSONPath: "{ .Replacement }",
+			},
+			{
+				Heading:  "USAGE",
+				JSONPath: "{ .Usage }",
+			},
+		},
+	}
+}
+
 // GetResourceTypeShowTableFormat returns the fields to output from a resource type object for show command.
 func GetResourceTypeShowTableFormat() output.FormatterOptions {
 	return output.FormatterOptions{
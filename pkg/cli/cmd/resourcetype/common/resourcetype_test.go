@@ -19,8 +19,11 @@ package common
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -62,3 +65,39 @@ func Test_GetResourceTypeDetails(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func Test_ResourceTypesForProvider_Deprecation(t *testing.T) {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	provider := &v20231001preview.ResourceProviderSummary{
+		Name: new("Applications.Test"),
+		ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+			"exampleResources": {
+				Deprecation: &v20231001preview.Deprecation{
+					SunsetDate:  &sunset,
+					Replacement: new("Applications.Test/newResources"),
+				},
+				APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+					"2023-10-01-preview": {
+						Deprecation: &v20231001preview.Deprecation{Message: new("Upgrade to 2024-01-01.")},
+					},
+					"2024-01-01": {},
+				},
+			},
+		},
+	}
+
+	resourceTypes := ResourceTypesForProvider(provider)
+	require.Len(t, resourceTypes, 1)
+
+	rt := resourceTypes[0]
+	require.True(t, rt.IsDeprecated())
+	require.Equal(t, &datamodel.Deprecation{SunsetDate: &sunset, Replacement: "Applications.Test/newResources"}, rt.Deprecation)
+	require.Equal(t, &datamodel.Deprecation{Message: "Upgrade to 2024-01-01."}, rt.APIVersions["2023-10-01-preview"].Deprecation)
+	require.Nil(t, rt.APIVersions["2024-01-01"].Deprecation)
+
+	rt.Deprecation = nil
+	require.True(t, rt.IsDeprecated())
+
+	rt.APIVersions["2023-10-01-preview"].Deprecation = nil
+	require.False(t, rt.IsDeprecated())
+}
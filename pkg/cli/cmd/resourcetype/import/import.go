@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetypeimport // import is a reserved word in go, so we can't use it as a package name.
+
+import (
+	"context"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagNamespace       = "namespace"
+	flagAPIVersion      = "api-version"
+	flagCRDVersion      = "crd-version"
+	flagSchemaName      = "schema-name"
+	flagDestinationFile = "destination-file"
+)
+
+// NewCommand creates an instance of the `rad resource-type import` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "import [resource-type-name]",
+		Short: "Import a resource type from a Kubernetes CRD or an OpenAPI schema",
+		Long: `Import a resource type from a Kubernetes CRD or an OpenAPI schema
+
+Converts the schema of a Kubernetes custom resource definition (CRD), or a component schema of an OpenAPI document, into a resource provider manifest with a single resource type. The manifest can be registered with 'rad resource-provider create'.
+
+For CRDs, the spec of the custom resource becomes the properties of the resource type, and its status becomes read-only properties. Fields validated with the 'self == oldSelf' rule are imported as immutable, and the validation rules of the spec are kept. The storage version of the CRD is imported unless a CRD version is specified.
+
+For OpenAPI documents, references to other schemas of the document are inlined, and the object schemas composed with allOf are merged. The schema to import must be specified when the document has several schemas.
+
+String properties holding secrets, such as passwords, tokens and keys, are marked as sensitive. Constructs that are not supported by Radius, such as oneOf or integer-or-string values, are changed or dropped and reported as warnings.
+
+The resource type name defaults to the plural name of the CRD, or to the camelCased name of the OpenAPI schema. The manifest is printed unless a destination file is specified.`,
+		Example: `
+# Import a resource type from a Kubernetes CRD
+rad resource-type import --from-file databases.yaml --namespace MyCompany.Data --api-version 2025-01-01-preview
+
+# Import a resource type from an OpenAPI schema and write the manifest to a file
+rad resource-type import redisCaches --from-file openapi.yaml --schema-name RedisCache --namespace MyCompany.Data --api-version 2025-01-01-preview --destination-file redisCaches.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddFromFileFlagVar(cmd, &runner.FilePath)
+	_ = cmd.MarkFlagRequired("from-file")
+	_ = cmd.MarkFlagFilename("from-file", "yaml", "yml", "json")
+	cmd.Flags().StringVar(&runner.Options.Namespace, flagNamespace, "", "The namespace of the resource provider of the resource type, for example MyCompany.Resources")
+	_ = cmd.MarkFlagRequired(flagNamespace)
+	cmd.Flags().StringVar(&runner.Options.APIVersion, flagAPIVersion, "", "The API version of the resource type, for example 2025-01-01-preview")
+	_ = cmd.MarkFlagRequired(flagAPIVersion)
+	cmd.Flags().StringVar(&runner.Options.CRDVersion, flagCRDVersion, "", "The version of the CRD to import. Defaults to the storage version")
+	cmd.Flags().StringVar(&runner.Options.SchemaName, flagSchemaName, "", "The name of the OpenAPI component schema to import. Required when the document has several schemas")
+	cmd.Flags().StringVarP(&runner.DestinationFile, flagDestinationFile, "d", "", "Path of the file the manifest is written to. Defaults to printing the manifest")
+	_ = cmd.MarkFlagFilename(flagDestinationFile, "yaml", "yml")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-type import` command.
+type Runner struct {
+	Output          output.Interface
+	FileSystem      filesystem.FileSystem
+	FilePath        string
+	DestinationFile string
+	Options         manifest.ImportOptions
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-type import` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Output:     factory.GetOutput(),
+		FileSystem: filesystem.NewOSFS(),
+	}
+}
+
+// Validate runs validation for the `rad resource-type import` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	r.Options.ResourceType = cli.ReadResourceTypeNameArgs(cmd, args)
+	return nil
+}
+
+// Run runs the `rad resource-type import` command.
+func (r *Runner) Run(ctx context.Context) error {
+	data, err := r.FileSystem.ReadFile(r.FilePath)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to read %s.", r.FilePath)
+	}
+
+	resourceProvider, warnings, err := manifest.Import(ctx, data, r.Options)
+	for _, warning := range warnings {
+		r.Output.LogInfo("Warning: %s", warning.String())
+	}
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to import a resource type from %s.", r.FilePath)
+	}
+
+	bs, err := manifest.Marshal(resourceProvider)
+	if err != nil {
+		return err
+	}
+
+	if r.DestinationFile == "" {
+		r.Output.LogInfo("%s", strings.TrimSuffix(string(bs), "\n"))
+		return nil
+	}
+
+	err = r.FileSystem.WriteFile(r.DestinationFile, bs, 0644)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Manifest of resource provider %q written to %s", resourceProvider.Namespace, r.DestinationFile)
+	return nil
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetypeimport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+const crd = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: queues.example.com
+spec:
+  group: example.com
+  names:
+    kind: Queue
+    plural: queues
+  scope: Namespaced
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            spec:
+              type: object
+              properties:
+                size:
+                  x-kubernetes-int-or-string: true
+`
+
+const expectedManifest = `namespace: MyCompany.Messaging
+types:
+  queues:
+    capabilities: []
+    apiVersions:
+      2025-01-01-preview:
+        schema:
+          properties:
+            application:
+              description: (Optional) The Radius Application ID.
+              type: string
+            environment:
+              description: (Required) The Radius Environment ID.
+              type: string
+            size:
+              type: string
+          required:
+            - environment
+          type: object`
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"--from-file", "crd.yaml", "--namespace", "MyCompany.Messaging", "--api-version", "2025-01-01-preview"},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Valid: resource type name",
+			Input:         []string{"messageQueues", "--from-file", "crd.yaml", "--namespace", "MyCompany.Messaging", "--api-version", "2025-01-01-preview"},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"messageQueues", "queues", "--from-file", "crd.yaml", "--namespace", "MyCompany.Messaging", "--api-version", "2025-01-01-preview"},
+			ExpectedValid: false,
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	options := manifest.ImportOptions{Namespace: "MyCompany.Messaging", APIVersion: "2025-01-01-preview"}
+
+	t.Run("Success: print manifest", func(t *testing.T) {
+		fileSystem := filesystem.NewMemMapFileSystem()
+		require.NoError(t, fileSystem.WriteFile("crd.yaml", []byte(crd), 0644))
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:     outputSink,
+			FileSystem: fileSystem,
+			FilePath:   "crd.yaml",
+			Options:    options,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Warning: %s",
+				Params: []any{"size: integer-or-string values are imported as strings"},
+			},
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{expectedManifest},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: write manifest", func(t *testing.T) {
+		fileSystem := filesystem.NewMemMapFileSystem()
+		require.NoError(t, fileSystem.WriteFile("crd.yaml", []byte(crd), 0644))
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:          outputSink,
+			FileSystem:      fileSystem,
+			FilePath:        "crd.yaml",
+			DestinationFile: "manifest.yaml",
+			Options:         options,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		contents, err := fileSystem.ReadFile("manifest.yaml")
+		require.NoError(t, err)
+		require.Equal(t, expectedManifest+"\n", string(contents))
+
+		require.Equal(t, output.LogOutput{
+			Format: "Manifest of resource provider %q written to %s",
+			Params: []any{"MyCompany.Messaging", "manifest.yaml"},
+		}, outputSink.Writes[len(outputSink.Writes)-1])
+	})
+
+	t.Run("Error: unsupported document", func(t *testing.T) {
+		fileSystem := filesystem.NewMemMapFileSystem()
+		require.NoError(t, fileSystem.WriteFile("deployment.yaml", []byte("kind: Deployment"), 0644))
+
+		runner := &Runner{
+			Output:     &output.MockOutput{},
+			FileSystem: fileSystem,
+			FilePath:   "deployment.yaml",
+			Options:    options,
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.True(t, clierrors.IsFriendlyError(err))
+		require.Contains(t, err.Error(), "Failed to import a resource type from deployment.yaml.")
+	})
+}
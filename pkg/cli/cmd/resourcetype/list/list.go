@@ -20,14 +20,18 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/maps"
 )
@@ -44,13 +48,17 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 Resource types are the entities that can be created and managed by Radius such as 'Applications.Core/containers'. Each resource type can define multiple API versions, and each API version defines a schema that resource instances conform to. Resource types can be configured using resource providers.`,
 		Example: `
 # List all resource types
-rad resource-type list`,
+rad resource-type list
+
+# List the deprecated resource types and the number of resources using them in all resource groups
+rad resource-type list --deprecated`,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().Bool("deprecated", false, "List only the deprecated resource types, with the number of resources using them in all resource groups")
 
 	return cmd, runner
 }
@@ -62,6 +70,7 @@ type Runner struct {
 	Output            output.Interface
 	Format            string
 	Workspace         *workspaces.Workspace
+	Deprecated        bool
 }
 
 // NewRunner creates an instance of the runner for the `rad resource-type list` command.
@@ -87,6 +96,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
+	deprecated, err := cmd.Flags().GetBool("deprecated")
+	if err != nil {
+		return err
+	}
+	r.Deprecated = deprecated
+
 	return nil
 }
 
@@ -102,6 +117,10 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.Deprecated {
+		return r.listDeprecated(ctx, client, resourceProviders)
+	}
+
 	resourceTypes := []common.ResourceTypeListOutputFormat{}
 	for _, resourceProvider := range resourceProviders {
 
@@ -125,3 +144,82 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// listDeprecated lists the deprecated resource types with the number of resources using them. The resources are
+// counted in all the resource groups of the plane, not only in the scope of the workspace.
+func (r *Runner) listDeprecated(ctx context.Context, client clients.ApplicationsManagementClient, resourceProviders []v20231001preview.ResourceProviderSummary) error {
+	resourceGroups, err := client.ListResourceGroups(ctx, "local")
+	if err != nil {
+		return err
+	}
+
+	resourceTypes := []common.DeprecatedResourceTypeOutputFormat{}
+	for _, resourceProvider := range resourceProviders {
+		for _, rt := range common.ResourceTypesForProvider(&resourceProvider) {
+			if !rt.IsDeprecated() {
+				continue
+			}
+
+			usage := 0
+			for _, resourceGroup := range resourceGroups {
+				resources, err := client.ListResourcesOfTypeInResourceGroup(ctx, "local", *resourceGroup.Name, rt.Name)
+				if err != nil {
+					return err
+				}
+				usage += len(resources)
+			}
+
+			resourceTypes = append(resourceTypes, deprecatedResourceType(rt, usage))
+		}
+	}
+
+	slices.SortFunc(resourceTypes, func(a common.DeprecatedResourceTypeOutputFormat, b common.DeprecatedResourceTypeOutputFormat) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return r.Output.WriteFormatted(r.Format, resourceTypes, common.GetDeprecatedResourceTypeTableFormat())
+}
+
+// deprecatedResourceType summarizes the deprecation of the resource type and its API versions.
+func deprecatedResourceType(rt common.ResourceType, usage int) common.DeprecatedResourceTypeOutputFormat {
+	result := common.DeprecatedResourceTypeOutputFormat{
+		Name:  rt.Name,
+		Usage: usage,
+	}
+
+	deprecations := []*datamodel.Deprecation{}
+	if rt.Deprecation != nil {
+		result.Deprecated = "all"
+		deprecations = append(deprecations, rt.Deprecation)
+	}
+
+	apiVersions := maps.Keys(rt.APIVersions)
+	slices.Sort(apiVersions)
+	deprecatedVersions := []string{}
+	for _, apiVersion := range apiVersions {
+		if deprecation := rt.APIVersions[apiVersion].Deprecation; deprecation != nil {
+			deprecatedVersions = append(deprecatedVersions, apiVersion)
+			deprecations = append(deprecations, deprecation)
+		}
+	}
+	if rt.Deprecation == nil {
+		result.Deprecated = strings.Join(deprecatedVersions, ", ")
+	}
+
+	var sunset *time.Time
+	replacements := []string{}
+	for _, deprecation := range deprecations {
+		if deprecation.SunsetDate != nil && (sunset == nil || deprecation.SunsetDate.Before(*sunset)) {
+			sunset = deprecation.SunsetDate
+		}
+		if deprecation.Replacement != "" && !slices.Contains(replacements, deprecation.Replacement) {
+			replacements = append(replacements, deprecation.Replacement)
+		}
+	}
+	if sunset != nil {
+		result.SunsetDate = sunset.UTC().Format(time.DateOnly)
+	}
+	result.Replacement = strings.Join(replacements, ", ")
+
+	return result
+}
@@ -19,8 +19,10 @@ package list
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -45,6 +47,12 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: true,
 			ConfigHolder:  framework.ConfigHolder{Config: config},
 		},
+		{
+			Name:          "Valid: deprecated",
+			Input:         []string{"--deprecated"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
 		{
 			Name:          "Invalid: too many arguments",
 			Input:         []string{"dddd"},
@@ -150,4 +158,101 @@ func Test_Run(t *testing.T) {
 
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Deprecated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		later := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		resourceProviders := []v20231001preview.ResourceProviderSummary{
+			{
+				Name: new("Applications.Test"),
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"oldResources": {
+						Deprecation: &v20231001preview.Deprecation{
+							SunsetDate:  &later,
+							Replacement: new("Applications.Test/newResources"),
+						},
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2023-10-01-preview": {
+								Deprecation: &v20231001preview.Deprecation{SunsetDate: &sunset},
+							},
+						},
+					},
+					"newResources": {
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2023-10-01-preview": {
+								Deprecation: &v20231001preview.Deprecation{Replacement: new("2024-01-01")},
+							},
+							"2024-01-01": {},
+						},
+					},
+					"currentResources": {
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2024-01-01": {},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceProviderSummaries(gomock.Any(), "local").
+			Return(resourceProviders, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]v20231001preview.ResourceGroupResource{{Name: new("test-group")}, {Name: new("other-group")}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInResourceGroup(gomock.Any(), "local", "test-group", "Applications.Test/oldResources").
+			Return([]generated.GenericResource{{Name: new("a")}, {Name: new("b")}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInResourceGroup(gomock.Any(), "local", "other-group", "Applications.Test/oldResources").
+			Return([]generated.GenericResource{{Name: new("c")}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInResourceGroup(gomock.Any(), "local", gomock.Any(), "Applications.Test/newResources").
+			Return([]generated.GenericResource{}, nil).
+			Times(2)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Format:            "table",
+			Output:            outputSink,
+			Deprecated:        true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []common.DeprecatedResourceTypeOutputFormat{
+					{
+						Name:        "Applications.Test/newResources",
+						Deprecated:  "2023-10-01-preview",
+						Replacement: "2024-01-01",
+						Usage:       0,
+					},
+					{
+						Name:        "Applications.Test/oldResources",
+						Deprecated:  "all",
+						SunsetDate:  "2026-01-01",
+						Replacement: "Applications.Test/newResources",
+						Usage:       3,
+					},
+				},
+				Options: common.GetDeprecatedResourceTypeTableFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
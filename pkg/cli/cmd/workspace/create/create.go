@@ -19,6 +19,7 @@ package create
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
@@ -47,7 +48,9 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Create a workspace",
 		Long: `Create a workspace.
 		
-Available workspaceTypes: kubernetes
+Available workspaceTypes: kubernetes, oidc
+
+Workspaces of type 'oidc' connect directly to a Radius API endpoint and authenticate with OpenID Connect tokens. Run 'rad workspace login' to acquire a token after creating the workspace.
 
 Workspaces allow you to manage multiple Radius platforms and environments using a local configuration file. 
 
@@ -57,7 +60,9 @@ You can easily define and switch between workspaces to deploy and manage applica
 # Create a workspace with name 'myworkspace' and kubernetes context 'aks'
 rad workspace create kubernetes myworkspace --context aks
 # Create a workspace with name of current kubernetes context in current kubernetes context
-rad workspace create kubernetes`,
+rad workspace create kubernetes
+# Create a workspace with name 'myworkspace' that authenticates with OpenID Connect tokens
+rad workspace create oidc myworkspace --endpoint https://radius.example.com --issuer https://login.example.com --client-id radius`,
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -66,6 +71,10 @@ rad workspace create kubernetes`,
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().BoolP("force", "f", false, "Overwrite existing workspace if present")
 	cmd.Flags().StringP("context", "c", "", "the Kubernetes context to use, will use the default if unset")
+	cmd.Flags().String("endpoint", "", "the URL of the Radius API, for workspaces of type 'oidc'")
+	cmd.Flags().String("issuer", "", "the URL of the OpenID Connect issuer, for workspaces of type 'oidc'")
+	cmd.Flags().String("client-id", "", "the client ID registered with the OpenID Connect issuer, for workspaces of type 'oidc'")
+	cmd.Flags().StringSlice("scopes", nil, "additional scopes to request, for workspaces of type 'oidc'")
 
 	return cmd, runner
 }
@@ -108,27 +117,21 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
-	if err != nil {
-		return clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
-	}
-	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
-	if err != nil {
-		return err
-	}
-
-	_, ok := kubeContextList.Contexts[context]
-	if !ok {
-		return fmt.Errorf("the kubeconfig does not contain a context called %q", context)
-	}
-
-	if workspaceName == "" {
-		workspaceName = context
-	}
+	var connection map[string]any
+	if args[0] == workspaces.KindOIDC {
+		connection, err = r.validateOIDC(cmd)
+		if err != nil {
+			return err
+		}
 
-	state, err := r.HelmInterface.CheckRadiusInstall(context)
-	if !state.RadiusInstalled || err != nil {
-		return fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+		if workspaceName == "" {
+			return clierrors.Message("A workspace name is required for workspaces of type 'oidc'.")
+		}
+	} else {
+		connection, workspaceName, err = r.validateKubernetes(cmd, workspaceName)
+		if err != nil {
+			return err
+		}
 	}
 
 	workspaceExists, err := cli.HasWorkspace(config, workspaceName)
@@ -155,9 +158,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.Workspace = &workspaces.Workspace{}
 		r.Workspace.Name = workspaceName
 	}
-	r.Workspace.Connection = map[string]any{}
-	r.Workspace.Connection["context"] = context
-	r.Workspace.Connection["kind"] = args[0]
+	r.Workspace.Connection = connection
 
 	group, err := cmd.Flags().GetString("group")
 	if err != nil {
@@ -169,17 +170,23 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Workspaces of type 'oidc' cannot connect until 'rad workspace login' is run, so their resource group
+	// and environment are not verified.
+	verify := args[0] == workspaces.KindKubernetes
+
 	var client clients.ApplicationsManagementClient
 	if group != "" {
 		r.Workspace.Scope = "/planes/radius/local/resourceGroups/" + group
 
-		client, err = r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
-		if err != nil {
-			return err
-		}
-		_, err := client.GetResourceGroup(cmd.Context(), "local", group)
-		if err != nil {
-			return clierrors.Message("The resource group %q does not exist. Run `rad env create` try again.", r.Workspace.Scope)
+		if verify {
+			client, err = r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
+			if err != nil {
+				return err
+			}
+			_, err := client.GetResourceGroup(cmd.Context(), "local", group)
+			if err != nil {
+				return clierrors.Message("The resource group %q does not exist. Run `rad env create` try again.", r.Workspace.Scope)
+			}
 		}
 
 		//we want to make sure we dont have a workspace which has environment in a different scope from workspace's scope
@@ -193,15 +200,95 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		}
 		r.Workspace.Environment = r.Workspace.Scope + "/providers/applications.core/environments/" + env
 
-		_, err = client.GetEnvironment(cmd.Context(), env)
-		if err != nil {
-			return clierrors.Message("The environment %q does not exist. Run `rad env create` try again.", r.Workspace.Environment)
+		if verify {
+			_, err = client.GetEnvironment(cmd.Context(), env)
+			if err != nil {
+				return clierrors.Message("The environment %q does not exist. Run `rad env create` try again.", r.Workspace.Environment)
+			}
 		}
 	}
 
 	return nil
 }
 
+// validateKubernetes validates the Kubernetes context of a workspace of type 'kubernetes' and returns its connection
+// and name. The name defaults to the name of the Kubernetes context.
+func (r *Runner) validateKubernetes(cmd *cobra.Command, workspaceName string) (map[string]any, string, error) {
+	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
+	if err != nil {
+		return nil, "", clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
+	}
+	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, ok := kubeContextList.Contexts[context]
+	if !ok {
+		return nil, "", fmt.Errorf("the kubeconfig does not contain a context called %q", context)
+	}
+
+	if workspaceName == "" {
+		workspaceName = context
+	}
+
+	state, err := r.HelmInterface.CheckRadiusInstall(context)
+	if !state.RadiusInstalled || err != nil {
+		return nil, "", fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+	}
+
+	return map[string]any{
+		"context": context,
+		"kind":    workspaces.KindKubernetes,
+	}, workspaceName, nil
+}
+
+// validateOIDC validates the flags of a workspace of type 'oidc' and returns its connection.
+func (r *Runner) validateOIDC(cmd *cobra.Command) (map[string]any, error) {
+	endpoint, err := cmd.Flags().GetString("endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := cmd.Flags().GetString("issuer")
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, err := cmd.Flags().GetString("client-id")
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := cmd.Flags().GetStringSlice("scopes")
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint == "" || issuer == "" || clientID == "" {
+		return nil, clierrors.Message("Workspaces of type 'oidc' require the --endpoint, --issuer and --client-id flags.")
+	}
+
+	for _, value := range []string{endpoint, issuer} {
+		parsed, err := url.ParseRequestURI(value)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return nil, clierrors.Message("The URL %q is invalid. URLs must use the http or https scheme.", value)
+		}
+	}
+
+	connection := map[string]any{
+		"kind":     workspaces.KindOIDC,
+		"endpoint": endpoint,
+		"issuer":   issuer,
+		"clientId": clientID,
+	}
+	if len(scopes) > 0 {
+		connection["scopes"] = scopes
+	}
+
+	return connection, nil
+}
+
 // Run runs the `rad workspace create` command.
 //
 
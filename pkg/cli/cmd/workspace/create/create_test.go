@@ -126,6 +126,52 @@ func Test_Validate(t *testing.T) {
 				mocks.ApplicationManagementClient.EXPECT().GetEnvironment(gomock.Any(), "env1").Return(corerp.EnvironmentResource{}, nil).Times(1)
 			},
 		},
+		{
+			Name:          "create oidc workspace without issuer",
+			Input:         []string{"oidc", "ws", "--endpoint", "https://radius.example.com", "--client-id", "radius"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "create oidc workspace with invalid endpoint",
+			Input:         []string{"oidc", "ws", "--endpoint", "radius.example.com", "--issuer", "https://login.example.com", "--client-id", "radius"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "create oidc workspace without name",
+			Input:         []string{"oidc", "--endpoint", "https://radius.example.com", "--issuer", "https://login.example.com", "--client-id", "radius"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid oidc create command",
+			Input:         []string{"oidc", "ws", "--endpoint", "https://radius.example.com", "--issuer", "https://login.example.com", "--client-id", "radius", "-g", "rg1", "-e", "env1"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]any{
+					"kind":     "oidc",
+					"endpoint": "https://radius.example.com",
+					"issuer":   "https://login.example.com",
+					"clientId": "radius",
+				}, r.Workspace.Connection)
+				require.Equal(t, "/planes/radius/local/resourceGroups/rg1/providers/applications.core/environments/env1", r.Workspace.Environment)
+			},
+		},
 	}
 
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package login
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/oidc"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad workspace login` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in to a workspace",
+		Long: `Log in to a workspace of type 'oidc'.
+
+Acquires a token from the OpenID Connect issuer of the workspace using the device authorization flow. You will be asked to open a URL and enter a code to approve the request. The token is stored in the ~/.rad/tokens directory and refreshed automatically when it expires.`,
+		Example: `# Log in to the current workspace
+rad workspace login
+
+# Log in to a named workspace
+rad workspace login my-workspace`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad workspace login` command.
+type Runner struct {
+	ConfigHolder *framework.ConfigHolder
+	Output       output.Interface
+	Workspace    *workspaces.Workspace
+	Connection   *workspaces.OIDCConnectionConfig
+
+	// TokenCache is the cache where the token is stored. The default cache is used if unset.
+	TokenCache *oidc.Cache
+}
+
+// NewRunner creates a new instance of the `rad workspace login` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder: factory.GetConfigHolder(),
+		Output:       factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad workspace login` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspaceArgs(cmd, r.ConfigHolder.Config, args)
+	if err != nil {
+		return err
+	}
+
+	connection, err := workspace.ConnectionConfig()
+	if err != nil {
+		return err
+	}
+
+	oidcConnection, ok := connection.(*workspaces.OIDCConnectionConfig)
+	if !ok {
+		return clierrors.Message("The workspace %q is not of type 'oidc'. Only workspaces of type 'oidc' require a login.", workspace.Name)
+	}
+
+	r.Workspace = workspace
+	r.Connection = oidcConnection
+
+	return nil
+}
+
+// Run runs the `rad workspace login` command.
+func (r *Runner) Run(ctx context.Context) error {
+	cache := r.TokenCache
+	if cache == nil {
+		var err error
+		cache, err = oidc.NewDefaultCache()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := oidc.Login(ctx, r.Connection.OIDCConfig(), cache, func(device *oauth2.DeviceAuthResponse) {
+		if device.VerificationURIComplete != "" {
+			r.Output.LogInfo("To log in, open %s and confirm the code %s.", device.VerificationURIComplete, device.UserCode)
+		} else {
+			r.Output.LogInfo("To log in, open %s and enter the code %s.", device.VerificationURI, device.UserCode)
+		}
+	})
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to log in to workspace %q.", r.Workspace.Name)
+	}
+
+	r.Output.LogInfo("Logged in to workspace %q.", r.Workspace.Name)
+
+	return nil
+}
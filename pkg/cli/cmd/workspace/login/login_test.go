@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package login
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/oidc"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/radius-project/radius/test/testoidc"
+	"github.com/stretchr/testify/require"
+)
+
+const oidcConfig = `
+workspaces:
+  default: oidc-workspace
+  items:
+    oidc-workspace:
+      connection:
+        kind: oidc
+        endpoint: https://radius.example.com
+        issuer: https://login.example.com
+        clientId: radius
+    test-workspace:
+      connection:
+        context: test-context
+        kind: kubernetes
+`
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfig(t, oidcConfig)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "login current workspace valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "oidc-workspace", r.Workspace.Name)
+				require.Equal(t, "https://login.example.com", r.Connection.Issuer)
+				require.Equal(t, "radius", r.Connection.ClientID)
+			},
+		},
+		{
+			Name:          "login kubernetes workspace invalid",
+			Input:         []string{radcli.TestWorkspaceName},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "login workspace not-found invalid",
+			Input:         []string{"other-workspace"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctx := testcontext.New(t)
+	issuer := testoidc.NewIssuer(t)
+	outputSink := &output.MockOutput{}
+	cache := &oidc.Cache{Directory: t.TempDir()}
+
+	connection := &workspaces.OIDCConnectionConfig{
+		Kind:     workspaces.KindOIDC,
+		Endpoint: "https://radius.example.com",
+		Issuer:   issuer.URL,
+		ClientID: issuer.ClientID,
+	}
+	runner := &Runner{
+		ConfigHolder: &framework.ConfigHolder{},
+		Output:       outputSink,
+		Workspace:    &workspaces.Workspace{Name: "oidc-workspace"},
+		Connection:   connection,
+		TokenCache:   cache,
+	}
+
+	err := runner.Run(ctx)
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "To log in, open %s and enter the code %s.",
+			Params: []any{fmt.Sprintf("%s/device", issuer.URL), testoidc.UserCode},
+		},
+		output.LogOutput{
+			Format: "Logged in to workspace %q.",
+			Params: []any{"oidc-workspace"},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+
+	token, err := oidc.NewTokenSource(connection.OIDCConfig(), cache).Token()
+	require.NoError(t, err)
+	require.NotEmpty(t, token.AccessToken)
+}
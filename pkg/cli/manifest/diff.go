@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+
+	yaml "github.com/goccy/go-yaml"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	// DifferenceAdded is a value that is in the manifest but not registered.
+	DifferenceAdded = "added"
+
+	// DifferenceRemoved is a value that is registered but not in the manifest.
+	DifferenceRemoved = "removed"
+
+	// DifferenceChanged is a value that is registered with a different value than in the manifest.
+	DifferenceChanged = "changed"
+)
+
+// Difference is a difference between a registered resource provider and its manifest.
+type Difference struct {
+	// Change is the kind of difference: DifferenceAdded, DifferenceRemoved or DifferenceChanged.
+	Change string
+
+	// Path is the path of the value in the manifest, e.g. 'types.testResources.capabilities'.
+	Path string
+
+	// Registered is the registered value, or nil if the value is only in the manifest.
+	Registered any
+
+	// Manifest is the value in the manifest, or nil if the value is only registered.
+	Manifest any
+}
+
+// Diff returns the differences between the registered resource provider and its manifest, sorted by path. The
+// locations, capabilities, API versions, schemas, conversions, actions and deprecations of the resource types are
+// compared. Differences that are not significant, such as the order of capabilities or the format of sunset dates,
+// are ignored.
+func Diff(registered *ResourceProvider, manifest *ResourceProvider) ([]Difference, error) {
+	registeredValue, err := toComparable(registered)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestValue, err := toComparable(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	differences := []Difference{}
+	diffValues("", registeredValue, manifestValue, &differences)
+	return differences, nil
+}
+
+// toComparable normalizes the resource provider and returns it as generic YAML values.
+func toComparable(resourceProvider *ResourceProvider) (map[string]any, error) {
+	if resourceProvider == nil {
+		return map[string]any{}, nil
+	}
+
+	normalized := ResourceProvider{
+		Location: resourceProvider.Location,
+		Types:    map[string]*ResourceType{},
+	}
+	if len(normalized.Location) == 0 {
+		normalized.Location = map[string]string{v1.LocationGlobal: ""}
+	}
+
+	for name, resourceType := range resourceProvider.Types {
+		if resourceType == nil {
+			continue
+		}
+
+		copied := *resourceType
+		copied.Capabilities = slices.Sorted(slices.Values(resourceType.Capabilities))
+		copied.Deprecation = normalizeDeprecation(resourceType.Deprecation)
+		copied.APIVersions = map[string]*ResourceTypeAPIVersion{}
+		for apiVersionName, apiVersion := range resourceType.APIVersions {
+			if apiVersion == nil {
+				continue
+			}
+			copiedVersion := *apiVersion
+			copiedVersion.Deprecation = normalizeDeprecation(apiVersion.Deprecation)
+			copied.APIVersions[apiVersionName] = &copiedVersion
+		}
+		normalized.Types[name] = &copied
+	}
+
+	bs, err := yaml.Marshal(&normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err := yaml.Unmarshal(bs, &result); err != nil {
+		return nil, err
+	}
+
+	// The namespace is not compared: the registered resource provider is looked up by the namespace of the manifest.
+	delete(result, "namespace")
+
+	return result, nil
+}
+
+// normalizeDeprecation formats the sunset date of the deprecation the same way as exported manifests.
+func normalizeDeprecation(deprecation *Deprecation) *Deprecation {
+	if deprecation == nil {
+		return nil
+	}
+
+	copied := *deprecation
+	if sunsetDate, err := deprecation.ParseSunsetDate(); err == nil && sunsetDate != nil {
+		copied.SunsetDate = formatSunsetDate(*sunsetDate)
+	}
+
+	return &copied
+}
+
+// diffValues appends the differences between the registered and manifest values at the given path.
+func diffValues(path string, registered any, manifest any, differences *[]Difference) {
+	registeredMap, registeredIsMap := registered.(map[string]any)
+	manifestMap, manifestIsMap := manifest.(map[string]any)
+	if registeredIsMap && manifestIsMap {
+		keys := maps.Keys(registeredMap)
+		for key := range manifestMap {
+			if _, ok := registeredMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			registeredValue, inRegistered := registeredMap[key]
+			manifestValue, inManifest := manifestMap[key]
+			switch {
+			case !inRegistered:
+				*differences = append(*differences, Difference{Change: DifferenceAdded, Path: joinPath(path, key), Manifest: manifestValue})
+			case !inManifest:
+				*differences = append(*differences, Difference{Change: DifferenceRemoved, Path: joinPath(path, key), Registered: registeredValue})
+			default:
+				diffValues(joinPath(path, key), registeredValue, manifestValue, differences)
+			}
+		}
+		return
+	}
+
+	registeredSlice, registeredIsSlice := registered.([]any)
+	manifestSlice, manifestIsSlice := manifest.([]any)
+	if registeredIsSlice && manifestIsSlice && len(registeredSlice) == len(manifestSlice) {
+		for i := range registeredSlice {
+			diffValues(path+"["+strconv.Itoa(i)+"]", registeredSlice[i], manifestSlice[i], differences)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(registered, manifest) {
+		*differences = append(*differences, Difference{Change: DifferenceChanged, Path: path, Registered: registered, Manifest: manifest})
+	}
+}
+
+// joinPath joins a path and a key with a dot.
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
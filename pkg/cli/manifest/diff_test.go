@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testDiffResourceProvider() *ResourceProvider {
+	return &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				Capabilities:      []string{"ManualResourceProvisioning", "SupportsRecipes"},
+				DefaultAPIVersion: new("2025-01-01-preview"),
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"size":        map[string]any{"type": "string"},
+								"environment": map[string]any{"type": "string"},
+							},
+							"required": []any{"environment"},
+						},
+					},
+				},
+				Deprecation: &Deprecation{SunsetDate: "2026-01-01"},
+			},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("no differences", func(t *testing.T) {
+		registered := testDiffResourceProvider()
+		manifest := testDiffResourceProvider()
+
+		// Ignored differences
+		manifest.Namespace = "mycompany.resources"
+		manifest.Location = map[string]string{"global": ""}
+		manifest.Types["testResources"].Capabilities = []string{"SupportsRecipes", "ManualResourceProvisioning"}
+		manifest.Types["testResources"].Deprecation.SunsetDate = "2026-01-01T00:00:00Z"
+
+		differences, err := Diff(registered, manifest)
+		require.NoError(t, err)
+		require.Empty(t, differences)
+	})
+
+	t.Run("differences", func(t *testing.T) {
+		registered := testDiffResourceProvider()
+		registered.Types["oldResources"] = &ResourceType{
+			APIVersions: map[string]*ResourceTypeAPIVersion{"2024-01-01": {Schema: map[string]any{}}},
+		}
+
+		manifest := testDiffResourceProvider()
+		manifest.Location = map[string]string{"global": "http://localhost:8080"}
+		testResources := manifest.Types["testResources"]
+		testResources.Capabilities = []string{"ManualResourceProvisioning"}
+		testResources.APIVersions["2025-01-01-preview"].Schema.(map[string]any)["properties"].(map[string]any)["size"] = map[string]any{"type": "integer"}
+		testResources.APIVersions["2026-01-01"] = &ResourceTypeAPIVersion{Schema: map[string]any{}}
+
+		differences, err := Diff(registered, manifest)
+		require.NoError(t, err)
+		require.Equal(t, []Difference{
+			{Change: DifferenceChanged, Path: "location.global", Registered: "", Manifest: "http://localhost:8080"},
+			{Change: DifferenceRemoved, Path: "types.oldResources", Registered: map[string]any{
+				"capabilities": []any{},
+				"apiVersions":  map[string]any{"2024-01-01": map[string]any{"schema": map[string]any{}}},
+			}},
+			{Change: DifferenceChanged, Path: "types.testResources.apiVersions.2025-01-01-preview.schema.properties.size.type", Registered: "string", Manifest: "integer"},
+			{Change: DifferenceAdded, Path: "types.testResources.apiVersions.2026-01-01", Manifest: map[string]any{"schema": map[string]any{}}},
+			{Change: DifferenceChanged, Path: "types.testResources.capabilities", Registered: []any{"ManualResourceProvisioning", "SupportsRecipes"}, Manifest: []any{"ManualResourceProvisioning"}},
+		}, differences)
+	})
+
+	t.Run("not registered", func(t *testing.T) {
+		differences, err := Diff(&ResourceProvider{Namespace: "MyCompany.Resources"}, testDiffResourceProvider())
+		require.NoError(t, err)
+		require.Len(t, differences, 1)
+		require.Equal(t, DifferenceAdded, differences[0].Change)
+		require.Equal(t, "types.testResources", differences[0].Path)
+	})
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"time"
+
+	yaml "github.com/goccy/go-yaml"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// ExportResourceProvider reads a resource provider registered in UCP, with its locations, resource types and API
+// versions, and returns it as a manifest. Registering the manifest recreates the resource provider.
+func ExportResourceProvider(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, namespace string) (*ResourceProvider, error) {
+	response, err := clientFactory.NewResourceProvidersClient().Get(ctx, planeName, namespace, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceProvider := &ResourceProvider{
+		Namespace: to.String(response.Name),
+		Types:     map[string]*ResourceType{},
+	}
+
+	locationsPager := clientFactory.NewLocationsClient().NewListPager(planeName, namespace, nil)
+	for locationsPager.More() {
+		page, err := locationsPager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, location := range page.Value {
+			address := ""
+			if location.Properties != nil {
+				address = to.String(location.Properties.Address)
+			}
+
+			if resourceProvider.Location == nil {
+				resourceProvider.Location = map[string]string{}
+			}
+			resourceProvider.Location[to.String(location.Name)] = address
+		}
+	}
+
+	// The global location without an address is the default of manifests.
+	if address, ok := resourceProvider.Location[v1.LocationGlobal]; ok && address == "" && len(resourceProvider.Location) == 1 {
+		resourceProvider.Location = nil
+	}
+
+	resourceTypesPager := clientFactory.NewResourceTypesClient().NewListPager(planeName, namespace, nil)
+	for resourceTypesPager.More() {
+		page, err := resourceTypesPager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resourceType := range page.Value {
+			typeName := to.String(resourceType.Name)
+			exported, err := exportResourceType(ctx, clientFactory, planeName, namespace, typeName, resourceType.Properties)
+			if err != nil {
+				return nil, err
+			}
+			resourceProvider.Types[typeName] = exported
+		}
+	}
+
+	return resourceProvider, nil
+}
+
+// exportResourceType returns the manifest of a resource type registered in UCP.
+func exportResourceType(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, namespace string, typeName string, properties *v20231001preview.ResourceTypeProperties) (*ResourceType, error) {
+	resourceType := &ResourceType{
+		Capabilities: []string{},
+		APIVersions:  map[string]*ResourceTypeAPIVersion{},
+	}
+
+	if properties != nil {
+		if capabilities := to.StringArray(properties.Capabilities); capabilities != nil {
+			resourceType.Capabilities = capabilities
+		}
+		resourceType.DefaultAPIVersion = properties.DefaultAPIVersion
+		resourceType.Description = properties.Description
+		resourceType.Deprecation = deprecationFromAPI(properties.Deprecation)
+
+		for name, action := range properties.Actions {
+			if action == nil {
+				continue
+			}
+			if resourceType.Actions == nil {
+				resourceType.Actions = map[string]*Action{}
+			}
+			resourceType.Actions[name] = actionFromAPI(action)
+		}
+	}
+
+	apiVersionsPager := clientFactory.NewAPIVersionsClient().NewListPager(planeName, namespace, typeName, nil)
+	for apiVersionsPager.More() {
+		page, err := apiVersionsPager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, apiVersion := range page.Value {
+			exported := &ResourceTypeAPIVersion{Schema: map[string]any{}}
+			if apiVersion.Properties != nil {
+				if apiVersion.Properties.Schema != nil {
+					exported.Schema = apiVersion.Properties.Schema
+				}
+				exported.Conversion = conversionFromAPI(apiVersion.Properties.Conversion)
+				exported.Deprecation = deprecationFromAPI(apiVersion.Properties.Deprecation)
+			}
+			resourceType.APIVersions[to.String(apiVersion.Name)] = exported
+		}
+	}
+
+	return resourceType, nil
+}
+
+// Marshal returns the YAML manifest of the resource provider.
+func Marshal(resourceProvider *ResourceProvider) ([]byte, error) {
+	return yaml.MarshalWithOptions(resourceProvider, yaml.IndentSequence(true))
+}
+
+// conversionFromAPI converts the conversion rules of the UCP API model to the manifest.
+func conversionFromAPI(conversion *v20231001preview.APIVersionConversion) *Conversion {
+	if conversion == nil {
+		return nil
+	}
+
+	result := &Conversion{Defaults: conversion.Defaults}
+	for _, rename := range conversion.Renames {
+		if rename == nil {
+			continue
+		}
+		result.Renames = append(result.Renames, &FieldRename{
+			From: to.String(rename.From),
+			To:   to.String(rename.To),
+		})
+	}
+
+	return result
+}
+
+// deprecationFromAPI converts the deprecation of the UCP API model to the manifest. Sunset dates at midnight UTC are
+// written as dates.
+func deprecationFromAPI(deprecation *v20231001preview.Deprecation) *Deprecation {
+	if deprecation == nil {
+		return nil
+	}
+
+	result := &Deprecation{
+		Message:                to.String(deprecation.Message),
+		Replacement:            to.String(deprecation.Replacement),
+		BlockCreateAfterSunset: to.Bool(deprecation.BlockCreateAfterSunset),
+	}
+	if deprecation.SunsetDate != nil {
+		result.SunsetDate = formatSunsetDate(*deprecation.SunsetDate)
+	}
+
+	return result
+}
+
+// formatSunsetDate formats a sunset date as a date if it is at midnight UTC, and as an RFC 3339 timestamp otherwise.
+func formatSunsetDate(sunsetDate time.Time) string {
+	sunsetDate = sunsetDate.UTC()
+	if sunsetDate.Equal(sunsetDate.Truncate(24 * time.Hour)) {
+		return sunsetDate.Format(time.DateOnly)
+	}
+	return sunsetDate.Format(time.RFC3339)
+}
+
+// actionFromAPI converts an action of the UCP API model to the manifest.
+func actionFromAPI(action *v20231001preview.ResourceTypeAction) *Action {
+	result := &Action{
+		Description:  action.Description,
+		InputSchema:  action.InputSchema,
+		OutputSchema: action.OutputSchema,
+	}
+	if action.Recipe != nil {
+		result.Recipe = &ActionRecipe{
+			TemplateKind:    to.String(action.Recipe.TemplateKind),
+			TemplatePath:    to.String(action.Recipe.TemplatePath),
+			TemplateVersion: to.String(action.Recipe.TemplateVersion),
+		}
+	}
+	if action.Callback != nil {
+		result.Callback = &ActionCallback{URL: to.String(action.Callback.URL)}
+	}
+
+	return result
+}
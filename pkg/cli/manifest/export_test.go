@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportResourceProvider(t *testing.T) {
+	clientFactory, err := NewRegisteredTestClientFactory()
+	require.NoError(t, err)
+
+	exported, err := ExportResourceProvider(context.Background(), clientFactory, "local", "MyCompany.Resources")
+	require.NoError(t, err)
+
+	expected, err := ReadFile("testdata/valid.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, exported)
+
+	// The exported manifest can be read back.
+	bs, err := Marshal(exported)
+	require.NoError(t, err)
+	roundTripped, err := ReadBytes(bs)
+	require.NoError(t, err)
+	require.Equal(t, exported, roundTripped)
+}
+
+func TestExportResourceProvider_NotFound(t *testing.T) {
+	clientFactory, err := NewTestClientFactory(WithResourceProviderServerNotFoundError)
+	require.NoError(t, err)
+
+	_, err = ExportResourceProvider(context.Background(), clientFactory, "local", "MyCompany.Resources")
+	require.Error(t, err)
+}
+
+func Test_deprecationFromAPI(t *testing.T) {
+	require.Nil(t, deprecationFromAPI(nil))
+
+	require.Equal(t, &Deprecation{
+		Message:                "Use newResources.",
+		SunsetDate:             "2026-01-01",
+		Replacement:            "MyCompany.Resources/newResources",
+		BlockCreateAfterSunset: true,
+	}, deprecationFromAPI(&v20231001preview.Deprecation{
+		Message:                new("Use newResources."),
+		SunsetDate:             new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Replacement:            new("MyCompany.Resources/newResources"),
+		BlockCreateAfterSunset: new(true),
+	}))
+
+	require.Equal(t, &Deprecation{SunsetDate: "2026-01-01T12:30:00Z"}, deprecationFromAPI(&v20231001preview.Deprecation{
+		SunsetDate: new(time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC)),
+	}))
+}
+
+func Test_conversionFromAPI(t *testing.T) {
+	require.Nil(t, conversionFromAPI(nil))
+
+	conversion := &Conversion{
+		Renames:  []*FieldRename{{From: "size", To: "sku.size"}},
+		Defaults: map[string]any{"replicas": 1},
+	}
+	require.Equal(t, conversion, conversionFromAPI(conversion.ToAPI()))
+}
+
+func Test_actionFromAPI(t *testing.T) {
+	action := &Action{
+		Description: new("Restarts the resource."),
+		InputSchema: map[string]any{"type": "object"},
+		Recipe: &ActionRecipe{
+			TemplateKind:    "terraform",
+			TemplatePath:    "registry.example.com/restart",
+			TemplateVersion: "1.0.0",
+		},
+	}
+	require.Equal(t, action, actionFromAPI(action.ToAPI()))
+
+	callback := &Action{Callback: &ActionCallback{URL: "https://example.com/restart"}}
+	require.Equal(t, callback, actionFromAPI(callback.ToAPI()))
+}
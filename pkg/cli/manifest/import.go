@@ -0,0 +1,681 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// crdKind is the kind of Kubernetes custom resource definitions.
+	crdKind = "CustomResourceDefinition"
+
+	// immutableRule is the CEL rule marking a field of a Kubernetes custom resource as immutable.
+	immutableRule = "self==oldSelf"
+
+	// annotationSensitive marks a property of a Radius schema as sensitive.
+	annotationSensitive = "x-radius-sensitive"
+
+	// annotationImmutable marks a property of a Radius schema as immutable.
+	annotationImmutable = "x-radius-immutable"
+
+	// annotationValidations holds the CEL validation rules of a Radius schema.
+	annotationValidations = "x-radius-validations"
+)
+
+var (
+	// importedKeywords are the schema keywords copied as-is to the imported schema.
+	importedKeywords = []string{
+		"default",
+		"description",
+		"enum",
+		"example",
+		"exclusiveMaximum",
+		"exclusiveMinimum",
+		"format",
+		"maxItems",
+		"maxLength",
+		"maxProperties",
+		"maximum",
+		"minItems",
+		"minLength",
+		"minProperties",
+		"minimum",
+		"multipleOf",
+		"nullable",
+		"pattern",
+		"readOnly",
+		"title",
+		"uniqueItems",
+	}
+
+	// ignoredKeywords are the schema keywords dropped from the imported schema without a warning because they have no
+	// meaning for Radius resources, e.g. the merge strategies of Kubernetes server-side apply.
+	ignoredKeywords = []string{
+		"deprecated",
+		"externalDocs",
+		"x-kubernetes-list-map-keys",
+		"x-kubernetes-list-type",
+		"x-kubernetes-map-type",
+		"x-kubernetes-preserve-unknown-fields",
+		"xml",
+	}
+
+	// sensitiveNameMarkers are the parts of property names that identify secrets, compared case-insensitively.
+	sensitiveNameMarkers = []string{"password", "passwd", "secret", "token", "credential", "privatekey", "apikey", "accesskey", "connectionstring"}
+
+	// nonSensitiveNameSuffixes are the suffixes of property names that reference a secret rather than hold it, e.g.
+	// 'secretName' or 'tokenSecretRef'.
+	nonSensitiveNameSuffixes = []string{"name", "ref", "path", "file"}
+)
+
+// ImportOptions are the options used to import a resource type from a Kubernetes custom resource definition or an
+// OpenAPI document.
+type ImportOptions struct {
+	// Namespace is the namespace of the resource provider of the imported resource type, e.g. 'MyCompany.Resources'.
+	Namespace string
+
+	// ResourceType is the name of the imported resource type. Defaults to the plural name of the custom resource
+	// definition, or to the camelCased name of the OpenAPI schema.
+	ResourceType string
+
+	// APIVersion is the API version of the imported resource type, e.g. '2025-01-01-preview'.
+	APIVersion string
+
+	// CRDVersion is the version of the custom resource definition to import. Defaults to the storage version.
+	CRDVersion string
+
+	// SchemaName is the name of the OpenAPI component schema to import. It can be omitted when the document has a
+	// single schema.
+	SchemaName string
+}
+
+// ImportWarning is a construct of an imported schema that is not supported by Radius, and was changed or dropped.
+type ImportWarning struct {
+	// Path is the path of the property in the imported schema, or empty for the root schema.
+	Path string
+
+	// Message describes how the construct was imported.
+	Message string
+}
+
+// String returns the warning with the path of the property.
+func (w ImportWarning) String() string {
+	if w.Path == "" {
+		return w.Message
+	}
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// Import converts a Kubernetes custom resource definition or an OpenAPI document into a resource provider manifest
+// with a single resource type. The kind of document is detected from its content.
+//
+// The constructs of the schema that are not supported by Radius are changed or dropped, and returned as warnings. An
+// error is returned if the imported schema does not meet the constraints of Radius schemas.
+func Import(ctx context.Context, data []byte, options ImportOptions) (*ResourceProvider, []ImportWarning, error) {
+	document := map[string]any{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case document["kind"] == crdKind:
+		return ImportCRD(ctx, data, options)
+	case document["openapi"] != nil || document["swagger"] != nil:
+		return ImportOpenAPI(ctx, data, options)
+	default:
+		return nil, nil, errors.New("the document must be a Kubernetes CustomResourceDefinition or an OpenAPI document")
+	}
+}
+
+// ImportCRD converts a Kubernetes custom resource definition into a resource provider manifest.
+//
+// The spec of the custom resource becomes the properties of the resource type, and the status of the custom resource
+// becomes read-only properties. Fields made immutable with the 'self == oldSelf' validation rule are marked as
+// immutable, and the validation rules of the spec are kept as validation rules of the resource type.
+func ImportCRD(ctx context.Context, data []byte, options ImportOptions) (*ResourceProvider, []ImportWarning, error) {
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	if err := yaml.Unmarshal(data, &crd); err != nil {
+		return nil, nil, err
+	}
+
+	if crd.Kind != crdKind {
+		return nil, nil, fmt.Errorf("the document must be a Kubernetes %s, got %q", crdKind, crd.Kind)
+	}
+
+	var version *apiextensionsv1.CustomResourceDefinitionVersion
+	for i := range crd.Spec.Versions {
+		if (options.CRDVersion == "" && crd.Spec.Versions[i].Storage) || crd.Spec.Versions[i].Name == options.CRDVersion {
+			version = &crd.Spec.Versions[i]
+			break
+		}
+	}
+
+	if version == nil && options.CRDVersion != "" {
+		return nil, nil, fmt.Errorf("the custom resource definition %q has no version %q", crd.Name, options.CRDVersion)
+	} else if version == nil {
+		return nil, nil, fmt.Errorf("the custom resource definition %q has no storage version", crd.Name)
+	}
+
+	if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+		return nil, nil, fmt.Errorf("version %q of the custom resource definition %q has no schema", version.Name, crd.Name)
+	}
+
+	root, err := toSchemaMap(version.Schema.OpenAPIV3Schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	importer := &schemaImporter{document: root}
+	if _, ok := root["x-kubernetes-validations"]; ok {
+		importer.warn("", "the validation rules of the custom resource were dropped, only the validation rules of its spec are imported")
+	}
+
+	rootProperties, _ := root["properties"].(map[string]any)
+	spec, ok := rootProperties["spec"].(map[string]any)
+	if !ok {
+		importer.warn("", "the custom resource has no spec, the resource type has no properties")
+		spec = map[string]any{"type": "object"}
+	}
+
+	schema := importer.convert("", spec)
+	if status, ok := rootProperties["status"].(map[string]any); ok {
+		importer.importStatus(schema, status)
+	}
+
+	// The description of the spec is usually generic, e.g. "spec defines the desired state", prefer the description
+	// of the custom resource.
+	delete(schema, "description")
+	description, _ := root["description"].(string)
+
+	resourceType := options.ResourceType
+	if resourceType == "" {
+		resourceType = crd.Spec.Names.Plural
+	}
+
+	return importer.finish(ctx, options, resourceType, description, schema)
+}
+
+// ImportOpenAPI converts a component schema of an OpenAPI 3 document, or a definition of a Swagger 2 document, into a
+// resource provider manifest. References to other schemas of the document are inlined.
+func ImportOpenAPI(ctx context.Context, data []byte, options ImportOptions) (*ResourceProvider, []ImportWarning, error) {
+	document := map[string]any{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, nil, err
+	}
+
+	var schemas map[string]any
+	var schemasPath string
+	switch {
+	case document["openapi"] != nil:
+		components, _ := document["components"].(map[string]any)
+		schemas, _ = components["schemas"].(map[string]any)
+		schemasPath = "#/components/schemas/"
+	case document["swagger"] != nil:
+		schemas, _ = document["definitions"].(map[string]any)
+		schemasPath = "#/definitions/"
+	default:
+		return nil, nil, errors.New("the document must be an OpenAPI document")
+	}
+
+	if len(schemas) == 0 {
+		return nil, nil, errors.New("the OpenAPI document has no schemas")
+	}
+
+	schemaName := options.SchemaName
+	if schemaName == "" && len(schemas) > 1 {
+		return nil, nil, fmt.Errorf("the OpenAPI document has several schemas, specify one of: %s", strings.Join(slices.Sorted(maps.Keys(schemas)), ", "))
+	} else if schemaName == "" {
+		schemaName = slices.Collect(maps.Keys(schemas))[0]
+	}
+
+	source, ok := schemas[schemaName].(map[string]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("the OpenAPI document has no schema %q", schemaName)
+	}
+
+	importer := &schemaImporter{document: document, resolving: []string{schemasPath + schemaName}}
+	schema := importer.convert("", source)
+
+	description, _ := schema["description"].(string)
+	delete(schema, "description")
+
+	resourceType := options.ResourceType
+	if resourceType == "" {
+		resourceType = lowerFirst(schemaName)
+	}
+
+	return importer.finish(ctx, options, resourceType, description, schema)
+}
+
+// schemaImporter converts Kubernetes structural schemas and OpenAPI schemas into Radius schemas.
+type schemaImporter struct {
+	// document is the document the references of the schema are resolved in.
+	document map[string]any
+
+	// resolving is the stack of references being resolved, used to detect recursive schemas.
+	resolving []string
+
+	// warnings are the constructs of the schema that were changed or dropped.
+	warnings []ImportWarning
+}
+
+// warn records a construct of the schema that was changed or dropped.
+func (i *schemaImporter) warn(path string, format string, args ...any) {
+	i.warnings = append(i.warnings, ImportWarning{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// convert returns the Radius schema of the source schema at the given path.
+func (i *schemaImporter) convert(path string, source map[string]any) map[string]any {
+	if ref, ok := source["$ref"].(string); ok {
+		result := i.convertRef(path, ref)
+		if description, ok := source["description"]; ok {
+			result["description"] = description
+		}
+		return result
+	}
+
+	result := map[string]any{}
+
+	// allOf is commonly used to compose OpenAPI schemas, the composed object schemas are merged.
+	if allOf, ok := source["allOf"].([]any); ok {
+		for _, item := range allOf {
+			itemSchema, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			mergeSchema(result, i.convert(path, itemSchema))
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(source)) {
+		value := source[key]
+		switch {
+		case key == "allOf":
+			// Merged above.
+		case key == "type":
+			i.convertType(path, value, result)
+		case key == "properties":
+			properties, _ := result["properties"].(map[string]any)
+			if properties == nil {
+				properties = map[string]any{}
+			}
+			sourceProperties, _ := value.(map[string]any)
+			for _, name := range slices.Sorted(maps.Keys(sourceProperties)) {
+				property, ok := sourceProperties[name].(map[string]any)
+				if !ok {
+					i.warn(joinPath(path, name), "the property schema is not an object and was dropped")
+					continue
+				}
+				properties[name] = i.convertProperty(joinPath(path, name), name, property)
+			}
+			result["properties"] = properties
+		case key == "required":
+			required, _ := result["required"].([]any)
+			sourceRequired, _ := value.([]any)
+			for _, name := range sourceRequired {
+				if !slices.Contains(required, name) {
+					required = append(required, name)
+				}
+			}
+			result["required"] = required
+		case key == "items":
+			items, ok := value.(map[string]any)
+			if !ok {
+				i.warn(path, "tuple items are not supported and were dropped")
+				continue
+			}
+			result["items"] = i.convert(joinPath(path, "items"), items)
+		case key == "additionalProperties":
+			switch additionalProperties := value.(type) {
+			case bool:
+				if additionalProperties {
+					i.warn(path, "additionalProperties: true is not supported and was dropped")
+				} else {
+					result["additionalProperties"] = false
+				}
+			case map[string]any:
+				result["additionalProperties"] = i.convert(joinPath(path, "additionalProperties"), additionalProperties)
+			}
+		case key == "anyOf" || key == "oneOf" || key == "not" || key == "discriminator":
+			i.warn(path, "%s is not supported and was dropped", key)
+		case key == "x-kubernetes-int-or-string":
+			if value == true {
+				result["type"] = "string"
+				i.warn(path, "integer-or-string values are imported as strings")
+			}
+		case key == "x-kubernetes-embedded-resource":
+			if value == true {
+				i.warn(path, "embedded Kubernetes resources are imported as objects without validation of apiVersion, kind and metadata")
+			}
+		case key == "x-kubernetes-validations":
+			i.convertValidations(path, value, result)
+		case slices.Contains(importedKeywords, key) || strings.HasPrefix(key, "x-radius-"):
+			result[key] = value
+		case slices.Contains(ignoredKeywords, key):
+			// Dropped without a warning.
+		default:
+			i.warn(path, "%s is not supported and was dropped", key)
+		}
+	}
+
+	if properties, ok := result["properties"].(map[string]any); ok && len(properties) > 0 {
+		if _, ok := result["additionalProperties"].(map[string]any); ok {
+			delete(result, "additionalProperties")
+			i.warn(path, "additionalProperties cannot be combined with properties and was dropped")
+		}
+	}
+
+	return result
+}
+
+// convertProperty returns the Radius schema of a property. String properties holding secrets are marked as sensitive.
+func (i *schemaImporter) convertProperty(path string, name string, source map[string]any) map[string]any {
+	result := i.convert(path, source)
+	if _, ok := result[annotationSensitive]; ok || !isSensitiveProperty(name, result) {
+		return result
+	}
+
+	result[annotationSensitive] = true
+	if result[annotationImmutable] == true {
+		delete(result, annotationImmutable)
+		i.warn(path, "sensitive properties cannot be immutable, the immutability was dropped")
+	}
+
+	return result
+}
+
+// convertRef returns the Radius schema of a reference to another schema of the document. External and recursive
+// references are not supported.
+func (i *schemaImporter) convertRef(path string, ref string) map[string]any {
+	if !strings.HasPrefix(ref, "#/") {
+		i.warn(path, "the external reference %s is not supported and was dropped", ref)
+		return map[string]any{}
+	}
+
+	if slices.Contains(i.resolving, ref) {
+		i.warn(path, "the recursive reference %s is not supported and was replaced by an object", ref)
+		return map[string]any{"type": "object"}
+	}
+
+	var target any = i.document
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		parent, _ := target.(map[string]any)
+		target = parent[token]
+	}
+
+	targetSchema, ok := target.(map[string]any)
+	if !ok {
+		i.warn(path, "the reference %s was not found and was dropped", ref)
+		return map[string]any{}
+	}
+
+	i.resolving = append(i.resolving, ref)
+	defer func() { i.resolving = i.resolving[:len(i.resolving)-1] }()
+
+	return i.convert(path, targetSchema)
+}
+
+// convertType sets the type of the Radius schema. OpenAPI 3.1 type lists are supported when they combine a single type
+// with null.
+func (i *schemaImporter) convertType(path string, value any, result map[string]any) {
+	types, ok := value.([]any)
+	if !ok {
+		result["type"] = value
+		return
+	}
+
+	nonNull := slices.DeleteFunc(slices.Clone(types), func(t any) bool { return t == "null" })
+	if len(nonNull) != 1 {
+		i.warn(path, "a list of types is not supported and was dropped")
+		return
+	}
+
+	result["type"] = nonNull[0]
+	if len(nonNull) < len(types) {
+		result["nullable"] = true
+	}
+}
+
+// convertValidations converts the CEL validation rules of a Kubernetes structural schema. The 'self == oldSelf' rule
+// marks a property as immutable, and the rules of the root schema become validation rules of the resource type. Other
+// rules are dropped.
+func (i *schemaImporter) convertValidations(path string, value any, result map[string]any) {
+	rules, _ := value.([]any)
+	validations := []any{}
+	for _, item := range rules {
+		rule, _ := item.(map[string]any)
+		expression, _ := rule["rule"].(string)
+
+		switch {
+		case strings.Join(strings.Fields(expression), "") == immutableRule && path != "":
+			result[annotationImmutable] = true
+		case path == "":
+			validation := map[string]any{"rule": expression}
+			if message, ok := rule["message"].(string); ok && message != "" {
+				validation["message"] = message
+			}
+			if _, ok := rule["messageExpression"]; ok {
+				i.warn(path, "the message expression of the validation rule %q is not supported and was dropped", expression)
+			}
+			validations = append(validations, validation)
+		default:
+			i.warn(path, "validation rules are only supported on the root schema, the rule %q was dropped", expression)
+		}
+	}
+
+	if len(validations) > 0 {
+		result[annotationValidations] = validations
+	}
+}
+
+// importStatus adds the properties of the status of a custom resource to the schema as read-only properties. They are
+// set by the recipe of the resource.
+func (i *schemaImporter) importStatus(schema map[string]any, status map[string]any) {
+	statusProperties, _ := status["properties"].(map[string]any)
+	if len(statusProperties) == 0 {
+		return
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+		schema["properties"] = properties
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(statusProperties)) {
+		if _, ok := properties[name]; ok {
+			i.warn(joinPath("status", name), "the status property has the same name as a spec property and was dropped")
+			continue
+		}
+
+		property, ok := statusProperties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		converted := i.convertProperty(name, name, property)
+		converted["readOnly"] = true
+		properties[name] = converted
+	}
+}
+
+// finish adds the properties common to all resource types to the schema, and returns the manifest of the resource
+// type validated against the constraints of Radius.
+func (i *schemaImporter) finish(ctx context.Context, options ImportOptions, resourceTypeName string, description string, schema map[string]any) (*ResourceProvider, []ImportWarning, error) {
+	schema["type"] = "object"
+
+	properties, _ := schema["properties"].(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+		schema["properties"] = properties
+	}
+	if _, ok := properties["environment"]; !ok {
+		properties["environment"] = map[string]any{"type": "string", "description": "(Required) The Radius Environment ID."}
+	}
+	if _, ok := properties["application"]; !ok {
+		properties["application"] = map[string]any{"type": "string", "description": "(Optional) The Radius Application ID."}
+	}
+
+	required, _ := schema["required"].([]any)
+	if !slices.Contains(required, any("environment")) {
+		schema["required"] = append([]any{"environment"}, required...)
+	}
+
+	resourceType := &ResourceType{
+		Capabilities: []string{},
+		APIVersions: map[string]*ResourceTypeAPIVersion{
+			options.APIVersion: {Schema: normalizeNumbers(schema)},
+		},
+	}
+	if description != "" {
+		resourceType.Description = &description
+	}
+
+	resourceProvider := &ResourceProvider{
+		Namespace: options.Namespace,
+		Types:     map[string]*ResourceType{resourceTypeName: resourceType},
+	}
+
+	// Round-trip the manifest to validate the namespace, resource type name and API version like a manifest file.
+	bs, err := Marshal(resourceProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := ReadBytes(bs); err != nil {
+		return nil, i.warnings, err
+	}
+
+	if err := validateManifestSchemas(ctx, resourceProvider); err != nil {
+		return nil, i.warnings, fmt.Errorf("the imported schema is not supported by Radius: %w", err)
+	}
+
+	return resourceProvider, i.warnings, nil
+}
+
+// mergeSchema merges the source schema into the destination schema. Properties and required properties are combined,
+// other keywords of the destination schema take precedence.
+func mergeSchema(destination map[string]any, source map[string]any) {
+	for key, value := range source {
+		switch key {
+		case "properties":
+			properties, _ := destination["properties"].(map[string]any)
+			if properties == nil {
+				properties = map[string]any{}
+			}
+			sourceProperties, _ := value.(map[string]any)
+			maps.Copy(properties, sourceProperties)
+			destination["properties"] = properties
+		case "required":
+			required, _ := destination["required"].([]any)
+			sourceRequired, _ := value.([]any)
+			for _, name := range sourceRequired {
+				if !slices.Contains(required, name) {
+					required = append(required, name)
+				}
+			}
+			destination["required"] = required
+		default:
+			if _, ok := destination[key]; !ok {
+				destination[key] = value
+			}
+		}
+	}
+}
+
+// isSensitiveProperty returns true if the property is a string holding a secret, based on its format or its name.
+func isSensitiveProperty(name string, schema map[string]any) bool {
+	if schema["type"] != "string" {
+		return false
+	}
+
+	if schema["format"] == "password" {
+		return true
+	}
+
+	lower := strings.ToLower(name)
+	for _, suffix := range nonSensitiveNameSuffixes {
+		if strings.HasSuffix(lower, suffix) {
+			return false
+		}
+	}
+
+	for _, marker := range sensitiveNameMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeNumbers converts the whole numbers of a schema decoded from JSON to integers, so that they are not written
+// as floating-point numbers in the manifest.
+func normalizeNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeNumbers(item)
+		}
+	case []any:
+		for index, item := range v {
+			v[index] = normalizeNumbers(item)
+		}
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v)
+		}
+	}
+
+	return value
+}
+
+// toSchemaMap converts a Kubernetes structural schema to generic JSON values.
+func toSchemaMap(schema *apiextensionsv1.JSONSchemaProps) (map[string]any, error) {
+	bs, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(bs, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// lowerFirst returns the string with its first letter lowercased.
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	runes := []rune(s)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
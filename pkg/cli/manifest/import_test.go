@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImport(t *testing.T) {
+	tests := []struct {
+		name             string
+		file             string
+		options          ImportOptions
+		expectedManifest string
+		expectedWarnings []ImportWarning
+	}{
+		{
+			name:             "CRD",
+			file:             "testdata/import/crd.yaml",
+			options:          ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01-preview"},
+			expectedManifest: "testdata/import/crd-manifest.yaml",
+			expectedWarnings: []ImportWarning{
+				{Path: "backup", Message: "anyOf is not supported and was dropped"},
+				{Path: "backup.schedule", Message: `validation rules are only supported on the root schema, the rule "self.size() > 0" was dropped`},
+				{Path: "port", Message: "integer-or-string values are imported as strings"},
+			},
+		},
+		{
+			name:             "OpenAPI",
+			file:             "testdata/import/openapi.yaml",
+			options:          ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01-preview", SchemaName: "RedisCache"},
+			expectedManifest: "testdata/import/openapi-manifest.yaml",
+			expectedWarnings: []ImportWarning{
+				{Path: "maxMemory", Message: "oneOf is not supported and was dropped"},
+				{Path: "parent", Message: "the recursive reference #/components/schemas/RedisCache is not supported and was replaced by an object"},
+				{Path: "settings", Message: "additionalProperties: true is not supported and was dropped"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := os.ReadFile(tt.file)
+			require.NoError(t, err)
+
+			imported, warnings, err := Import(context.Background(), data, tt.options)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedWarnings, warnings)
+
+			expected, err := ReadFile(tt.expectedManifest)
+			require.NoError(t, err)
+
+			// Compare the manifests as read from files, numbers are decoded differently.
+			bs, err := Marshal(imported)
+			require.NoError(t, err)
+			actual, err := ReadBytes(bs)
+			require.NoError(t, err)
+			require.Equal(t, expected, actual)
+		})
+	}
+}
+
+func TestImport_Options(t *testing.T) {
+	data, err := os.ReadFile("testdata/import/crd.yaml")
+	require.NoError(t, err)
+
+	imported, _, err := Import(context.Background(), data, ImportOptions{
+		Namespace:    "MyCompany.Resources",
+		ResourceType: "legacyDatabases",
+		APIVersion:   "2024-01-01",
+		CRDVersion:   "v1alpha1",
+	})
+	require.NoError(t, err)
+	require.Contains(t, imported.Types, "legacyDatabases")
+	require.Contains(t, imported.Types["legacyDatabases"].APIVersions, "2024-01-01")
+	require.Equal(t, []any{"environment"}, imported.Types["legacyDatabases"].APIVersions["2024-01-01"].Schema.(map[string]any)["required"])
+}
+
+func TestImport_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		file    string
+		options ImportOptions
+		err     string
+	}{
+		{
+			name:    "unknown document",
+			data:    "kind: Deployment",
+			options: ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01"},
+			err:     "the document must be a Kubernetes CustomResourceDefinition or an OpenAPI document",
+		},
+		{
+			name:    "unknown CRD version",
+			file:    "testdata/import/crd.yaml",
+			options: ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01", CRDVersion: "v2"},
+			err:     `the custom resource definition "databases.example.com" has no version "v2"`,
+		},
+		{
+			name:    "ambiguous OpenAPI schema",
+			file:    "testdata/import/openapi.yaml",
+			options: ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01"},
+			err:     "the OpenAPI document has several schemas, specify one of: RedisCache, Resource, Tls",
+		},
+		{
+			name:    "unknown OpenAPI schema",
+			file:    "testdata/import/openapi.yaml",
+			options: ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01", SchemaName: "Missing"},
+			err:     `the OpenAPI document has no schema "Missing"`,
+		},
+		{
+			name:    "invalid API version",
+			file:    "testdata/import/crd.yaml",
+			options: ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "v1"},
+			err:     "must be a valid API version",
+		},
+		{
+			name: "reserved property",
+			data: `
+openapi: 3.0.0
+components:
+  schemas:
+    Widget:
+      type: object
+      properties:
+        recipe:
+          type: string`,
+			options: ImportOptions{Namespace: "MyCompany.Resources", APIVersion: "2025-01-01"},
+			err:     "property 'recipe' is reserved and cannot be used",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(tt.data)
+			if tt.file != "" {
+				var err error
+				data, err = os.ReadFile(tt.file)
+				require.NoError(t, err)
+			}
+
+			_, _, err := Import(context.Background(), data, tt.options)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func Test_isSensitiveProperty(t *testing.T) {
+	tests := []struct {
+		name      string
+		schema    map[string]any
+		sensitive bool
+	}{
+		{name: "adminPassword", schema: map[string]any{"type": "string"}, sensitive: true},
+		{name: "apiKey", schema: map[string]any{"type": "string"}, sensitive: true},
+		{name: "clientSecret", schema: map[string]any{"type": "string"}, sensitive: true},
+		{name: "value", schema: map[string]any{"type": "string", "format": "password"}, sensitive: true},
+		{name: "secretName", schema: map[string]any{"type": "string"}, sensitive: false},
+		{name: "tokenSecretRef", schema: map[string]any{"type": "string"}, sensitive: false},
+		{name: "credentials", schema: map[string]any{"type": "object"}, sensitive: false},
+		{name: "host", schema: map[string]any{"type": "string"}, sensitive: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.sensitive, isSensitiveProperty(tt.name, tt.schema))
+		})
+	}
+}
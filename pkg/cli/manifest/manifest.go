@@ -16,6 +16,14 @@ limitations under the License.
 
 package manifest
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
 // ResourceProvider represents a resource provider manifest.
 type ResourceProvider struct {
 	// Namespace is the resource provider name. This is also the namespace of the types defined by the resource provider.
@@ -41,6 +49,49 @@ type ResourceType struct {
 
 	// Description of the resource type.
 	Description *string `yaml:"description,omitempty"`
+
+	// Actions is a map of the actions that can be invoked on resources of the resource type, keyed by action name.
+	Actions map[string]*Action `yaml:"actions,omitempty" validate:"dive,keys,actionName,endkeys,required"`
+
+	// Deprecation marks the resource type as deprecated.
+	Deprecation *Deprecation `yaml:"deprecation,omitempty"`
+}
+
+// Action represents an action that can be invoked on resources of a resource type with a POST request, e.g.
+// "restart" or "rotateCredentials". An action is implemented by either a recipe or an HTTP callback.
+type Action struct {
+	// Description of the action.
+	Description *string `yaml:"description,omitempty"`
+
+	// InputSchema is the OpenAPI schema of the action input.
+	InputSchema map[string]any `yaml:"inputSchema,omitempty"`
+
+	// OutputSchema is the OpenAPI schema of the action output.
+	OutputSchema map[string]any `yaml:"outputSchema,omitempty"`
+
+	// Recipe is the recipe implementing the action.
+	Recipe *ActionRecipe `yaml:"recipe,omitempty"`
+
+	// Callback is the HTTP callback implementing the action.
+	Callback *ActionCallback `yaml:"callback,omitempty"`
+}
+
+// ActionRecipe represents the recipe implementing an action.
+type ActionRecipe struct {
+	// TemplateKind is the kind of the recipe template.
+	TemplateKind string `yaml:"templateKind" validate:"required,oneof=bicep terraform"`
+
+	// TemplatePath is the path to the recipe template, e.g. an OCI registry path or a Terraform module source.
+	TemplatePath string `yaml:"templatePath" validate:"required"`
+
+	// TemplateVersion is the version of the Terraform module.
+	TemplateVersion string `yaml:"templateVersion,omitempty"`
+}
+
+// ActionCallback represents the HTTP callback implementing an action.
+type ActionCallback struct {
+	// URL is the URL the action invocation is posted to.
+	URL string `yaml:"url" validate:"required"`
 }
 
 type ResourceTypeAPIVersion struct {
@@ -49,4 +100,136 @@ type ResourceTypeAPIVersion struct {
 	// TODO: this allows anything right now, and will be ignored. We'll improve this in
 	// a future pull-request.
 	Schema any `yaml:"schema" validate:"required"`
+
+	// Conversion defines how resources are converted between this API version and the default API version of the
+	// resource type. Resources of an API version with conversion rules are stored in the default API version.
+	Conversion *Conversion `yaml:"conversion,omitempty"`
+
+	// Deprecation marks the API version as deprecated.
+	Deprecation *Deprecation `yaml:"deprecation,omitempty"`
+}
+
+// Deprecation represents the deprecation of a resource type or API version. Users of a deprecated resource type or
+// API version are warned until its sunset date, after which the creation of new resources can be rejected.
+type Deprecation struct {
+	// Message is shown to the users of the deprecated resource type or API version, e.g. how to migrate.
+	Message string `yaml:"message,omitempty"`
+
+	// SunsetDate is the date after which the resource type or API version is no longer supported, e.g. "2026-01-01"
+	// or "2026-01-01T00:00:00Z".
+	SunsetDate string `yaml:"sunsetDate,omitempty"`
+
+	// Replacement is the resource type or API version replacing the deprecated one.
+	Replacement string `yaml:"replacement,omitempty"`
+
+	// BlockCreateAfterSunset rejects the creation of new resources after the sunset date. Existing resources can
+	// still be updated and deleted.
+	BlockCreateAfterSunset bool `yaml:"blockCreateAfterSunset,omitempty"`
+}
+
+// Conversion represents the rules used to convert resources between an API version and the default API version.
+type Conversion struct {
+	// Renames is the list of fields renamed or moved in the default API version. Renames are applied in order when
+	// converting to the default API version, and in reverse order when converting from it.
+	Renames []*FieldRename `yaml:"renames,omitempty" validate:"dive,required"`
+
+	// Defaults is the default values of the fields only defined in the default API version, keyed by field path.
+	// Defaults are applied when converting to the default API version and the field is not set in the request or in the
+	// stored resource. The fields are removed when converting from the default API version.
+	Defaults map[string]any `yaml:"defaults,omitempty"`
+}
+
+// FieldRename represents a field renamed or moved between an API version and the default API version. Paths are
+// relative to the resource properties, with nested fields separated by '.'.
+type FieldRename struct {
+	// From is the path of the field in the API version.
+	From string `yaml:"from" validate:"required"`
+
+	// To is the path of the field in the default API version.
+	To string `yaml:"to" validate:"required"`
+}
+
+// ToAPI converts the conversion rules to the UCP API model.
+func (c *Conversion) ToAPI() *v20231001preview.APIVersionConversion {
+	if c == nil {
+		return nil
+	}
+
+	result := &v20231001preview.APIVersionConversion{
+		Defaults: c.Defaults,
+	}
+	for _, rename := range c.Renames {
+		result.Renames = append(result.Renames, &v20231001preview.FieldRename{
+			From: to.Ptr(rename.From),
+			To:   to.Ptr(rename.To),
+		})
+	}
+
+	return result
+}
+
+// ParseSunsetDate parses the sunset date of the deprecation. It returns nil if the sunset date is not set.
+func (d *Deprecation) ParseSunsetDate() (*time.Time, error) {
+	if d == nil || d.SunsetDate == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if sunsetDate, err := time.Parse(layout, d.SunsetDate); err == nil {
+			return &sunsetDate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("sunsetDate %q must be a date such as 2026-01-01 or an RFC 3339 timestamp", d.SunsetDate)
+}
+
+// ToAPI converts the deprecation to the UCP API model. An invalid sunset date is ignored, the manifest is expected to
+// be validated first.
+func (d *Deprecation) ToAPI() *v20231001preview.Deprecation {
+	if d == nil {
+		return nil
+	}
+
+	result := &v20231001preview.Deprecation{}
+	if d.Message != "" {
+		result.Message = to.Ptr(d.Message)
+	}
+	if d.Replacement != "" {
+		result.Replacement = to.Ptr(d.Replacement)
+	}
+	if d.BlockCreateAfterSunset {
+		result.BlockCreateAfterSunset = to.Ptr(true)
+	}
+	result.SunsetDate, _ = d.ParseSunsetDate()
+
+	return result
+}
+
+// ToAPI converts the action to the UCP API model.
+func (a *Action) ToAPI() *v20231001preview.ResourceTypeAction {
+	if a == nil {
+		return nil
+	}
+
+	result := &v20231001preview.ResourceTypeAction{
+		Description:  a.Description,
+		InputSchema:  a.InputSchema,
+		OutputSchema: a.OutputSchema,
+	}
+	if a.Recipe != nil {
+		result.Recipe = &v20231001preview.ActionRecipe{
+			TemplateKind: to.Ptr(a.Recipe.TemplateKind),
+			TemplatePath: to.Ptr(a.Recipe.TemplatePath),
+		}
+		if a.Recipe.TemplateVersion != "" {
+			result.Recipe.TemplateVersion = to.Ptr(a.Recipe.TemplateVersion)
+		}
+	}
+	if a.Callback != nil {
+		result.Callback = &v20231001preview.ActionCallback{
+			URL: to.Ptr(a.Callback.URL),
+		}
+	}
+
+	return result
 }
@@ -18,6 +18,7 @@ package manifest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +47,38 @@ func TestReadFileYAML(t *testing.T) {
 	require.Equal(t, expected, result)
 }
 
+func TestReadFile_ConversionYAML(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				DefaultAPIVersion: new("2025-01-01"),
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2024-01-01": {
+						Schema: map[string]any{},
+						Conversion: &Conversion{
+							Renames:  []*FieldRename{{From: "size", To: "sku.size"}},
+							Defaults: map[string]any{"sku.tier": "standard"},
+						},
+					},
+					"2025-01-01": {
+						Schema: map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/conversion.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	conversion := result.Types["testResources"].APIVersions["2024-01-01"].Conversion.ToAPI()
+	require.Equal(t, "size", *conversion.Renames[0].From)
+	require.Equal(t, "sku.size", *conversion.Renames[0].To)
+	require.Equal(t, map[string]any{"sku.tier": "standard"}, conversion.Defaults)
+}
+
 func TestReadFile_InvalidYAML(t *testing.T) {
 	// Errors in the yaml library are non-exported, so it's hard to test the exact error.
 	result, err := ReadFile("testdata/invalid-yaml.yaml")
@@ -93,3 +126,93 @@ func TestReadFile_MissingRequiredFieldJSON(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestReadFile_ActionsYAML(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01": {
+						Schema: map[string]any{},
+					},
+				},
+				Actions: map[string]*Action{
+					"backup": {
+						Description: new("Backs up the resource."),
+						InputSchema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"retentionDays": map[string]any{"type": "integer"},
+							},
+						},
+						Recipe: &ActionRecipe{
+							TemplateKind:    "terraform",
+							TemplatePath:    "git::https://github.com/example/backup.git",
+							TemplateVersion: "1.0.0",
+						},
+					},
+					"restart": {
+						Callback: &ActionCallback{URL: "https://example.com/restart"},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/actions.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	backup := result.Types["testResources"].Actions["backup"].ToAPI()
+	require.Equal(t, "terraform", *backup.Recipe.TemplateKind)
+	require.Equal(t, "1.0.0", *backup.Recipe.TemplateVersion)
+	require.Nil(t, backup.Callback)
+
+	restart := result.Types["testResources"].Actions["restart"].ToAPI()
+	require.Equal(t, "https://example.com/restart", *restart.Callback.URL)
+	require.Nil(t, restart.Recipe)
+}
+
+func TestReadFile_DeprecationYAML(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"oldResources": {
+				DefaultAPIVersion: new("2025-01-01"),
+				Deprecation: &Deprecation{
+					Message:                "See https://example.com/migrate for the migration guide.",
+					SunsetDate:             "2026-01-01",
+					Replacement:            "MyCompany.Resources/newResources",
+					BlockCreateAfterSunset: true,
+				},
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2024-01-01": {
+						Schema: map[string]any{},
+						Deprecation: &Deprecation{
+							SunsetDate:  "2025-06-01T00:00:00Z",
+							Replacement: "2025-01-01",
+						},
+					},
+					"2025-01-01": {
+						Schema: map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/deprecation.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+
+	deprecation := result.Types["oldResources"].Deprecation.ToAPI()
+	require.Equal(t, "MyCompany.Resources/newResources", *deprecation.Replacement)
+	require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *deprecation.SunsetDate)
+	require.True(t, *deprecation.BlockCreateAfterSunset)
+
+	deprecation = result.Types["oldResources"].APIVersions["2024-01-01"].Deprecation.ToAPI()
+	require.Equal(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), *deprecation.SunsetDate)
+	require.Nil(t, deprecation.Message)
+	require.Nil(t, deprecation.BlockCreateAfterSunset)
+}
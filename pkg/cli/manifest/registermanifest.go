@@ -79,6 +79,8 @@ func RegisterResourceProvider(ctx context.Context, clientFactory *v20231001previ
 					Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
 					DefaultAPIVersion: resourceType.DefaultAPIVersion,
 					Description:       resourceType.Description,
+					Actions:           actionsToAPI(resourceType.Actions),
+					Deprecation:       resourceType.Deprecation.ToAPI(),
 				},
 			}, nil)
 			if err != nil {
@@ -101,10 +103,14 @@ func RegisterResourceProvider(ctx context.Context, clientFactory *v20231001previ
 		for apiVersionName := range resourceType.APIVersions {
 			logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Namespace, resourceTypeName, apiVersionName)
 			schema := resourceType.APIVersions[apiVersionName].Schema.(map[string]any)
+			conversion := resourceType.APIVersions[apiVersionName].Conversion.ToAPI()
+			deprecation := resourceType.APIVersions[apiVersionName].Deprecation.ToAPI()
 			err = retryOperation(ctx, func() error {
 				apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, resourceTypeName, apiVersionName, v20231001preview.APIVersionResource{
 					Properties: &v20231001preview.APIVersionProperties{
-						Schema: schema,
+						Schema:      schema,
+						Conversion:  conversion,
+						Deprecation: deprecation,
 					},
 				}, nil)
 				if err != nil {
@@ -237,6 +243,8 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 				Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
 				DefaultAPIVersion: resourceType.DefaultAPIVersion,
 				Description:       resourceType.Description,
+				Actions:           actionsToAPI(resourceType.Actions),
+				Deprecation:       resourceType.Deprecation.ToAPI(),
 			},
 		}, nil)
 		if err != nil {
@@ -255,10 +263,14 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 
 	for apiVersionName := range resourceType.APIVersions {
 		schema := resourceType.APIVersions[apiVersionName].Schema.(map[string]any)
+		conversion := resourceType.APIVersions[apiVersionName].Conversion.ToAPI()
+		deprecation := resourceType.APIVersions[apiVersionName].Deprecation.ToAPI()
 		logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Namespace, typeName, apiVersionName)
 		apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, typeName, apiVersionName, v20231001preview.APIVersionResource{
 			Properties: &v20231001preview.APIVersionProperties{
-				Schema: schema,
+				Schema:      schema,
+				Conversion:  conversion,
+				Deprecation: deprecation,
 			},
 		}, nil)
 		if err != nil {
@@ -362,9 +374,37 @@ func ValidateManifest(ctx context.Context, path string) (resourceProvider *Resou
 		return nil, fmt.Errorf("failed to validate manifest schemas: %w", err)
 	}
 
+	if err := validateManifestConversions(resourceProvider); err != nil {
+		return nil, fmt.Errorf("failed to validate manifest conversions: %w", err)
+	}
+
+	if err := validateManifestActions(ctx, resourceProvider); err != nil {
+		return nil, fmt.Errorf("failed to validate manifest actions: %w", err)
+	}
+
+	if err := validateManifestDeprecations(resourceProvider); err != nil {
+		return nil, fmt.Errorf("failed to validate manifest deprecations: %w", err)
+	}
+
 	return resourceProvider, nil
 }
 
+// actionsToAPI converts the actions of a resource type to the UCP API model.
+func actionsToAPI(actions map[string]*Action) map[string]*v20231001preview.ResourceTypeAction {
+	if actions == nil {
+		return nil
+	}
+
+	result := map[string]*v20231001preview.ResourceTypeAction{}
+	for name, action := range actions {
+		if action != nil {
+			result[name] = action.ToAPI()
+		}
+	}
+
+	return result
+}
+
 // extractLocationInfo extracts location name and address from resource provider
 func extractLocationInfo(resourceProvider ResourceProvider) (string, string) {
 	var locationName string
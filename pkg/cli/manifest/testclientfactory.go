@@ -324,3 +324,96 @@ func WithResourceProviderServerInternalError() ucpfake.ResourceProvidersServer {
 	}
 	return resourceProvidersServerInternalError
 }
+
+// NewRegisteredTestClientFactory creates a new client factory for testing purposes that serves the resource provider
+// registered from testdata/valid.yaml.
+func NewRegisteredTestClientFactory() (*v20231001preview.ClientFactory, error) {
+	serverFactory := ucpfake.ServerFactory{
+		ResourceProvidersServer: WithResourceProviderServerNoError(),
+		ResourceTypesServer:     WithRegisteredResourceTypeServer(),
+		APIVersionsServer:       WithRegisteredAPIVersionServer(),
+		LocationsServer:         WithRegisteredLocationServer(),
+	}
+
+	clientOptions := &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: ucpfake.NewServerFactoryTransport(&serverFactory),
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&azfake.TokenCredential{}, clientOptions)
+}
+
+func WithRegisteredLocationServer() ucpfake.LocationsServer {
+	locationsServer := WithLocationServerNoError()
+	locationsServer.NewListPager = func(
+		planeName string,
+		resourceProviderName string,
+		options *v20231001preview.LocationsClientListOptions,
+	) (resp azfake.PagerResponder[v20231001preview.LocationsClientListResponse]) {
+		resp.AddPage(http.StatusOK, v20231001preview.LocationsClientListResponse{
+			LocationResourceListResult: v20231001preview.LocationResourceListResult{
+				Value: []*v20231001preview.LocationResource{
+					{
+						Name: new("global"),
+						Properties: &v20231001preview.LocationProperties{
+							Address: new("http://localhost:8080"),
+						},
+					},
+				},
+			},
+		}, nil)
+		return
+	}
+	return locationsServer
+}
+
+func WithRegisteredResourceTypeServer() ucpfake.ResourceTypesServer {
+	resourceTypesServer := WithResourceTypeServerNoError()
+	resourceTypesServer.NewListPager = func(
+		planeName string,
+		resourceProviderName string,
+		options *v20231001preview.ResourceTypesClientListOptions,
+	) (resp azfake.PagerResponder[v20231001preview.ResourceTypesClientListResponse]) {
+		resp.AddPage(http.StatusOK, v20231001preview.ResourceTypesClientListResponse{
+			ResourceTypeResourceListResult: v20231001preview.ResourceTypeResourceListResult{
+				Value: []*v20231001preview.ResourceTypeResource{
+					{
+						Name: new("testResources"),
+						Properties: &v20231001preview.ResourceTypeProperties{
+							Capabilities: []*string{new("ManualResourceProvisioning")},
+							Description:  new("This is a test resource type."),
+						},
+					},
+				},
+			},
+		}, nil)
+		return
+	}
+	return resourceTypesServer
+}
+
+func WithRegisteredAPIVersionServer() ucpfake.APIVersionsServer {
+	apiVersionsServer := WithAPIVersionServerNoError()
+	apiVersionsServer.NewListPager = func(
+		planeName string,
+		resourceProviderName string,
+		resourceTypeName string,
+		options *v20231001preview.APIVersionsClientListOptions,
+	) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+		resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+			APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+				Value: []*v20231001preview.APIVersionResource{
+					{
+						Name: new("2025-01-01-preview"),
+						Properties: &v20231001preview.APIVersionProperties{
+							Schema: map[string]any{},
+						},
+					},
+				},
+			},
+		}, nil)
+		return
+	}
+	return apiVersionsServer
+}
@@ -19,6 +19,7 @@ package manifest
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
 
 	"github.com/go-playground/validator/v10"
@@ -30,11 +31,13 @@ var (
 	resourceTypeRegex              = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
 	apiVersionRegex                = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
 	capabilityRegex                = regexp.MustCompile(`^[A-Z][A-Za-z0-9]+$`)
+	actionNameRegex                = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
 
 	resourceProviderNamespaceMessage = "{0} must be a valid resource provider namespace. A resource provider namespace must contain two PascalCased segments separated by a '.'. Example: MyCompany.Resources"
 	resourceTypeMessage              = "{0} must be a valid resource type. A resource type should be camelCased. Example: myResourceType"
 	apiVersionMessage                = "{0} must be a valid API version. An API version must be a date in YYYY-MM-DD format, and may optionally have the suffix '-preview'. Example: 2025-01-01"
 	capabilityMessage                = "{0} must be a valid capability. A capability should use PascalCase. Example: MyCapability"
+	actionNameMessage                = "{0} must be a valid action name. An action name should be camelCased. Example: rotateCredentials"
 )
 
 func resourceProviderNamespace(fl validator.FieldLevel) bool {
@@ -57,6 +60,11 @@ func validateCapability(fl validator.FieldLevel) bool {
 	return capabilityRegex.Match([]byte(str))
 }
 
+func validateActionName(fl validator.FieldLevel) bool {
+	str := fl.Field().String()
+	return actionNameRegex.Match([]byte(str))
+}
+
 // validateManifestSchemas validates schemas in a ResourceProvider
 func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) error {
 	if provider == nil {
@@ -99,3 +107,148 @@ func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) er
 
 	return nil
 }
+
+// validateManifestConversions validates the conversion rules declared by the API versions in a ResourceProvider.
+// Conversion rules convert resources to the default API version of the resource type, so the resource type must set
+// a default API version, and the default API version cannot declare conversion rules.
+func validateManifestConversions(provider *ResourceProvider) error {
+	if provider == nil {
+		return fmt.Errorf("provider is nil")
+	}
+
+	errors := &schema.ValidationErrors{}
+
+	for resourceTypeName, resourceType := range provider.Types {
+		for apiVersion, versionInfo := range resourceType.APIVersions {
+			if versionInfo.Conversion == nil {
+				continue
+			}
+
+			conversionPath := fmt.Sprintf("%s/%s@%s.conversion", provider.Namespace, resourceTypeName, apiVersion)
+
+			switch {
+			case resourceType.DefaultAPIVersion == nil:
+				errors.Add(schema.NewSchemaError(conversionPath, "conversion rules require the resource type to set defaultApiVersion"))
+				continue
+			case *resourceType.DefaultAPIVersion == apiVersion:
+				errors.Add(schema.NewSchemaError(conversionPath, "the default API version cannot declare conversion rules"))
+				continue
+			case resourceType.APIVersions[*resourceType.DefaultAPIVersion] == nil:
+				errors.Add(schema.NewSchemaError(conversionPath, fmt.Sprintf("the default API version %s is not defined", *resourceType.DefaultAPIVersion)))
+				continue
+			}
+
+			if err := schema.ValidateConversion(versionInfo.Conversion.ToAPI()); err != nil {
+				errors.Add(schema.NewSchemaError(conversionPath, err.Error()))
+			}
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+// validateManifestDeprecations validates the deprecation of the resource types and API versions in a
+// ResourceProvider. The sunset date must be a valid date, and is required to block the creation of new resources.
+func validateManifestDeprecations(provider *ResourceProvider) error {
+	if provider == nil {
+		return fmt.Errorf("provider is nil")
+	}
+
+	errors := &schema.ValidationErrors{}
+
+	validate := func(path string, deprecation *Deprecation) {
+		if deprecation == nil {
+			return
+		}
+
+		sunsetDate, err := deprecation.ParseSunsetDate()
+		if err != nil {
+			errors.Add(schema.NewSchemaError(path, err.Error()))
+			return
+		}
+
+		if deprecation.BlockCreateAfterSunset && sunsetDate == nil {
+			errors.Add(schema.NewSchemaError(path, "blockCreateAfterSunset requires a sunsetDate"))
+		}
+	}
+
+	for resourceTypeName, resourceType := range provider.Types {
+		validate(fmt.Sprintf("%s/%s.deprecation", provider.Namespace, resourceTypeName), resourceType.Deprecation)
+
+		for apiVersion, versionInfo := range resourceType.APIVersions {
+			validate(fmt.Sprintf("%s/%s@%s.deprecation", provider.Namespace, resourceTypeName, apiVersion), versionInfo.Deprecation)
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+// validateManifestActions validates the actions declared by the resource types in a ResourceProvider. Each action
+// must be implemented by exactly one of a recipe or an HTTP callback, and its input and output schemas must be valid
+// OpenAPI schemas.
+func validateManifestActions(ctx context.Context, provider *ResourceProvider) error {
+	if provider == nil {
+		return fmt.Errorf("provider is nil")
+	}
+
+	errors := &schema.ValidationErrors{}
+
+	for resourceTypeName, resourceType := range provider.Types {
+		for actionName, action := range resourceType.Actions {
+			if action == nil {
+				continue
+			}
+
+			actionPath := fmt.Sprintf("%s/%s.actions.%s", provider.Namespace, resourceTypeName, actionName)
+
+			if (action.Recipe == nil) == (action.Callback == nil) {
+				errors.Add(schema.NewSchemaError(actionPath, "an action must set exactly one of recipe or callback"))
+			}
+
+			if action.Callback != nil {
+				u, err := url.Parse(action.Callback.URL)
+				if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					errors.Add(schema.NewSchemaError(actionPath+".callback.url", "callback url must be an absolute http or https URL"))
+				}
+			}
+
+			for _, actionSchema := range []struct {
+				name string
+				data map[string]any
+			}{
+				{name: "inputSchema", data: action.InputSchema},
+				{name: "outputSchema", data: action.OutputSchema},
+			} {
+				if actionSchema.data == nil {
+					continue
+				}
+
+				schemaPath := actionPath + "." + actionSchema.name
+
+				openAPISchema, err := schema.ConvertToOpenAPISchema(actionSchema.data)
+				if err != nil {
+					errors.Add(schema.NewSchemaError(schemaPath, fmt.Sprintf("failed to parse schema: %v", err)))
+					continue
+				}
+
+				if err := openAPISchema.Validate(ctx); err != nil {
+					errors.Add(schema.NewSchemaError(schemaPath, err.Error()))
+				}
+			}
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
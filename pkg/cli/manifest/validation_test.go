@@ -282,6 +282,61 @@ func TestCapabilityValidation(t *testing.T) {
 	}
 }
 
+func TestActionNameValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		actionName string
+		valid      bool
+	}{
+		{
+			name:       "valid action name",
+			actionName: "restart",
+			valid:      true,
+		},
+		{
+			name:       "valid camelCase",
+			actionName: "rotateCredentials",
+			valid:      true,
+		},
+		{
+			name:       "invalid - starts with uppercase",
+			actionName: "Restart",
+			valid:      false,
+		},
+		{
+			name:       "invalid - special characters",
+			actionName: "rotate-credentials",
+			valid:      false,
+		},
+		{
+			name:       "invalid - empty",
+			actionName: "",
+			valid:      false,
+		},
+	}
+
+	v := validator.New()
+	err := v.RegisterValidation("actionName", validateActionName)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testStruct := struct {
+				Action string `validate:"actionName"`
+			}{
+				Action: tt.actionName,
+			}
+
+			err := v.Struct(testStruct)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateManifestSchemas(t *testing.T) {
 	ctx := context.Background()
 
@@ -495,3 +550,183 @@ func TestValidateManifestSchemas(t *testing.T) {
 		require.Len(t, validationErrors.Errors, 2)
 	})
 }
+
+func TestValidateManifestConversions(t *testing.T) {
+	conversion := &Conversion{
+		Renames: []*FieldRename{{From: "size", To: "sku.size"}},
+	}
+
+	newProvider := func(defaultAPIVersion *string, apiVersions map[string]*ResourceTypeAPIVersion) *ResourceProvider {
+		return &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					DefaultAPIVersion: defaultAPIVersion,
+					APIVersions:       apiVersions,
+				},
+			},
+		}
+	}
+
+	t.Run("nil provider", func(t *testing.T) {
+		err := validateManifestConversions(nil)
+		require.ErrorContains(t, err, "provider is nil")
+	})
+
+	t.Run("valid conversion", func(t *testing.T) {
+		provider := newProvider(new("2025-01-01"), map[string]*ResourceTypeAPIVersion{
+			"2024-01-01": {Schema: map[string]any{}, Conversion: conversion},
+			"2025-01-01": {Schema: map[string]any{}},
+		})
+		err := validateManifestConversions(provider)
+		require.NoError(t, err)
+	})
+
+	t.Run("missing default API version", func(t *testing.T) {
+		provider := newProvider(nil, map[string]*ResourceTypeAPIVersion{
+			"2024-01-01": {Schema: map[string]any{}, Conversion: conversion},
+		})
+		err := validateManifestConversions(provider)
+		require.ErrorContains(t, err, "conversion rules require the resource type to set defaultApiVersion")
+		require.ErrorContains(t, err, "Test.Provider/widgets@2024-01-01.conversion")
+	})
+
+	t.Run("conversion on default API version", func(t *testing.T) {
+		provider := newProvider(new("2024-01-01"), map[string]*ResourceTypeAPIVersion{
+			"2024-01-01": {Schema: map[string]any{}, Conversion: conversion},
+		})
+		err := validateManifestConversions(provider)
+		require.ErrorContains(t, err, "the default API version cannot declare conversion rules")
+	})
+
+	t.Run("undefined default API version", func(t *testing.T) {
+		provider := newProvider(new("2025-01-01"), map[string]*ResourceTypeAPIVersion{
+			"2024-01-01": {Schema: map[string]any{}, Conversion: conversion},
+		})
+		err := validateManifestConversions(provider)
+		require.ErrorContains(t, err, "the default API version 2025-01-01 is not defined")
+	})
+
+	t.Run("invalid field path", func(t *testing.T) {
+		provider := newProvider(new("2025-01-01"), map[string]*ResourceTypeAPIVersion{
+			"2024-01-01": {Schema: map[string]any{}, Conversion: &Conversion{Renames: []*FieldRename{{From: "size", To: "status.size"}}}},
+			"2025-01-01": {Schema: map[string]any{}},
+		})
+		err := validateManifestConversions(provider)
+		require.ErrorContains(t, err, "must not reference the reserved property \"status\"")
+	})
+}
+
+func TestValidateManifestActions(t *testing.T) {
+	newProvider := func(actions map[string]*Action) *ResourceProvider {
+		return &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{"2024-01-01": {Schema: map[string]any{}}},
+					Actions:     actions,
+				},
+			},
+		}
+	}
+
+	t.Run("nil provider", func(t *testing.T) {
+		err := validateManifestActions(context.Background(), nil)
+		require.ErrorContains(t, err, "provider is nil")
+	})
+
+	t.Run("valid actions", func(t *testing.T) {
+		provider := newProvider(map[string]*Action{
+			"backup": {
+				InputSchema: map[string]any{"type": "object", "properties": map[string]any{"retentionDays": map[string]any{"type": "integer"}}},
+				Recipe:      &ActionRecipe{TemplateKind: "bicep", TemplatePath: "ghcr.io/example/backup:latest"},
+			},
+			"restart": {
+				Callback: &ActionCallback{URL: "https://example.com/restart"},
+			},
+		})
+		err := validateManifestActions(context.Background(), provider)
+		require.NoError(t, err)
+	})
+
+	t.Run("no implementation", func(t *testing.T) {
+		provider := newProvider(map[string]*Action{"restart": {}})
+		err := validateManifestActions(context.Background(), provider)
+		require.ErrorContains(t, err, "Test.Provider/widgets.actions.restart")
+		require.ErrorContains(t, err, "an action must set exactly one of recipe or callback")
+	})
+
+	t.Run("recipe and callback", func(t *testing.T) {
+		provider := newProvider(map[string]*Action{
+			"restart": {
+				Recipe:   &ActionRecipe{TemplateKind: "bicep", TemplatePath: "ghcr.io/example/restart:latest"},
+				Callback: &ActionCallback{URL: "https://example.com/restart"},
+			},
+		})
+		err := validateManifestActions(context.Background(), provider)
+		require.ErrorContains(t, err, "an action must set exactly one of recipe or callback")
+	})
+
+	t.Run("relative callback url", func(t *testing.T) {
+		provider := newProvider(map[string]*Action{
+			"restart": {Callback: &ActionCallback{URL: "/restart"}},
+		})
+		err := validateManifestActions(context.Background(), provider)
+		require.ErrorContains(t, err, "Test.Provider/widgets.actions.restart.callback.url")
+		require.ErrorContains(t, err, "callback url must be an absolute http or https URL")
+	})
+
+	t.Run("invalid input schema", func(t *testing.T) {
+		provider := newProvider(map[string]*Action{
+			"restart": {
+				InputSchema: map[string]any{"type": "not-a-type"},
+				Callback:    &ActionCallback{URL: "https://example.com/restart"},
+			},
+		})
+		err := validateManifestActions(context.Background(), provider)
+		require.ErrorContains(t, err, "Test.Provider/widgets.actions.restart.inputSchema")
+	})
+}
+
+func TestValidateManifestDeprecations(t *testing.T) {
+	newProvider := func(resourceTypeDeprecation *Deprecation, apiVersionDeprecation *Deprecation) *ResourceProvider {
+		return &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					Deprecation: resourceTypeDeprecation,
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2025-01-01": {Schema: map[string]any{}, Deprecation: apiVersionDeprecation},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("nil provider", func(t *testing.T) {
+		err := validateManifestDeprecations(nil)
+		require.ErrorContains(t, err, "provider is nil")
+	})
+
+	t.Run("valid deprecation", func(t *testing.T) {
+		provider := newProvider(
+			&Deprecation{SunsetDate: "2026-01-01", BlockCreateAfterSunset: true},
+			&Deprecation{Replacement: "2026-01-01"})
+		err := validateManifestDeprecations(provider)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid sunset date", func(t *testing.T) {
+		provider := newProvider(&Deprecation{SunsetDate: "next year"}, nil)
+		err := validateManifestDeprecations(provider)
+		require.ErrorContains(t, err, "Test.Provider/widgets.deprecation")
+		require.ErrorContains(t, err, `sunsetDate "next year" must be a date such as 2026-01-01 or an RFC 3339 timestamp`)
+	})
+
+	t.Run("block create without sunset date", func(t *testing.T) {
+		provider := newProvider(nil, &Deprecation{BlockCreateAfterSunset: true})
+		err := validateManifestDeprecations(provider)
+		require.ErrorContains(t, err, "Test.Provider/widgets@2025-01-01.deprecation")
+		require.ErrorContains(t, err, "blockCreateAfterSunset requires a sunsetDate")
+	})
+}
@@ -128,6 +128,35 @@ func GetGenericResourceTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetResourceConditionsTableFormat returns the fields to output from the conditions of a resource.
+// This function should be used with the Go type clients.ResourceCondition.
+func GetResourceConditionsTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "CONDITION",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+			{
+				Heading:  "REASON",
+				JSONPath: "{ .Reason }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+			{
+				Heading:  "LAST TRANSITION",
+				JSONPath: "{ .LastTransitionTime }",
+			},
+		},
+	}
+}
+
 func GetRecipesForEnvironmentTableFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
@@ -151,6 +180,57 @@ func GetRecipesForEnvironmentTableFormat() output.FormatterOptions {
 	}
 }
 
+func GetResolvedRecipesTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:     "RECIPE PACK",
+				JSONPath:    "{ .RecipePack }",
+				Transformer: &ResourceIDToResourceNameTransformer{},
+			},
+			{
+				Heading:  "LAYER",
+				JSONPath: "{ .Layer }",
+			},
+			{
+				Heading:  "RECIPE KIND",
+				JSONPath: "{ .RecipeKind }",
+			},
+			{
+				Heading:  "RECIPE LOCATION",
+				JSONPath: "{ .RecipeLocation }",
+			},
+		},
+	}
+}
+
+func GetResolvedRecipeParametersTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "PARAMETER",
+				JSONPath: "{ .Parameter }",
+			},
+			{
+				Heading:  "VALUE",
+				JSONPath: "{ .Value }",
+			},
+			{
+				Heading:  "SOURCE",
+				JSONPath: "{ .Source }",
+			},
+		},
+	}
+}
+
 func GetProvidersForEnvironmentTableFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
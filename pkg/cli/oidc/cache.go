@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// Cache stores tokens on the local file system, one file per issuer and client ID. Files are only readable by the
+// current user.
+type Cache struct {
+	// Directory is the directory containing the token files.
+	Directory string
+}
+
+// NewDefaultCache returns a cache that stores tokens in '~/.rad/tokens'.
+func NewDefaultCache() (*Cache, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("could not find home directory: %w", err)
+	}
+
+	return &Cache{Directory: filepath.Join(home, ".rad", "tokens")}, nil
+}
+
+type cachedToken struct {
+	AccessToken  string    `json:"accessToken"`
+	IDToken      string    `json:"idToken,omitempty"`
+	RefreshToken string    `json:"refreshToken,omitempty"`
+	TokenType    string    `json:"tokenType,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Load reads the token for the configuration. ErrNotLoggedIn is returned if there is no token.
+func (c *Cache) Load(config Config) (*oauth2.Token, error) {
+	b, err := os.ReadFile(c.path(config))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("no token found for %q: %w", config.Issuer, ErrNotLoggedIn)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read token: %w", err)
+	}
+
+	cached := cachedToken{}
+	err = json.Unmarshal(b, &cached)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	token := &oauth2.Token{
+		AccessToken:  cached.AccessToken,
+		RefreshToken: cached.RefreshToken,
+		TokenType:    cached.TokenType,
+		Expiry:       cached.Expiry,
+	}
+	return token.WithExtra(map[string]any{"id_token": cached.IDToken}), nil
+}
+
+// Save writes the token for the configuration, replacing any existing token.
+func (c *Cache) Save(config Config, token *oauth2.Token) error {
+	cached := cachedToken{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		Expiry:       token.Expiry,
+	}
+	if idToken, ok := token.Extra("id_token").(string); ok {
+		cached.IDToken = idToken
+	}
+
+	b, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(c.Directory, 0700)
+	if err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+
+	err = os.WriteFile(c.path(config), b, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write token: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cache) path(config Config) string {
+	hash := sha256.Sum256([]byte(config.Issuer + "|" + config.ClientID))
+	return filepath.Join(c.Directory, hex.EncodeToString(hash[:])+".json")
+}
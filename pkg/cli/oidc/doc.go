@@ -0,0 +1,20 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// oidc acquires and caches OpenID Connect tokens used by the CLI to authenticate with Radius APIs.
+// Tokens are acquired with the OAuth 2.0 device authorization grant and refreshed with their
+// refresh token when they expire.
+package oidc
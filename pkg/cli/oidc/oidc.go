@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// Config describes the OpenID Connect client used to acquire tokens.
+type Config struct {
+	// Issuer is the issuer URL.
+	Issuer string
+
+	// ClientID is the ID of the client registered with the issuer.
+	ClientID string
+
+	// Scopes are the scopes requested for tokens. 'openid' and 'offline_access' are always requested.
+	Scopes []string
+}
+
+// ErrNotLoggedIn is returned when no token has been acquired for a configuration.
+var ErrNotLoggedIn = errors.New("not logged in")
+
+// Discover retrieves the OpenID configuration of the issuer and returns its device authorization and
+// token endpoints.
+func Discover(ctx context.Context, issuer string) (oauth2.Endpoint, error) {
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return oauth2.Endpoint{}, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("failed to retrieve the OpenID configuration of %q: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return oauth2.Endpoint{}, fmt.Errorf("failed to retrieve the OpenID configuration of %q: %s", issuer, resp.Status)
+	}
+
+	discovery := struct {
+		Issuer                      string `json:"issuer"`
+		DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+		TokenEndpoint               string `json:"token_endpoint"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&discovery)
+	if err != nil {
+		return oauth2.Endpoint{}, fmt.Errorf("failed to decode the OpenID configuration of %q: %w", issuer, err)
+	}
+
+	if discovery.Issuer != issuer {
+		return oauth2.Endpoint{}, fmt.Errorf("the OpenID configuration of %q is for the issuer %q", issuer, discovery.Issuer)
+	}
+
+	if discovery.TokenEndpoint == "" {
+		return oauth2.Endpoint{}, fmt.Errorf("the OpenID configuration of %q has no token endpoint", issuer)
+	}
+
+	return oauth2.Endpoint{
+		DeviceAuthURL: discovery.DeviceAuthorizationEndpoint,
+		TokenURL:      discovery.TokenEndpoint,
+	}, nil
+}
+
+// Login acquires a token using the device authorization grant and stores it in the cache. The prompt function is
+// called with the verification URI and user code that the user must enter to approve the request.
+func Login(ctx context.Context, config Config, cache *Cache, prompt func(*oauth2.DeviceAuthResponse)) error {
+	endpoint, err := Discover(ctx, config.Issuer)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.DeviceAuthURL == "" {
+		return fmt.Errorf("the issuer %q does not support the device authorization grant", config.Issuer)
+	}
+
+	oauthConfig := config.oauth2Config(endpoint)
+	device, err := oauthConfig.DeviceAuth(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start device authorization: %w", err)
+	}
+
+	prompt(device)
+
+	token, err := oauthConfig.DeviceAccessToken(ctx, device)
+	if err != nil {
+		return fmt.Errorf("failed to acquire a token: %w", err)
+	}
+
+	return cache.Save(config, token)
+}
+
+// NewTokenSource returns a token source for the configuration. The token source returns the cached token, refreshing
+// it when it has expired. Tokens are only read from the cache when first requested, so ErrNotLoggedIn is returned
+// by Token() when no token was acquired.
+func NewTokenSource(config Config, cache *Cache) oauth2.TokenSource {
+	return &tokenSource{config: config, cache: cache}
+}
+
+type tokenSource struct {
+	config Config
+	cache  *Cache
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// Token returns a valid token, refreshing it if needed. The ID token is preferred over the access token because
+// the audience of the ID token is the client ID, which is what Radius validates.
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		token, err := s.cache.Load(s.config)
+		if err != nil {
+			return nil, err
+		}
+		s.token = token
+	}
+
+	if !s.token.Valid() {
+		if s.token.RefreshToken == "" {
+			return nil, fmt.Errorf("the token for %q has expired: %w", s.config.Issuer, ErrNotLoggedIn)
+		}
+
+		ctx := context.Background()
+		endpoint, err := Discover(ctx, s.config.Issuer)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := s.config.oauth2Config(endpoint).TokenSource(ctx, s.token).Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh the token for %q: %w", s.config.Issuer, err)
+		}
+
+		err = s.cache.Save(s.config, token)
+		if err != nil {
+			return nil, err
+		}
+
+		// Reload the token so that the ID token is read the same way as for a cached token.
+		token, err = s.cache.Load(s.config)
+		if err != nil {
+			return nil, err
+		}
+		s.token = token
+	}
+
+	bearer := s.token.AccessToken
+	if idToken, ok := s.token.Extra("id_token").(string); ok && idToken != "" {
+		bearer = idToken
+	}
+
+	return &oauth2.Token{AccessToken: bearer, TokenType: "Bearer", Expiry: s.token.Expiry}, nil
+}
+
+func (c Config) oauth2Config(endpoint oauth2.Endpoint) *oauth2.Config {
+	scopes := []string{"openid", "offline_access"}
+	for _, scope := range c.Scopes {
+		if scope != "openid" && scope != "offline_access" {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return &oauth2.Config{
+		ClientID: c.ClientID,
+		Endpoint: endpoint,
+		Scopes:   scopes,
+	}
+}
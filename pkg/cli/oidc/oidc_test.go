@@ -0,0 +1,107 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/radius-project/radius/test/testoidc"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func Test_Discover(t *testing.T) {
+	ctx := testcontext.New(t)
+	issuer := testoidc.NewIssuer(t)
+
+	endpoint, err := Discover(ctx, issuer.URL)
+	require.NoError(t, err)
+	require.Equal(t, issuer.URL+"/device/code", endpoint.DeviceAuthURL)
+	require.Equal(t, issuer.URL+"/token", endpoint.TokenURL)
+
+	_, err = Discover(ctx, issuer.URL+"/other")
+	require.Error(t, err)
+}
+
+func Test_Login(t *testing.T) {
+	ctx := testcontext.New(t)
+	issuer := testoidc.NewIssuer(t)
+	cache := &Cache{Directory: t.TempDir()}
+	config := Config{Issuer: issuer.URL, ClientID: issuer.ClientID}
+
+	_, err := NewTokenSource(config, cache).Token()
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+
+	var prompted *oauth2.DeviceAuthResponse
+	err = Login(ctx, config, cache, func(device *oauth2.DeviceAuthResponse) {
+		prompted = device
+	})
+	require.NoError(t, err)
+	require.Equal(t, testoidc.UserCode, prompted.UserCode)
+	require.Equal(t, issuer.URL+"/device", prompted.VerificationURI)
+
+	info, err := os.Stat(cache.path(config))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	token, err := NewTokenSource(config, cache).Token()
+	require.NoError(t, err)
+	require.Equal(t, "Bearer", token.TokenType)
+	require.NotEmpty(t, token.AccessToken)
+	require.Equal(t, 1, issuer.TokenRequests())
+}
+
+func Test_TokenSource_Refresh(t *testing.T) {
+	issuer := testoidc.NewIssuer(t)
+	cache := &Cache{Directory: t.TempDir()}
+	config := Config{Issuer: issuer.URL, ClientID: issuer.ClientID}
+
+	expired := &oauth2.Token{
+		AccessToken:  "expired",
+		RefreshToken: testoidc.RefreshToken,
+		Expiry:       time.Now().Add(-time.Minute),
+	}
+	require.NoError(t, cache.Save(config, expired))
+
+	source := NewTokenSource(config, cache)
+	token, err := source.Token()
+	require.NoError(t, err)
+	require.NotEqual(t, "expired", token.AccessToken)
+	require.Equal(t, 1, issuer.TokenRequests())
+
+	// The refreshed token is cached.
+	cached, err := cache.Load(config)
+	require.NoError(t, err)
+	require.True(t, cached.Valid())
+	require.Equal(t, token.AccessToken, cached.Extra("id_token"))
+
+	_, err = source.Token()
+	require.NoError(t, err)
+	require.Equal(t, 1, issuer.TokenRequests())
+}
+
+func Test_TokenSource_ExpiredWithoutRefreshToken(t *testing.T) {
+	cache := &Cache{Directory: t.TempDir()}
+	config := Config{Issuer: "https://issuer", ClientID: "radius"}
+	require.NoError(t, cache.Save(config, &oauth2.Token{AccessToken: "expired", Expiry: time.Now().Add(-time.Minute)}))
+
+	_, err := NewTokenSource(config, cache).Token()
+	require.ErrorIs(t, err, ErrNotLoggedIn)
+}
@@ -25,10 +25,14 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/oidc"
 	"github.com/radius-project/radius/pkg/sdk"
 )
 
-const KindKubernetes string = "kubernetes"
+const (
+	KindKubernetes string = "kubernetes"
+	KindOIDC       string = "oidc"
+)
 
 // MakeFallbackWorkspace creates an un-named workspace that will use the current KubeContext.
 // This is is used in fallback cases where the user has no config.
@@ -88,6 +92,19 @@ func (ws Workspace) ConnectionConfig() (ConnectionConfig, error) {
 			return nil, err
 		}
 
+		return config, nil
+	case KindOIDC:
+		config := &OIDCConnectionConfig{}
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{ErrorUnused: true, Result: config})
+		if err != nil {
+			return nil, err
+		}
+
+		err = decoder.Decode(ws.Connection)
+		if err != nil {
+			return nil, err
+		}
+
 		return config, nil
 	default:
 		return nil, fmt.Errorf("unsupported connection kind '%s'", kind)
@@ -110,6 +127,8 @@ func (ws Workspace) Connect(ctx context.Context) (sdk.Connection, error) {
 	err = sdk.TestConnection(ctx, connection)
 	if errors.Is(err, &sdk.ErrRadiusNotInstalled{}) {
 		return nil, fmt.Errorf("could not connect to radius: %w", err)
+	} else if errors.Is(err, oidc.ErrNotLoggedIn) {
+		return nil, fmt.Errorf("could not connect to radius: %w. Run 'rad workspace login' and try again", err)
 	} else if err != nil {
 		return nil, err
 	}
@@ -128,6 +147,13 @@ func (ws Workspace) ConnectionConfigEquals(other ConnectionConfig) bool {
 		}
 
 		return ws.Connection["kind"] == KindKubernetes && ws.IsSameKubernetesContext(kc.Context)
+	case KindOIDC:
+		oc, ok := other.(*OIDCConnectionConfig)
+		if !ok {
+			return false
+		}
+
+		return ws.Connection["kind"] == KindOIDC && ws.Connection["endpoint"] == oc.Endpoint
 	default:
 		return false
 	}
@@ -212,3 +238,54 @@ func (c *KubernetesConnectionConfig) Connect() (sdk.Connection, error) {
 
 	return sdk.NewKubernetesConnectionFromConfig(config)
 }
+
+var _ ConnectionConfig = (*OIDCConnectionConfig)(nil)
+
+type OIDCConnectionConfig struct {
+	// Kind specifies the kind of connection. For OIDCConnectionConfig this is always 'oidc'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind"`
+
+	// Endpoint is the URL of the Radius API, without the UCP path base.
+	Endpoint string `json:"endpoint" mapstructure:"endpoint" yaml:"endpoint"`
+
+	// Issuer is the URL of the OpenID Connect issuer of tokens.
+	Issuer string `json:"issuer" mapstructure:"issuer" yaml:"issuer"`
+
+	// ClientID is the ID of the client registered with the issuer.
+	ClientID string `json:"clientId" mapstructure:"clientId" yaml:"clientId"`
+
+	// Scopes are additional scopes requested for tokens. This field is optional.
+	Scopes []string `json:"scopes" mapstructure:"scopes" yaml:"scopes,omitempty"`
+}
+
+// String() returns a string that describes the OIDC connection configuration.
+func (c *OIDCConnectionConfig) String() string {
+	return fmt.Sprintf("OIDC (endpoint=%s, issuer=%s)", c.Endpoint, c.Issuer)
+}
+
+// GetKind() returns the string "KindOIDC" for an OIDCConnectionConfig object.
+func (c *OIDCConnectionConfig) GetKind() string {
+	return KindOIDC
+}
+
+// OIDCConfig returns the configuration used to acquire tokens for the connection.
+func (c *OIDCConnectionConfig) OIDCConfig() oidc.Config {
+	return oidc.Config{Issuer: c.Issuer, ClientID: c.ClientID, Scopes: c.Scopes}
+}
+
+// Connect() creates a connection to the endpoint that authenticates with the token acquired by 'rad workspace login'.
+func (c *OIDCConnectionConfig) Connect() (sdk.Connection, error) {
+	strURL := strings.TrimSuffix(c.Endpoint, "/")
+	strURL = strURL + "/apis/api.ucp.dev/v1alpha3"
+	_, err := url.ParseRequestURI(strURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := oidc.NewDefaultCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewBearerTokenConnection(strURL, oidc.NewTokenSource(c.OIDCConfig(), cache))
+}
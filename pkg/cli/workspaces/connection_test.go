@@ -43,3 +43,29 @@ func Test_IsSameKubernetesContext(t *testing.T) {
 	require.Equal(t, isSame, false)
 
 }
+
+func Test_ConnectionConfig_OIDC(t *testing.T) {
+	ws := Workspace{
+		Name: "my_workspace",
+		Connection: map[string]any{
+			"kind":     "oidc",
+			"endpoint": "https://radius.example.com",
+			"issuer":   "https://login.example.com",
+			"clientId": "radius",
+		},
+	}
+
+	config, err := ws.ConnectionConfig()
+	require.NoError(t, err)
+	require.Equal(t, &OIDCConnectionConfig{
+		Kind:     KindOIDC,
+		Endpoint: "https://radius.example.com",
+		Issuer:   "https://login.example.com",
+		ClientID: "radius",
+	}, config)
+	require.True(t, ws.ConnectionConfigEquals(config))
+
+	connection, err := config.Connect()
+	require.NoError(t, err)
+	require.Equal(t, "https://radius.example.com/apis/api.ucp.dev/v1alpha3", connection.Endpoint())
+}
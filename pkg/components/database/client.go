@@ -162,8 +162,14 @@ type QueryFilter struct {
 	//	- "properties.application"
 	Field string
 
+	// Key specifies the key of the map entry to filter when Field refers to a map, such as "tags". Map keys
+	// can contain characters that aren't valid in Field, so they are matched separately. Key is optional and is
+	// matched case-insensitively, like tag names in Azure Resource Manager.
+	// Example: Field "tags" and Key "cost-center" filter by the value of the "cost-center" tag.
+	Key string
+
 	// Value specifies the value to filter. The value must be a string and will be
-	// compared case-insentively with the property value.
+	// compared case-sensitively with the property value.
 	Value string
 }
 
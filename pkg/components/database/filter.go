@@ -53,11 +53,19 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 				return false, nil
 			}
 
-			if i < len(fields)-1 {
+			if i < len(fields)-1 || filter.Key != "" {
 				// Need to go further into the nested fields
 				value = reflect.ValueOf(value.Interface())
 			}
 		}
+
+		if filter.Key != "" {
+			value = mapIndexFold(value, filter.Key)
+			if !value.IsValid() {
+				// Key doesn't exist, no match
+				return false, nil
+			}
+		}
 		comparator := reflect.ValueOf(filter.Value)
 
 		if value.Type().Kind() == reflect.Interface {
@@ -78,3 +86,24 @@ func (o Object) MatchesFilters(filters []QueryFilter) (bool, error) {
 
 	return true, nil
 }
+
+// mapIndexFold returns the value of the map entry whose key is equal to key under Unicode case-folding. It returns
+// the zero Value if the value is not a map with string keys or has no such entry.
+func mapIndexFold(value reflect.Value, key string) reflect.Value {
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return reflect.Value{}
+	}
+
+	if entry := value.MapIndex(reflect.ValueOf(key)); entry.IsValid() {
+		return entry
+	}
+
+	iter := value.MapRange()
+	for iter.Next() {
+		if strings.EqualFold(iter.Key().String(), key) {
+			return iter.Value()
+		}
+	}
+
+	return reflect.Value{}
+}
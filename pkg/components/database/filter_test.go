@@ -119,6 +119,50 @@ func Test_MatchesFilters(t *testing.T) {
 			Filters:       []QueryFilter{{Field: "value", Value: "hot"}},
 			ExpectedMatch: false,
 		},
+
+		// We can filter by map keys
+		{
+			Description:   "key_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"cost-center": "payments"}}},
+			Filters:       []QueryFilter{{Field: "tags", Key: "cost-center", Value: "payments"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "key_not_match",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"cost-center": "payments"}}},
+			Filters:       []QueryFilter{{Field: "tags", Key: "cost-center", Value: "checkout"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "key_does_not_exist",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"team": "payments"}}},
+			Filters:       []QueryFilter{{Field: "tags", Key: "cost-center", Value: "payments"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "key_match_case_insensitive",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"Cost-Center": "payments"}}},
+			Filters:       []QueryFilter{{Field: "tags", Key: "cost-center", Value: "payments"}},
+			ExpectedMatch: true,
+		},
+		{
+			Description:   "key_value_case_sensitive",
+			Obj:           &Object{Data: map[string]any{"tags": map[string]any{"cost-center": "Payments"}}},
+			Filters:       []QueryFilter{{Field: "tags", Key: "cost-center", Value: "payments"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "key_field_not_map",
+			Obj:           &Object{Data: map[string]any{"tags": "cost-center"}},
+			Filters:       []QueryFilter{{Field: "tags", Key: "cost-center", Value: "payments"}},
+			ExpectedMatch: false,
+		},
+		{
+			Description:   "nested_key_match",
+			Obj:           &Object{Data: map[string]any{"properties": map[string]any{"tags": map[string]any{"app.kubernetes.io": "store"}}}},
+			Filters:       []QueryFilter{{Field: "properties.tags", Key: "app.kubernetes.io", Value: "store"}},
+			ExpectedMatch: true,
+		},
 	}
 
 	for _, testcase := range cases {
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// AuditEventFailedCount is the metric name for the count of audit events that could not be recorded.
+	AuditEventFailedCount = "audit.event.failed"
+)
+
+type auditMetrics struct {
+	counters map[string]metric.Int64Counter
+}
+
+func newAuditMetrics() *auditMetrics {
+	return &auditMetrics{
+		counters: make(map[string]metric.Int64Counter),
+	}
+}
+
+// Init initializes the counters for auditMetrics and returns an error if any of the initialization fails.
+func (a *auditMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("audit-metrics")
+
+	var err error
+	a.counters[AuditEventFailedCount], err = meter.Int64Counter(AuditEventFailedCount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordAuditEventFailed increments the AuditEventFailedCount metric for an audit event of the kind. It should be
+// called when an audit event could not be recorded, so that the loss of audit events can be alerted on.
+func (a *auditMetrics) RecordAuditEventFailed(ctx context.Context, kind string) {
+	if a.counters[AuditEventFailedCount] != nil {
+		a.counters[AuditEventFailedCount].Add(ctx, 1, metric.WithAttributes(auditEventKindAttrKey.String(normalizeAttrValue(kind))))
+	}
+}
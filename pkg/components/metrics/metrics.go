@@ -22,6 +22,9 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultAuditMetrics holds audit metrics definitions.
+	DefaultAuditMetrics = newAuditMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +37,9 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultAuditMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -42,6 +42,9 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// auditEventKindAttrKey is the attribute name for the kind of an audit event.
+	auditEventKindAttrKey = attribute.Key("audit_event_kind")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
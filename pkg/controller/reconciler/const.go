@@ -54,6 +54,13 @@ const (
 	// DeploymentResourceFinalizer is the name of the finalizer added to DeploymentResources.
 	DeploymentResourceFinalizer = "radapp.io/deployment-resource-finalizer"
 
+	// ResourceTypeFinalizer is the name of the finalizer added to the custom resources of projected resource types.
+	ResourceTypeFinalizer = "radapp.io/resource-type-finalizer"
+
+	// ResourceTypeSyncInterval is the amount of time to wait between synchronizations of the resource types
+	// projected as custom resource definitions.
+	ResourceTypeSyncInterval time.Duration = 30 * time.Second
+
 	// GitRepositoryHttpRetryCount is the number of times to retry GitRepository HTTP requests.
 	GitRepositoryHttpRetryCount = 9
 )
@@ -90,7 +90,7 @@ func (rc *mockRadiusClient) Groups(scope string) ResourceGroupClient {
 	return &mockResourceGroupClient{mock: rc, scope: scope}
 }
 
-func (rc *mockRadiusClient) Resources(scope string, resourceType string) ResourceClient {
+func (rc *mockRadiusClient) Resources(scope string, resourceType string, apiVersion ...string) ResourceClient {
 	return &mockResourceClient{mock: rc, scope: scope, resourceType: resourceType}
 }
 
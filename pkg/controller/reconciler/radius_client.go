@@ -33,7 +33,7 @@ type RadiusClient interface {
 	Containers(scope string) ContainerClient
 	Environments(scope string) EnvironmentClient
 	Groups(scope string) ResourceGroupClient
-	Resources(scope string, resourceType string) ResourceClient
+	Resources(scope string, resourceType string, apiVersion ...string) ResourceClient
 }
 
 type ApplicationClient interface {
@@ -68,6 +68,11 @@ type ResourceClient interface {
 	ListSecrets(ctx context.Context, resourceName string) (generated.GenericResourcesClientListSecretsResponse, error)
 }
 
+// ResourceProviderClient lists the resource providers registered with UCP.
+type ResourceProviderClient interface {
+	ListSummaries(ctx context.Context, planeName string) ([]ucpv20231001preview.ResourceProviderSummary, error)
+}
+
 type RadiusClientImpl struct {
 	connection sdk.Connection
 }
@@ -114,8 +119,15 @@ func (c *RadiusClientImpl) Groups(scope string) ResourceGroupClient {
 	return &ResourceGroupClientImpl{inner: rgc, scope: scope}
 }
 
-func (c *RadiusClientImpl) Resources(scope string, resourceType string) ResourceClient {
-	gc, err := generated.NewGenericResourcesClient(resourceType, scope, &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+func (c *RadiusClientImpl) Resources(scope string, resourceType string, apiVersion ...string) ResourceClient {
+	clientOptions := sdk.NewClientOptions(c.connection)
+	if len(apiVersion) != 0 {
+		// The generated client uses 2023-10-01-preview unless an API version is provided. User-defined
+		// resource types are only served at the API versions they are registered with.
+		clientOptions.APIVersion = apiVersion[0]
+	}
+
+	gc, err := generated.NewGenericResourcesClient(resourceType, scope, &aztoken.AnonymousCredential{}, clientOptions)
 	if err != nil {
 		panic("failed to create client: " + err.Error())
 	}
@@ -240,3 +252,35 @@ func (rc *ResourceClientImpl) Get(ctx context.Context, resourceName string) (gen
 func (rc *ResourceClientImpl) ListSecrets(ctx context.Context, resourceName string) (generated.GenericResourcesClientListSecretsResponse, error) {
 	return rc.inner.ListSecrets(ctx, resourceName, nil)
 }
+
+var _ ResourceProviderClient = (*ResourceProviderClientImpl)(nil)
+
+type ResourceProviderClientImpl struct {
+	inner *ucpv20231001preview.ResourceProvidersClient
+}
+
+func NewResourceProviderClient(connection sdk.Connection) *ResourceProviderClientImpl {
+	rpc, err := ucpv20231001preview.NewResourceProvidersClient(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(connection))
+	if err != nil {
+		panic("failed to create client: " + err.Error())
+	}
+
+	return &ResourceProviderClientImpl{inner: rpc}
+}
+
+func (rpc *ResourceProviderClientImpl) ListSummaries(ctx context.Context, planeName string) ([]ucpv20231001preview.ResourceProviderSummary, error) {
+	result := []ucpv20231001preview.ResourceProviderSummary{}
+	pager := rpc.inner.NewListProviderSummariesPager(planeName, nil)
+	for pager.More() {
+		response, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range response.Value {
+			result = append(result, *summary)
+		}
+	}
+
+	return result, nil
+}
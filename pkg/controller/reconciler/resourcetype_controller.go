@@ -0,0 +1,364 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var _ manager.Runnable = (*ResourceTypeCRDController)(nil)
+
+// ResourceTypeCRDController projects the Radius resource types with the KubernetesCustomResource capability into
+// Kubernetes. It periodically lists the resource types registered with UCP, creates or updates a custom resource
+// definition for each projected resource type, and starts a reconciler for its custom resources.
+//
+// When a resource type loses the capability or is deleted, its reconciler is stopped and the finalizers of its custom
+// resources are removed, so that they can be deleted without deleting the Radius resources. The custom resource
+// definition is left in place: deleting it would delete the custom resources, which may still be in use. It can be
+// deleted by the cluster administrator, and is updated again if the resource type regains the capability.
+//
+// The controller needs permissions on the API group of each projected resource type. A resource type is not projected
+// until the controller is allowed to manage its custom resources.
+type ResourceTypeCRDController struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// ResourceProviders is the client used to list the resource types registered with UCP.
+	ResourceProviders ResourceProviderClient
+
+	// StartReconciler starts a reconciler for the custom resources of a projected resource type. The reconciler
+	// must run until the context is cancelled.
+	StartReconciler func(ctx context.Context, reconciler *ResourceTypeReconciler) error
+
+	// Interval is the amount of time to wait between synchronizations.
+	Interval time.Duration
+
+	// reconcilers are the reconcilers started, by name of custom resource definition.
+	reconcilers map[string]*runningReconciler
+
+	// released are the custom resource definitions that are no longer projected and whose custom resources were
+	// released, by name.
+	released map[string]bool
+}
+
+// runningReconciler is a reconciler started by the controller.
+type runningReconciler struct {
+	reconciler *ResourceTypeReconciler
+
+	// stop stops the reconciler.
+	stop context.CancelFunc
+}
+
+// Start runs the synchronization loop until the context is cancelled.
+func (c *ResourceTypeCRDController) Start(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithName("resourcetype-crd-controller")
+
+	interval := c.Interval
+	if interval == 0 {
+		interval = ResourceTypeSyncInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := c.synchronize(ctx)
+		if err != nil {
+			// Resource types that can't be projected are retried on the next synchronization.
+			logger.Error(err, "Unable to synchronize resource types.")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// synchronize projects the resource types registered with UCP that have the KubernetesCustomResource capability,
+// and removes the projection of the resource types that no longer have it.
+func (c *ResourceTypeCRDController) synchronize(ctx context.Context) error {
+	if c.reconcilers == nil {
+		c.reconcilers = map[string]*runningReconciler{}
+	}
+	if c.released == nil {
+		c.released = map[string]bool{}
+	}
+
+	providers, err := c.ResourceProviders.ListSummaries(ctx, "local")
+	if err != nil {
+		return fmt.Errorf("failed to list resource providers: %w", err)
+	}
+
+	errs := []error{}
+	projected := map[string]bool{}
+	for _, provider := range providers {
+		for typeName, resourceType := range provider.ResourceTypes {
+			if resourceType == nil || !HasKubernetesCustomResourceCapability(resourceType) {
+				continue
+			}
+
+			resourceTypeName := to.String(provider.Name) + "/" + typeName
+			if gvk, plural, err := resourceTypeGroupVersionKind(resourceTypeName); err == nil {
+				projected[plural+"."+gvk.Group] = true
+			}
+
+			err := c.project(ctx, resourceTypeName, resourceType)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to project resource type %q: %w", resourceTypeName, err))
+			}
+		}
+	}
+
+	err = c.releaseStaleProjections(ctx, projected)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// project creates or updates the custom resource definition of a resource type, and starts the reconciler of its
+// custom resources once the definition is established.
+func (c *ResourceTypeCRDController) project(ctx context.Context, resourceTypeName string, resourceType *ucpv20231001preview.ResourceProviderSummaryResourceType) error {
+	projection, err := NewResourceTypeProjection(resourceTypeName, resourceType)
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("resourceType", projection.ResourceType, "apiVersion", projection.APIVersion)
+
+	crd, err := projection.CustomResourceDefinition()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.reconcilers[crd.Name]; !ok {
+		// Don't create a definition for custom resources that the reconciler would not be allowed to manage.
+		err = c.checkAccess(ctx, projection)
+		if err != nil {
+			return err
+		}
+	}
+
+	established, err := c.applyCustomResourceDefinition(ctx, crd)
+	if err != nil {
+		return err
+	} else if !established {
+		// The API server serves the custom resources once the definition is established. We'll start
+		// the reconciler on a later synchronization.
+		logger.Info("Waiting for custom resource definition to be established.", "name", crd.Name)
+		return nil
+	}
+
+	delete(c.released, crd.Name)
+	if running, ok := c.reconcilers[crd.Name]; ok {
+		running.reconciler.SetProjection(projection)
+		return nil
+	}
+
+	logger.Info("Starting reconciler.", "kind", projection.GroupVersionKind.Kind)
+	reconciler := NewResourceTypeReconciler(projection)
+	reconcilerCtx, stop := context.WithCancel(ctx)
+	err = c.StartReconciler(reconcilerCtx, reconciler)
+	if err != nil {
+		stop()
+		return fmt.Errorf("failed to start reconciler: %w", err)
+	}
+
+	c.reconcilers[crd.Name] = &runningReconciler{reconciler: reconciler, stop: stop}
+	return nil
+}
+
+// checkAccess returns an error if the controller is not allowed to manage the custom resources of a projected
+// resource type.
+func (c *ResourceTypeCRDController) checkAccess(ctx context.Context, projection *ResourceTypeProjection) error {
+	for _, attributes := range []authorizationv1.ResourceAttributes{
+		{Verb: "watch", Resource: projection.Plural},
+		{Verb: "update", Resource: projection.Plural},
+		{Verb: "update", Resource: projection.Plural, Subresource: "status"},
+	} {
+		attributes.Group = projection.GroupVersionKind.Group
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+		}
+
+		err := c.Client.Create(ctx, review)
+		if err != nil {
+			return fmt.Errorf("failed to check access to the API group %q: %w", attributes.Group, err)
+		}
+
+		if !review.Status.Allowed {
+			return fmt.Errorf("the controller is not allowed to %s %s in the API group %q: add %q to controller.resourceTypeAPIGroups in the Helm chart values", attributes.Verb, attributes.Resource, attributes.Group, attributes.Group)
+		}
+	}
+
+	return nil
+}
+
+// releaseStaleProjections releases the custom resource definitions managed by Radius that are not in the set of
+// projected definitions. The definitions are left in place.
+func (c *ResourceTypeCRDController) releaseStaleProjections(ctx context.Context, projected map[string]bool) error {
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	err := c.Client.List(ctx, list, client.MatchingLabels{LabelManagedBy: ResourceTypeCRDManager})
+	if err != nil {
+		return fmt.Errorf("failed to list custom resource definitions: %w", err)
+	}
+
+	errs := []error{}
+	for i := range list.Items {
+		crd := &list.Items[i]
+		if projected[crd.Name] || c.released[crd.Name] {
+			continue
+		}
+
+		err := c.releaseProjection(ctx, crd)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to release custom resource definition %q: %w", crd.Name, err))
+			continue
+		}
+
+		c.released[crd.Name] = true
+	}
+
+	return errors.Join(errs...)
+}
+
+// releaseProjection stops the reconciler of a custom resource definition and removes the finalizers of its custom
+// resources, so that deleting them keeps their Radius resources.
+func (c *ResourceTypeCRDController) releaseProjection(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", crd.Name, "resourceType", crd.Annotations[AnnotationResourceType])
+
+	if running, ok := c.reconcilers[crd.Name]; ok {
+		logger.Info("Stopping reconciler.")
+		running.stop()
+		delete(c.reconcilers, crd.Name)
+	}
+
+	for _, version := range crd.Spec.Versions {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.ListKind})
+		err := c.Client.List(ctx, list)
+		if meta.IsNoMatchError(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to list custom resources: %w", err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if controllerutil.RemoveFinalizer(obj, ResourceTypeFinalizer) {
+				err := c.Client.Update(ctx, obj)
+				if err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("failed to remove the finalizer of %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+				}
+			}
+		}
+	}
+
+	logger.Info("Released custom resource definition. It is no longer projected and can be deleted.")
+	return nil
+}
+
+// applyCustomResourceDefinition creates or updates a custom resource definition, and returns true if it is established.
+func (c *ResourceTypeCRDController) applyCustomResourceDefinition(ctx context.Context, crd *apiextensionsv1.CustomResourceDefinition) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", crd.Name)
+
+	hash, err := computeCustomResourceDefinitionHash(crd)
+	if err != nil {
+		return false, err
+	}
+	crd.Annotations[AnnotationRadiusConfigurationHash] = hash
+
+	existing := &apiextensionsv1.CustomResourceDefinition{}
+	err = c.Client.Get(ctx, client.ObjectKey{Name: crd.Name}, existing)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Creating custom resource definition.")
+		err = c.Client.Create(ctx, crd)
+		if err != nil {
+			return false, fmt.Errorf("failed to create custom resource definition %q: %w", crd.Name, err)
+		}
+
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to fetch custom resource definition %q: %w", crd.Name, err)
+	}
+
+	if existing.Labels[LabelManagedBy] != ResourceTypeCRDManager {
+		return false, fmt.Errorf("custom resource definition %q already exists and is not managed by Radius", crd.Name)
+	}
+
+	if existing.Annotations[AnnotationRadiusConfigurationHash] != hash {
+		logger.Info("Updating custom resource definition.")
+		existing.Spec = crd.Spec
+		if existing.Annotations == nil {
+			existing.Annotations = map[string]string{}
+		}
+		for key, value := range crd.Annotations {
+			existing.Annotations[key] = value
+		}
+
+		err = c.Client.Update(ctx, existing)
+		if err != nil {
+			return false, fmt.Errorf("failed to update custom resource definition %q: %w", crd.Name, err)
+		}
+	}
+
+	for _, condition := range existing.Status.Conditions {
+		if condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// computeCustomResourceDefinitionHash computes a hash of the spec of a generated custom resource definition, so
+// that it's only updated when the resource type changes.
+func computeCustomResourceDefinitionHash(crd *apiextensionsv1.CustomResourceDefinition) (string, error) {
+	b, err := json.Marshal(struct {
+		Annotations map[string]string                            `json:"annotations"`
+		Spec        apiextensionsv1.CustomResourceDefinitionSpec `json:"spec"`
+	}{Annotations: crd.Annotations, Spec: crd.Spec})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(b)
+	hash := hex.EncodeToString(sum[:])
+	return hash, nil
+}
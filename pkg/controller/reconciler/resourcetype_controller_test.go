@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+type mockResourceProviderClient struct {
+	summaries []ucpv20231001preview.ResourceProviderSummary
+}
+
+func (c *mockResourceProviderClient) ListSummaries(ctx context.Context, planeName string) ([]ucpv20231001preview.ResourceProviderSummary, error) {
+	return c.summaries, nil
+}
+
+func setupResourceTypeCRDControllerTest(t *testing.T, objects ...client.Object) (*ResourceTypeCRDController, *mockResourceProviderClient, *[]*ResourceTypeReconciler) {
+	s := runtime.NewScheme()
+	require.NoError(t, apiextensionsv1.AddToScheme(s))
+
+	providers := &mockResourceProviderClient{
+		summaries: []ucpv20231001preview.ResourceProviderSummary{
+			{
+				Name: new("Radius.Data"),
+				ResourceTypes: map[string]*ucpv20231001preview.ResourceProviderSummaryResourceType{
+					"postgreSqlDatabases": makePostgreSqlDatabasesSummary(),
+					"redisCaches": {
+						DefaultAPIVersion: new("2025-08-01-preview"),
+						APIVersions: map[string]*ucpv20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2025-08-01-preview": {Schema: map[string]any{"type": "object"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	started := []*ResourceTypeReconciler{}
+	controller := &ResourceTypeCRDController{
+		Client: fake.NewClientBuilder().
+			WithScheme(s).
+			WithObjects(objects...).
+			WithInterceptorFuncs(interceptor.Funcs{Create: allowAccessReviews(true)}).
+			Build(),
+		ResourceProviders: providers,
+		StartReconciler: func(ctx context.Context, reconciler *ResourceTypeReconciler) error {
+			started = append(started, reconciler)
+			return nil
+		},
+	}
+
+	return controller, providers, &started
+}
+
+// allowAccessReviews returns an interceptor that answers the access reviews of the controller.
+func allowAccessReviews(allowed bool) func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+	return func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+		if review, ok := obj.(*authorizationv1.SelfSubjectAccessReview); ok {
+			review.Status.Allowed = allowed
+			return nil
+		}
+		return c.Create(ctx, obj, opts...)
+	}
+}
+
+func establish(t *testing.T, c client.Client, name string) {
+	ctx := testcontext.New(t)
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: name}, crd))
+
+	crd.Status.Conditions = []apiextensionsv1.CustomResourceDefinitionCondition{
+		{Type: apiextensionsv1.Established, Status: apiextensionsv1.ConditionTrue},
+	}
+	require.NoError(t, c.Status().Update(ctx, crd))
+}
+
+func Test_ResourceTypeCRDController_Synchronize(t *testing.T) {
+	ctx := testcontext.New(t)
+	controller, providers, started := setupResourceTypeCRDControllerTest(t)
+
+	// The custom resource definition is created for the resource type with the capability only.
+	err := controller.synchronize(ctx)
+	require.NoError(t, err)
+
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	require.NoError(t, controller.Client.List(ctx, list))
+	require.Len(t, list.Items, 1)
+	require.Equal(t, "postgresqldatabases.radius.data.radapp.io", list.Items[0].Name)
+	require.NotEmpty(t, list.Items[0].Annotations[AnnotationRadiusConfigurationHash])
+
+	// The reconciler waits for the custom resource definition to be established.
+	require.Empty(t, *started)
+
+	establish(t, controller.Client, "postgresqldatabases.radius.data.radapp.io")
+
+	err = controller.synchronize(ctx)
+	require.NoError(t, err)
+	require.Len(t, *started, 1)
+	require.Equal(t, "2025-08-01-preview", (*started)[0].Projection().APIVersion)
+
+	// A new default API version updates the definition and the running reconciler.
+	summary := providers.summaries[0].ResourceTypes["postgreSqlDatabases"]
+	summary.DefaultAPIVersion = new("2025-01-01-preview")
+
+	err = controller.synchronize(ctx)
+	require.NoError(t, err)
+	require.Len(t, *started, 1)
+	require.Equal(t, "2025-01-01-preview", (*started)[0].Projection().APIVersion)
+
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	require.NoError(t, controller.Client.Get(ctx, client.ObjectKey{Name: "postgresqldatabases.radius.data.radapp.io"}, crd))
+	require.Equal(t, "2025-01-01-preview", crd.Annotations[AnnotationResourceTypeAPIVersion])
+	require.NotContains(t, crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"].Properties, "size")
+}
+
+func Test_ResourceTypeCRDController_Synchronize_NotManaged(t *testing.T) {
+	ctx := testcontext.New(t)
+	existing := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: ctrl.ObjectMeta{Name: "postgresqldatabases.radius.data.radapp.io"},
+	}
+	controller, _, started := setupResourceTypeCRDControllerTest(t, existing)
+
+	err := controller.synchronize(ctx)
+	require.ErrorContains(t, err, `custom resource definition "postgresqldatabases.radius.data.radapp.io" already exists and is not managed by Radius`)
+	require.Empty(t, *started)
+}
+
+func Test_ResourceTypeCRDController_Synchronize_AccessDenied(t *testing.T) {
+	ctx := testcontext.New(t)
+	controller, _, started := setupResourceTypeCRDControllerTest(t)
+	controller.Client = interceptor.NewClient(controller.Client.(client.WithWatch), interceptor.Funcs{Create: allowAccessReviews(false)})
+
+	err := controller.synchronize(ctx)
+	require.ErrorContains(t, err, `the controller is not allowed to watch postgresqldatabases in the API group "radius.data.radapp.io": add "radius.data.radapp.io" to controller.resourceTypeAPIGroups`)
+	require.Empty(t, *started)
+
+	// The custom resource definition is not created.
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	require.NoError(t, controller.Client.List(ctx, list))
+	require.Empty(t, list.Items)
+}
+
+func Test_ResourceTypeCRDController_Synchronize_RemovesProjection(t *testing.T) {
+	ctx := testcontext.New(t)
+	controller, providers, started := setupResourceTypeCRDControllerTest(t)
+
+	// The reconciler is stopped when its context is cancelled.
+	stopped := &atomic.Bool{}
+	controller.StartReconciler = func(ctx context.Context, reconciler *ResourceTypeReconciler) error {
+		*started = append(*started, reconciler)
+		context.AfterFunc(ctx, func() { stopped.Store(true) })
+		return nil
+	}
+
+	require.NoError(t, controller.synchronize(ctx))
+	establish(t, controller.Client, "postgresqldatabases.radius.data.radapp.io")
+	require.NoError(t, controller.synchronize(ctx))
+	require.Len(t, *started, 1)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind((*started)[0].Projection().GroupVersionKind)
+	obj.SetNamespace("default")
+	obj.SetName("db")
+	obj.SetFinalizers([]string{ResourceTypeFinalizer})
+	require.NoError(t, controller.Client.Create(ctx, obj))
+
+	// The resource type loses the capability.
+	capabilities := providers.summaries[0].ResourceTypes["postgreSqlDatabases"].Capabilities
+	providers.summaries[0].ResourceTypes["postgreSqlDatabases"].Capabilities = nil
+
+	err := controller.synchronize(ctx)
+	require.NoError(t, err)
+	require.Eventually(t, stopped.Load, recipeTestWaitDuration, recipeTestWaitInterval)
+
+	// The custom resource definition is left in place, so the custom resources are not deleted.
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	err = controller.Client.Get(ctx, client.ObjectKey{Name: "postgresqldatabases.radius.data.radapp.io"}, crd)
+	require.NoError(t, err)
+
+	// The finalizer is removed so that the custom resource can be deleted without the reconciler.
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GroupVersionKind())
+	require.NoError(t, controller.Client.Get(ctx, client.ObjectKeyFromObject(obj), current))
+	require.Empty(t, current.GetFinalizers())
+
+	// The resource type regains the capability.
+	providers.summaries[0].ResourceTypes["postgreSqlDatabases"].Capabilities = capabilities
+
+	require.NoError(t, controller.synchronize(ctx))
+	require.Len(t, *started, 2)
+}
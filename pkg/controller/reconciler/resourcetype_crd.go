@@ -0,0 +1,470 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// ResourceTypeCRDGroupSuffix is the suffix of the API groups of the custom resource definitions generated for
+	// Radius resource types. The API group of a resource type is its lowercased namespace followed by this suffix.
+	ResourceTypeCRDGroupSuffix = ".radapp.io"
+
+	// ResourceTypeCRDVersion is the version of the custom resource definitions generated for Radius resource types.
+	//
+	// The version is fixed so that the API version of the Radius resource type can change without requiring a
+	// migration of the stored custom resources. The Radius API version is recorded in an annotation instead.
+	ResourceTypeCRDVersion = "v1alpha1"
+
+	// AnnotationResourceType is the name of the annotation that indicates the Radius resource type projected by a
+	// custom resource definition.
+	AnnotationResourceType = "radapp.io/resource-type"
+
+	// AnnotationResourceTypeAPIVersion is the name of the annotation that indicates the Radius API version projected
+	// by a custom resource definition.
+	AnnotationResourceTypeAPIVersion = "radapp.io/api-version"
+
+	// LabelManagedBy is the name of the label that indicates the manager of a custom resource definition.
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+
+	// ResourceTypeCRDManager is the value of the managed-by label of the custom resource definitions generated for
+	// Radius resource types.
+	ResourceTypeCRDManager = "radius"
+)
+
+// ResourceTypeProjection describes how a Radius resource type is projected into Kubernetes as a custom resource.
+type ResourceTypeProjection struct {
+	// ResourceType is the fully-qualified name of the resource type. eg: 'Radius.Data/postgreSqlDatabases'.
+	ResourceType string
+
+	// APIVersion is the API version of the resource type used to manage the resources.
+	APIVersion string
+
+	// GroupVersionKind is the group, version and kind of the custom resources.
+	GroupVersionKind schema.GroupVersionKind
+
+	// Plural is the plural name of the custom resources.
+	Plural string
+
+	// Description is the description of the resource type.
+	Description string
+
+	// Schema is the schema of the resource type at the API version.
+	Schema map[string]any
+
+	// StatusProperties are the names of the read-only properties of the resource that are reflected
+	// in the status of the custom resources. Sensitive properties are never reflected.
+	StatusProperties []string
+}
+
+// HasKubernetesCustomResourceCapability returns true if the resource type is projected as a Kubernetes custom resource.
+func HasKubernetesCustomResourceCapability(resourceType *ucpv20231001preview.ResourceProviderSummaryResourceType) bool {
+	for _, capability := range resourceType.Capabilities {
+		if to.String(capability) == datamodel.CapabilityKubernetesCustomResource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewResourceTypeProjection creates the projection of a resource type from its summary. The default API version of
+// the resource type is projected, or its latest API version if it has no default API version.
+func NewResourceTypeProjection(resourceTypeName string, resourceType *ucpv20231001preview.ResourceProviderSummaryResourceType) (*ResourceTypeProjection, error) {
+	gvk, plural, err := resourceTypeGroupVersionKind(resourceTypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion := to.String(resourceType.DefaultAPIVersion)
+	if apiVersion == "" {
+		versions := make([]string, 0, len(resourceType.APIVersions))
+		for version := range resourceType.APIVersions {
+			versions = append(versions, version)
+		}
+		if len(versions) == 0 {
+			return nil, fmt.Errorf("resource type %q has no API versions", resourceTypeName)
+		}
+
+		sort.Strings(versions)
+		apiVersion = versions[len(versions)-1]
+	}
+
+	version, ok := resourceType.APIVersions[apiVersion]
+	if !ok || version == nil {
+		return nil, fmt.Errorf("resource type %q has no API version %q", resourceTypeName, apiVersion)
+	} else if version.Schema == nil {
+		return nil, fmt.Errorf("resource type %q has no schema for API version %q", resourceTypeName, apiVersion)
+	}
+
+	projection := &ResourceTypeProjection{
+		ResourceType:     resourceTypeName,
+		APIVersion:       apiVersion,
+		GroupVersionKind: gvk,
+		Plural:           plural,
+		Description:      to.String(resourceType.Description),
+		Schema:           version.Schema,
+	}
+
+	properties, _ := version.Schema["properties"].(map[string]any)
+	for name, value := range properties {
+		property, _ := value.(map[string]any)
+		if isReadOnlySchema(property) && !isSensitiveSchema(property) {
+			projection.StatusProperties = append(projection.StatusProperties, name)
+		}
+	}
+	sort.Strings(projection.StatusProperties)
+
+	return projection, nil
+}
+
+// resourceTypeGroupVersionKind returns the group, version, kind and plural name of the custom resources of a resource type.
+//
+// For example 'Radius.Data/postgreSqlDatabases' is projected as the kind 'PostgreSqlDatabase' of the group
+// 'radius.data.radapp.io', with the plural name 'postgresqldatabases'.
+func resourceTypeGroupVersionKind(resourceTypeName string) (schema.GroupVersionKind, string, error) {
+	namespace, typeName, ok := strings.Cut(resourceTypeName, "/")
+	if !ok || namespace == "" || typeName == "" || strings.Contains(typeName, "/") {
+		return schema.GroupVersionKind{}, "", fmt.Errorf("resource type %q must be of the form 'Namespace/typeName'", resourceTypeName)
+	}
+
+	group := strings.ToLower(namespace) + ResourceTypeCRDGroupSuffix
+	if errs := validation.IsDNS1123Subdomain(group); len(errs) > 0 {
+		return schema.GroupVersionKind{}, "", fmt.Errorf("resource type %q cannot be projected to the API group %q: %s", resourceTypeName, group, strings.Join(errs, ", "))
+	}
+
+	plural := strings.ToLower(typeName)
+	if errs := validation.IsDNS1035Label(plural); len(errs) > 0 {
+		return schema.GroupVersionKind{}, "", fmt.Errorf("resource type %q cannot be projected to the resource %q: %s", resourceTypeName, plural, strings.Join(errs, ", "))
+	}
+
+	kind := singular(typeName)
+	kind = strings.ToUpper(kind[:1]) + kind[1:]
+
+	return schema.GroupVersionKind{Group: group, Version: ResourceTypeCRDVersion, Kind: kind}, plural, nil
+}
+
+// singular returns the singular form of a plural resource type name.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies") && len(name) > 3:
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s") && len(name) > 1 && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	default:
+		return name
+	}
+}
+
+// CustomResourceDefinition generates the custom resource definition of the projected resource type.
+//
+// The spec of the custom resources holds the properties of the resource, except the read-only properties. The
+// environment and application are names rather than resource IDs, they default to 'default' and to the namespace
+// of the custom resource. The status holds the provisioning state and the read-only properties of the resource.
+func (p *ResourceTypeProjection) CustomResourceDefinition() (*apiextensionsv1.CustomResourceDefinition, error) {
+	spec, err := p.specSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := p.statusSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	description := p.Description
+	if description == "" {
+		description = fmt.Sprintf("%s is a %s resource managed by Radius.", p.GroupVersionKind.Kind, p.ResourceType)
+	}
+
+	return &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: p.Plural + "." + p.GroupVersionKind.Group,
+			Labels: map[string]string{
+				LabelManagedBy: ResourceTypeCRDManager,
+			},
+			Annotations: map[string]string{
+				AnnotationResourceType:           p.ResourceType,
+				AnnotationResourceTypeAPIVersion: p.APIVersion,
+			},
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: p.GroupVersionKind.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Kind:       p.GroupVersionKind.Kind,
+				ListKind:   p.GroupVersionKind.Kind + "List",
+				Plural:     p.Plural,
+				Singular:   strings.ToLower(p.GroupVersionKind.Kind),
+				Categories: []string{"all", "radius"},
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    p.GroupVersionKind.Version,
+					Served:  true,
+					Storage: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Description: description,
+							Type:        "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"apiVersion": {Type: "string"},
+								"kind":       {Type: "string"},
+								"metadata":   {Type: "object"},
+								"spec":       *spec,
+								"status":     *status,
+							},
+						},
+					},
+					Subresources: &apiextensionsv1.CustomResourceSubresources{
+						Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+					},
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Status",
+							Type:        "string",
+							JSONPath:    ".status.phrase",
+							Description: "Status of the resource",
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// specSchema returns the schema of the spec of the custom resources.
+func (p *ResourceTypeProjection) specSchema() (*apiextensionsv1.JSONSchemaProps, error) {
+	converted := convertSchema(p.Schema, func(name string, property map[string]any) bool {
+		return !isReadOnlySchema(property)
+	})
+
+	properties, _ := converted["properties"].(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+		converted["properties"] = properties
+	}
+
+	properties["environment"] = map[string]any{
+		"type":        "string",
+		"description": "Environment is the name of the Radius environment to use. If unset the value 'default' will be used as the environment name.",
+	}
+	if _, ok := properties["application"]; ok {
+		properties["application"] = map[string]any{
+			"type":        "string",
+			"description": "Application is the name of the Radius application to use. If unset the namespace of the resource will be used as the application name.",
+		}
+	}
+
+	required, _ := converted["required"].([]any)
+	required = slices.DeleteFunc(required, func(name any) bool {
+		return name == "environment" || name == "application"
+	})
+	if len(required) > 0 {
+		converted["required"] = required
+	} else {
+		delete(converted, "required")
+	}
+
+	converted["type"] = "object"
+	delete(converted, "description")
+	delete(converted, "x-kubernetes-preserve-unknown-fields")
+
+	return toJSONSchemaProps(converted)
+}
+
+// statusSchema returns the schema of the status of the custom resources.
+func (p *ResourceTypeProjection) statusSchema() (*apiextensionsv1.JSONSchemaProps, error) {
+	status := &apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"observedGeneration": {Type: "integer", Format: "int64", Description: "ObservedGeneration is the most recent generation observed for this resource."},
+			"application":        {Type: "string", Description: "Application is the resource ID of the application."},
+			"environment":        {Type: "string", Description: "Environment is the resource ID of the environment."},
+			"scope":              {Type: "string", Description: "Scope is the resource ID of the scope."},
+			"resource":           {Type: "string", Description: "Resource is the resource ID of the resource."},
+			"phrase":             {Type: "string", Description: "Phrase indicates the current status of the resource."},
+			"operation": {
+				Type:        "object",
+				Description: "Operation tracks the status of an in-progress provisioning operation.",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"resumeToken":   {Type: "string", Description: "ResumeToken is a token that can be used to resume an in-progress provisioning operation."},
+					"operationKind": {Type: "string", Description: "OperationKind describes the type of operation being performed."},
+				},
+			},
+		},
+	}
+
+	if len(p.StatusProperties) == 0 {
+		return status, nil
+	}
+
+	properties := map[string]any{}
+	all, _ := p.Schema["properties"].(map[string]any)
+	for _, name := range p.StatusProperties {
+		property, _ := all[name].(map[string]any)
+		properties[name] = convertSchema(property, func(name string, property map[string]any) bool {
+			return !isSensitiveSchema(property)
+		})
+	}
+
+	converted, err := toJSONSchemaProps(map[string]any{
+		"type":        "object",
+		"description": "Properties are the read-only properties of the resource.",
+		"properties":  properties,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status.Properties["properties"] = *converted
+	return status, nil
+}
+
+// crdSchemaKeywords are the keywords of the Radius schemas that are copied as-is to the custom resource definitions.
+var crdSchemaKeywords = []string{
+	"type", "format", "title", "description", "default", "example", "enum", "nullable",
+	"pattern", "minimum", "maximum", "multipleOf", "minLength", "maxLength",
+	"minItems", "maxItems", "uniqueItems", "minProperties", "maxProperties",
+}
+
+// convertSchema converts a Radius schema to a structural schema of a custom resource definition. Properties are
+// only kept when include returns true.
+//
+// Keywords that have no equivalent in custom resource definitions are dropped. Properties annotated with
+// x-radius-immutable are validated with the 'self == oldSelf' rule. Schemas that do not describe their content
+// preserve unknown fields, so that the API server does not prune them.
+func convertSchema(input map[string]any, include func(name string, property map[string]any) bool) map[string]any {
+	result := map[string]any{}
+	for _, keyword := range crdSchemaKeywords {
+		if value, ok := input[keyword]; ok {
+			result[keyword] = value
+		}
+	}
+
+	// OpenAPI 3.0 declares exclusive bounds as booleans, other forms have no equivalent.
+	for _, keyword := range []string{"exclusiveMinimum", "exclusiveMaximum"} {
+		if value, ok := input[keyword].(bool); ok {
+			result[keyword] = value
+		}
+	}
+
+	if properties, ok := input["properties"].(map[string]any); ok {
+		converted := map[string]any{}
+		for name, value := range properties {
+			property, _ := value.(map[string]any)
+			if property == nil || !include(name, property) {
+				continue
+			}
+			converted[name] = convertSchema(property, include)
+		}
+		result["properties"] = converted
+
+		if required, ok := input["required"].([]any); ok {
+			kept := []any{}
+			for _, name := range required {
+				if _, ok := converted[fmt.Sprint(name)]; ok {
+					kept = append(kept, name)
+				}
+			}
+			if len(kept) > 0 {
+				result["required"] = kept
+			}
+		}
+	}
+
+	if items, ok := input["items"].(map[string]any); ok {
+		result["items"] = convertSchema(items, include)
+	}
+
+	// Custom resource definitions don't allow both properties and additionalProperties.
+	if _, ok := result["properties"]; !ok {
+		switch additionalProperties := input["additionalProperties"].(type) {
+		case map[string]any:
+			result["additionalProperties"] = convertSchema(additionalProperties, include)
+		case bool:
+			if additionalProperties {
+				result["x-kubernetes-preserve-unknown-fields"] = true
+			}
+		}
+	}
+
+	if _, ok := result["type"]; !ok {
+		if _, ok := result["properties"]; ok {
+			result["type"] = "object"
+		} else {
+			result["x-kubernetes-preserve-unknown-fields"] = true
+		}
+	}
+
+	if result["type"] == "object" && result["properties"] == nil && result["additionalProperties"] == nil {
+		result["x-kubernetes-preserve-unknown-fields"] = true
+	}
+
+	if immutable, _ := input["x-radius-immutable"].(bool); immutable {
+		result["x-kubernetes-validations"] = []any{
+			map[string]any{"rule": "self == oldSelf", "message": "Value is immutable"},
+		}
+	}
+
+	return result
+}
+
+// toJSONSchemaProps decodes a converted schema.
+func toJSONSchemaProps(input map[string]any) (*apiextensionsv1.JSONSchemaProps, error) {
+	bs, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	props := &apiextensionsv1.JSONSchemaProps{}
+	err = json.Unmarshal(bs, props)
+	if err != nil {
+		return nil, fmt.Errorf("the schema cannot be converted to a custom resource definition schema: %w", err)
+	}
+
+	return props, nil
+}
+
+func isReadOnlySchema(schema map[string]any) bool {
+	readOnly, _ := schema["readOnly"].(bool)
+	return readOnly
+}
+
+func isSensitiveSchema(schema map[string]any) bool {
+	sensitive, _ := schema["x-radius-sensitive"].(bool)
+	return sensitive
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func makePostgreSqlDatabasesSummary() *ucpv20231001preview.ResourceProviderSummaryResourceType {
+	return &ucpv20231001preview.ResourceProviderSummaryResourceType{
+		Capabilities:      []*string{new("KubernetesCustomResource")},
+		DefaultAPIVersion: new("2025-08-01-preview"),
+		Description:       new("A PostgreSQL database."),
+		APIVersions: map[string]*ucpv20231001preview.ResourceTypeSummaryResultAPIVersion{
+			"2025-01-01-preview": {Schema: map[string]any{"type": "object"}},
+			"2025-08-01-preview": {
+				Schema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"environment": map[string]any{"type": "string", "description": "The Radius Environment ID."},
+						"application": map[string]any{"type": "string", "description": "The Radius Application ID."},
+						"size": map[string]any{
+							"type":               "string",
+							"enum":               []any{"S", "M", "L"},
+							"x-radius-immutable": true,
+						},
+						"password": map[string]any{"type": "string", "x-radius-sensitive": true},
+						"tags": map[string]any{
+							"type":                 "object",
+							"additionalProperties": map[string]any{"type": "string"},
+						},
+						"options": map[string]any{"type": "object"},
+						"host":    map[string]any{"type": "string", "readOnly": true},
+						"port":    map[string]any{"type": "integer", "readOnly": true, "exclusiveMinimum": true, "minimum": 0},
+						"connectionString": map[string]any{
+							"type":               "string",
+							"readOnly":           true,
+							"x-radius-sensitive": true,
+						},
+					},
+					"required": []any{"environment", "size", "host"},
+					"x-radius-validations": []any{
+						map[string]any{"rule": "self.size != 'L'"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_HasKubernetesCustomResourceCapability(t *testing.T) {
+	require.True(t, HasKubernetesCustomResourceCapability(makePostgreSqlDatabasesSummary()))
+	require.False(t, HasKubernetesCustomResourceCapability(&ucpv20231001preview.ResourceProviderSummaryResourceType{
+		Capabilities: []*string{new("ManualResourceProvisioning")},
+	}))
+	require.False(t, HasKubernetesCustomResourceCapability(&ucpv20231001preview.ResourceProviderSummaryResourceType{}))
+}
+
+func Test_resourceTypeGroupVersionKind(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		gvk          schema.GroupVersionKind
+		plural       string
+		err          string
+	}{
+		{
+			resourceType: "Radius.Data/postgreSqlDatabases",
+			gvk:          schema.GroupVersionKind{Group: "radius.data.radapp.io", Version: "v1alpha1", Kind: "PostgreSqlDatabase"},
+			plural:       "postgresqldatabases",
+		},
+		{
+			resourceType: "MyCompany.Resources/redisCaches",
+			gvk:          schema.GroupVersionKind{Group: "mycompany.resources.radapp.io", Version: "v1alpha1", Kind: "RedisCache"},
+			plural:       "rediscaches",
+		},
+		{
+			resourceType: "MyCompany.Resources/gateways",
+			gvk:          schema.GroupVersionKind{Group: "mycompany.resources.radapp.io", Version: "v1alpha1", Kind: "Gateway"},
+			plural:       "gateways",
+		},
+		{
+			resourceType: "invalid",
+			err:          `resource type "invalid" must be of the form 'Namespace/typeName'`,
+		},
+		{
+			resourceType: "MyCompany.Resources/9lives",
+			err:          `resource type "MyCompany.Resources/9lives" cannot be projected to the resource "9lives"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			gvk, plural, err := resourceTypeGroupVersionKind(tt.resourceType)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.gvk, gvk)
+			require.Equal(t, tt.plural, plural)
+		})
+	}
+}
+
+func Test_singular(t *testing.T) {
+	tests := map[string]string{
+		"postgreSqlDatabases": "postgreSqlDatabase",
+		"policies":            "policy",
+		"addresses":           "address",
+		"accessPasses":        "accessPass",
+		"ingress":             "ingress",
+		"s":                   "s",
+		"data":                "data",
+	}
+
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			require.Equal(t, expected, singular(input))
+		})
+	}
+}
+
+func Test_NewResourceTypeProjection(t *testing.T) {
+	t.Run("default API version", func(t *testing.T) {
+		projection, err := NewResourceTypeProjection("Radius.Data/postgreSqlDatabases", makePostgreSqlDatabasesSummary())
+		require.NoError(t, err)
+		require.Equal(t, "Radius.Data/postgreSqlDatabases", projection.ResourceType)
+		require.Equal(t, "2025-08-01-preview", projection.APIVersion)
+		require.Equal(t, "postgresqldatabases", projection.Plural)
+		require.Equal(t, "A PostgreSQL database.", projection.Description)
+		require.Equal(t, []string{"host", "port"}, projection.StatusProperties)
+	})
+
+	t.Run("latest API version", func(t *testing.T) {
+		summary := makePostgreSqlDatabasesSummary()
+		summary.DefaultAPIVersion = nil
+
+		projection, err := NewResourceTypeProjection("Radius.Data/postgreSqlDatabases", summary)
+		require.NoError(t, err)
+		require.Equal(t, "2025-08-01-preview", projection.APIVersion)
+	})
+
+	t.Run("no API versions", func(t *testing.T) {
+		_, err := NewResourceTypeProjection("Radius.Data/postgreSqlDatabases", &ucpv20231001preview.ResourceProviderSummaryResourceType{})
+		require.EqualError(t, err, `resource type "Radius.Data/postgreSqlDatabases" has no API versions`)
+	})
+
+	t.Run("no schema", func(t *testing.T) {
+		summary := makePostgreSqlDatabasesSummary()
+		summary.APIVersions["2025-08-01-preview"].Schema = nil
+
+		_, err := NewResourceTypeProjection("Radius.Data/postgreSqlDatabases", summary)
+		require.EqualError(t, err, `resource type "Radius.Data/postgreSqlDatabases" has no schema for API version "2025-08-01-preview"`)
+	})
+}
+
+func Test_ResourceTypeProjection_CustomResourceDefinition(t *testing.T) {
+	projection, err := NewResourceTypeProjection("Radius.Data/postgreSqlDatabases", makePostgreSqlDatabasesSummary())
+	require.NoError(t, err)
+
+	crd, err := projection.CustomResourceDefinition()
+	require.NoError(t, err)
+
+	require.Equal(t, "postgresqldatabases.radius.data.radapp.io", crd.Name)
+	require.Equal(t, map[string]string{LabelManagedBy: ResourceTypeCRDManager}, crd.Labels)
+	require.Equal(t, map[string]string{
+		AnnotationResourceType:           "Radius.Data/postgreSqlDatabases",
+		AnnotationResourceTypeAPIVersion: "2025-08-01-preview",
+	}, crd.Annotations)
+
+	require.Equal(t, "radius.data.radapp.io", crd.Spec.Group)
+	require.Equal(t, apiextensionsv1.NamespaceScoped, crd.Spec.Scope)
+	require.Equal(t, apiextensionsv1.CustomResourceDefinitionNames{
+		Kind:       "PostgreSqlDatabase",
+		ListKind:   "PostgreSqlDatabaseList",
+		Plural:     "postgresqldatabases",
+		Singular:   "postgresqldatabase",
+		Categories: []string{"all", "radius"},
+	}, crd.Spec.Names)
+
+	require.Len(t, crd.Spec.Versions, 1)
+	version := crd.Spec.Versions[0]
+	require.Equal(t, "v1alpha1", version.Name)
+	require.True(t, version.Served)
+	require.True(t, version.Storage)
+	require.NotNil(t, version.Subresources.Status)
+	require.Equal(t, ".status.phrase", version.AdditionalPrinterColumns[0].JSONPath)
+	require.Equal(t, "A PostgreSQL database.", version.Schema.OpenAPIV3Schema.Description)
+
+	expectedSpec := apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"environment": {
+				Type:        "string",
+				Description: "Environment is the name of the Radius environment to use. If unset the value 'default' will be used as the environment name.",
+			},
+			"application": {
+				Type:        "string",
+				Description: "Application is the name of the Radius application to use. If unset the namespace of the resource will be used as the application name.",
+			},
+			"size": {
+				Type: "string",
+				Enum: []apiextensionsv1.JSON{{Raw: []byte(`"S"`)}, {Raw: []byte(`"M"`)}, {Raw: []byte(`"L"`)}},
+				XValidations: apiextensionsv1.ValidationRules{
+					{Rule: "self == oldSelf", Message: "Value is immutable"},
+				},
+			},
+			"password": {Type: "string"},
+			"tags": {
+				Type:                 "object",
+				AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: true, Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"}},
+			},
+			"options": {Type: "object", XPreserveUnknownFields: new(true)},
+		},
+		Required: []string{"size"},
+	}
+	require.Equal(t, expectedSpec, version.Schema.OpenAPIV3Schema.Properties["spec"])
+
+	status := version.Schema.OpenAPIV3Schema.Properties["status"]
+	require.Equal(t, "object", status.Type)
+	require.Contains(t, status.Properties, "phrase")
+	require.Contains(t, status.Properties, "operation")
+	require.Equal(t, apiextensionsv1.JSONSchemaProps{
+		Type:        "object",
+		Description: "Properties are the read-only properties of the resource.",
+		Properties: map[string]apiextensionsv1.JSONSchemaProps{
+			"host": {Type: "string"},
+			"port": {Type: "integer", Minimum: to.Ptr(0.0), ExclusiveMinimum: true},
+		},
+	}, status.Properties["properties"])
+}
+
+func Test_convertSchema(t *testing.T) {
+	includeAll := func(name string, property map[string]any) bool { return true }
+
+	tests := []struct {
+		name     string
+		input    map[string]any
+		expected map[string]any
+	}{
+		{
+			name:     "untyped",
+			input:    map[string]any{"description": "Anything."},
+			expected: map[string]any{"description": "Anything.", "x-kubernetes-preserve-unknown-fields": true},
+		},
+		{
+			name:     "properties without type",
+			input:    map[string]any{"properties": map[string]any{"name": map[string]any{"type": "string"}}},
+			expected: map[string]any{"type": "object", "properties": map[string]any{"name": map[string]any{"type": "string"}}},
+		},
+		{
+			name: "properties and additional properties",
+			input: map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"name": map[string]any{"type": "string"}},
+				"additionalProperties": map[string]any{"type": "string"},
+			},
+			expected: map[string]any{"type": "object", "properties": map[string]any{"name": map[string]any{"type": "string"}}},
+		},
+		{
+			name: "arrays",
+			input: map[string]any{
+				"type":     "array",
+				"items":    map[string]any{"type": "object", "additionalProperties": true},
+				"maxItems": 3,
+			},
+			expected: map[string]any{
+				"type":     "array",
+				"items":    map[string]any{"type": "object", "x-kubernetes-preserve-unknown-fields": true},
+				"maxItems": 3,
+			},
+		},
+		{
+			name:     "unsupported keywords",
+			input:    map[string]any{"type": "number", "exclusiveMinimum": 1, "x-radius-sensitive": true, "readOnly": true},
+			expected: map[string]any{"type": "number"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, convertSchema(tt.input, includeAll))
+		})
+	}
+}
@@ -0,0 +1,622 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/go-logr/logr"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ResourceTypeStatus defines the observed state of a custom resource projected from a Radius resource type.
+type ResourceTypeStatus struct {
+	// ObservedGeneration is the most recent generation observed for this resource.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Application is the resource ID of the application.
+	Application string `json:"application,omitempty"`
+
+	// Environment is the resource ID of the environment.
+	Environment string `json:"environment,omitempty"`
+
+	// Scope is the resource ID of the scope.
+	Scope string `json:"scope,omitempty"`
+
+	// Resource is the resource ID of the resource.
+	Resource string `json:"resource,omitempty"`
+
+	// Operation tracks the status of an in-progress provisioning operation.
+	Operation *radappiov1alpha3.ResourceOperation `json:"operation,omitempty"`
+
+	// Phrase indicates the current status of the resource.
+	Phrase radappiov1alpha3.RecipePhrase `json:"phrase,omitempty"`
+
+	// Properties are the read-only properties of the resource.
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// ResourceTypeReconciler reconciles the custom resources of a Radius resource type projected into Kubernetes.
+//
+// Each custom resource is mirrored to a Radius resource of the same name. The spec of the custom resource holds the
+// properties of the Radius resource, and the status reflects the provisioning state and the read-only properties.
+type ResourceTypeReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// DelayInterval is the amount of time to wait between operations.
+	DelayInterval time.Duration
+
+	lock       sync.RWMutex
+	projection *ResourceTypeProjection
+}
+
+// NewResourceTypeReconciler creates a reconciler for the custom resources of the projected resource type.
+func NewResourceTypeReconciler(projection *ResourceTypeProjection) *ResourceTypeReconciler {
+	return &ResourceTypeReconciler{projection: projection}
+}
+
+// Projection returns the projection of the resource type reconciled.
+func (r *ResourceTypeReconciler) Projection() *ResourceTypeProjection {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.projection
+}
+
+// SetProjection updates the projection of the resource type reconciled, for example when its default API version changes.
+// The group, version and kind of the custom resources cannot change.
+func (r *ResourceTypeReconciler) SetProjection(projection *ResourceTypeProjection) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.projection = projection
+}
+
+// Reconcile is the main reconciliation loop for the custom resources of the resource type.
+func (r *ResourceTypeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	projection := r.Projection()
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", projection.GroupVersionKind.Kind, "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(projection.GroupVersionKind)
+	err := r.Client.Get(ctx, req.NamespacedName, obj)
+	if apierrors.IsNotFound(err) {
+		// This can happen due to a data-race if the resource is created and then deleted before we can
+		// reconcile it. There's nothing to do here.
+		logger.Info("Resource is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	status, err := getResourceTypeStatus(obj)
+	if err != nil {
+		logger.Error(err, "Unable to read status.")
+		return ctrl.Result{}, err
+	}
+
+	// Our algorithm is the same as the Recipe reconciler:
+	//
+	// 1. Check if we have an "operation" in progress. If so, check it's status.
+	// 2. If the resource is being deleted then process deletion.
+	// 3. If the resource is not being deleted then process this as a creation or update.
+	//
+	// Unlike recipes, the spec holds the properties of the Radius resource, so a new generation
+	// of the resource starts a new PUT operation.
+
+	if status.Operation != nil {
+		result, err := r.reconcileOperation(ctx, projection, obj, status)
+		if err != nil {
+			logger.Error(err, "Unable to reconcile in-progress operation.")
+			return ctrl.Result{}, err
+		} else if result.IsZero() {
+			// NOTE: if reconcileOperation completes successfully, then it will return a "zero" result,
+			// this means the operation has completed and we should continue processing.
+			logger.Info("Operation completed successfully.")
+		} else {
+			logger.Info("Requeueing to continue operation.")
+			return result, nil
+		}
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return r.reconcileDelete(ctx, projection, obj, status)
+	}
+
+	return r.reconcileUpdate(ctx, projection, obj, status)
+}
+
+// reconcileOperation reconciles a resource that has an operation in progress.
+func (r *ResourceTypeReconciler) reconcileOperation(ctx context.Context, projection *ResourceTypeProjection, obj *unstructured.Unstructured, status *ResourceTypeStatus) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resources := r.Radius.Resources(status.Scope, projection.ResourceType, projection.APIVersion)
+	if status.Operation.OperationKind == radappiov1alpha3.OperationKindPut {
+		poller, err := resources.ContinueCreateOperation(ctx, status.Operation.ResumeToken)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to continue PUT operation: %w", err)
+		}
+
+		_, err = poller.Poll(ctx)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to poll operation status: %w", err)
+		}
+
+		if !poller.Done() {
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation is complete.
+		_, err = poller.Result(ctx)
+		if err != nil {
+			// Operation failed, reset state and retry.
+			r.EventRecorder.Event(obj, corev1.EventTypeWarning, "ResourceError", err.Error())
+			logger.Error(err, "Update failed.")
+
+			status.Operation = nil
+			status.Phrase = radappiov1alpha3.PhraseFailed
+
+			err = r.updateStatus(ctx, obj, status)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation was a success. Update the status and continue.
+		//
+		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		status.Operation = nil
+		status.Resource = status.Scope + "/providers/" + projection.ResourceType + "/" + obj.GetName()
+		return ctrl.Result{}, nil
+
+	} else if status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
+		poller, err := resources.ContinueDeleteOperation(ctx, status.Operation.ResumeToken)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to continue DELETE operation: %w", err)
+		}
+
+		_, err = poller.Poll(ctx)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to poll operation status: %w", err)
+		}
+
+		if !poller.Done() {
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation is complete.
+		_, err = poller.Result(ctx)
+		if err != nil {
+			// Operation failed, reset state and retry.
+			r.EventRecorder.Event(obj, corev1.EventTypeWarning, "ResourceError", err.Error())
+			logger.Error(err, "Delete failed.")
+
+			status.Operation = nil
+			status.Phrase = radappiov1alpha3.PhraseFailed
+
+			err = r.updateStatus(ctx, obj, status)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+		}
+
+		// If we get here, the operation was a success. Update the status and continue.
+		//
+		// NOTE: we don't need to save the status here, because we're going to continue reconciling.
+		status.Operation = nil
+		status.Resource = ""
+		status.Properties = nil
+		return ctrl.Result{}, nil
+	}
+
+	// If we get here, this was an unknown operation kind. This is a bug in our code, or someone
+	// tampered with the status of the object. Just reset the state and move on.
+	logger.Error(fmt.Errorf("unknown operation kind: %s", status.Operation.OperationKind), "Unknown operation kind.")
+
+	status.Operation = nil
+	status.Phrase = radappiov1alpha3.PhraseFailed
+
+	err := r.updateStatus(ctx, obj, status)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceTypeReconciler) reconcileUpdate(ctx context.Context, projection *ResourceTypeProjection, obj *unstructured.Unstructured, status *ResourceTypeStatus) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Ensure that our finalizer is present before we start any operations.
+	if controllerutil.AddFinalizer(obj, ResourceTypeFinalizer) {
+		err := r.Client.Update(ctx, obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The spec has changed since the last operation, or the last operation failed.
+	changed := status.ObservedGeneration != obj.GetGeneration() || status.Phrase == radappiov1alpha3.PhraseFailed
+
+	// Since we're going to reconcile, update the observed generation.
+	//
+	// We don't want to do this if we're in the middle of an operation, because we haven't
+	// fully processed any status changes until the async operation completes.
+	status.ObservedGeneration = obj.GetGeneration()
+
+	spec, _, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	environmentName := "default"
+	if value, ok := spec["environment"].(string); ok && value != "" {
+		environmentName = value
+	}
+
+	applicationName := obj.GetNamespace()
+	if value, ok := spec["application"].(string); ok && value != "" {
+		applicationName = value
+	}
+
+	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", environmentName, applicationName)
+	if err != nil {
+		r.EventRecorder.Event(obj, corev1.EventTypeWarning, "DependencyError", err.Error())
+		logger.Error(err, "Unable to resolve dependencies.")
+		return ctrl.Result{}, fmt.Errorf("failed to resolve dependencies: %w", err)
+	}
+
+	status.Scope = resourceGroupID
+	status.Environment = environmentID
+	status.Application = applicationID
+
+	updatePoller, deletePoller, err := r.startPutOrDeleteOperationIfNeeded(ctx, projection, obj, status, spec, changed)
+	if err != nil {
+		logger.Error(err, "Unable to create or update resource.")
+		r.EventRecorder.Event(obj, corev1.EventTypeWarning, "ResourceError", err.Error())
+		return ctrl.Result{}, err
+	} else if updatePoller != nil {
+		// We've successfully started an operation. Update the status and requeue.
+		token, err := updatePoller.ResumeToken()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
+		}
+
+		status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
+		status.Phrase = radappiov1alpha3.PhraseUpdating
+		err = r.updateStatus(ctx, obj, status)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	} else if deletePoller != nil {
+		// We've successfully started an operation. Update the status and requeue.
+		token, err := deletePoller.ResumeToken()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
+		}
+
+		status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
+		status.Phrase = radappiov1alpha3.PhraseDeleting
+		err = r.updateStatus(ctx, obj, status)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	// If we get here then it means we can process the result of the operation.
+	logger.Info("Resource is in desired state.", "resourceId", status.Resource)
+
+	err = r.updateStatusProperties(ctx, projection, status)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to read properties of %s: %w", status.Resource, err)
+	}
+
+	status.Phrase = radappiov1alpha3.PhraseReady
+	err = r.updateStatus(ctx, obj, status)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(obj, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceTypeReconciler) reconcileDelete(ctx context.Context, projection *ResourceTypeProjection, obj *unstructured.Unstructured, status *ResourceTypeStatus) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Since we're going to reconcile, update the observed generation.
+	//
+	// We don't want to do this if we're in the middle of an operation, because we haven't
+	// fully processed any status changes until the async operation completes.
+	status.ObservedGeneration = obj.GetGeneration()
+
+	poller, err := r.startDeleteOperationIfNeeded(ctx, projection, status)
+	if err != nil {
+		logger.Error(err, "Unable to delete resource.")
+		r.EventRecorder.Event(obj, corev1.EventTypeWarning, "ResourceError", err.Error())
+		return ctrl.Result{}, err
+	} else if poller != nil {
+		// We've successfully started an operation. Update the status and requeue.
+		token, err := poller.ResumeToken()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get operation token: %w", err)
+		}
+
+		status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindDelete}
+		status.Phrase = radappiov1alpha3.PhraseDeleting
+		err = r.updateStatus(ctx, obj, status)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	logger.Info("Resource is deleted.")
+
+	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
+	// resource.
+	if controllerutil.RemoveFinalizer(obj, ResourceTypeFinalizer) {
+		err := r.Client.Update(ctx, obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		status.ObservedGeneration = obj.GetGeneration()
+	}
+
+	status.Phrase = radappiov1alpha3.PhraseDeleted
+	err = r.updateStatus(ctx, obj, status)
+	if apierrors.IsNotFound(err) {
+		// The resource is gone once the finalizer is removed.
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(obj, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceTypeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context, projection *ResourceTypeProjection, obj *unstructured.Unstructured, status *ResourceTypeStatus, spec map[string]any, changed bool) (sdkclients.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], sdkclients.Poller[generated.GenericResourcesClientDeleteResponse], error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resourceID := status.Scope + "/providers/" + projection.ResourceType + "/" + obj.GetName()
+	if status.Resource != "" && !strings.EqualFold(status.Resource, resourceID) {
+		// If we get here it means that the environment or application changed, so we should delete
+		// the old resource and create a new one.
+		logger.Info("Resource is already created but is out-of-date")
+
+		logger.Info("Starting DELETE operation.")
+		poller, err := deleteResource(ctx, r.Radius, status.Resource, projection.APIVersion)
+		if err != nil {
+			return nil, nil, err
+		} else if poller != nil {
+			return nil, poller, nil
+		}
+
+		// Deletion was synchronous
+		status.Resource = ""
+		status.Properties = nil
+	}
+
+	// Note: we separate this check from the previous block, because it could complete synchronously.
+	if status.Resource != "" && !changed {
+		logger.Info("Resource is already created and is up-to-date.")
+		return nil, nil, nil
+	}
+
+	logger.Info("Starting PUT operation.")
+	properties := map[string]any{}
+	for key, value := range spec {
+		properties[key] = value
+	}
+	delete(properties, "application")
+	properties["environment"] = status.Environment
+
+	// Not every resource type belongs to an application.
+	if _, ok := projectedProperties(projection)["application"]; ok {
+		properties["application"] = status.Application
+	}
+
+	poller, err := createOrUpdateResource(ctx, r.Radius, resourceID, properties, projection.APIVersion)
+	if err != nil {
+		return nil, nil, err
+	} else if poller != nil {
+		return poller, nil, nil
+	}
+
+	// Update was synchronous
+	status.Resource = resourceID
+	return nil, nil, nil
+}
+
+func (r *ResourceTypeReconciler) startDeleteOperationIfNeeded(ctx context.Context, projection *ResourceTypeProjection, status *ResourceTypeStatus) (sdkclients.Poller[generated.GenericResourcesClientDeleteResponse], error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if status.Resource == "" {
+		logger.Info("Resource is already deleted (or was never created).")
+		return nil, nil
+	}
+
+	logger.Info("Starting DELETE operation.")
+	poller, err := deleteResource(ctx, r.Radius, status.Resource, projection.APIVersion)
+	if err != nil {
+		return nil, err
+	} else if poller != nil {
+		return poller, err
+	}
+
+	// Deletion was synchronous
+
+	status.Resource = ""
+	status.Properties = nil
+	return nil, nil
+}
+
+// updateStatusProperties reflects the read-only properties of the Radius resource in the status.
+func (r *ResourceTypeReconciler) updateStatusProperties(ctx context.Context, projection *ResourceTypeProjection, status *ResourceTypeStatus) error {
+	if len(projection.StatusProperties) == 0 {
+		status.Properties = nil
+		return nil
+	}
+
+	result, err := fetchResource(ctx, r.Radius, status.Resource, projection.APIVersion)
+	if err != nil {
+		return err
+	}
+
+	properties := map[string]any{}
+	for _, name := range projection.StatusProperties {
+		if value, ok := result.Properties[name]; ok {
+			properties[name] = value
+		}
+	}
+
+	status.Properties = properties
+	return nil
+}
+
+func (r *ResourceTypeReconciler) updateStatus(ctx context.Context, obj *unstructured.Unstructured, status *ResourceTypeStatus) error {
+	err := setResourceTypeStatus(obj, status)
+	if err != nil {
+		return err
+	}
+
+	return r.Client.Status().Update(ctx, obj)
+}
+
+func (r *ResourceTypeReconciler) requeueDelay() time.Duration {
+	delay := r.DelayInterval
+	if delay == 0 {
+		delay = PollingDelay
+	}
+
+	return delay
+}
+
+// StartWithManager starts a controller for the custom resources, using the cache and the controller options of the
+// Manager. Unlike the controllers registered with the Manager, the controller runs until the context is cancelled, so
+// that it can be stopped when the resource type is no longer projected.
+func (r *ResourceTypeReconciler) StartWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	projection := r.Projection()
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", projection.GroupVersionKind.Kind)
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(projection.GroupVersionKind)
+
+	// The controller is started again if the resource type is projected again, so its name is not unique.
+	options := controller.Options{Reconciler: r, SkipNameValidation: to.Ptr(true)}
+	options.DefaultFromConfig(mgr.GetControllerOptions())
+
+	c, err := controller.NewUnmanaged(strings.ReplaceAll(projection.Plural+"."+projection.GroupVersionKind.Group, ".", "-"), options)
+	if err != nil {
+		return err
+	}
+
+	err = c.Watch(source.Kind(mgr.GetCache(), obj, &handler.TypedEnqueueRequestForObject[*unstructured.Unstructured]{}))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		err := c.Start(ctx)
+		if err != nil {
+			logger.Error(err, "Reconciler exited with error.")
+		}
+
+		// Stop watching the custom resources, their definition is about to be deleted.
+		err = mgr.GetCache().RemoveInformer(context.Background(), obj)
+		if err != nil {
+			logger.Error(err, "Unable to stop watching custom resources.")
+		}
+	}()
+
+	return nil
+}
+
+// projectedProperties returns the properties of the schema of the projected resource type.
+func projectedProperties(projection *ResourceTypeProjection) map[string]any {
+	properties, _ := projection.Schema["properties"].(map[string]any)
+	return properties
+}
+
+func getResourceTypeStatus(obj *unstructured.Unstructured) (*ResourceTypeStatus, error) {
+	status := &ResourceTypeStatus{}
+
+	value, ok, err := unstructured.NestedMap(obj.Object, "status")
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return status, nil
+	}
+
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(value, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func setResourceTypeStatus(obj *unstructured.Unstructured, status *ResourceTypeStatus) error {
+	value, err := runtime.DefaultUnstructuredConverter.ToUnstructured(status)
+	if err != nil {
+		return err
+	}
+
+	return unstructured.SetNestedMap(obj.Object, value, "status")
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+func SetupResourceTypeTest(t *testing.T) (*mockRadiusClient, client.Client, *ResourceTypeProjection) {
+	SkipWithoutEnvironment(t)
+
+	// Shut down the manager when the test exits.
+	ctx, cancel := testcontext.NewWithCancel(t)
+	t.Cleanup(cancel)
+
+	projection, err := NewResourceTypeProjection("Radius.Data/postgreSqlDatabases", makePostgreSqlDatabasesSummary())
+	require.NoError(t, err)
+
+	crd, err := projection.CustomResourceDefinition()
+	require.NoError(t, err)
+
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	require.NoError(t, err)
+
+	err = c.Create(ctx, crd)
+	if !apierrors.IsAlreadyExists(err) {
+		require.NoError(t, err)
+	}
+
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		current := &apiextensionsv1.CustomResourceDefinition{}
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: crd.Name}, current))
+
+		established := false
+		for _, condition := range current.Status.Conditions {
+			established = established || (condition.Type == apiextensionsv1.Established && condition.Status == apiextensionsv1.ConditionTrue)
+		}
+		assert.True(t, established, "custom resource definition is not established")
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "failed to establish custom resource definition")
+
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
+		Scheme: scheme,
+		Controller: crconfig.Controller{
+			SkipNameValidation: new(true),
+		},
+
+		// Suppress metrics in tests to avoid conflicts.
+		Metrics: server.Options{
+			BindAddress: "0",
+		},
+	})
+	require.NoError(t, err)
+
+	radius := NewMockRadiusClient()
+	reconciler := NewResourceTypeReconciler(projection)
+	reconciler.Client = mgr.GetClient()
+	reconciler.Scheme = mgr.GetScheme()
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	reconciler.EventRecorder = mgr.GetEventRecorderFor("resourcetype-controller")
+	reconciler.Radius = radius
+	reconciler.DelayInterval = recipeTestControllerDelayInterval
+
+	go func() {
+		// Cannot use require/assert here - accessing testing.T from a non-test goroutine causes a data race.
+		if err := mgr.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			panic(fmt.Sprintf("manager exited with error: %v", err))
+		}
+	}()
+
+	err = reconciler.StartWithManager(ctx, mgr)
+	require.NoError(t, err)
+
+	return radius, mgr.GetClient(), projection
+}
+
+func makeResourceTypeObject(projection *ResourceTypeProjection, name types.NamespacedName, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetGroupVersionKind(projection.GroupVersionKind)
+	obj.SetNamespace(name.Namespace)
+	obj.SetName(name.Name)
+	return obj
+}
+
+func waitForResourceTypeState(t *testing.T, client client.Client, projection *ResourceTypeProjection, name types.NamespacedName, phrase radappiov1alpha3.RecipePhrase) *ResourceTypeStatus {
+	ctx := testcontext.New(t)
+
+	logger := t
+	status := &ResourceTypeStatus{}
+	require.EventuallyWithTf(t, func(t *assert.CollectT) {
+		logger.Logf("Fetching %s: %+v", projection.GroupVersionKind.Kind, name)
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(projection.GroupVersionKind)
+		err := client.Get(ctx, name, current)
+		require.NoError(t, err)
+
+		status, err = getResourceTypeStatus(current)
+		require.NoError(t, err)
+		logger.Logf("Status: %+v", status)
+		assert.Equal(t, status.ObservedGeneration, current.GetGeneration(), "Status is not updated")
+
+		if assert.Equal(t, phrase, status.Phrase) && phrase != radappiov1alpha3.PhraseReady {
+			assert.NotEmpty(t, status.Operation)
+		}
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "failed to enter %s state", phrase)
+
+	return status
+}
+
+func Test_ResourceTypeReconciler_Basic(t *testing.T) {
+	ctx := testcontext.New(t)
+	radius, client, projection := SetupResourceTypeTest(t)
+
+	name := types.NamespacedName{Namespace: "resourcetype-basic", Name: "test-resourcetype-basic"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	obj := makeResourceTypeObject(projection, name, map[string]any{"size": "S"})
+	err = client.Create(ctx, obj)
+	require.NoError(t, err)
+
+	// Resource will be waiting for environment to be created.
+	createEnvironment(radius, "default", "default")
+
+	// Resource will be waiting for the database to complete provisioning.
+	status := waitForResourceTypeState(t, client, projection, name, radappiov1alpha3.PhraseUpdating)
+	require.Equal(t, "/planes/radius/local/resourcegroups/default-resourcetype-basic", status.Scope)
+
+	resourceID := status.Scope + "/providers/Radius.Data/postgreSqlDatabases/" + name.Name
+	radius.Update(func() {
+		resource := radius.resources[resourceID]
+		require.Equal(t, "S", resource.Properties["size"])
+		require.Equal(t, status.Environment, resource.Properties["environment"])
+		require.Equal(t, status.Application, resource.Properties["application"])
+
+		resource.Properties["host"] = "db.example.com"
+		resource.Properties["connectionString"] = "secret"
+		radius.resources[resourceID] = resource
+	})
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	// Read-only properties are reflected once the operation completes.
+	status = waitForResourceTypeState(t, client, projection, name, radappiov1alpha3.PhraseReady)
+	require.Equal(t, resourceID, status.Resource)
+	require.Equal(t, map[string]any{"host": "db.example.com"}, status.Properties)
+
+	// Updating the spec updates the resource.
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(projection.GroupVersionKind)
+	require.NoError(t, client.Get(ctx, name, current))
+	require.NoError(t, unstructured.SetNestedField(current.Object, map[string]any{"team": "data"}, "spec", "tags"))
+	require.NoError(t, client.Update(ctx, current))
+
+	status = waitForResourceTypeState(t, client, projection, name, radappiov1alpha3.PhraseUpdating)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+	waitForResourceTypeState(t, client, projection, name, radappiov1alpha3.PhraseReady)
+
+	err = client.Delete(ctx, current)
+	require.NoError(t, err)
+
+	// Deletion of the resource is in progress.
+	status = waitForResourceTypeState(t, client, projection, name, radappiov1alpha3.PhraseDeleting)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	require.Eventuallyf(t, func() bool {
+		err := client.Get(ctx, name, current)
+		return apierrors.IsNotFound(err)
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "resource still exists")
+}
+
+func Test_ResourceTypeStatus_RoundTrip(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+
+	status, err := getResourceTypeStatus(obj)
+	require.NoError(t, err)
+	require.Equal(t, &ResourceTypeStatus{}, status)
+
+	expected := &ResourceTypeStatus{
+		ObservedGeneration: 2,
+		Scope:              "/planes/radius/local/resourcegroups/default-test",
+		Resource:           "/planes/radius/local/resourcegroups/default-test/providers/Radius.Data/postgreSqlDatabases/db",
+		Operation:          &radappiov1alpha3.ResourceOperation{ResumeToken: "token", OperationKind: radappiov1alpha3.OperationKindPut},
+		Phrase:             radappiov1alpha3.PhraseUpdating,
+		Properties:         map[string]any{"host": "db.example.com", "port": int64(5432)},
+	}
+	require.NoError(t, setResourceTypeStatus(obj, expected))
+
+	status, err = getResourceTypeStatus(obj)
+	require.NoError(t, err)
+	require.Equal(t, expected, status)
+}
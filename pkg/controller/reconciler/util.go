@@ -154,7 +154,7 @@ func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, envi
 	return nil
 }
 
-func deleteResource(ctx context.Context, radius RadiusClient, resourceID string) (sdkclients.Poller[generated.GenericResourcesClientDeleteResponse], error) {
+func deleteResource(ctx context.Context, radius RadiusClient, resourceID string, apiVersion ...string) (sdkclients.Poller[generated.GenericResourcesClientDeleteResponse], error) {
 	id, err := resources.Parse(resourceID)
 	if err != nil {
 		return nil, err
@@ -163,7 +163,7 @@ func deleteResource(ctx context.Context, radius RadiusClient, resourceID string)
 	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", id.RootScope(), "resourceType", id.Type())
 	logger.Info("Deleting resource.")
 
-	poller, err := radius.Resources(id.RootScope(), id.Type()).BeginDelete(ctx, id.Name(), nil)
+	poller, err := radius.Resources(id.RootScope(), id.Type(), apiVersion...).BeginDelete(ctx, id.Name(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +181,7 @@ func deleteResource(ctx context.Context, radius RadiusClient, resourceID string)
 	return nil, nil
 }
 
-func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID string, properties map[string]any) (sdkclients.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error) {
+func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID string, properties map[string]any, apiVersion ...string) (sdkclients.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error) {
 	id, err := resources.Parse(resourceID)
 	if err != nil {
 		return nil, err
@@ -195,7 +195,7 @@ func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID
 		Name:       new(id.Name()),
 		Properties: properties,
 	}
-	poller, err := radius.Resources(id.RootScope(), id.Type()).BeginCreateOrUpdate(ctx, id.Name(), body, nil)
+	poller, err := radius.Resources(id.RootScope(), id.Type(), apiVersion...).BeginCreateOrUpdate(ctx, id.Name(), body, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +213,7 @@ func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID
 	return nil, nil
 }
 
-func fetchResource(ctx context.Context, radius RadiusClient, resourceID string) (generated.GenericResourcesClientGetResponse, error) {
+func fetchResource(ctx context.Context, radius RadiusClient, resourceID string, apiVersion ...string) (generated.GenericResourcesClientGetResponse, error) {
 	id, err := resources.Parse(resourceID)
 	if err != nil {
 		return generated.GenericResourcesClientGetResponse{}, err
@@ -222,7 +222,7 @@ func fetchResource(ctx context.Context, radius RadiusClient, resourceID string)
 	logger := ucplog.FromContextOrDiscard(ctx).WithValues("scope", id.RootScope(), "resourceType", id.Type())
 	logger.Info("Fetching resource.")
 
-	return radius.Resources(id.RootScope(), id.Type()).Get(ctx, id.Name())
+	return radius.Resources(id.RootScope(), id.Type(), apiVersion...).Get(ctx, id.Name())
 }
 
 func deleteContainer(ctx context.Context, radius RadiusClient, containerID string) (sdkclients.Poller[corerpv20231001preview.ContainersClientDeleteResponse], error) {
@@ -149,6 +149,22 @@ func (s *Service) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to setup %s controller: %w", "DeploymentResource", err)
 	}
 
+	err = mgr.Add(&reconciler.ResourceTypeCRDController{
+		Client:            mgr.GetClient(),
+		ResourceProviders: reconciler.NewResourceProviderClient(s.Options.UCPConnection),
+		StartReconciler: func(ctx context.Context, r *reconciler.ResourceTypeReconciler) error {
+			r.Client = mgr.GetClient()
+			r.Scheme = mgr.GetScheme()
+			//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+			r.EventRecorder = mgr.GetEventRecorderFor("resourcetype-controller")
+			r.Radius = reconciler.NewRadiusClient(s.Options.UCPConnection)
+			return r.StartWithManager(ctx, mgr)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "ResourceTypeCRD", err)
+	}
+
 	err = (&reconciler.FluxController{
 		Client:         mgr.GetClient(),
 		ArchiveFetcher: reconciler.NewArchiveFetcher(),
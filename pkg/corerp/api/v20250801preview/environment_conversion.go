@@ -74,6 +74,9 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.BicepConfig = to.String(src.Properties.BicepConfig)
 	}
 
+	// Convert TrustPolicy
+	converted.Properties.TrustPolicy = toTrustPolicyDataModel(src.Properties.TrustPolicy)
+
 	return converted, nil
 }
 
@@ -124,6 +127,9 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.BicepConfig = &env.Properties.BicepConfig
 	}
 
+	// Convert TrustPolicy
+	dst.Properties.TrustPolicy = fromTrustPolicyDataModel(env.Properties.TrustPolicy)
+
 	return nil
 }
 
@@ -55,6 +55,9 @@ func TestEnvironmentConvertVersionedToDataModel(t *testing.T) {
 					},
 				},
 			},
+			TrustPolicy: &RecipeTrustPolicy{
+				PublicKeys: []*string{new("test-public-key")},
+			},
 		},
 	}
 
@@ -70,6 +73,7 @@ func TestEnvironmentConvertVersionedToDataModel(t *testing.T) {
 	require.Equal(t, map[string]string{"env": "test"}, env.Tags)
 	require.Equal(t, []string{"/planes/radius/local/providers/Radius.Core/recipePacks/azure-aci-pack"}, env.Properties.RecipePacks)
 	require.Equal(t, false, env.Properties.Simulated)
+	require.Equal(t, &datamodel.RecipeTrustPolicy{PublicKeys: []string{"test-public-key"}}, env.Properties.TrustPolicy)
 	require.NotNil(t, env.Properties.Providers)
 	require.NotNil(t, env.Properties.Providers.Azure)
 	require.Equal(t, "00000000-0000-0000-0000-000000000000", env.Properties.Providers.Azure.SubscriptionId)
@@ -112,6 +116,9 @@ func TestEnvironmentConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 			Simulated: false,
+			TrustPolicy: &datamodel.RecipeTrustPolicy{
+				PublicKeys: []string{"test-public-key"},
+			},
 		},
 	}
 
@@ -124,6 +131,7 @@ func TestEnvironmentConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, new("West US"), versionedResource.Location)
 	require.Equal(t, map[string]*string{"env": new("test")}, versionedResource.Tags)
 	require.Equal(t, []*string{new("/planes/radius/local/providers/Radius.Core/recipePacks/test-pack")}, versionedResource.Properties.RecipePacks)
+	require.Equal(t, &RecipeTrustPolicy{PublicKeys: []*string{new("test-public-key")}}, versionedResource.Properties.TrustPolicy)
 	require.NotNil(t, versionedResource.Properties.Providers)
 	require.NotNil(t, versionedResource.Properties.Providers.Kubernetes)
 	require.Equal(t, new("default"), versionedResource.Properties.Providers.Kubernetes.Namespace)
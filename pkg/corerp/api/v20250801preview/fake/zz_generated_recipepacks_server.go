@@ -213,7 +213,19 @@ func (r *RecipePacksServerTransport) dispatchNewListByScopePager(req *http.Reque
 		if len(matches) < 2 {
 			return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
 		}
-		resp := r.srv.NewListByScopePager(nil)
+		qp := req.URL.Query()
+		layerUnescaped, err := url.QueryUnescape(qp.Get("layer"))
+		if err != nil {
+			return nil, err
+		}
+		layerParam := getOptional(v20250801preview.RecipePackLayer(layerUnescaped))
+		var options *v20250801preview.RecipePacksClientListByScopeOptions
+		if layerParam != nil {
+			options = &v20250801preview.RecipePacksClientListByScopeOptions{
+				Layer: layerParam,
+			}
+		}
+		resp := r.srv.NewListByScopePager(options)
 		newListByScopePager = &resp
 		r.newListByScopePager.add(req, newListByScopePager)
 		server.PagerResponderInjectNextLinks(newListByScopePager, req, func(page *v20250801preview.RecipePacksClientListByScopeResponse, createLink func() string) {
@@ -53,6 +53,12 @@ func (src *RecipePackResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.ReferencedBy = to.StringArray(src.Properties.ReferencedBy)
 	}
 
+	// Convert Layer
+	converted.Properties.Layer = toRecipePackLayerDataModel(src.Properties.Layer)
+
+	// Convert TrustPolicy
+	converted.Properties.TrustPolicy = toTrustPolicyDataModel(src.Properties.TrustPolicy)
+
 	return converted, nil
 }
 
@@ -83,6 +89,12 @@ func (dst *RecipePackResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.ReferencedBy = to.ArrayofStringPtrs(recipePack.Properties.ReferencedBy)
 	}
 
+	// Convert Layer
+	dst.Properties.Layer = fromRecipePackLayerDataModel(recipePack.Properties.Layer)
+
+	// Convert TrustPolicy
+	dst.Properties.TrustPolicy = fromTrustPolicyDataModel(recipePack.Properties.TrustPolicy)
+
 	return nil
 }
 
@@ -138,3 +150,38 @@ func fromRecipeKindDataModel(kind string) *RecipeKind {
 	recipeKind := RecipeKind(kind)
 	return &recipeKind
 }
+
+func toRecipePackLayerDataModel(layer *RecipePackLayer) datamodel.RecipePackLayer {
+	if layer == nil {
+		return datamodel.RecipePackLayerEnvironment
+	}
+	return datamodel.RecipePackLayer(*layer)
+}
+
+func fromRecipePackLayerDataModel(layer datamodel.RecipePackLayer) *RecipePackLayer {
+	if layer == "" {
+		return nil
+	}
+	recipePackLayer := RecipePackLayer(layer)
+	return &recipePackLayer
+}
+
+func toTrustPolicyDataModel(policy *RecipeTrustPolicy) *datamodel.RecipeTrustPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &datamodel.RecipeTrustPolicy{
+		PublicKeys: to.StringArray(policy.PublicKeys),
+	}
+}
+
+func fromTrustPolicyDataModel(policy *datamodel.RecipeTrustPolicy) *RecipeTrustPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &RecipeTrustPolicy{
+		PublicKeys: to.ArrayofStringPtrs(policy.PublicKeys),
+	}
+}
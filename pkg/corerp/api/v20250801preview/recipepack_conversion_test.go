@@ -22,6 +22,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/stretchr/testify/require"
 )
@@ -49,6 +50,8 @@ func TestRecipePackConvertVersionedToDataModel(t *testing.T) {
 	require.Equal(t, *versionedResource.Name, recipePack.Name)
 	require.Equal(t, *versionedResource.Type, recipePack.Type)
 	require.Equal(t, *versionedResource.Location, recipePack.Location)
+	require.Equal(t, datamodel.RecipePackLayerResourceGroup, recipePack.Properties.Layer)
+	require.Equal(t, &datamodel.RecipeTrustPolicy{PublicKeys: []string{*versionedResource.Properties.TrustPolicy.PublicKeys[0]}}, recipePack.Properties.TrustPolicy)
 
 	// Validate API version metadata
 	require.Equal(t, Version, recipePack.InternalMetadata.CreatedAPIVersion)
@@ -75,6 +78,20 @@ func TestRecipePackConvertDataModelToVersioned(t *testing.T) {
 	require.Equal(t, dataModel.Type, *versionedResource.Type)
 	require.Equal(t, dataModel.Location, *versionedResource.Location)
 	require.NotNil(t, versionedResource.Properties)
+	require.Equal(t, RecipePackLayerResourceGroup, *versionedResource.Properties.Layer)
+	require.Equal(t, dataModel.Properties.TrustPolicy.PublicKeys, to.StringArray(versionedResource.Properties.TrustPolicy.PublicKeys))
+}
+
+func TestRecipePackConvertVersionedToDataModel_DefaultLayer(t *testing.T) {
+	versionedResource := RecipePackResource{
+		Properties: &RecipePackProperties{
+			Recipes: map[string]*RecipeDefinition{},
+		},
+	}
+
+	dm, err := versionedResource.ConvertTo()
+	require.NoError(t, err)
+	require.Equal(t, datamodel.RecipePackLayerEnvironment, dm.(*datamodel.RecipePack).Properties.Layer)
 }
 
 func TestRecipePackConvertInvalidModel(t *testing.T) {
@@ -177,3 +177,24 @@ func PossibleRecipeKindValues() []RecipeKind {
 		RecipeKindTerraform,
 	}
 }
+
+// RecipePackLayer - The layer a recipe pack applies at
+type RecipePackLayer string
+
+const (
+	// RecipePackLayerEnvironment - The recipe pack applies to the environments that reference it
+	RecipePackLayerEnvironment RecipePackLayer = "environment"
+	// RecipePackLayerOrganization - The recipe pack applies to all environments in the plane
+	RecipePackLayerOrganization RecipePackLayer = "organization"
+	// RecipePackLayerResourceGroup - The recipe pack applies to all environments in the resource group of the recipe pack
+	RecipePackLayerResourceGroup RecipePackLayer = "resourceGroup"
+)
+
+// PossibleRecipePackLayerValues returns the possible values for the RecipePackLayer const type.
+func PossibleRecipePackLayerValues() []RecipePackLayer {
+	return []RecipePackLayer{
+		RecipePackLayerEnvironment,
+		RecipePackLayerOrganization,
+		RecipePackLayerResourceGroup,
+	}
+}
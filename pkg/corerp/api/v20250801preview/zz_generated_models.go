@@ -280,6 +280,9 @@ type EnvironmentProperties struct {
 	// Resource ID of a Radius.Core/terraformConfigs resource providing Terraform recipe settings.
 	TerraformConfig *string
 
+	// The trust policy used to verify the signatures of the recipes used in this environment.
+	TrustPolicy *RecipeTrustPolicy
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -530,6 +533,16 @@ type RecipePackProperties struct {
 	// REQUIRED; Map of resource types to their recipe configurations
 	Recipes map[string]*RecipeDefinition
 
+	// The layer the recipe pack applies at. Organization recipe packs apply to all environments in the plane, resource group
+	// recipe packs apply to all environments in the resource group of the recipe pack, and environment recipe packs apply to
+	// the environments that reference them. Recipes of more specific layers override recipes of less specific layers. Defaults
+	// to environment.
+	Layer *RecipePackLayer
+
+	// The trust policy used to verify the signatures of the recipes in this recipe pack. Recipes must satisfy it in addition
+	// to the trust policy of the environment.
+	TrustPolicy *RecipeTrustPolicy
+
 	// READ-ONLY; The status of the asynchronous operation
 	ProvisioningState *ProvisioningState
 
@@ -600,6 +613,15 @@ type RecipeStatus struct {
 	TemplateVersion *string
 }
 
+// RecipeTrustPolicy - Trust policy used to verify the signatures of Bicep recipes stored in OCI registries before they
+// are pulled. Bicep recipes must be signed with cosign using one of the trusted keys. Signature verification is only supported
+// for Bicep recipes: Terraform modules are downloaded by Terraform, so the signatures of Terraform recipes are not verified.
+type RecipeTrustPolicy struct {
+	// REQUIRED; PEM-encoded public keys trusted to sign recipes. A recipe is trusted if it has a valid signature from any of
+	// the keys.
+	PublicKeys []*string
+}
+
 // Resource - Common fields that are returned in the response for all Azure Resource Manager resources
 type Resource struct {
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
@@ -641,6 +641,7 @@ func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "recipeParameters", e.RecipeParameters)
 	populate(objectMap, "simulated", e.Simulated)
 	populate(objectMap, "terraformConfig", e.TerraformConfig)
+	populate(objectMap, "trustPolicy", e.TrustPolicy)
 	return json.Marshal(objectMap)
 }
 
@@ -674,6 +675,9 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "terraformConfig":
 			err = unpopulate(val, "TerraformConfig", &e.TerraformConfig)
 			delete(rawMsg, key)
+		case "trustPolicy":
+			err = unpopulate(val, "TrustPolicy", &e.TrustPolicy)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", e, err)
@@ -1304,9 +1308,11 @@ func (r *RecipeDefinition) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type RecipePackProperties.
 func (r RecipePackProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "layer", r.Layer)
 	populate(objectMap, "provisioningState", r.ProvisioningState)
 	populate(objectMap, "recipes", r.Recipes)
 	populate(objectMap, "referencedBy", r.ReferencedBy)
+	populate(objectMap, "trustPolicy", r.TrustPolicy)
 	return json.Marshal(objectMap)
 }
 
@@ -1319,6 +1325,9 @@ func (r *RecipePackProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "layer":
+			err = unpopulate(val, "Layer", &r.Layer)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &r.ProvisioningState)
 			delete(rawMsg, key)
@@ -1328,6 +1337,9 @@ func (r *RecipePackProperties) UnmarshalJSON(data []byte) error {
 		case "referencedBy":
 			err = unpopulate(val, "ReferencedBy", &r.ReferencedBy)
 			delete(rawMsg, key)
+		case "trustPolicy":
+			err = unpopulate(val, "TrustPolicy", &r.TrustPolicy)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -1496,6 +1508,33 @@ func (r *RecipeStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type RecipeTrustPolicy.
+func (r RecipeTrustPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "publicKeys", r.PublicKeys)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type RecipeTrustPolicy.
+func (r *RecipeTrustPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "publicKeys":
+			err = unpopulate(val, "PublicKeys", &r.PublicKeys)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type Resource.
 func (r Resource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
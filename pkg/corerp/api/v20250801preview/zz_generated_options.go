@@ -106,7 +106,8 @@ type RecipePacksClientGetOptions struct {
 
 // RecipePacksClientListByScopeOptions contains the optional parameters for the RecipePacksClient.NewListByScopePager method.
 type RecipePacksClientListByScopeOptions struct {
-	// placeholder for future optional parameters
+	// Only list the recipe packs of this layer.
+	Layer *RecipePackLayer
 }
 
 // RecipePacksClientUpdateOptions contains the optional parameters for the RecipePacksClient.Update method.
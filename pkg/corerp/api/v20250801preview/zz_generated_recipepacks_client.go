@@ -233,7 +233,7 @@ func (client *RecipePacksClient) NewListByScopePager(options *RecipePacksClientL
 }
 
 // listByScopeCreateRequest creates the ListByScope request.
-func (client *RecipePacksClient) listByScopeCreateRequest(ctx context.Context, _ *RecipePacksClientListByScopeOptions) (*policy.Request, error) {
+func (client *RecipePacksClient) listByScopeCreateRequest(ctx context.Context, options *RecipePacksClientListByScopeOptions) (*policy.Request, error) {
 	urlPath := "/{rootScope}/providers/Radius.Core/recipePacks"
 	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
 	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
@@ -242,6 +242,9 @@ func (client *RecipePacksClient) listByScopeCreateRequest(ctx context.Context, _
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2025-08-01-preview")
+	if options != nil && options.Layer != nil {
+		reqQP.Set("layer", string(*options.Layer))
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -56,6 +56,9 @@ type EnvironmentProperties_v20250801preview struct {
 
 	// Simulated indicates if this is a simulated environment.
 	Simulated bool `json:"simulated,omitempty"`
+
+	// TrustPolicy is the policy used to verify the signatures of the recipes used in this environment.
+	TrustPolicy *RecipeTrustPolicy `json:"trustPolicy,omitempty"`
 }
 
 // Providers_v20250801preview represents cloud provider configurations for the environment.
@@ -22,6 +22,21 @@ import (
 
 const RecipePackResourceType = "Radius.Core/recipePacks"
 
+// RecipePackLayer is the layer a recipe pack applies at.
+type RecipePackLayer string
+
+const (
+	// RecipePackLayerOrganization is the layer of recipe packs that apply to all environments in the plane.
+	RecipePackLayerOrganization RecipePackLayer = "organization"
+
+	// RecipePackLayerResourceGroup is the layer of recipe packs that apply to all environments in the resource group of
+	// the recipe pack.
+	RecipePackLayerResourceGroup RecipePackLayer = "resourceGroup"
+
+	// RecipePackLayerEnvironment is the layer of recipe packs that apply to the environments that reference them.
+	RecipePackLayerEnvironment RecipePackLayer = "environment"
+)
+
 // RecipePack represents the 2025-08-01-preview recipe pack resource.
 type RecipePack struct {
 	v1.BaseResource
@@ -45,6 +60,20 @@ type RecipePackProperties struct {
 
 	// ReferencedBy is a list of environment IDs that reference this recipe pack.
 	ReferencedBy []string `json:"referencedBy,omitempty"`
+
+	// Layer is the layer the recipe pack applies at. An empty layer is the environment layer.
+	Layer RecipePackLayer `json:"layer,omitempty"`
+
+	// TrustPolicy is the policy used to verify the signatures of the recipes in this recipe pack.
+	// Recipes must satisfy it in addition to the trust policy of the environment.
+	TrustPolicy *RecipeTrustPolicy `json:"trustPolicy,omitempty"`
+}
+
+// RecipeTrustPolicy represents the policy used to verify the signatures of recipes stored in OCI registries
+// before they are pulled.
+type RecipeTrustPolicy struct {
+	// PublicKeys is the list of PEM-encoded public keys trusted to sign recipes.
+	PublicKeys []string `json:"publicKeys"`
 }
 
 // RecipeDefinition represents a recipe definition in the datamodel.
@@ -18,13 +18,17 @@ package recipepacks
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -64,6 +68,10 @@ func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWrite
 		return resp, err
 	}
 
+	if resp, err := r.validateLayer(ctx, serviceCtx.ResourceID, newResource); resp != nil || err != nil {
+		return resp, err
+	}
+
 	logger.Info("Creating or updating recipe pack", "resourceID", serviceCtx.ResourceID.String())
 
 	newResource.SetProvisioningState(v1.ProvisioningStateSucceeded)
@@ -74,3 +82,53 @@ func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWrite
 
 	return r.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
 }
+
+// validateLayer ensures that no other recipe pack of the same organization or resource group layer defines a recipe for
+// the same resource type. Environment recipe packs are validated when they are added to an environment.
+func (r *CreateOrUpdateRecipePack) validateLayer(ctx context.Context, id resources.ID, recipePack *datamodel.RecipePack) (rest.Response, error) {
+	query := database.Query{
+		ResourceType: datamodel.RecipePackResourceType,
+		Filters: []database.QueryFilter{
+			{
+				Field: "properties.layer",
+				Value: string(recipePack.Properties.Layer),
+			},
+		},
+	}
+
+	switch recipePack.Properties.Layer {
+	case datamodel.RecipePackLayerOrganization:
+		query.RootScope = id.PlaneScope()
+		query.ScopeRecursive = true
+	case datamodel.RecipePackLayerResourceGroup:
+		query.RootScope = id.RootScope()
+	default:
+		return nil, nil
+	}
+
+	result, err := r.DatabaseClient().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range result.Items {
+		if strings.EqualFold(item.ID, id.String()) {
+			continue
+		}
+
+		existing := &datamodel.RecipePack{}
+		if err := item.As(existing); err != nil {
+			return nil, err
+		}
+
+		for resourceType := range recipePack.Properties.Recipes {
+			for existingResourceType := range existing.Properties.Recipes {
+				if strings.EqualFold(resourceType, existingResourceType) {
+					return rest.NewConflictResponse(fmt.Sprintf("Resource type '%s' is defined in multiple recipe packs of the %s layer: %s and %s", resourceType, recipePack.Properties.Layer, item.ID, id.String())), nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -31,6 +31,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/to"
@@ -148,6 +149,74 @@ func TestCreateOrUpdateRecipePackRun_UpdateExisting(t *testing.T) {
 	require.Equal(t, v20250801preview.ProvisioningStateSucceeded, *actualOutput.Properties.ProvisioningState)
 }
 
+func TestCreateOrUpdateRecipePackRun_Layer(t *testing.T) {
+	existingID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/platform/providers/Radius.Core/recipePacks/org"
+
+	tests := []struct {
+		name           string
+		existingLayer  datamodel.RecipePackLayer
+		layer          v20250801preview.RecipePackLayer
+		expectedStatus int
+	}{
+		{
+			name:           "organization layer conflict",
+			existingLayer:  datamodel.RecipePackLayerOrganization,
+			layer:          v20250801preview.RecipePackLayerOrganization,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "resource group layer in another resource group",
+			existingLayer:  datamodel.RecipePackLayerResourceGroup,
+			layer:          v20250801preview.RecipePackLayerResourceGroup,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "different layer",
+			existingLayer:  datamodel.RecipePackLayerOrganization,
+			layer:          v20250801preview.RecipePackLayerResourceGroup,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient := inmemory.NewClient()
+			recipePackInput, _, _ := getTestModels()
+			recipePackInput.Properties.Layer = to.Ptr(tt.layer)
+
+			existing := &datamodel.RecipePack{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{ID: existingID, Type: datamodel.RecipePackResourceType},
+				},
+				Properties: datamodel.RecipePackProperties{
+					Layer: tt.existingLayer,
+					Recipes: map[string]*datamodel.RecipeDefinition{
+						"applications.datastores/rediscaches": {RecipeKind: "bicep", RecipeLocation: "ghcr.io/org/recipes/redis:1.0"},
+					},
+				},
+			}
+			err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: existingID}, Data: existing})
+			require.NoError(t, err)
+
+			jsonPayload, err := json.Marshal(recipePackInput)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPut, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/default/providers/Radius.Core/recipePacks/testrecipepack?api-version=2025-08-01-preview", strings.NewReader(string(jsonPayload)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			ctx := rpctest.NewARMRequestContext(req)
+
+			ctl, err := NewCreateOrUpdateRecipePack(ctrl.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+		})
+	}
+}
+
 func getTestModels() (*v20250801preview.RecipePackResource, *datamodel.RecipePack, *v20250801preview.RecipePackResource) {
 	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/default/providers/Radius.Core/recipePacks/testrecipepack"
 	resourceName := "testrecipepack"
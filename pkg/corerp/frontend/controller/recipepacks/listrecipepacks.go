@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+)
+
+const (
+	// LayerParameterName is the query string parameter for the layer used to filter list requests.
+	LayerParameterName = "layer"
+)
+
+var _ ctrl.Controller = (*ListRecipePacks)(nil)
+
+// ListRecipePacks is the controller implementation to list recipe pack resources. The recipe packs can be filtered
+// by layer and by tag.
+type ListRecipePacks struct {
+	ctrl.Operation[*datamodel.RecipePack, datamodel.RecipePack]
+	recursive bool
+}
+
+// NewListRecipePacks creates a new controller for listing the recipe packs of a resource group.
+func NewListRecipePacks(opts ctrl.Options) (ctrl.Controller, error) {
+	return newListRecipePacks(opts, false), nil
+}
+
+// NewListRecipePacksInPlane creates a new controller for listing the recipe packs of all the resource groups of a plane.
+func NewListRecipePacksInPlane(opts ctrl.Options) (ctrl.Controller, error) {
+	return newListRecipePacks(opts, true), nil
+}
+
+func newListRecipePacks(opts ctrl.Options, recursive bool) *ListRecipePacks {
+	return &ListRecipePacks{
+		Operation: ctrl.NewOperation(opts,
+			ctrl.ResourceOptions[datamodel.RecipePack]{
+				ResponseConverter: converter.RecipePackDataModelToVersioned,
+			},
+		),
+		recursive: recursive,
+	}
+}
+
+// Run lists the recipe packs of the scope. If the request filters by layer or by tag only the matching recipe packs
+// are returned.
+func (r *ListRecipePacks) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	layer, err := parseLayerFilter(req.URL.Query())
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	tagFilter, err := v1.ParseTagFilter(req.URL.Query())
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	query := database.Query{
+		RootScope:      serviceCtx.ResourceID.RootScope(),
+		ResourceType:   serviceCtx.ResourceID.Type(),
+		ScopeRecursive: r.recursive,
+		Filters:        ctrl.TagQueryFilters(tagFilter, "tags"),
+	}
+	if layer != "" {
+		query.Filters = append(query.Filters, database.QueryFilter{Field: "properties.layer", Value: string(layer)})
+	}
+
+	result, err := r.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
+	if err != nil {
+		return nil, err
+	}
+
+	items := []any{}
+	for _, item := range result.Items {
+		recipePack := &datamodel.RecipePack{}
+		if err := item.As(recipePack); err != nil {
+			return nil, err
+		}
+
+		versioned, err := r.ResponseConverter()(recipePack, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, versioned)
+	}
+
+	nextLink, err := nextLinkWithLayer(ctrl.GetNextLinkURL(ctx, req, result.PaginationToken), layer)
+	if err != nil {
+		return nil, err
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{Value: items, NextLink: nextLink}), nil
+}
+
+// parseLayerFilter parses the layer filter from the 'layer' query string parameter of a list request. It returns an
+// empty layer if the request doesn't filter by layer.
+func parseLayerFilter(query url.Values) (datamodel.RecipePackLayer, error) {
+	value := query.Get(LayerParameterName)
+	if value == "" {
+		return "", nil
+	}
+
+	for _, layer := range []datamodel.RecipePackLayer{
+		datamodel.RecipePackLayerOrganization,
+		datamodel.RecipePackLayerResourceGroup,
+		datamodel.RecipePackLayerEnvironment,
+	} {
+		if strings.EqualFold(value, string(layer)) {
+			return layer, nil
+		}
+	}
+
+	return "", fmt.Errorf("the %q query parameter must be one of: %s, %s, %s", LayerParameterName, datamodel.RecipePackLayerOrganization, datamodel.RecipePackLayerResourceGroup, datamodel.RecipePackLayerEnvironment)
+}
+
+// nextLinkWithLayer preserves the layer filter in the next link so that the next page is filtered the same way.
+func nextLinkWithLayer(nextLink string, layer datamodel.RecipePackLayer) (string, error) {
+	if nextLink == "" || layer == "" {
+		return nextLink, nil
+	}
+
+	u, err := url.Parse(nextLink)
+	if err != nil {
+		return "", err
+	}
+
+	qps := u.Query()
+	qps.Set(LayerParameterName, string(layer))
+	u.RawQuery = qps.Encode()
+
+	return u.String(), nil
+}
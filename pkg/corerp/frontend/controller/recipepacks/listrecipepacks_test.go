@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+)
+
+func TestListRecipePacksRun(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+	for id, layer := range map[string]datamodel.RecipePackLayer{
+		"/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/org":  datamodel.RecipePackLayerOrganization,
+		"/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/team": datamodel.RecipePackLayerResourceGroup,
+		"/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/rg":    datamodel.RecipePackLayerResourceGroup,
+		"/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/env":   datamodel.RecipePackLayerEnvironment,
+	} {
+		recipePack := &datamodel.RecipePack{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: id, Type: datamodel.RecipePackResourceType},
+			},
+			Properties: datamodel.RecipePackProperties{Layer: layer},
+		}
+		err := databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: recipePack})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		plane          bool
+		expectedStatus int
+		expectedIDs    []string
+	}{
+		{
+			name:           "organization packs of the plane",
+			url:            "/planes/radius/local/providers/Radius.Core/recipePacks?api-version=2025-08-01-preview&layer=organization",
+			plane:          true,
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/org"},
+		},
+		{
+			name:           "resource group packs of a resource group",
+			url:            "/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks?api-version=2025-08-01-preview&layer=resourceGroup",
+			expectedStatus: http.StatusOK,
+			expectedIDs:    []string{"/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/rg"},
+		},
+		{
+			name:           "all packs of a resource group",
+			url:            "/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks?api-version=2025-08-01-preview",
+			expectedStatus: http.StatusOK,
+			expectedIDs: []string{
+				"/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/env",
+				"/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/rg",
+			},
+		},
+		{
+			name:           "invalid layer",
+			url:            "/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks?api-version=2025-08-01-preview&layer=team",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			newController := NewListRecipePacks
+			if tt.plane {
+				newController = NewListRecipePacksInPlane
+			}
+			ctl, err := newController(ctrl.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			list := struct {
+				Value []struct {
+					ID string `json:"id"`
+				} `json:"value"`
+			}{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+
+			ids := []string{}
+			for _, item := range list.Value {
+				ids = append(ids, item.ID)
+			}
+			require.ElementsMatch(t, tt.expectedIDs, ids)
+		})
+	}
+}
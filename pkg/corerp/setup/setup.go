@@ -261,6 +261,12 @@ func SetupRadiusCoreNamespace(recipeControllerConfig *controllerconfig.RecipeCon
 		RequestConverter:  converter.RecipePackDataModelFromVersioned,
 		ResponseConverter: converter.RecipePackDataModelToVersioned,
 
+		ListPlane: builder.Operation[datamodel.RecipePack]{
+			APIController: rp_ctrl.NewListRecipePacksInPlane,
+		},
+		List: builder.Operation[datamodel.RecipePack]{
+			APIController: rp_ctrl.NewListRecipePacks,
+		},
 		Put: builder.Operation[datamodel.RecipePack]{
 			APIController: rp_ctrl.NewCreateOrUpdateRecipePack,
 		},
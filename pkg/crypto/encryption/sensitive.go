@@ -95,10 +95,26 @@ func NewSensitiveDataHandlerFromProvider(ctx context.Context, provider KeyProvid
 // Returns an error if any field encryption fails. In case of error, partial encryption may have occurred.
 // Fields that are not found are skipped - this allows optional sensitive fields to be absent.
 func (h *SensitiveDataHandler) EncryptSensitiveFields(data map[string]any, sensitiveFieldPaths []string, resourceID string) error {
+	return h.EncryptSensitiveFieldsKeepingStored(data, sensitiveFieldPaths, resourceID, nil)
+}
+
+// EncryptSensitiveFieldsKeepingStored encrypts all sensitive fields in the data like EncryptSensitiveFields, except
+// the values that are encrypted values of the stored data. Those values were copied from the stored resource and are
+// already encrypted. The stored data may be nil.
+func (h *SensitiveDataHandler) EncryptSensitiveFieldsKeepingStored(data map[string]any, sensitiveFieldPaths []string, resourceID string, stored map[string]any) error {
+	storedCiphertexts := map[string]bool{}
+	collectCiphertexts(stored, storedCiphertexts)
+
 	for _, path := range sensitiveFieldPaths {
 		// Build associated data from resource ID and field path
 		ad := buildAssociatedData(resourceID, path)
-		if err := h.encryptFieldAtPath(data, path, ad); err != nil {
+		processor := func(value any) (any, error) {
+			if isStoredCiphertext(value, storedCiphertexts) {
+				return value, nil
+			}
+			return h.encryptValue(value, ad)
+		}
+		if err := h.processFieldAtPath(data, path, processor); err != nil {
 			// Skip fields that are not found - they may not exist in this resource instance
 			// (e.g., optional sensitive properties)
 			if errors.Is(err, ErrFieldNotFound) {
@@ -190,12 +206,38 @@ func (h *SensitiveDataHandler) getEncryptorForDecryption(ctx context.Context, en
 	return NewEncryptorWithVersion(key, version)
 }
 
-// encryptFieldAtPath encrypts the value at the given field path in the data.
-func (h *SensitiveDataHandler) encryptFieldAtPath(data map[string]any, path string, associatedData []byte) error {
-	processor := func(value any) (any, error) {
-		return h.encryptValue(value, associatedData)
+// collectCiphertexts adds the ciphertexts of the encrypted values found in the value to the set.
+func collectCiphertexts(value any, ciphertexts map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		encrypted, hasEncrypted := v["encrypted"].(string)
+		_, hasNonce := v["nonce"].(string)
+		if hasEncrypted && hasNonce {
+			ciphertexts[encrypted] = true
+			return
+		}
+
+		for _, item := range v {
+			collectCiphertexts(item, ciphertexts)
+		}
+	case []any:
+		for _, item := range v {
+			collectCiphertexts(item, ciphertexts)
+		}
 	}
-	return h.processFieldAtPath(data, path, processor)
+}
+
+// isStoredCiphertext returns true if the value is an encrypted value with one of the stored ciphertexts. Nonces are
+// random, so a ciphertext is only found in the stored data if the value was copied from it.
+func isStoredCiphertext(value any, ciphertexts map[string]bool) bool {
+	encMap, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	encrypted, hasEncrypted := encMap["encrypted"].(string)
+	_, hasNonce := encMap["nonce"].(string)
+	return hasEncrypted && hasNonce && ciphertexts[encrypted]
 }
 
 // decryptFieldAtPath decrypts the value at the given field path in the data.
@@ -1128,3 +1128,33 @@ func deepCopySlice(original []any) []any {
 	}
 	return result
 }
+
+func TestSensitiveDataHandler_EncryptKeepingStored(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	handler, err := NewSensitiveDataHandlerFromKey(key)
+	require.NoError(t, err)
+
+	resourceID := "/planes/radius/local/resourceGroups/test/providers/Foo.Bar/myResources/test"
+	stored := map[string]any{
+		"password": "secret123",
+	}
+	err = handler.EncryptSensitiveFields(stored, []string{"password"}, resourceID)
+	require.NoError(t, err)
+
+	// The stored ciphertext is kept, and a value in the encrypted format that isn't stored is encrypted.
+	forged := map[string]any{"encrypted": "YWJj", "nonce": "YWJj"}
+	data := map[string]any{
+		"password": stored["password"],
+		"token":    forged,
+	}
+	err = handler.EncryptSensitiveFieldsKeepingStored(data, []string{"password", "token"}, resourceID, stored)
+	require.NoError(t, err)
+	require.Equal(t, stored["password"], data["password"])
+	require.NotEqual(t, forged, data["token"])
+
+	err = handler.DecryptSensitiveFields(context.Background(), data, []string{"password"}, resourceID)
+	require.NoError(t, err)
+	require.Equal(t, "secret123", data["password"])
+}
@@ -91,6 +91,9 @@ func (d *DynamicResource) ConvertFrom(src v1.DataModelInterface) error {
 		return fmt.Errorf("failed to unmarshal properties: %w", err)
 	}
 
+	// The inputs of actions can hold credentials, they're only stored for the backend to run the action.
+	datamodel.RedactActionInputs(properties)
+
 	d.ID = &dm.ID
 	d.Name = &dm.Name
 	d.Type = &dm.Type
@@ -100,6 +100,31 @@ func Test_DynamicResource_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			// Action inputs are never returned.
+			filename: "dynamicresource-datamodel-action.json",
+			expected: &DynamicResource{
+				ID:       new("/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/testResource"),
+				Name:     new("testResource"),
+				Type:     new("Applications.Test/testResources"),
+				Location: new("global"),
+				Tags: map[string]*string{
+					"env": new("dev"),
+				},
+				Properties: map[string]any{
+					"provisioningState": fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					"message":           "Hello, world!",
+					"status": map[string]any{
+						"actions": map[string]any{
+							"backup": map[string]any{
+								"operationId": "op-1",
+								"state":       "Accepted",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -0,0 +1,405 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// codeActionFailed is the error code of action invocations that fail.
+	codeActionFailed = "ActionFailed"
+
+	// actionCallbackTimeout is the timeout of the HTTP callbacks implementing actions.
+	actionCallbackTimeout = 5 * time.Minute
+
+	// maxActionCallbackResponseSize is the maximum size of the responses of the HTTP callbacks in bytes. The output of
+	// the action is stored in the status of the resource.
+	maxActionCallbackResponseSize = 1 << 20
+)
+
+// ActionController is the async operation controller to run the actions invoked on dynamic resources.
+//
+// The frontend records the invocation in the status of the resource with the ID of the async operation, and the
+// encrypted input of the action. The controller runs the recipe or the HTTP callback implementing the action, and
+// records the output or the error of the action. The input is removed from the status once the action has run.
+type ActionController struct {
+	ctrl.BaseController
+
+	actions             map[string]*v20231001preview.ResourceTypeAction
+	engine              engine.Engine
+	configurationLoader configloader.ConfigurationLoader
+	httpClient          *http.Client
+	keyProvider         encryption.KeyProvider
+}
+
+// NewActionController creates a new ActionController for the actions declared by a resource type.
+func NewActionController(opts ctrl.Options, actions map[string]*v20231001preview.ResourceTypeAction, engine engine.Engine, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
+	var keyProvider encryption.KeyProvider
+	if opts.KubeClient != nil {
+		keyProvider = encryption.NewKubernetesKeyProvider(opts.KubeClient, nil)
+	}
+
+	return &ActionController{
+		BaseController:      ctrl.NewBaseAsyncController(opts),
+		actions:             actions,
+		engine:              engine,
+		configurationLoader: configurationLoader,
+		httpClient:          &http.Client{Timeout: actionCallbackTimeout},
+		keyProvider:         keyProvider,
+	}, nil
+}
+
+// actionCallbackRequest is the body of the request sent to the HTTP callback implementing an action.
+type actionCallbackRequest struct {
+	// Action is the name of the action.
+	Action string `json:"action"`
+
+	// Resource is the resource the action is invoked on.
+	Resource actionCallbackResource `json:"resource"`
+
+	// Input is the input of the action.
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// actionCallbackResource is the resource sent to the HTTP callback implementing an action.
+type actionCallbackResource struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Properties map[string]any `json:"properties"`
+}
+
+// Run runs the action invoked by the async operation.
+func (c *ActionController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	actionName, actionStatus, ok := resource.FindActionStatus(request.OperationID.String())
+	if !ok {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: fmt.Sprintf("no action was invoked by operation %q", request.OperationID.String()),
+		}), nil
+	}
+
+	action, ok := c.actions[actionName]
+	if !ok || action == nil {
+		return c.completeAction(ctx, obj.ETag, resource, actionName, actionStatus, nil, &v1.ErrorDetails{
+			Code:    codeActionFailed,
+			Message: fmt.Sprintf("action %q is no longer supported by resource type %q", actionName, resource.Type),
+		})
+	}
+
+	input, err := c.decryptInput(ctx, resource, actionName, actionStatus)
+	if err != nil {
+		return c.completeAction(ctx, obj.ETag, resource, actionName, actionStatus, nil, &v1.ErrorDetails{
+			Code:    codeActionFailed,
+			Message: fmt.Sprintf("failed to decrypt the action input: %v", err),
+		})
+	}
+
+	logger.Info("Running action", "resourceID", request.ResourceID, "action", actionName)
+
+	var output map[string]any
+	switch {
+	case action.Callback != nil:
+		output, err = c.runCallback(ctx, resource, actionName, action.Callback, input)
+	case action.Recipe != nil:
+		output, err = c.runRecipe(ctx, resource, actionName, action.Recipe, actionStatus, input)
+	default:
+		err = fmt.Errorf("action %q has no implementation", actionName)
+	}
+	if err != nil {
+		errorDetails := recipes.GetErrorDetails(err)
+		if errorDetails == nil {
+			errorDetails = &v1.ErrorDetails{Code: codeActionFailed, Message: err.Error()}
+		}
+		return c.completeAction(ctx, obj.ETag, resource, actionName, actionStatus, nil, errorDetails)
+	}
+
+	if err := schema.ValidateActionPayload(output, action.OutputSchema); err != nil {
+		return c.completeAction(ctx, obj.ETag, resource, actionName, actionStatus, nil, &v1.ErrorDetails{
+			Code:    codeActionFailed,
+			Message: fmt.Sprintf("action output validation failed: %v", err),
+		})
+	}
+
+	return c.completeAction(ctx, obj.ETag, resource, actionName, actionStatus, output, nil)
+}
+
+// decryptInput decrypts the input of the action stored in the status by the frontend.
+func (c *ActionController) decryptInput(ctx context.Context, resource *datamodel.DynamicResource, actionName string, actionStatus *datamodel.ActionStatus) (map[string]any, error) {
+	if len(actionStatus.Input) == 0 {
+		return nil, nil
+	}
+
+	if c.keyProvider == nil {
+		return nil, fmt.Errorf("kubernetes client not configured for sensitive data decryption")
+	}
+
+	handler, err := encryption.NewSensitiveDataHandlerFromProvider(ctx, c.keyProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return actionStatus.GetInput(ctx, handler, resource.ID, actionName)
+}
+
+// completeAction records the output or the error of the action in the status of the resource, and removes the input
+// of the action.
+func (c *ActionController) completeAction(ctx context.Context, etag string, resource *datamodel.DynamicResource, actionName string, actionStatus *datamodel.ActionStatus, output map[string]any, errorDetails *v1.ErrorDetails) (ctrl.Result, error) {
+	actionStatus.Input = nil
+	actionStatus.Output = output
+	actionStatus.Error = errorDetails
+	actionStatus.State = v1.ProvisioningStateSucceeded
+	if errorDetails != nil {
+		actionStatus.State = v1.ProvisioningStateFailed
+	}
+	resource.SetActionStatus(actionName, *actionStatus)
+
+	update := &database.Object{
+		Metadata: database.Metadata{ID: resource.ID},
+		Data:     resource,
+	}
+	if err := c.DatabaseClient().Save(ctx, update, database.WithETag(etag)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if errorDetails != nil {
+		return ctrl.NewFailedResult(*errorDetails), nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// runCallback posts the action invocation to the HTTP callback implementing the action. The output of the action is
+// the JSON object returned by the callback.
+func (c *ActionController) runCallback(ctx context.Context, resource *datamodel.DynamicResource, actionName string, callback *v20231001preview.ActionCallback, input map[string]any) (map[string]any, error) {
+	body, err := json.Marshal(actionCallbackRequest{
+		Action: actionName,
+		Resource: actionCallbackResource{
+			ID:         resource.ID,
+			Type:       resource.Type,
+			Properties: resource.Properties,
+		},
+		Input: input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.String(callback.URL), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call action callback: %w", err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxActionCallbackResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read action callback response: %w", err)
+	}
+	if len(content) > maxActionCallbackResponseSize {
+		return nil, fmt.Errorf("action callback response is larger than the maximum size of %d bytes", maxActionCallbackResponseSize)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("action callback returned status code %d: %s", resp.StatusCode, string(bytes.TrimSpace(content)))
+	}
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		return nil, nil
+	}
+
+	output := map[string]any{}
+	if err := json.Unmarshal(content, &output); err != nil {
+		return nil, fmt.Errorf("action callback response must be a JSON object: %w", err)
+	}
+
+	return output, nil
+}
+
+// runRecipe executes the recipe implementing the action with the action input as the recipe parameters. The output
+// of the action is the values returned by the recipe.
+//
+// The recipe is executed with the resource ID suffixed with the action name, so that the state of the recipe is
+// tracked separately from the state of the recipe deploying the resource. The recipe and the resources it deployed are
+// recorded in the status of the action, so they're deleted with the resource. The signature of the recipe is verified
+// against the trust policy of the environment of the resource, as for the recipe deploying the resource.
+func (c *ActionController) runRecipe(ctx context.Context, resource *datamodel.DynamicResource, actionName string, recipe *v20231001preview.ActionRecipe, actionStatus *datamodel.ActionStatus, input map[string]any) (map[string]any, error) {
+	recipeStatus := &datamodel.ActionRecipeStatus{
+		TemplateKind:    to.String(recipe.TemplateKind),
+		TemplatePath:    to.String(recipe.TemplatePath),
+		TemplateVersion: to.String(recipe.TemplateVersion),
+	}
+
+	previousState := []string{}
+	if actionStatus.Recipe != nil {
+		for _, outputResource := range actionStatus.Recipe.OutputResources {
+			previousState = append(previousState, outputResource.ID.String())
+		}
+
+		// The resources deployed by the previous run are kept until the recipe cleans them up.
+		recipeStatus.OutputResources = actionStatus.Recipe.OutputResources
+	}
+	actionStatus.Recipe = recipeStatus
+
+	metadata := actionRecipeMetadata(resource, actionName, input)
+	definition := actionRecipeDefinition(resource, actionName, recipeStatus)
+
+	configuration, err := c.configurationLoader.LoadConfiguration(ctx, metadata)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+	if configuration.TrustPolicy != nil {
+		definition.TrustPolicies = []recipes.TrustPolicy{*configuration.TrustPolicy}
+	}
+
+	recipeOutput, err := c.engine.Execute(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		PreviousState:    previousState,
+		RecipeDefinition: definition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if recipeOutput == nil {
+		return nil, nil
+	}
+
+	outputResources, err := processors.GetOutputResourcesFromRecipe(recipeOutput)
+	if err != nil {
+		return nil, err
+	}
+	recipeStatus.OutputResources = outputResources
+
+	return recipeOutput.Values, nil
+}
+
+// runDeleteActionRecipes deletes the resources deployed by the actions of the resource being deleted. It returns a
+// non-nil result when the deletion must not continue.
+func runDeleteActionRecipes(ctx context.Context, databaseClient database.Client, eng engine.Engine, resourceID string) (*ctrl.Result, error) {
+	obj, err := databaseClient.Get(ctx, resourceID)
+	if errors.Is(err, &database.ErrNotFound{ID: resourceID}) {
+		return nil, nil
+	} else if err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return &ctrl.Result{}, err
+	}
+
+	if err := deleteActionRecipes(ctx, eng, resource); err != nil {
+		recipeError := &recipes.RecipeError{}
+		if errors.As(err, &recipeError) {
+			result := ctrl.NewFailedResult(recipeError.ErrorDetails)
+			return &result, nil
+		}
+		return &ctrl.Result{}, err
+	}
+
+	return nil, nil
+}
+
+// deleteActionRecipes deletes the resources deployed by the recipes implementing the actions invoked on the resource.
+func deleteActionRecipes(ctx context.Context, eng engine.Engine, resource *datamodel.DynamicResource) error {
+	actionStatuses := resource.ActionStatuses()
+	for _, actionName := range slices.Sorted(maps.Keys(actionStatuses)) {
+		recipeStatus := actionStatuses[actionName].Recipe
+		if recipeStatus == nil {
+			continue
+		}
+
+		err := eng.Delete(ctx, engine.DeleteOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: actionRecipeMetadata(resource, actionName, nil),
+			},
+			OutputResources:  recipeStatus.OutputResources,
+			RecipeDefinition: actionRecipeDefinition(resource, actionName, recipeStatus),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete the resources deployed by action %q: %w", actionName, err)
+		}
+	}
+
+	return nil
+}
+
+// actionRecipeMetadata returns the metadata of the recipe implementing an action.
+func actionRecipeMetadata(resource *datamodel.DynamicResource, actionName string, input map[string]any) recipes.ResourceMetadata {
+	return recipes.ResourceMetadata{
+		Name:          actionName,
+		EnvironmentID: resource.ResourceMetadata().EnvironmentID(),
+		ApplicationID: resource.ResourceMetadata().ApplicationID(),
+		ResourceID:    resource.ID + "/actions/" + actionName,
+		Parameters:    input,
+	}
+}
+
+// actionRecipeDefinition returns the definition of the recipe implementing an action.
+func actionRecipeDefinition(resource *datamodel.DynamicResource, actionName string, recipeStatus *datamodel.ActionRecipeStatus) *recipes.EnvironmentDefinition {
+	return &recipes.EnvironmentDefinition{
+		Name:            actionName,
+		Driver:          recipeStatus.TemplateKind,
+		ResourceType:    resource.Type,
+		TemplatePath:    recipeStatus.TemplatePath,
+		TemplateVersion: recipeStatus.TemplateVersion,
+	}
+}
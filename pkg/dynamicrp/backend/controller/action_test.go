@@ -0,0 +1,361 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/rp/util/registrytest"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+const (
+	testActionResourceID       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/test-resource"
+	testActionOutputResourceID = "/planes/kubernetes/local/namespaces/default/providers/core/Pod/restart"
+)
+
+func Test_ActionController_Run(t *testing.T) {
+	setup := func(t *testing.T, actions map[string]*v20231001preview.ResourceTypeAction, eng engine.Engine) (*ActionController, database.Client, *ctrl.Request) {
+		databaseClient := inmemory.NewClient()
+		operationID := uuid.New()
+
+		key, err := encryption.GenerateKey()
+		require.NoError(t, err)
+		keyProvider, err := encryption.NewInMemoryKeyProvider(key)
+		require.NoError(t, err)
+		handler, err := encryption.NewSensitiveDataHandlerFromProvider(context.Background(), keyProvider)
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   testActionResourceID,
+					Type: "Applications.Test/testResources",
+				},
+			},
+			Properties: map[string]any{
+				"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+				"size":        "large",
+			},
+		}
+		actionStatus := datamodel.ActionStatus{
+			OperationID: operationID.String(),
+			State:       v1.ProvisioningStateAccepted,
+		}
+		require.NoError(t, actionStatus.SetInput(handler, testActionResourceID, "restart", map[string]any{"force": true}))
+		resource.SetActionStatus("restart", actionStatus)
+		err = databaseClient.Save(context.Background(), &database.Object{
+			Metadata: database.Metadata{ID: testActionResourceID},
+			Data:     resource,
+		})
+		require.NoError(t, err)
+
+		configurationLoader := configloader.NewMockConfigurationLoader(gomock.NewController(t))
+		configurationLoader.EXPECT().
+			LoadConfiguration(gomock.Any(), gomock.Any()).
+			Return(&recipes.Configuration{}, nil).
+			AnyTimes()
+
+		controller, err := NewActionController(ctrl.Options{DatabaseClient: databaseClient}, actions, eng, configurationLoader)
+		require.NoError(t, err)
+		controller.(*ActionController).keyProvider = keyProvider
+
+		request := &ctrl.Request{
+			ResourceID:  testActionResourceID,
+			OperationID: operationID,
+		}
+
+		return controller.(*ActionController), databaseClient, request
+	}
+
+	getActionStatus := func(t *testing.T, databaseClient database.Client, request *ctrl.Request) *datamodel.ActionStatus {
+		obj, err := databaseClient.Get(context.Background(), testActionResourceID)
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{}
+		require.NoError(t, obj.As(resource))
+
+		name, status, ok := resource.FindActionStatus(request.OperationID.String())
+		require.True(t, ok)
+		require.Equal(t, "restart", name)
+		return status
+	}
+
+	t.Run("callback succeeds", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body := actionCallbackRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "restart", body.Action)
+			require.Equal(t, testActionResourceID, body.Resource.ID)
+			require.Equal(t, "large", body.Resource.Properties["size"])
+			require.Equal(t, map[string]any{"force": true}, body.Input)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"restartedAt": "2024-01-01T00:00:00Z"}`))
+		}))
+		defer server.Close()
+
+		controller, databaseClient, request := setup(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart": {
+				Callback: &v20231001preview.ActionCallback{URL: to.Ptr(server.URL)},
+				OutputSchema: map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"restartedAt": map[string]any{"type": "string"}},
+				},
+			},
+		}, nil)
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{}, result)
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateSucceeded, status.State)
+		require.Equal(t, map[string]any{"restartedAt": "2024-01-01T00:00:00Z"}, status.Output)
+		require.Nil(t, status.Error)
+		require.Nil(t, status.Input)
+	})
+
+	t.Run("callback fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "restart is not possible", http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		controller, databaseClient, request := setup(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart": {Callback: &v20231001preview.ActionCallback{URL: to.Ptr(server.URL)}},
+		}, nil)
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Equal(t, codeActionFailed, result.Error.Code)
+		require.Contains(t, result.Error.Message, "action callback returned status code 503: restart is not possible")
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateFailed, status.State)
+		require.Equal(t, result.Error, status.Error)
+		require.Nil(t, status.Input)
+	})
+
+	t.Run("callback response too large", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"log": "` + strings.Repeat("a", maxActionCallbackResponseSize) + `"}`))
+		}))
+		defer server.Close()
+
+		controller, databaseClient, request := setup(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart": {Callback: &v20231001preview.ActionCallback{URL: to.Ptr(server.URL)}},
+		}, nil)
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Equal(t, codeActionFailed, result.Error.Code)
+		require.Contains(t, result.Error.Message, "action callback response is larger than the maximum size of 1048576 bytes")
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateFailed, status.State)
+		require.Nil(t, status.Output)
+	})
+
+	t.Run("output does not match schema", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"restartedAt": 5}`))
+		}))
+		defer server.Close()
+
+		controller, databaseClient, request := setup(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart": {
+				Callback: &v20231001preview.ActionCallback{URL: to.Ptr(server.URL)},
+				OutputSchema: map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"restartedAt": map[string]any{"type": "string"}},
+				},
+			},
+		}, nil)
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Contains(t, result.Error.Message, "action output validation failed")
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateFailed, status.State)
+		require.Nil(t, status.Output)
+	})
+
+	t.Run("recipe succeeds", func(t *testing.T) {
+		mockEngine := engine.NewMockEngine(gomock.NewController(t))
+		mockEngine.EXPECT().
+			Execute(gomock.Any(), engine.ExecuteOptions{
+				BaseOptions: engine.BaseOptions{
+					Recipe: recipes.ResourceMetadata{
+						Name:          "restart",
+						EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+						ResourceID:    testActionResourceID + "/actions/restart",
+						Parameters:    map[string]any{"force": true},
+					},
+				},
+				PreviousState: []string{},
+				RecipeDefinition: &recipes.EnvironmentDefinition{
+					Name:            "restart",
+					Driver:          recipes.TemplateKindTerraform,
+					ResourceType:    "Applications.Test/testResources",
+					TemplatePath:    "git::https://github.com/example/restart.git",
+					TemplateVersion: "1.0.0",
+				},
+			}).
+			Return(&recipes.RecipeOutput{
+				Values:    map[string]any{"restarted": true},
+				Resources: []string{testActionOutputResourceID},
+			}, nil).
+			Times(1)
+
+		controller, databaseClient, request := setup(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart": {
+				Recipe: &v20231001preview.ActionRecipe{
+					TemplateKind:    to.Ptr(recipes.TemplateKindTerraform),
+					TemplatePath:    to.Ptr("git::https://github.com/example/restart.git"),
+					TemplateVersion: to.Ptr("1.0.0"),
+				},
+			},
+		}, mockEngine)
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{}, result)
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateSucceeded, status.State)
+		require.Equal(t, map[string]any{"restarted": true}, status.Output)
+		require.Equal(t, &datamodel.ActionRecipeStatus{
+			TemplateKind:    recipes.TemplateKindTerraform,
+			TemplatePath:    "git::https://github.com/example/restart.git",
+			TemplateVersion: "1.0.0",
+			OutputResources: []rpv1.OutputResource{
+				{ID: resources.MustParse(testActionOutputResourceID), RadiusManaged: to.Ptr(true)},
+			},
+		}, status.Recipe)
+	})
+
+	t.Run("unsigned recipe is rejected by the trust policy of the environment", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, nil)
+		t.Cleanup(ts.CloseServer)
+
+		trustPolicy := &recipes.TrustPolicy{PublicKeys: []string{"trusted-public-key"}}
+		configurationLoader := configloader.NewMockConfigurationLoader(gomock.NewController(t))
+		configurationLoader.EXPECT().
+			LoadConfiguration(gomock.Any(), gomock.Any()).
+			Return(&recipes.Configuration{TrustPolicy: trustPolicy}, nil).
+			AnyTimes()
+
+		eng := engine.NewEngine(engine.Options{
+			ConfigurationLoader: configurationLoader,
+			Drivers: map[string]driver.Driver{
+				recipes.TemplateKindBicep: &registryDriver{client: ts.TestServer.Client()},
+			},
+		})
+
+		controller, databaseClient, request := setup(t, map[string]*v20231001preview.ResourceTypeAction{
+			"restart": {
+				Recipe: &v20231001preview.ActionRecipe{
+					TemplateKind: to.Ptr(recipes.TemplateKindBicep),
+					TemplatePath: to.Ptr(ts.TestImageURL),
+				},
+			},
+		}, eng)
+		controller.configurationLoader = configurationLoader
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Equal(t, recipes.RecipeSignatureVerificationFailed, result.Error.Code)
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateFailed, status.State)
+		require.Nil(t, status.Output)
+	})
+
+	t.Run("action removed from resource type", func(t *testing.T) {
+		controller, databaseClient, request := setup(t, nil, nil)
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Contains(t, result.Error.Message, `action "restart" is no longer supported`)
+
+		status := getActionStatus(t, databaseClient, request)
+		require.Equal(t, v1.ProvisioningStateFailed, status.State)
+	})
+
+	t.Run("no action invoked by operation", func(t *testing.T) {
+		controller, _, request := setup(t, nil, nil)
+		request.OperationID = uuid.New()
+
+		result, err := controller.Run(testcontext.New(t), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+		require.Contains(t, result.Error.Message, "no action was invoked by operation")
+	})
+}
+
+// registryDriver is a recipe driver that pulls recipes from a registry like the Bicep driver, without deploying them.
+type registryDriver struct {
+	client remote.Client
+}
+
+func (d *registryDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+	data := map[string]any{}
+	if _, err := util.ReadFromRegistry(ctx, opts.Definition, &data, d.client); err != nil {
+		return nil, err
+	}
+
+	return &recipes.RecipeOutput{}, nil
+}
+
+func (d *registryDriver) Delete(ctx context.Context, opts driver.DeleteOptions) error {
+	return nil
+}
+
+func (d *registryDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
+	return nil, nil
+}
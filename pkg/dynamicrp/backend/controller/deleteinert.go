@@ -20,24 +20,31 @@ import (
 	"context"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/recipes/engine"
 )
 
 // InertDeleteController is the async operation controller to perform DELETE processing on
 // dynamic resources not deployed using recipes.
 type InertDeleteController struct {
 	ctrl.BaseController
+	engine engine.Engine
 }
 
 // NewInertDeleteController creates a new InertDeleteController.
-func NewInertDeleteController(opts ctrl.Options) (ctrl.Controller, error) {
+func NewInertDeleteController(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
 	return &InertDeleteController{
 		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         engine,
 	}, nil
 }
 
-// Run executes the deletion of a dynamic resource from the database. It implements
-// the async controller interface and returns an error if the deletion fails.
+// Run executes the deletion of a dynamic resource from the database, after deleting the resources deployed by the
+// actions of the resource. It implements the async controller interface and returns an error if the deletion fails.
 func (c *InertDeleteController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	if result, err := runDeleteActionRecipes(ctx, c.DatabaseClient(), c.engine, request.ResourceID); result != nil || err != nil {
+		return *result, err
+	}
+
 	err := c.DatabaseClient().Delete(ctx, request.ResourceID)
 	if err != nil {
 		return ctrl.Result{}, err
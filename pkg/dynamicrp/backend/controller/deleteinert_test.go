@@ -17,10 +17,20 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -35,7 +45,7 @@ func Test_InertDeleteController_Run(t *testing.T) {
 			DatabaseClient: databaseClient,
 		}
 
-		controller, err := NewInertDeleteController(opts)
+		controller, err := NewInertDeleteController(opts, nil)
 		require.NoError(t, err)
 		return controller.(*InertDeleteController), databaseClient
 	}
@@ -47,9 +57,79 @@ func Test_InertDeleteController_Run(t *testing.T) {
 	}
 
 	// Controller needs to call delete on the resource.
+	databaseClient.EXPECT().Get(gomock.Any(), request.ResourceID).Return(&database.Object{Data: map[string]any{}}, nil).Times(1)
 	databaseClient.EXPECT().Delete(gomock.Any(), request.ResourceID).Return(nil).Times(1)
 
 	result, err := controller.Run(testcontext.New(t), request)
 	require.NoError(t, err)
 	require.Equal(t, ctrl.Result{}, result)
 }
+
+func Test_InertDeleteController_Run_DeletesActionRecipes(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testActionResourceID,
+				Type: "Applications.Test/testResources",
+			},
+		},
+		Properties: map[string]any{
+			"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+		},
+	}
+	outputResources := []rpv1.OutputResource{
+		{ID: resources.MustParse(testActionOutputResourceID), RadiusManaged: to.Ptr(true)},
+	}
+	resource.SetActionStatus("restart", datamodel.ActionStatus{
+		OperationID: uuid.NewString(),
+		State:       v1.ProvisioningStateSucceeded,
+		Recipe: &datamodel.ActionRecipeStatus{
+			TemplateKind:    recipes.TemplateKindBicep,
+			TemplatePath:    "ghcr.io/example/restart:1.0",
+			OutputResources: outputResources,
+		},
+	})
+	// Actions implemented by callbacks don't deploy resources.
+	resource.SetActionStatus("rotate", datamodel.ActionStatus{
+		OperationID: uuid.NewString(),
+		State:       v1.ProvisioningStateSucceeded,
+	})
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: testActionResourceID},
+		Data:     resource,
+	})
+	require.NoError(t, err)
+
+	mockEngine := engine.NewMockEngine(gomock.NewController(t))
+	mockEngine.EXPECT().
+		Delete(gomock.Any(), engine.DeleteOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          "restart",
+					EnvironmentID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+					ResourceID:    testActionResourceID + "/actions/restart",
+				},
+			},
+			OutputResources: outputResources,
+			RecipeDefinition: &recipes.EnvironmentDefinition{
+				Name:         "restart",
+				Driver:       recipes.TemplateKindBicep,
+				ResourceType: "Applications.Test/testResources",
+				TemplatePath: "ghcr.io/example/restart:1.0",
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	controller, err := NewInertDeleteController(ctrl.Options{DatabaseClient: databaseClient}, mockEngine)
+	require.NoError(t, err)
+
+	result, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: testActionResourceID})
+	require.NoError(t, err)
+	require.Equal(t, ctrl.Result{}, result)
+
+	_, err = databaseClient.Get(context.Background(), testActionResourceID)
+	require.ErrorIs(t, err, &database.ErrNotFound{ID: testActionResourceID})
+}
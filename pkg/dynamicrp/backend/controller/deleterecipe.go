@@ -45,8 +45,13 @@ func NewRecipeDeleteController(opts ctrl.Options, engine engine.Engine, configur
 }
 
 // Run processes DELETE operations for dynamic resources deployed using recipes.
-// It creates and delegates the request to DeleteResource controller to handle the deletion.
+// It deletes the resources deployed by the actions of the resource, then creates and delegates the request to
+// DeleteResource controller to handle the deletion.
 func (c *RecipeDeleteController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	if result, err := runDeleteActionRecipes(ctx, c.DatabaseClient(), c.engine, request.ResourceID); result != nil || err != nil {
+		return *result, err
+	}
+
 	deleteController, err := recipecontroller.NewDeleteResource(c.opts, &processor.DynamicProcessor{}, c.engine, c.configurationLoader)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/algorithm/graph"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/resourceutil"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ graph.DependencyItem = (*connectedResource)(nil)
+
+// connectedResource is a resource in the dependency graph formed by the connections of a resource.
+type connectedResource struct {
+	id                string
+	provisioningState v1.ProvisioningState
+	dependencies      []string
+}
+
+// Key implements graph.DependencyItem.
+func (r *connectedResource) Key() string {
+	return r.id
+}
+
+// GetDependencies implements graph.DependencyItem.
+func (r *connectedResource) GetDependencies() ([]string, error) {
+	return r.dependencies, nil
+}
+
+// pendingConnectedResources returns the IDs of the resources the given resource is connected to, directly or
+// transitively, that are still being deployed, in deployment order. The recipe of the resource must be executed after
+// the recipes of its connections, so that the recipe outputs of connected resources passed to the recipe context are
+// up to date.
+//
+// A *v1.ErrClientRP is returned if the connections form a cycle or if a connected resource failed to deploy or was
+// canceled, even when other connected resources are still being deployed.
+func pendingConnectedResources(ctx context.Context, client database.Client, resourceID string) ([]string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	items, err := getConnectedResources(ctx, client, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 1 {
+		return nil, nil
+	}
+
+	dependencyGraph, err := graph.ComputeDependencyGraph(items)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered, err := dependencyGraph.Order()
+	if err != nil {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("the connections of resource %q form a dependency cycle", resourceID))
+	}
+
+	pending := []string{}
+	for _, item := range ordered {
+		connected := item.(*connectedResource)
+		if strings.EqualFold(connected.id, resourceID) {
+			continue
+		}
+
+		state := connected.provisioningState
+		if state == v1.ProvisioningStateFailed || state == v1.ProvisioningStateCanceled {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("connected resource %q is in %s state", connected.id, state))
+		}
+
+		if !state.IsTerminal() {
+			logger.Info("Waiting for connected resource to be deployed", "connectedResourceID", connected.id, "provisioningState", state)
+			pending = append(pending, connected.id)
+		}
+	}
+
+	return pending, nil
+}
+
+// getConnectedResources returns the resource and the resources it is connected to, directly or transitively.
+// Connections to resources that do not exist are ignored.
+func getConnectedResources(ctx context.Context, client database.Client, resourceID string) ([]graph.DependencyItem, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	visited := map[string]*connectedResource{}
+	items := []graph.DependencyItem{}
+
+	queue := []string{resourceID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		if _, ok := visited[strings.ToLower(id)]; ok {
+			continue
+		}
+
+		obj, err := client.Get(ctx, id)
+		if errors.Is(err, &database.ErrNotFound{ID: id}) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get connected resource %s: %w", id, err)
+		}
+
+		resource := &datamodel.DynamicResource{}
+		if err := obj.As(resource); err != nil {
+			return nil, err
+		}
+
+		connections, err := resourceutil.GetConnectionNameandSourceIDs(obj.Data)
+		if err != nil && strings.EqualFold(id, resourceID) {
+			return nil, err
+		} else if err != nil {
+			// Connections of other resource types are not required to reference resources, for example
+			// container connections can reference URLs.
+			logger.Info("Ignoring connections of connected resource", "connectedResourceID", id, "error", err.Error())
+			connections = nil
+		}
+
+		item := &connectedResource{id: id, provisioningState: resource.ProvisioningState()}
+		visited[strings.ToLower(id)] = item
+		items = append(items, item)
+
+		for _, connectedID := range connections {
+			queue = append(queue, connectedID)
+			item.dependencies = append(item.dependencies, connectedID)
+		}
+	}
+
+	// Use the keys of the resources that exist for the dependencies, and drop connections to missing resources.
+	for _, item := range visited {
+		dependencies := []string{}
+		for _, dependency := range item.dependencies {
+			if connected, ok := visited[strings.ToLower(dependency)]; ok {
+				dependencies = append(dependencies, connected.id)
+			}
+		}
+		item.dependencies = dependencies
+	}
+
+	return items, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCacheID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/caches/cache"
+	testNetworkID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/networks/network"
+	testVaultID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/vaults/vault"
+)
+
+func saveTestResource(t *testing.T, client database.Client, id string, state v1.ProvisioningState, connections ...string) {
+	err := client.Save(context.Background(), newTestResourceObject(id, state, connections...))
+	require.NoError(t, err)
+}
+
+func newTestResourceObject(id string, state v1.ProvisioningState, connections ...string) *database.Object {
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: state,
+			},
+		},
+		Properties: map[string]any{},
+	}
+
+	if len(connections) > 0 {
+		conns := map[string]any{}
+		for i, connection := range connections {
+			conns[string(rune('a'+i))] = map[string]any{"source": connection}
+		}
+		resource.Properties["connections"] = conns
+	}
+
+	return &database.Object{Metadata: database.Metadata{ID: id}, Data: resource}
+}
+
+func Test_pendingConnectedResources(t *testing.T) {
+	t.Run("no connections", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating)
+
+		pending, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+
+	t.Run("connected resources are deployed", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testNetworkID, testVaultID)
+		saveTestResource(t, client, testNetworkID, v1.ProvisioningStateSucceeded)
+		saveTestResource(t, client, testVaultID, v1.ProvisioningStateSucceeded, testNetworkID)
+
+		pending, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+
+	t.Run("connection to missing resource is ignored", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testNetworkID)
+
+		pending, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+
+	t.Run("connected resource being deployed", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testNetworkID)
+		saveTestResource(t, client, testNetworkID, v1.ProvisioningStateUpdating)
+
+		pending, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.NoError(t, err)
+		require.Equal(t, []string{testNetworkID}, pending)
+	})
+
+	t.Run("connected resource failed", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testVaultID)
+		saveTestResource(t, client, testVaultID, v1.ProvisioningStateSucceeded, testNetworkID)
+		saveTestResource(t, client, testNetworkID, v1.ProvisioningStateFailed)
+
+		_, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.Equal(t, v1.NewClientErrInvalidRequest("connected resource \""+testNetworkID+"\" is in Failed state"), err)
+	})
+
+	t.Run("connected resource canceled while another is being deployed", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testVaultID, testNetworkID)
+		saveTestResource(t, client, testVaultID, v1.ProvisioningStateUpdating)
+		saveTestResource(t, client, testNetworkID, v1.ProvisioningStateCanceled)
+
+		_, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.Equal(t, v1.NewClientErrInvalidRequest("connected resource \""+testNetworkID+"\" is in Canceled state"), err)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testNetworkID)
+		saveTestResource(t, client, testNetworkID, v1.ProvisioningStateSucceeded, testVaultID)
+		saveTestResource(t, client, testVaultID, v1.ProvisioningStateSucceeded, testCacheID)
+
+		_, err := pendingConnectedResources(testcontext.New(t), client, testCacheID)
+		require.Equal(t, v1.NewClientErrInvalidRequest("the connections of resource \""+testCacheID+"\" form a dependency cycle"), err)
+	})
+}
@@ -98,7 +98,7 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 	switch operationType.Method {
 	case v1.OperationDelete:
 		if hasCapability(resourceTypeDetails, datamodel.CapabilityManualResourceProvisioning) {
-			return NewInertDeleteController(options)
+			return NewInertDeleteController(options, c.engine)
 		}
 		return NewRecipeDeleteController(options, c.engine, c.configurationLoader)
 
@@ -108,6 +108,9 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		}
 		return NewRecipePutController(options, c.engine, c.configurationLoader)
 
+	case v1.OperationPost:
+		return NewActionController(options, resourceTypeDetails.Properties.Actions, c.engine, c.configurationLoader)
+
 	default:
 		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
 	}
@@ -129,7 +132,14 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return fmt.Errorf("failed to access and validate resource data: %w", err)
 	}
 
-	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, request.APIVersion)
+	// Validate against the schema of the API version the resource is stored in. Resources are stored in the hub API
+	// version of the resource type when the requested API version declares conversion rules.
+	apiVersion := request.APIVersion
+	if updatedAPIVersion, ok := resourceData["updatedApiVersion"].(string); ok && updatedAPIVersion != "" {
+		apiVersion = updatedAPIVersion
+	}
+
+	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, apiVersion)
 	if err != nil {
 		if errors.Is(err, processor.ErrNoSchemaFound) {
 			logger := ucplog.FromContextOrDiscard(ctx)
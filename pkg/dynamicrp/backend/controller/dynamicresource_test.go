@@ -104,6 +104,19 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipeDeleteController{}, selected)
 	})
 
+	t.Run("POST action", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPost}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &ActionController{}, selected)
+	})
+
 	t.Run("unknown operation", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
@@ -18,12 +18,26 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
 	recipecontroller "github.com/radius-project/radius/pkg/portableresources/backend/controller"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// connectionPollInitialInterval is the initial interval between checks of the connected resources of a resource
+	// waiting for them to be deployed.
+	connectionPollInitialInterval = 2 * time.Second
+
+	// connectionPollMaxInterval is the maximum interval between checks of the connected resources of a resource
+	// waiting for them to be deployed.
+	connectionPollMaxInterval = 30 * time.Second
 )
 
 // RecipePutController is the async operation controller to perform PUT processing on "recipe" dynamic resources.
@@ -32,6 +46,10 @@ type RecipePutController struct {
 	opts                ctrl.Options
 	engine              engine.Engine
 	configurationLoader configloader.ConfigurationLoader
+
+	// pollInitialInterval and pollMaxInterval bound the backoff between checks of the connected resources.
+	pollInitialInterval time.Duration
+	pollMaxInterval     time.Duration
 }
 
 // NewRecipePutController creates a new RecipePutController.
@@ -41,12 +59,21 @@ func NewRecipePutController(opts ctrl.Options, engine engine.Engine, configurati
 		opts:                opts,
 		engine:              engine,
 		configurationLoader: configurationLoader,
+		pollInitialInterval: connectionPollInitialInterval,
+		pollMaxInterval:     connectionPollMaxInterval,
 	}, nil
 }
 
 // Run processes PUT operations for dynamic resources deployed using recipes.
-// It creates and delegates the request to CreateOrUpdateResource controller to handle the operation.
+// It waits until the resources it is connected to are deployed, and then creates and delegates the request to
+// CreateOrUpdateResource controller to handle the operation. The operation fails with a conflict if the connected
+// resources are not deployed within half of the operation timeout, so that the recipe has the rest to run.
 func (c *RecipePutController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	// Recipe outputs of connected resources are passed to the recipe, so their recipes need to be executed first.
+	if err := c.waitForConnectedResources(ctx, request.ResourceID, request.Timeout()/2); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	putController, err := recipecontroller.NewCreateOrUpdateResource(c.opts, &processor.DynamicProcessor{}, c.engine, c.configurationLoader)
 	if err != nil {
 		return ctrl.Result{}, err
@@ -54,3 +81,40 @@ func (c *RecipePutController) Run(ctx context.Context, request *ctrl.Request) (c
 
 	return putController.Run(ctx, request)
 }
+
+// waitForConnectedResources polls the connected resources of the resource with a bounded exponential backoff until
+// they are deployed. The wait is part of the operation rather than a requeue of the operation, so it doesn't count
+// towards the retries of the operation. Returns a *v1.ErrClientRP with a conflict code when a connected resource is
+// not deployed within the timeout, so that the client can retry the operation.
+func (c *RecipePutController) waitForConnectedResources(ctx context.Context, resourceID string, timeout time.Duration) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	deadline := time.Now().Add(timeout)
+	interval := c.pollInitialInterval
+	for {
+		pending, err := pendingConnectedResources(ctx, c.DatabaseClient(), resourceID)
+		if err != nil {
+			return err
+		} else if len(pending) == 0 {
+			return nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return &v1.ErrClientRP{
+				Code:    v1.CodeConflict,
+				Message: fmt.Sprintf("connected resource not ready: %q was not deployed within %s, retry the operation once it's deployed", pending[0], timeout),
+			}
+		}
+
+		interval = min(interval, remaining)
+		logger.Info("Waiting for connected resources to be deployed", "retryAfter", interval.String())
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval = min(interval*2, c.pollMaxInterval)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	queueinmemory "github.com/radius-project/radius/pkg/components/queue/inmemory"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_RecipePutController_WaitForConnectedResources(t *testing.T) {
+	newController := func(t *testing.T) (*RecipePutController, *inmemory.Client) {
+		client := inmemory.NewClient()
+		saveTestResource(t, client, testCacheID, v1.ProvisioningStateUpdating, testNetworkID)
+		saveTestResource(t, client, testNetworkID, v1.ProvisioningStateUpdating)
+
+		controller, err := NewRecipePutController(ctrl.Options{DatabaseClient: client}, nil, nil)
+		require.NoError(t, err)
+		controller.(*RecipePutController).pollInitialInterval = time.Millisecond
+		controller.(*RecipePutController).pollMaxInterval = 10 * time.Millisecond
+
+		return controller.(*RecipePutController), client
+	}
+
+	t.Run("connected resource is deployed", func(t *testing.T) {
+		controller, client := newController(t)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			saveTestResource(t, client, testNetworkID, v1.ProvisioningStateSucceeded)
+		}()
+
+		err := controller.waitForConnectedResources(testcontext.New(t), testCacheID, time.Minute)
+		require.NoError(t, err)
+	})
+
+	t.Run("connected resource fails", func(t *testing.T) {
+		controller, client := newController(t)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			saveTestResource(t, client, testNetworkID, v1.ProvisioningStateFailed)
+		}()
+
+		err := controller.waitForConnectedResources(testcontext.New(t), testCacheID, time.Minute)
+		require.Equal(t, v1.NewClientErrInvalidRequest("connected resource \""+testNetworkID+"\" is in Failed state"), err)
+	})
+
+	t.Run("connected resource is not deployed in time", func(t *testing.T) {
+		controller, _ := newController(t)
+
+		// The wait is bounded by half of the operation timeout.
+		timeout := 100 * time.Millisecond
+		_, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: testCacheID, OperationTimeout: &timeout})
+		require.Equal(t, &v1.ErrClientRP{
+			Code:    v1.CodeConflict,
+			Message: "connected resource not ready: \"" + testNetworkID + "\" was not deployed within 50ms, retry the operation once it's deployed",
+		}, err)
+	})
+
+	t.Run("operation is canceled", func(t *testing.T) {
+		controller, _ := newController(t)
+
+		ctx, cancel := context.WithTimeout(testcontext.New(t), 50*time.Millisecond)
+		defer cancel()
+
+		err := controller.waitForConnectedResources(ctx, testCacheID, time.Minute)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// Test_RecipePutController_Worker_SlowConnection runs the controller in the async operation worker, with a connected
+// resource that is deployed long after the message lock of the operation has expired. The wait must not be counted
+// as retries of the operation.
+func Test_RecipePutController_Worker_SlowConnection(t *testing.T) {
+	ctx, cancel := context.WithCancel(testcontext.New(t))
+	defer cancel()
+
+	const lockDuration = 100 * time.Millisecond
+
+	databaseClient := inmemory.NewClient()
+	queueClient := queueinmemory.New(queueinmemory.NewInMemQueue(lockDuration))
+	statusManager := statusmanager.New(databaseClient, queueClient, v1.LocationGlobal)
+
+	saveTestResource(t, databaseClient, testCacheID, v1.ProvisioningStateAccepted, testNetworkID)
+	saveTestResource(t, databaseClient, testNetworkID, v1.ProvisioningStateUpdating)
+
+	mctrl := gomock.NewController(t)
+	configurationLoader := configloader.NewMockConfigurationLoader(mctrl)
+	configurationLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{Simulated: true}, nil).
+		Times(1)
+	mockEngine := engine.NewMockEngine(mctrl)
+	mockEngine.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipeOutput{}, nil).
+		Times(1)
+
+	registry := worker.NewControllerRegistry()
+	err := registry.Register("Applications.Test/caches", v1.OperationPut, func(opts ctrl.Options) (ctrl.Controller, error) {
+		controller, err := NewRecipePutController(opts, mockEngine, configurationLoader)
+		if err != nil {
+			return nil, err
+		}
+		controller.(*RecipePutController).pollInitialInterval = 10 * time.Millisecond
+		controller.(*RecipePutController).pollMaxInterval = 50 * time.Millisecond
+		return controller, nil
+	}, ctrl.Options{DatabaseClient: databaseClient})
+	require.NoError(t, err)
+
+	w := worker.New(worker.Options{
+		MaxOperationRetryCount:  2,
+		MessageExtendMargin:     lockDuration / 2,
+		MinMessageLockDuration:  lockDuration / 4,
+		DequeueIntervalDuration: 5 * time.Millisecond,
+	}, statusManager, queueClient, registry)
+	go func() {
+		_ = w.Start(ctx)
+	}()
+
+	resourceID := resources.MustParse(testCacheID)
+	operationID := uuid.New()
+	err = statusManager.QueueAsyncOperation(ctx, &v1.ARMRequestContext{
+		ResourceID:    resourceID,
+		OperationID:   operationID,
+		OperationType: v1.OperationType{Type: "Applications.Test/caches", Method: v1.OperationPut},
+	}, statusmanager.QueueOperationOptions{OperationTimeout: time.Minute})
+	require.NoError(t, err)
+
+	// The connected resource is deployed after many message lock durations.
+	time.Sleep(10 * lockDuration)
+	saveTestResource(t, databaseClient, testNetworkID, v1.ProvisioningStateSucceeded)
+
+	var status *statusmanager.Status
+	require.Eventually(t, func() bool {
+		status, err = statusManager.Get(ctx, resourceID, operationID)
+		require.NoError(t, err)
+		return status.Status.IsTerminal()
+	}, 10*time.Second, 10*time.Millisecond)
+
+	require.Nil(t, status.Error)
+	require.Equal(t, v1.ProvisioningStateSucceeded, status.Status)
+}
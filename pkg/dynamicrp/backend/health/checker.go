@@ -0,0 +1,295 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// state is the live health of a single output resource.
+type state int
+
+const (
+	stateReady state = iota
+	stateProgressing
+	stateDegraded
+)
+
+// resourceHealth is the live health of a single output resource, with the reason and message explaining it.
+type resourceHealth struct {
+	state   state
+	reason  string
+	message string
+}
+
+// Checker evaluates the live health of the output resources of a resource.
+type Checker struct {
+	client runtimeclient.Client
+}
+
+// NewChecker creates a new Checker reading output resources with the given Kubernetes client.
+func NewChecker(client runtimeclient.Client) *Checker {
+	return &Checker{client: client}
+}
+
+// Conditions returns the Ready, Degraded and Progressing conditions derived from the live health of the output
+// resources. Only Kubernetes output resources are evaluated. Returns nil if there is no output resource to evaluate,
+// so that the conditions reported by the recipe are kept.
+func (c *Checker) Conditions(ctx context.Context, outputResources []rpv1.OutputResource) ([]rpv1.Condition, error) {
+	results := []resourceHealth{}
+	for _, outputResource := range outputResources {
+		if outputResource.GetResourceType().Provider != resourcemodel.ProviderKubernetes {
+			continue
+		}
+
+		result, err := c.check(ctx, outputResource)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return aggregate(results), nil
+}
+
+func (c *Checker) check(ctx context.Context, outputResource rpv1.OutputResource) (resourceHealth, error) {
+	id := outputResource.ID
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+
+	mapping, err := c.client.RESTMapper().RESTMapping(schema.GroupKind{Group: group, Kind: kind})
+	if err != nil {
+		return resourceHealth{}, fmt.Errorf("could not find API version for type %q: %w", id.Type(), err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mapping.GroupVersionKind)
+	err = c.client.Get(ctx, runtimeclient.ObjectKey{Namespace: namespace, Name: name}, obj)
+	if apierrors.IsNotFound(err) {
+		return resourceHealth{state: stateDegraded, reason: "ResourceNotFound", message: fmt.Sprintf("%s %q was not found", kind, name)}, nil
+	} else if err != nil {
+		return resourceHealth{}, fmt.Errorf("failed to get %s %q: %w", kind, name, err)
+	}
+
+	switch {
+	case group == "apps" && kind == "Deployment":
+		return deploymentHealth(obj), nil
+	case group == "apps" && kind == "StatefulSet":
+		return statefulSetHealth(obj), nil
+	case group == "apps" && kind == "DaemonSet":
+		return daemonSetHealth(obj), nil
+	case group == "" && kind == "Pod":
+		return podHealth(obj), nil
+	default:
+		return genericHealth(obj), nil
+	}
+}
+
+// aggregate reduces the health of the output resources to conditions. The resource is degraded if any output resource
+// is degraded, progressing if any output resource is progressing, and ready otherwise.
+func aggregate(results []resourceHealth) []rpv1.Condition {
+	var degraded, progressing *resourceHealth
+	for i := range results {
+		switch results[i].state {
+		case stateDegraded:
+			if degraded == nil {
+				degraded = &results[i]
+			}
+		case stateProgressing:
+			if progressing == nil {
+				progressing = &results[i]
+			}
+		}
+	}
+
+	conditions := []rpv1.Condition{}
+	switch {
+	case degraded != nil:
+		conditions = append(conditions,
+			rpv1.Condition{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: degraded.reason, Message: degraded.message},
+			rpv1.Condition{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionTrue, Reason: degraded.reason, Message: degraded.message})
+	case progressing != nil:
+		conditions = append(conditions,
+			rpv1.Condition{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: progressing.reason, Message: progressing.message},
+			rpv1.Condition{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionFalse, Reason: "ResourcesHealthy"})
+	default:
+		conditions = append(conditions,
+			rpv1.Condition{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "ResourcesReady", Message: "All output resources are ready."},
+			rpv1.Condition{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionFalse, Reason: "ResourcesHealthy"})
+	}
+
+	if progressing != nil {
+		conditions = append(conditions, rpv1.Condition{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionTrue, Reason: progressing.reason, Message: progressing.message})
+	} else {
+		conditions = append(conditions, rpv1.Condition{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionFalse, Reason: "ResourcesStable"})
+	}
+
+	return conditions
+}
+
+func deploymentHealth(obj *unstructured.Unstructured) resourceHealth {
+	if failed, message := hasCondition(obj, "ReplicaFailure", "True"); failed {
+		return resourceHealth{state: stateDegraded, reason: "ReplicaFailure", message: message}
+	}
+	if failed, message := hasConditionReason(obj, "Progressing", "ProgressDeadlineExceeded"); failed {
+		return resourceHealth{state: stateDegraded, reason: "ProgressDeadlineExceeded", message: message}
+	}
+	if !observed(obj) {
+		return progressing("Deployment", obj.GetName(), "the latest generation has not been observed yet")
+	}
+
+	desired := replicas(obj)
+	updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	available, _, _ := unstructured.NestedInt64(obj.Object, "status", "availableReplicas")
+	if updated < desired || available < desired {
+		return progressing("Deployment", obj.GetName(), fmt.Sprintf("%d of %d replicas are updated and %d are available", updated, desired, available))
+	}
+
+	return resourceHealth{state: stateReady}
+}
+
+func statefulSetHealth(obj *unstructured.Unstructured) resourceHealth {
+	if !observed(obj) {
+		return progressing("StatefulSet", obj.GetName(), "the latest generation has not been observed yet")
+	}
+
+	desired := replicas(obj)
+	updated, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	if updated < desired || ready < desired {
+		return progressing("StatefulSet", obj.GetName(), fmt.Sprintf("%d of %d replicas are updated and %d are ready", updated, desired, ready))
+	}
+
+	return resourceHealth{state: stateReady}
+}
+
+func daemonSetHealth(obj *unstructured.Unstructured) resourceHealth {
+	if !observed(obj) {
+		return progressing("DaemonSet", obj.GetName(), "the latest generation has not been observed yet")
+	}
+
+	desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	available, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberAvailable")
+	if available < desired {
+		return progressing("DaemonSet", obj.GetName(), fmt.Sprintf("%d of %d pods are available", available, desired))
+	}
+
+	return resourceHealth{state: stateReady}
+}
+
+func podHealth(obj *unstructured.Unstructured) resourceHealth {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return resourceHealth{state: stateReady}
+	case "Failed":
+		message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+		return resourceHealth{state: stateDegraded, reason: "PodFailed", message: fmt.Sprintf("Pod %q failed: %s", obj.GetName(), message)}
+	}
+
+	if ready, _ := hasCondition(obj, "Ready", "True"); ready {
+		return resourceHealth{state: stateReady}
+	}
+
+	return progressing("Pod", obj.GetName(), fmt.Sprintf("the pod is %s and not ready", strings.ToLower(phase)))
+}
+
+// genericHealth evaluates resources of other kinds from their Ready condition, if they report one. Resources that do
+// not report a Ready condition are ready as long as they exist.
+func genericHealth(obj *unstructured.Unstructured) resourceHealth {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+
+		switch condition["status"] {
+		case "True":
+			return resourceHealth{state: stateReady}
+		case "False":
+			message, _ := condition["message"].(string)
+			return resourceHealth{state: stateDegraded, reason: "ResourceNotReady", message: fmt.Sprintf("%s %q is not ready: %s", obj.GetKind(), obj.GetName(), message)}
+		default:
+			return progressing(obj.GetKind(), obj.GetName(), "the readiness is unknown")
+		}
+	}
+
+	return resourceHealth{state: stateReady}
+}
+
+func progressing(kind string, name string, details string) resourceHealth {
+	return resourceHealth{state: stateProgressing, reason: "ResourcesProgressing", message: fmt.Sprintf("%s %q is progressing: %s", kind, name, details)}
+}
+
+// replicas returns the desired number of replicas, which defaults to 1.
+func replicas(obj *unstructured.Unstructured) int64 {
+	desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		return 1
+	}
+
+	return desired
+}
+
+// observed returns true if the controller has observed the latest generation of the object.
+func observed(obj *unstructured.Unstructured) bool {
+	observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	return !found || observedGeneration >= obj.GetGeneration()
+}
+
+// hasCondition returns true and the message of the condition if the object reports the condition with the given status.
+func hasCondition(obj *unstructured.Unstructured, conditionType string, status string) (bool, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if ok && condition["type"] == conditionType && condition["status"] == status {
+			message, _ := condition["message"].(string)
+			return true, message
+		}
+	}
+
+	return false, ""
+}
+
+// hasConditionReason returns true and the message of the condition if the object reports the condition with the given reason.
+func hasConditionReason(obj *unstructured.Unstructured, conditionType string, reason string) (bool, string) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if ok && condition["type"] == conditionType && condition["reason"] == reason {
+			message, _ := condition["message"].(string)
+			return true, message
+		}
+	}
+
+	return false, ""
+}
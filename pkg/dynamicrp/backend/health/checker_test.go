@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func fakeClient(objects ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme.Scheme)).
+		WithObjects(objects...).
+		Build()
+}
+
+func kubernetesOutputResource(group string, kind string, name string) rpv1.OutputResource {
+	return rpv1.OutputResource{ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, group, kind, "default", name)}
+}
+
+func deployment(name string, desired int32, available int32, conditions ...appsv1.DeploymentCondition) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: to.Ptr(desired)},
+		Status: appsv1.DeploymentStatus{
+			UpdatedReplicas:   available,
+			AvailableReplicas: available,
+			Conditions:        conditions,
+		},
+	}
+}
+
+func Test_Checker_Conditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		objects         []client.Object
+		outputResources []rpv1.OutputResource
+		expected        []rpv1.Condition
+	}{
+		{
+			name: "no kubernetes output resources",
+			outputResources: []rpv1.OutputResource{
+				{ID: resources.MustParse("/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders")},
+			},
+			expected: nil,
+		},
+		{
+			name:    "ready",
+			objects: []client.Object{deployment("web", 2, 2), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}},
+			outputResources: []rpv1.OutputResource{
+				kubernetesOutputResource("apps", "Deployment", "web"),
+				kubernetesOutputResource("", "Service", "web"),
+			},
+			expected: []rpv1.Condition{
+				{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "ResourcesReady", Message: "All output resources are ready."},
+				{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionFalse, Reason: "ResourcesHealthy"},
+				{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionFalse, Reason: "ResourcesStable"},
+			},
+		},
+		{
+			name:            "progressing",
+			objects:         []client.Object{deployment("web", 3, 1)},
+			outputResources: []rpv1.OutputResource{kubernetesOutputResource("apps", "Deployment", "web")},
+			expected: []rpv1.Condition{
+				{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "ResourcesProgressing", Message: `Deployment "web" is progressing: 1 of 3 replicas are updated and 1 are available`},
+				{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionFalse, Reason: "ResourcesHealthy"},
+				{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionTrue, Reason: "ResourcesProgressing", Message: `Deployment "web" is progressing: 1 of 3 replicas are updated and 1 are available`},
+			},
+		},
+		{
+			name: "degraded",
+			objects: []client.Object{deployment("web", 3, 1, appsv1.DeploymentCondition{
+				Type:    appsv1.DeploymentProgressing,
+				Status:  corev1.ConditionFalse,
+				Reason:  "ProgressDeadlineExceeded",
+				Message: `ReplicaSet "web-123" has timed out progressing.`,
+			})},
+			outputResources: []rpv1.OutputResource{kubernetesOutputResource("apps", "Deployment", "web")},
+			expected: []rpv1.Condition{
+				{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: `ReplicaSet "web-123" has timed out progressing.`},
+				{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionTrue, Reason: "ProgressDeadlineExceeded", Message: `ReplicaSet "web-123" has timed out progressing.`},
+				{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionFalse, Reason: "ResourcesStable"},
+			},
+		},
+		{
+			name:            "not found",
+			outputResources: []rpv1.OutputResource{kubernetesOutputResource("apps", "StatefulSet", "db")},
+			expected: []rpv1.Condition{
+				{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "ResourceNotFound", Message: `StatefulSet "db" was not found`},
+				{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionTrue, Reason: "ResourceNotFound", Message: `StatefulSet "db" was not found`},
+				{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionFalse, Reason: "ResourcesStable"},
+			},
+		},
+		{
+			name: "failed pod",
+			objects: []client.Object{&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "default"},
+				Status:     corev1.PodStatus{Phase: corev1.PodFailed, Message: "exit code 1"},
+			}},
+			outputResources: []rpv1.OutputResource{kubernetesOutputResource("", "Pod", "migrate")},
+			expected: []rpv1.Condition{
+				{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "PodFailed", Message: `Pod "migrate" failed: exit code 1`},
+				{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionTrue, Reason: "PodFailed", Message: `Pod "migrate" failed: exit code 1`},
+				{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionFalse, Reason: "ResourcesStable"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := NewChecker(fakeClient(tc.objects...))
+
+			conditions, err := checker.Conditions(context.Background(), tc.outputResources)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, conditions)
+		})
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"time"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// defaultRefreshInterval is the default interval between refreshes.
+	defaultRefreshInterval = 60 * time.Second
+
+	// globalLocation is the location of resource providers registered in UCP.
+	globalLocation = "global"
+
+	// leaseName is the name of the lease used to elect the replica that refreshes the conditions.
+	leaseName = "dynamic-rp-health-refresher"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// Refresher periodically refreshes the conditions of dynamic resources from the live health of their output resources,
+// so that a resource whose underlying infrastructure is failing reports it even though its last deployment succeeded.
+type Refresher struct {
+	options *dynamicrp.Options
+
+	databaseClient database.Client
+	ucpClient      *v20231001preview.ClientFactory
+	checker        *Checker
+	now            func() time.Time
+}
+
+// NewRefresher creates a new Refresher.
+func NewRefresher(options *dynamicrp.Options) *Refresher {
+	return &Refresher{options: options, now: time.Now}
+}
+
+// Name returns the name of the service used for logging.
+func (r *Refresher) Name() string {
+	return "dynamic-rp health refresher"
+}
+
+// Run runs the service.
+func (r *Refresher) Run(ctx context.Context) error {
+	databaseClient, err := r.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := r.options.KubernetesProvider.RuntimeClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes runtime client: %w", err)
+	}
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(r.options.UCP))
+	if err != nil {
+		return err
+	}
+
+	r.databaseClient = databaseClient
+	r.ucpClient = ucpClient
+	r.checker = NewChecker(kubeClient)
+
+	interval := defaultRefreshInterval
+	if r.options.Config.Health.RefreshIntervalSeconds > 0 {
+		interval = time.Duration(r.options.Config.Health.RefreshIntervalSeconds) * time.Second
+	}
+
+	// Without a lease namespace every replica refreshes the conditions, which is only suitable for a single replica.
+	if r.options.Config.Health.LeaseNamespace == "" {
+		r.refreshPeriodically(ctx, interval)
+		return nil
+	}
+
+	clientset, err := r.options.KubernetesProvider.ClientGoClient()
+	if err != nil {
+		return fmt.Errorf("failed to get Kubernetes client: %w", err)
+	}
+
+	identity, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get the identity for leader election: %w", err)
+	}
+
+	return runAsLeader(ctx, clientset, r.options.Config.Health.LeaseNamespace, identity, func(ctx context.Context) {
+		r.refreshPeriodically(ctx, interval)
+	})
+}
+
+// runAsLeader runs the function while this replica holds the lease, so that only one replica of dynamic-rp refreshes
+// the conditions at a time. When the lease is lost the function is cancelled and the replica campaigns again until the
+// context is cancelled.
+func runAsLeader(ctx context.Context, clientset kubernetes.Interface, namespace string, identity string, run func(ctx context.Context)) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: leaseName, Namespace: namespace},
+		Client:     clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            leaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				logger.Info("Acquired the lease, refreshing the conditions of dynamic resources", "identity", identity)
+				run(ctx)
+			},
+			OnStoppedLeading: func() {
+				logger.Info("Released the lease", "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create leader elector: %w", err)
+	}
+
+	for ctx.Err() == nil {
+		elector.Run(ctx)
+	}
+
+	return nil
+}
+
+// refreshPeriodically refreshes the conditions of dynamic resources on every interval until the context is cancelled.
+func (r *Refresher) refreshPeriodically(ctx context.Context, interval time.Duration) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := r.refreshAll(ctx)
+			if err != nil {
+				logger.Error(err, "failed to refresh the conditions of dynamic resources")
+			}
+		}
+	}
+}
+
+// refreshAll refreshes the conditions of the resources of every resource type served by dynamic-rp.
+func (r *Refresher) refreshAll(ctx context.Context) error {
+	var errs error
+
+	planes := r.ucpClient.NewRadiusPlanesClient().NewListPager(nil)
+	for planes.More() {
+		page, err := planes.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list planes: %w", err)
+		}
+
+		for _, plane := range page.Value {
+			resourceTypes, err := r.listResourceTypes(ctx, to.String(plane.Name))
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+
+			for _, resourceType := range resourceTypes {
+				errs = errors.Join(errs, r.refreshResourceType(ctx, "/planes/radius/"+to.String(plane.Name), resourceType))
+			}
+		}
+	}
+
+	return errs
+}
+
+// listResourceTypes lists the fully-qualified resource types served by dynamic-rp in the plane. These are the resource
+// types of the resource providers without an address, which UCP routes to dynamic-rp.
+func (r *Refresher) listResourceTypes(ctx context.Context, planeName string) ([]string, error) {
+	resourceTypes := []string{}
+
+	providers := r.ucpClient.NewResourceProvidersClient().NewListProviderSummariesPager(planeName, nil)
+	for providers.More() {
+		page, err := providers.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource providers of plane %q: %w", planeName, err)
+		}
+
+		for _, provider := range page.Value {
+			providerName := to.String(provider.Name)
+			location, err := r.ucpClient.NewLocationsClient().Get(ctx, planeName, providerName, globalLocation, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get location of resource provider %q: %w", providerName, err)
+			}
+
+			if location.Properties != nil && to.String(location.Properties.Address) != "" {
+				continue
+			}
+
+			for name := range provider.ResourceTypes {
+				resourceTypes = append(resourceTypes, providerName+"/"+name)
+			}
+		}
+	}
+
+	slices.Sort(resourceTypes)
+	return resourceTypes, nil
+}
+
+// refreshResourceType refreshes the conditions of the resources of a resource type.
+func (r *Refresher) refreshResourceType(ctx context.Context, rootScope string, resourceType string) error {
+	var errs error
+
+	query := database.Query{RootScope: rootScope, ScopeRecursive: true, ResourceType: resourceType}
+	token := ""
+	for {
+		result, err := r.databaseClient.Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return fmt.Errorf("failed to query resources of type %q: %w", resourceType, err)
+		}
+
+		for i := range result.Items {
+			errs = errors.Join(errs, r.refresh(ctx, &result.Items[i]))
+		}
+
+		if result.PaginationToken == "" {
+			return errs
+		}
+		token = result.PaginationToken
+	}
+}
+
+// refresh refreshes the conditions of a resource and saves it when they changed. The conditions derived from the live
+// health of the output resources replace the Ready, Degraded and Progressing conditions reported by the recipe, which
+// only reflect the health at deployment time.
+func (r *Refresher) refresh(ctx context.Context, obj *database.Object) error {
+	resource := &datamodel.DynamicResource{}
+	err := obj.As(resource)
+	if err != nil {
+		return err
+	}
+
+	// Resources being deployed or deleted are updated by the async operation.
+	if !resource.ProvisioningState().IsTerminal() {
+		return nil
+	}
+
+	health, err := r.checker.Conditions(ctx, resource.OutputResources())
+	if err != nil {
+		return fmt.Errorf("failed to check the health of resource %q: %w", resource.ID, err)
+	} else if health == nil {
+		return nil
+	}
+
+	existing := resource.Conditions()
+	conditions := slices.Clone(existing)
+	now := r.now().UTC()
+	for _, condition := range health {
+		conditions = rpv1.SetCondition(conditions, condition, now)
+	}
+	rpv1.SortConditions(conditions)
+
+	if reflect.DeepEqual(existing, conditions) {
+		return nil
+	}
+
+	resource.SetConditions(conditions)
+	err = r.databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: obj.ID}, Data: resource}, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		// The resource was updated concurrently. It will be refreshed on the next interval.
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to save resource %q: %w", resource.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testResourceType = "Contoso.Example/databases"
+	testReadyID      = "/planes/radius/local/resourceGroups/test-group/providers/Contoso.Example/databases/ready"
+	testUpdatingID   = "/planes/radius/local/resourceGroups/test-group/providers/Contoso.Example/databases/updating"
+)
+
+func testUCPClientFactory(t *testing.T) *v20231001preview.ClientFactory {
+	radiusPlanesServer := fake.RadiusPlanesServer{
+		NewListPager: func(options *v20231001preview.RadiusPlanesClientListOptions) (resp azfake.PagerResponder[v20231001preview.RadiusPlanesClientListResponse]) {
+			resp.AddPage(http.StatusOK, v20231001preview.RadiusPlanesClientListResponse{
+				RadiusPlaneResourceListResult: v20231001preview.RadiusPlaneResourceListResult{
+					Value: []*v20231001preview.RadiusPlaneResource{{Name: to.Ptr("local")}},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	resourceProvidersServer := fake.ResourceProvidersServer{
+		NewListProviderSummariesPager: func(planeName string, options *v20231001preview.ResourceProvidersClientListProviderSummariesOptions) (resp azfake.PagerResponder[v20231001preview.ResourceProvidersClientListProviderSummariesResponse]) {
+			resp.AddPage(http.StatusOK, v20231001preview.ResourceProvidersClientListProviderSummariesResponse{
+				PagedResourceProviderSummary: v20231001preview.PagedResourceProviderSummary{
+					Value: []*v20231001preview.ResourceProviderSummary{
+						{Name: to.Ptr("Applications.Core"), ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{"containers": {}}},
+						{Name: to.Ptr("Contoso.Example"), ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{"databases": {}}},
+					},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	locationsServer := fake.LocationsServer{
+		Get: func(ctx context.Context, planeName string, resourceProviderName string, locationName string, options *v20231001preview.LocationsClientGetOptions) (resp azfake.Responder[v20231001preview.LocationsClientGetResponse], errResp azfake.ErrorResponder) {
+			properties := &v20231001preview.LocationProperties{}
+			if resourceProviderName == "Applications.Core" {
+				properties.Address = to.Ptr("http://applications-rp.radius-system:5443")
+			}
+
+			resp.SetResponse(http.StatusOK, v20231001preview.LocationsClientGetResponse{
+				LocationResource: v20231001preview.LocationResource{Name: to.Ptr(locationName), Properties: properties},
+			}, nil)
+			return
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				RadiusPlanesServer:      radiusPlanesServer,
+				ResourceProvidersServer: resourceProvidersServer,
+				LocationsServer:         locationsServer,
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	return clientFactory
+}
+
+func saveTestResource(t *testing.T, databaseClient database.Client, id string, state v1.ProvisioningState) {
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{ID: id, Type: testResourceType},
+		},
+		Properties: map[string]any{},
+	}
+	resource.SetProvisioningState(state)
+	resource.SetConditions([]rpv1.Condition{
+		{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "RecipeSucceeded", LastTransitionTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	})
+	err := resource.ApplyDeploymentOutput(rpv1.DeploymentOutput{
+		DeployedOutputResources: []rpv1.OutputResource{kubernetesOutputResource("apps", "Deployment", "web")},
+	})
+	require.NoError(t, err)
+
+	err = databaseClient.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: resource})
+	require.NoError(t, err)
+}
+
+func getTestResource(t *testing.T, databaseClient database.Client, id string) (*datamodel.DynamicResource, string) {
+	obj, err := databaseClient.Get(context.Background(), id)
+	require.NoError(t, err)
+
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	return resource, obj.ETag
+}
+
+func Test_Refresher_RefreshAll(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	databaseClient := inmemory.NewClient()
+	saveTestResource(t, databaseClient, testReadyID, v1.ProvisioningStateSucceeded)
+	saveTestResource(t, databaseClient, testUpdatingID, v1.ProvisioningStateUpdating)
+
+	refresher := &Refresher{
+		databaseClient: databaseClient,
+		ucpClient:      testUCPClientFactory(t),
+		checker:        NewChecker(fakeClient([]client.Object{deployment("web", 3, 1)}...)),
+		now:            func() time.Time { return now },
+	}
+
+	err := refresher.refreshAll(context.Background())
+	require.NoError(t, err)
+
+	resource, etag := getTestResource(t, databaseClient, testReadyID)
+	require.Equal(t, []rpv1.Condition{
+		{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "ResourcesProgressing", Message: `Deployment "web" is progressing: 1 of 3 replicas are updated and 1 are available`, LastTransitionTime: now},
+		{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionFalse, Reason: "ResourcesHealthy", LastTransitionTime: now},
+		{Type: rpv1.ConditionProgressing, Status: rpv1.ConditionTrue, Reason: "ResourcesProgressing", Message: `Deployment "web" is progressing: 1 of 3 replicas are updated and 1 are available`, LastTransitionTime: now},
+	}, resource.Conditions())
+
+	// Resources with an ongoing operation are not refreshed.
+	resource, _ = getTestResource(t, databaseClient, testUpdatingID)
+	require.Equal(t, rpv1.ConditionTrue, rpv1.FindCondition(resource.Conditions(), rpv1.ConditionReady).Status)
+
+	// The resource is not saved again when the conditions did not change.
+	refresher.now = func() time.Time { return now.Add(time.Hour) }
+	err = refresher.refreshAll(context.Background())
+	require.NoError(t, err)
+
+	_, updatedETag := getTestResource(t, databaseClient, testReadyID)
+	require.Equal(t, etag, updatedETag)
+}
+
+func Test_RunAsLeader(t *testing.T) {
+	clientset := k8sfake.NewClientset()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The first replica acquires the lease and holds it until its context is cancelled.
+	leading := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- runAsLeader(ctx, clientset, "radius-system", "replica-1", func(ctx context.Context) {
+			close(leading)
+			<-ctx.Done()
+		})
+	}()
+
+	select {
+	case <-leading:
+	case <-ctx.Done():
+		require.Fail(t, "replica-1 did not acquire the lease")
+	}
+
+	lease, err := clientset.CoordinationV1().Leases("radius-system").Get(ctx, leaseName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "replica-1", to.String(lease.Spec.HolderIdentity))
+
+	// A second replica does not run while the lease is held.
+	otherCtx, otherCancel := context.WithTimeout(ctx, 3*retryPeriod)
+	defer otherCancel()
+	err = runAsLeader(otherCtx, clientset, "radius-system", "replica-2", func(ctx context.Context) {
+		require.Fail(t, "replica-2 should not run while replica-1 holds the lease")
+	})
+	require.NoError(t, err)
+
+	cancel()
+	require.NoError(t, <-done)
+}
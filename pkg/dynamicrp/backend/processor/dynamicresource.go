@@ -21,17 +21,21 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/resourceutil"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	schemautil "github.com/radius-project/radius/pkg/schema"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"golang.org/x/exp/slices"
 )
 
 var _ processors.ResourceProcessor[*datamodel.DynamicResource, datamodel.DynamicResource] = (*DynamicProcessor)(nil)
+var _ processors.SimulatedResourceProcessor[*datamodel.DynamicResource, datamodel.DynamicResource] = (*DynamicProcessor)(nil)
 var ErrNoSchemaFound = errors.New("no schema found for resource type")
 
 // DynamicProcessor is a processor for dynamic resources. It implements the processors.ResourceProcessor interface.
@@ -46,6 +50,44 @@ func (d *DynamicProcessor) Delete(ctx context.Context, resource *datamodel.Dynam
 
 // Process validates resource properties, and applies output values from the recipe output.
 func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.DynamicResource, options processors.Options) error {
+	schema, err := getResourceTypeSchema(ctx, options.UcpClient, resource)
+	if err != nil {
+		return err
+	}
+
+	return d.process(ctx, resource, options.RecipeOutput, schema)
+}
+
+// ProcessSimulated completes the simulated recipe output with values synthesized from the read-only properties of the
+// resource type schema, applies it like a real recipe output, and marks the resource as simulated.
+func (d *DynamicProcessor) ProcessSimulated(ctx context.Context, resource *datamodel.DynamicResource, options processors.Options) error {
+	schema, err := getResourceTypeSchema(ctx, options.UcpClient, resource)
+	if err != nil {
+		return err
+	}
+
+	values, secrets, err := schemautil.SimulateRecipeOutput(schema, options.RecipeOutput.Values, options.RecipeOutput.Secrets)
+	if err != nil {
+		return err
+	}
+
+	// Secrets are stored as strings.
+	for key, value := range secrets {
+		if _, ok := value.(string); !ok {
+			secrets[key] = fmt.Sprintf("%v", value)
+		}
+	}
+
+	err = d.process(ctx, resource, &recipes.RecipeOutput{Values: values, Secrets: secrets, Simulated: true}, schema)
+	if err != nil {
+		return err
+	}
+
+	resource.SetSimulated()
+	return nil
+}
+
+func (d *DynamicProcessor) process(ctx context.Context, resource *datamodel.DynamicResource, recipeOutput *recipes.RecipeOutput, schema map[string]any) error {
 	computedValues := map[string]any{}
 	secretValues := map[string]rpv1.SecretValueReference{}
 	outputResources := []rpv1.OutputResource{}
@@ -53,40 +95,79 @@ func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.Dyna
 
 	validator := processors.NewValidator(&computedValues, &secretValues, &outputResources, &status)
 
-	// TODO: loop over schema and add to validator - right now this bypasses validation.
-	for key, value := range options.RecipeOutput.Values {
+	for key, value := range recipeOutput.Values {
 		validator.AddOptionalAnyField(key, &value)
 	}
-	for key, value := range options.RecipeOutput.Secrets {
+	for key, value := range recipeOutput.Secrets {
 		value := value.(string)
 		validator.AddOptionalSecretField(key, &value)
 	}
 
-	err := validator.SetAndValidate(options.RecipeOutput)
+	err := validator.SetAndValidate(recipeOutput)
 	if err != nil {
 		return err
 	}
 
+	// Validate the recipe output against the resource type schema, so that a recipe returning the wrong type for a
+	// read-only property, or not returning a required one, fails the operation instead of being silently dropped.
+	err = schemautil.ValidateRecipeOutput(ctx, schema, recipeOutput.Values, recipeOutput.Secrets)
+	if err != nil {
+		return &processors.ValidationError{Message: fmt.Sprintf("recipe output is not valid for resource type %q: %s", resource.Type, err.Error())}
+	}
+
 	err = resource.ApplyDeploymentOutput(rpv1.DeploymentOutput{DeployedOutputResources: outputResources, ComputedValues: computedValues, SecretValues: secretValues})
 	if err != nil {
 		return err
 	}
 
-	err = addOutputValuestoResourceProperties(ctx, options.UcpClient, resource, computedValues, secretValues)
+	addOutputValuestoResourceProperties(resource, schema, computedValues, secretValues)
+
+	if recipeOutput.Status != nil {
+		resource.SetRecipeStatus(status)
+	}
+
+	conditions, err := recipeConditions(resource.Conditions(), recipeOutput, time.Now().UTC())
 	if err != nil {
-		return err
+		return &processors.ValidationError{Message: fmt.Sprintf("recipe output is not valid for resource type %q: %s", resource.Type, err.Error())}
 	}
 
+	resource.SetConditions(conditions)
+
 	return nil
 }
 
-// addOutputValuestoResourceProperties adds the computed values and secret values to the resource properties.
-// It retrieves the schema of the resource type and filters out the values that are not part of the schema.
-func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resource *datamodel.DynamicResource, computedValues map[string]any, secretValues map[string]rpv1.SecretValueReference) error {
+// recipeConditions merges the conditions reported by the recipe into the existing conditions of the resource. The
+// resource is reported as ready when the recipe does not report a Ready condition, because the recipe completed.
+func recipeConditions(existing []rpv1.Condition, recipeOutput *recipes.RecipeOutput, now time.Time) ([]rpv1.Condition, error) {
+	conditions := slices.Clone(existing)
+	for _, condition := range recipeOutput.Conditions {
+		err := condition.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = rpv1.SetCondition(conditions, condition, now)
+	}
+
+	if rpv1.FindCondition(recipeOutput.Conditions, rpv1.ConditionReady) == nil {
+		ready := rpv1.Condition{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "RecipeSucceeded"}
+		if recipeOutput.Simulated {
+			ready.Reason = "Simulated"
+			ready.Message = "The resource was populated from a simulated recipe output and no resources were deployed."
+		}
+
+		conditions = rpv1.SetCondition(conditions, ready, now)
+	}
 
+	rpv1.SortConditions(conditions)
+	return conditions, nil
+}
+
+// getResourceTypeSchema fetches the schema of the resource type for the api version the resource was last updated with.
+func getResourceTypeSchema(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resource *datamodel.DynamicResource) (map[string]any, error) {
 	ID, err := resources.Parse(resource.ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	plane := ID.PlaneNamespace()
@@ -95,13 +176,22 @@ func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v202310
 	resourceType := strings.Split(resource.Type, "/")[1]
 	apiVersionResource, err := ucpClient.NewAPIVersionsClient().Get(ctx, planeName, resourceProvider, resourceType, resource.InternalMetadata.UpdatedAPIVersion, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	if apiVersionResource.APIVersionResource.Properties == nil {
+		return nil, nil
+	}
+
+	return apiVersionResource.APIVersionResource.Properties.Schema, nil
+}
+
+// addOutputValuestoResourceProperties adds the computed values and secret values to the resource properties.
+// Values that are not part of the resource type schema are filtered out.
+func addOutputValuestoResourceProperties(resource *datamodel.DynamicResource, schema map[string]any, computedValues map[string]any, secretValues map[string]rpv1.SecretValueReference) {
 	// Filter out the basic properties from the resource properties
 	// This is to avoid overwriting the properties like application, environment etc when they are added as computed values or secret values.
 	resourceProps := []string{}
-	schema := apiVersionResource.APIVersionResource.Properties.Schema
 	if schema != nil {
 		if properties, ok := schema["properties"].(map[string]any); ok {
 			for key := range properties {
@@ -125,8 +215,6 @@ func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v202310
 			resource.Properties[key] = value.Value
 		}
 	}
-
-	return nil
 }
 
 // GetSchemaForResourceType fetches the schema for a resource type from UCP
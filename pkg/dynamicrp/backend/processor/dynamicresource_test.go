@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
@@ -31,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
 	"github.com/stretchr/testify/require"
@@ -76,6 +78,11 @@ func Test_Process(t *testing.T) {
 				Secrets: map[string]any{
 					"password": password,
 				},
+				Status: &rpv1.RecipeStatus{
+					TemplateKind:   recipes.TemplateKindBicep,
+					TemplatePath:   "ghcr.io/radius-project/recipes/test:latest",
+					TemplateDigest: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+				},
 			},
 			UcpClient: clientFactory,
 		}
@@ -116,6 +123,19 @@ func Test_Process(t *testing.T) {
 		secretPassword, ok := secrets["password"].(map[string]any)
 		require.True(t, ok)
 		require.Equal(t, options.RecipeOutput.Secrets["password"], secretPassword["Value"])
+
+		recipeStatus, ok := status["recipe"].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, map[string]any{
+			"templateKind":   recipes.TemplateKindBicep,
+			"templatePath":   "ghcr.io/radius-project/recipes/test:latest",
+			"templateDigest": "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		}, recipeStatus)
+
+		ready := rpv1.FindCondition(resource.Conditions(), rpv1.ConditionReady)
+		require.NotNil(t, ready)
+		require.Equal(t, rpv1.ConditionTrue, ready.Status)
+		require.Equal(t, "RecipeSucceeded", ready.Reason)
 	})
 
 	// test to check if the properties like environment, application , status etc are not overwritten if they are provided as part of the recipe output.
@@ -170,6 +190,76 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, application, properties["application"])
 	})
 
+	t.Run("recipe output does not match schema", func(t *testing.T) {
+		apiVersionServer := fake.APIVersionsServer{
+			Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+				response := v20231001preview.APIVersionsClientGetResponse{
+					APIVersionResource: v20231001preview.APIVersionResource{
+						Properties: &v20231001preview.APIVersionProperties{
+							Schema: map[string]any{
+								"properties": map[string]any{
+									"environment": map[string]any{"type": "string"},
+									"host":        map[string]any{"type": "string", "readOnly": true},
+									"port":        map[string]any{"type": "integer", "readOnly": true},
+								},
+								"required": []any{"environment", "host"},
+							},
+						},
+					},
+				}
+
+				resp.SetResponse(http.StatusOK, response, nil)
+				return
+			},
+		}
+
+		typedClientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+					APIVersionsServer: apiVersionServer,
+				}),
+			},
+		})
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testRecipeResources/test-resource",
+					Type: "Applications.Test/testRecipeResources",
+				},
+				InternalMetadata: v1.InternalMetadata{
+					UpdatedAPIVersion: "2024-01-01",
+				},
+			},
+			Properties: map[string]any{
+				"environment": environment,
+				"status":      map[string]any{},
+			},
+		}
+		options := processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{
+				Values: map[string]any{
+					"port": "not-a-port",
+				},
+			},
+			UcpClient: typedClientFactory,
+		}
+
+		err = processor.Process(context.Background(), resource, options)
+		require.Error(t, err)
+
+		validationErr, ok := err.(*processors.ValidationError)
+		require.True(t, ok)
+		require.Contains(t, validationErr.Message, "recipe output is not valid for resource type \"Applications.Test/testRecipeResources\"")
+		require.Contains(t, validationErr.Message, "required read-only property is not returned by the recipe")
+		require.Contains(t, validationErr.Message, "SchemaError error at \"port\": recipe output does not match the schema")
+
+		// The resource is not updated with invalid outputs.
+		_, ok = resource.Properties["port"]
+		require.False(t, ok)
+	})
+
 	t.Run("invalid resource id", func(t *testing.T) {
 		resource := &datamodel.DynamicResource{}
 		options := processors.Options{
@@ -198,6 +288,146 @@ func Test_Process(t *testing.T) {
 	})
 }
 
+func Test_ProcessSimulated(t *testing.T) {
+	processor := DynamicProcessor{}
+
+	apiVersionServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: map[string]any{
+							"properties": map[string]any{
+								"environment": map[string]any{"type": "string"},
+								"host":        map[string]any{"type": "string", "format": "hostname", "readOnly": true},
+								"port":        map[string]any{"type": "integer", "readOnly": true, "default": 6379},
+								"password":    map[string]any{"type": "string", "readOnly": true, "x-radius-sensitive": true},
+							},
+							"required": []any{"environment", "host"},
+						},
+					},
+				},
+			}
+
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: apiVersionServer,
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testRecipeResources/test-resource",
+				Type: "Applications.Test/testRecipeResources",
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: "2024-01-01",
+			},
+		},
+		Properties: map[string]any{
+			"environment": "test-environment",
+			"status":      map[string]any{},
+		},
+	}
+	options := processors.Options{
+		RecipeOutput: &recipes.RecipeOutput{
+			Values: map[string]any{
+				"host": "simulated-host",
+			},
+			Secrets:   map[string]any{},
+			Simulated: true,
+		},
+		UcpClient: clientFactory,
+	}
+
+	err = processor.ProcessSimulated(context.Background(), resource, options)
+	require.NoError(t, err)
+
+	bs, err := json.Marshal(resource.Properties)
+	require.NoError(t, err)
+
+	properties := map[string]any{}
+	err = json.Unmarshal(bs, &properties)
+	require.NoError(t, err)
+
+	// The declared output is kept, and missing read-only properties are synthesized from the schema.
+	require.Equal(t, "simulated-host", properties["host"])
+	require.Equal(t, float64(6379), properties["port"])
+	require.Equal(t, "test-environment", properties["environment"])
+
+	status, ok := properties["status"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, true, status["simulated"])
+
+	secrets, ok := status["secrets"].(map[string]any)
+	require.True(t, ok)
+	secretPassword, ok := secrets["password"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "simulated-password", secretPassword["Value"])
+
+	ready := rpv1.FindCondition(resource.Conditions(), rpv1.ConditionReady)
+	require.NotNil(t, ready)
+	require.Equal(t, rpv1.ConditionTrue, ready.Status)
+	require.Equal(t, "Simulated", ready.Reason)
+}
+
+func Test_RecipeConditions(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Hour)
+
+	t.Run("recipe reports conditions", func(t *testing.T) {
+		existing := []rpv1.Condition{
+			{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "RecipeSucceeded", LastTransitionTime: earlier},
+		}
+		recipeOutput := &recipes.RecipeOutput{
+			Conditions: []rpv1.Condition{
+				{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionTrue, Reason: "FailingOver", Message: "the primary replica is failing over"},
+				{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "FailingOver"},
+			},
+		}
+
+		conditions, err := recipeConditions(existing, recipeOutput, now)
+		require.NoError(t, err)
+		require.Equal(t, []rpv1.Condition{
+			{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "FailingOver", LastTransitionTime: now},
+			{Type: rpv1.ConditionDegraded, Status: rpv1.ConditionTrue, Reason: "FailingOver", Message: "the primary replica is failing over", LastTransitionTime: now},
+		}, conditions)
+
+		// The existing conditions are not modified.
+		require.Equal(t, rpv1.ConditionTrue, existing[0].Status)
+	})
+
+	t.Run("ready by default", func(t *testing.T) {
+		existing := []rpv1.Condition{
+			{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "RecipeSucceeded", LastTransitionTime: earlier},
+		}
+
+		conditions, err := recipeConditions(existing, &recipes.RecipeOutput{}, now)
+		require.NoError(t, err)
+		require.Equal(t, []rpv1.Condition{
+			{Type: rpv1.ConditionReady, Status: rpv1.ConditionTrue, Reason: "RecipeSucceeded", LastTransitionTime: earlier},
+		}, conditions)
+	})
+
+	t.Run("invalid condition", func(t *testing.T) {
+		recipeOutput := &recipes.RecipeOutput{
+			Conditions: []rpv1.Condition{{Type: rpv1.ConditionReady, Status: "Maybe"}},
+		}
+
+		_, err := recipeConditions(nil, recipeOutput, now)
+		require.EqualError(t, err, `condition "Ready" has invalid status "Maybe", must be one of "True", "False" or "Unknown"`)
+	})
+}
+
 func testUCPClientFactory() (*v20231001preview.ClientFactory, error) {
 	apiVersionServer := fake.APIVersionsServer{
 		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 )
 
 // Service runs the backend for the dynamic-rp.
@@ -83,6 +84,19 @@ func (w *Service) Run(ctx context.Context) error {
 	w.Service.QueueClient = queueClient
 	w.Service.OperationStatusManager = w.options.StatusManager
 
+	if w.options.Config.Audit.Enabled {
+		chain, err := audit.LoadChain(w.options.Config.Audit.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to configure audit: %w", err)
+		}
+
+		recorder, err := audit.NewRecorder(w.options.Config.Audit, chain, databaseClient)
+		if err != nil {
+			return fmt.Errorf("failed to configure audit: %w", err)
+		}
+		w.Service.Options.OutcomeRecorder = audit.NewOutcomeRecorder(recorder)
+	}
+
 	err = w.registerControllers()
 	if err != nil {
 		return err
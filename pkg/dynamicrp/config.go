@@ -36,6 +36,9 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Audit is the configuration for the audit log of async operations.
+	Audit hostoptions.AuditOptions `yaml:"audit"`
+
 	// Bicep configures properties for the Bicep recipe driver.
 	Bicep hostoptions.BicepOptions `yaml:"bicep"`
 
@@ -45,6 +48,10 @@ type Config struct {
 	// Environment is the configuration for the hosting environment.
 	Environment hostoptions.EnvironmentOptions `yaml:"environment"`
 
+	// Health is the configuration for refreshing the conditions of dynamic resources from the live health of their
+	// output resources.
+	Health HealthOptions `yaml:"health"`
+
 	// Kubernetes is the configuration for the Kubernetes client.
 	Kubernetes kubernetesclientprovider.Options `yaml:"kubernetes"`
 
@@ -79,6 +86,19 @@ type Config struct {
 	Worker hostoptions.WorkerServerOptions `yaml:"workerServer"`
 }
 
+// HealthOptions is the configuration for refreshing the conditions of dynamic resources.
+type HealthOptions struct {
+	// Enabled determines whether the conditions of dynamic resources are refreshed periodically.
+	Enabled bool `yaml:"enabled"`
+
+	// RefreshIntervalSeconds is the interval between refreshes in seconds. Defaults to 60 seconds.
+	RefreshIntervalSeconds int `yaml:"refreshIntervalSeconds,omitempty"`
+
+	// LeaseNamespace is the namespace of the lease used to elect the single replica that refreshes the conditions.
+	// When empty, every replica refreshes the conditions.
+	LeaseNamespace string `yaml:"leaseNamespace,omitempty"`
+}
+
 // LoadConfig loads a Config from bytes.
 func LoadConfig(bs []byte) (*Config, error) {
 	decoder := yaml.NewDecoder(bytes.NewBuffer(bs))
@@ -17,11 +17,13 @@ limitations under the License.
 package datamodel
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"maps"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -166,6 +168,227 @@ func (d *DynamicResource) ApplyDeploymentOutput(deploymentOutput rpv1.Deployment
 	return nil
 }
 
+// SetRecipeStatus stores the status of the recipe that deployed the resource under ".properties.status.recipe".
+func (d *DynamicResource) SetRecipeStatus(recipeStatus rpv1.RecipeStatus) {
+	// Store the JSON representation of the status, so that it is the same whether it was read from the database or not.
+	recipe := map[string]any{}
+	for key, value := range map[string]string{
+		"templateKind":    recipeStatus.TemplateKind,
+		"templatePath":    recipeStatus.TemplatePath,
+		"templateVersion": recipeStatus.TemplateVersion,
+		"templateDigest":  recipeStatus.TemplateDigest,
+	} {
+		if value != "" {
+			recipe[key] = value
+		}
+	}
+
+	d.Status()["recipe"] = recipe
+}
+
+// SetSimulated marks the resource as populated from a simulated recipe output under ".properties.status.simulated".
+// No resources were deployed for the resource, and the values set from the recipe output are synthesized.
+func (d *DynamicResource) SetSimulated() {
+	d.Status()["simulated"] = true
+}
+
+// Conditions returns the conditions stored under ".properties.status.conditions".
+func (d *DynamicResource) Conditions() []rpv1.Condition {
+	return d.ResourceMetadata().GetResourceStatus().Conditions
+}
+
+// SetConditions stores the conditions under ".properties.status.conditions", replacing the existing conditions.
+func (d *DynamicResource) SetConditions(conditions []rpv1.Condition) {
+	if len(conditions) == 0 {
+		delete(d.Status(), "conditions")
+		return
+	}
+
+	// Store the JSON representation of the conditions, so that it is the same whether it was read from the database or not.
+	bs, err := json.Marshal(conditions)
+	if err != nil {
+		panic("failed to marshal conditions: " + err.Error())
+	}
+
+	store := []any{}
+	err = json.Unmarshal(bs, &store)
+	if err != nil {
+		panic("failed to unmarshal conditions: " + err.Error())
+	}
+
+	d.Status()["conditions"] = store
+}
+
+// ActionStatus is the status of the last invocation of an action on a dynamic resource. It is stored under
+// ".properties.status.actions.<action name>".
+type ActionStatus struct {
+	// OperationID is the ID of the async operation invoking the action.
+	OperationID string `json:"operationId"`
+
+	// State is the provisioning state of the invocation.
+	State v1.ProvisioningState `json:"state"`
+
+	// Input is the encrypted input the action was invoked with. The input can hold credentials, so it's only stored
+	// until the invocation completes, and it's never returned by the API.
+	Input map[string]any `json:"input,omitempty"`
+
+	// Output is the output of the action. It is set when the invocation succeeds.
+	Output map[string]any `json:"output,omitempty"`
+
+	// Error is the error of the invocation. It is set when the invocation fails.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+
+	// Recipe is the recipe the action was last run with. It is set when the action is implemented by a recipe, so
+	// that the resources deployed by the recipe are deleted with the resource.
+	Recipe *ActionRecipeStatus `json:"recipe,omitempty"`
+}
+
+// ActionRecipeStatus is the recipe an action was run with and the resources deployed by the recipe.
+type ActionRecipeStatus struct {
+	// TemplateKind is the kind of the recipe template.
+	TemplateKind string `json:"templateKind"`
+
+	// TemplatePath is the path of the recipe template.
+	TemplatePath string `json:"templatePath"`
+
+	// TemplateVersion is the version of the recipe template, for Terraform recipes.
+	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// OutputResources is the resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource `json:"outputResources,omitempty"`
+}
+
+// actionInputField is the field of the action status the input is stored in, and the field path bound to the
+// encrypted input.
+const actionInputField = "input"
+
+// SetInput encrypts the input of the action and stores it in the status. The encrypted input is bound to the resource
+// ID and the action name, so it can't be moved to another resource or action.
+func (s *ActionStatus) SetInput(handler *encryption.SensitiveDataHandler, resourceID string, actionName string, input map[string]any) error {
+	if len(input) == 0 {
+		s.Input = nil
+		return nil
+	}
+
+	data := map[string]any{actionInputField: input}
+	if err := handler.EncryptSensitiveFields(data, []string{actionInputField}, actionInputResourceID(resourceID, actionName)); err != nil {
+		return err
+	}
+
+	encrypted, ok := data[actionInputField].(map[string]any)
+	if !ok {
+		return fmt.Errorf("encrypted action input has unexpected type %T", data[actionInputField])
+	}
+
+	s.Input = encrypted
+	return nil
+}
+
+// GetInput decrypts the input of the action stored in the status with SetInput.
+func (s *ActionStatus) GetInput(ctx context.Context, handler *encryption.SensitiveDataHandler, resourceID string, actionName string) (map[string]any, error) {
+	if len(s.Input) == 0 {
+		return nil, nil
+	}
+
+	data := map[string]any{actionInputField: maps.Clone(s.Input)}
+	if err := handler.DecryptSensitiveFields(ctx, data, []string{actionInputField}, actionInputResourceID(resourceID, actionName)); err != nil {
+		return nil, err
+	}
+
+	input, ok := data[actionInputField].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("decrypted action input has unexpected type %T", data[actionInputField])
+	}
+
+	return input, nil
+}
+
+// actionInputResourceID returns the ID the encrypted input of an action is bound to.
+func actionInputResourceID(resourceID string, actionName string) string {
+	return resourceID + "/actions/" + actionName
+}
+
+// SetActionStatus stores the status of the last invocation of an action under ".properties.status.actions".
+func (d *DynamicResource) SetActionStatus(actionName string, actionStatus ActionStatus) {
+	// Store the JSON representation of the status, so that it is the same whether it was read from the database or not.
+	bs, err := json.Marshal(actionStatus)
+	if err != nil {
+		panic("failed to marshal action status: " + err.Error())
+	}
+
+	store := map[string]any{}
+	err = json.Unmarshal(bs, &store)
+	if err != nil {
+		panic("failed to unmarshal action status: " + err.Error())
+	}
+
+	status := d.Status()
+	actions, ok := status["actions"].(map[string]any)
+	if !ok {
+		actions = map[string]any{}
+		status["actions"] = actions
+	}
+
+	actions[actionName] = store
+}
+
+// FindActionStatus returns the name and the status of the action invoked by the given async operation. Returns false
+// if no action was invoked by the operation.
+func (d *DynamicResource) FindActionStatus(operationID string) (string, *ActionStatus, bool) {
+	for name, status := range d.ActionStatuses() {
+		if status.OperationID == operationID {
+			return name, status, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// ActionStatuses returns the status of the last invocation of each action invoked on the resource, keyed by action name.
+func (d *DynamicResource) ActionStatuses() map[string]*ActionStatus {
+	actions, ok := d.Status()["actions"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	result := map[string]*ActionStatus{}
+	for name, obj := range actions {
+		// This is the best we can do. We require all of the data we store to be JSON-marshallable.
+		bs, err := json.Marshal(obj)
+		if err != nil {
+			continue
+		}
+
+		status := &ActionStatus{}
+		if err := json.Unmarshal(bs, status); err != nil {
+			continue
+		}
+
+		result[name] = status
+	}
+
+	return result
+}
+
+// RedactActionInputs removes the inputs of the actions invoked on the resource from "properties.status.actions".
+func RedactActionInputs(properties map[string]any) {
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	actions, ok := status["actions"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, obj := range actions {
+		if store, ok := obj.(map[string]any); ok {
+			delete(store, "input")
+		}
+	}
+}
+
 // OutputResources implements v1.RadiusResourceModel.
 func (d *DynamicResource) OutputResources() []rpv1.OutputResource {
 	return d.ResourceMetadata().GetResourceStatus().OutputResources
@@ -18,7 +18,10 @@ package datamodel
 
 import (
 	"testing"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/stretchr/testify/require"
@@ -136,6 +139,33 @@ func Test_DynamicResource_GetRecipe(t *testing.T) {
 				DeploymentStatus: "Succeeded",
 			},
 		},
+		{
+			name: "recipe with imported resources returns recipe",
+			resource: DynamicResource{
+				Properties: map[string]any{
+					"recipe": map[string]any{
+						"name": "test-recipe",
+						"import": []any{
+							map[string]any{
+								"id":       "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders",
+								"address":  "aws_db_instance.db",
+								"importId": "orders",
+							},
+						},
+					},
+				},
+			},
+			want: &portableresources.ResourceRecipe{
+				Name: "test-recipe",
+				Import: []portableresources.ResourceImport{
+					{
+						ID:       "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders",
+						Address:  "aws_db_instance.db",
+						ImportID: "orders",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -497,3 +527,138 @@ func Test_DynamicResource_GetSecrets(t *testing.T) {
 		})
 	}
 }
+
+func Test_DynamicResource_ActionStatus(t *testing.T) {
+	t.Run("set and find", func(t *testing.T) {
+		resource := &DynamicResource{}
+		resource.SetActionStatus("backup", ActionStatus{
+			OperationID: "op-1",
+			State:       v1.ProvisioningStateAccepted,
+			Input:       map[string]any{"retentionDays": float64(7)},
+		})
+
+		require.Equal(t, map[string]any{
+			"actions": map[string]any{
+				"backup": map[string]any{
+					"operationId": "op-1",
+					"state":       "Accepted",
+					"input":       map[string]any{"retentionDays": float64(7)},
+				},
+			},
+		}, resource.Properties["status"])
+
+		name, status, ok := resource.FindActionStatus("op-1")
+		require.True(t, ok)
+		require.Equal(t, "backup", name)
+		require.Equal(t, &ActionStatus{
+			OperationID: "op-1",
+			State:       v1.ProvisioningStateAccepted,
+			Input:       map[string]any{"retentionDays": float64(7)},
+		}, status)
+	})
+
+	t.Run("overwrites the previous invocation", func(t *testing.T) {
+		resource := &DynamicResource{}
+		resource.SetActionStatus("backup", ActionStatus{OperationID: "op-1", State: v1.ProvisioningStateSucceeded})
+		resource.SetActionStatus("restart", ActionStatus{OperationID: "op-2", State: v1.ProvisioningStateSucceeded})
+		resource.SetActionStatus("backup", ActionStatus{OperationID: "op-3", State: v1.ProvisioningStateAccepted})
+
+		_, _, ok := resource.FindActionStatus("op-1")
+		require.False(t, ok)
+
+		name, _, ok := resource.FindActionStatus("op-2")
+		require.True(t, ok)
+		require.Equal(t, "restart", name)
+
+		name, _, ok = resource.FindActionStatus("op-3")
+		require.True(t, ok)
+		require.Equal(t, "backup", name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resource := &DynamicResource{}
+		_, _, ok := resource.FindActionStatus("op-1")
+		require.False(t, ok)
+	})
+
+	t.Run("redact inputs", func(t *testing.T) {
+		resource := &DynamicResource{}
+		resource.SetActionStatus("backup", ActionStatus{
+			OperationID: "op-1",
+			State:       v1.ProvisioningStateAccepted,
+			Input:       map[string]any{"password": "secret"},
+		})
+
+		RedactActionInputs(resource.Properties)
+
+		require.Equal(t, map[string]*ActionStatus{
+			"backup": {OperationID: "op-1", State: v1.ProvisioningStateAccepted},
+		}, resource.ActionStatuses())
+	})
+}
+
+func Test_ActionStatus_Input(t *testing.T) {
+	key, err := encryption.GenerateKey()
+	require.NoError(t, err)
+	handler, err := encryption.NewSensitiveDataHandlerFromKey(key)
+	require.NoError(t, err)
+
+	resourceID := "/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/testResource"
+	input := map[string]any{"password": "secret", "retentionDays": float64(7)}
+
+	t.Run("round trip", func(t *testing.T) {
+		status := ActionStatus{}
+		require.NoError(t, status.SetInput(handler, resourceID, "backup", input))
+		require.NotContains(t, status.Input, "password")
+
+		decrypted, err := status.GetInput(t.Context(), handler, resourceID, "backup")
+		require.NoError(t, err)
+		require.Equal(t, input, decrypted)
+	})
+
+	t.Run("bound to the action", func(t *testing.T) {
+		status := ActionStatus{}
+		require.NoError(t, status.SetInput(handler, resourceID, "backup", input))
+
+		_, err := status.GetInput(t.Context(), handler, resourceID, "restore")
+		require.Error(t, err)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		status := ActionStatus{}
+		require.NoError(t, status.SetInput(handler, resourceID, "backup", nil))
+		require.Nil(t, status.Input)
+
+		decrypted, err := status.GetInput(t.Context(), handler, resourceID, "backup")
+		require.NoError(t, err)
+		require.Nil(t, decrypted)
+	})
+}
+
+func Test_DynamicResource_Conditions(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resource := &DynamicResource{}
+	require.Empty(t, resource.Conditions())
+
+	resource.SetConditions([]rpv1.Condition{
+		{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "FailingOver", LastTransitionTime: now},
+	})
+	require.Equal(t, map[string]any{
+		"conditions": []any{
+			map[string]any{
+				"type":               "Ready",
+				"status":             "False",
+				"reason":             "FailingOver",
+				"lastTransitionTime": "2024-01-01T00:00:00Z",
+			},
+		},
+	}, resource.Properties["status"])
+
+	require.Equal(t, []rpv1.Condition{
+		{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "FailingOver", LastTransitionTime: now},
+	}, resource.Conditions())
+
+	resource.SetConditions(nil)
+	require.Equal(t, map[string]any{}, resource.Properties["status"])
+}
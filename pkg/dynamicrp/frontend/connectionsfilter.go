@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// makeConnectionsFilter creates a schemaFilter that validates the connections constrained by the
+// x-radius-connection-types extension of the resource type schema: the connections must target resources of the
+// allowed resource types, and the targets in Radius planes must exist.
+//
+// The targets are read through UCP rather than from the database, so that the access checks of UCP apply. Targets in
+// other planes, like AWS or Azure resources, are not read.
+func makeConnectionsFilter(ucpClient *v20231001preview.ClientFactory, connection sdk.Connection) schemaFilter {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+		schemaData map[string]any,
+	) (rest.Response, error) {
+		connectionTypes := schema.ExtractConnectionTypes(schemaData)
+		if connectionTypes == nil {
+			return nil, nil
+		}
+
+		targets, err := schema.ValidateConnections(newResource.Properties, connectionTypes)
+		if err != nil {
+			validationErrs := &schema.ValidationErrors{}
+			if !errors.As(err, &validationErrs) {
+				return nil, err
+			}
+
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Message: fmt.Sprintf("Invalid connections: %v", err),
+				},
+			}), nil
+		}
+
+		for _, target := range targets {
+			if !resources_radius.IsRadiusResource(target.ID) {
+				continue
+			}
+
+			err := getConnectionTarget(ctx, ucpClient, connection, target.ID)
+			if clientv2.Is404Error(err) {
+				return newConnectionTargetResponse(target, "does not exist"), nil
+			} else if isForbiddenError(err) {
+				return newConnectionTargetResponse(target, "cannot be read"), nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	}
+}
+
+// getConnectionTarget reads the target of a connection through UCP, using the default API version of its resource
+// type.
+func getConnectionTarget(ctx context.Context, ucpClient *v20231001preview.ClientFactory, connection sdk.Connection, id resources.ID) error {
+	planeName := strings.Split(id.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, _ := strings.Cut(id.Type(), "/")
+
+	resourceType, err := ucpClient.NewResourceTypesClient().Get(ctx, planeName, resourceProvider, resourceTypeName, nil)
+	if err != nil {
+		return err
+	}
+	if resourceType.Properties == nil || to.String(resourceType.Properties.DefaultAPIVersion) == "" {
+		return fmt.Errorf("resource type %q has no default API version", id.Type())
+	}
+
+	clientOptions := sdk.NewClientOptions(connection)
+	clientOptions.APIVersion = *resourceType.Properties.DefaultAPIVersion
+
+	client, err := generated.NewGenericResourcesClient(id.Type(), id.RootScope(), &aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Get(ctx, id.Name(), nil)
+	return err
+}
+
+// isForbiddenError returns true if the error is a 403 response from UCP.
+func isForbiddenError(err error) bool {
+	respErr, ok := clientv2.ExtractResponseError(err)
+	return ok && respErr.StatusCode == http.StatusForbidden
+}
+
+// newConnectionTargetResponse creates the response rejecting a connection whose target does not exist or cannot be
+// read.
+func newConnectionTargetResponse(target schema.ConnectionTarget, reason string) rest.Response {
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: fmt.Sprintf("Invalid connections: connection %q targets resource %q, which %s", target.Name, target.ID.String(), reason),
+			Target:  "properties.connections." + target.Name + ".source",
+		},
+	})
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeConnectionsFilter(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithConnections(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"connections": map[string]any{
+				"type": "object",
+				"x-radius-connection-types": map[string]any{
+					"database": []any{"Radius.Data/postgreSqlDatabases"},
+				},
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"source": map[string]any{"type": "string"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	databaseID := "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/postgreSqlDatabases/db"
+	forbiddenID := "/planes/radius/local/resourceGroups/other-group/providers/Radius.Data/postgreSqlDatabases/db"
+	cacheID := "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/redisCaches/cache"
+
+	// The targets are read through UCP, which rejects the reads it does not authorize.
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path)
+		require.Equal(t, testHubAPIVersion, r.URL.Query().Get("api-version"))
+
+		switch {
+		case strings.EqualFold(r.URL.Path, databaseID):
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "` + databaseID + `"}`))
+		case strings.EqualFold(r.URL.Path, forbiddenID):
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": {"code": "Forbidden", "message": "forbidden"}}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": "NotFound", "message": "not found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeConnectionsFilter(ucpClient, connection))
+	options := &controller.Options{}
+
+	newResource := func(connections map[string]any) *datamodel.DynamicResource {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"environment": "env", "connections": connections}
+		return resource
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		resource := newResource(map[string]any{
+			"database": map[string]any{"source": databaseID},
+			"frontend": map[string]any{"source": "http://example.com"},
+		})
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		resource := newResource(map[string]any{
+			"database": map[string]any{"source": cacheID},
+		})
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Contains(t, response.(*rest.BadRequestResponse).Body.Error.Message, `connection "database" targets a resource of type "Radius.Data/redisCaches", expected Radius.Data/postgreSqlDatabases`)
+	})
+
+	t.Run("target does not exist", func(t *testing.T) {
+		missingID := "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/postgreSqlDatabases/missing"
+		resource := newResource(map[string]any{
+			"database": map[string]any{"source": missingID},
+		})
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, `Invalid connections: connection "database" targets resource "`+missingID+`", which does not exist`, body.Error.Message)
+		require.Equal(t, "properties.connections.database.source", body.Error.Target)
+	})
+
+	t.Run("target cannot be read", func(t *testing.T) {
+		resource := newResource(map[string]any{
+			"database": map[string]any{"source": forbiddenID},
+		})
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, `Invalid connections: connection "database" targets resource "`+forbiddenID+`", which cannot be read`, body.Error.Message)
+	})
+
+	t.Run("target outside of Radius planes", func(t *testing.T) {
+		awsID := "/planes/aws/aws/accounts/000/regions/us-west-2/providers/Radius.Data/postgreSqlDatabases/db"
+		resource := newResource(map[string]any{
+			"database": map[string]any{"source": awsID},
+		})
+
+		requests = []string{}
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Empty(t, requests)
+	})
+}
+
+func TestMakeConnectionsFilter_Unconstrained(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"connections": map[string]any{
+				"type":                 "object",
+				"additionalProperties": map[string]any{"type": "object"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeConnectionsFilter(ucpClient, nil))
+
+	resource := &datamodel.DynamicResource{}
+	resource.Properties = map[string]any{
+		"connections": map[string]any{"database": map[string]any{"source": "not-a-resource-id"}},
+	}
+
+	// UCP is not called when no connections are constrained.
+	response, err := filter(createTestContext(), resource, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.Nil(t, response)
+}
+
+func TestMakeConnectionsFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeConnectionsFilter(ucpClient, nil))
+
+	resource := &datamodel.DynamicResource{}
+	resource.Properties = map[string]any{}
+
+	response, err := filter(createTestContext(), resource, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
+
+func testUCPClientFactoryWithConnections(schema map[string]any) (*v20231001preview.ClientFactory, error) {
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceTypesServer: fake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{
+									DefaultAPIVersion: to.Ptr(testHubAPIVersion),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+				APIVersionsServer: fake.APIVersionsServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+							APIVersionResource: v20231001preview.APIVersionResource{
+								Properties: &v20231001preview.APIVersionProperties{Schema: schema},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// makeConversionFilter creates an UpdateFilter that converts the resource's Properties map from the requested API
+// version to the hub API version of the resource type before saving to the database.
+//
+// The hub API version is the default API version of the resource type. Only API versions that declare conversion
+// rules are converted: resources of other API versions are stored in the requested API version. The fields that are
+// only defined in the hub API version are kept from the stored resource.
+//
+// The filter must run before the encryption filter, so that sensitive fields are encrypted using the schema of the
+// API version the resource is stored in.
+func makeConversionFilter(ucpClient *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return convertToHubVersion(ctx, newResource, oldResource, ucpClient)
+	}
+}
+
+// convertToHubVersion converts the resource to the hub API version of the resource type.
+func convertToHubVersion(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := newResource.InternalMetadata.UpdatedAPIVersion
+
+	hubVersion, conversion, err := schema.GetConversion(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch conversion rules",
+			"resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch conversion rules for the API version",
+			},
+		}), nil
+	}
+
+	// No conversion rules: the resource is stored in the requested API version.
+	if conversion == nil {
+		return nil, nil
+	}
+
+	// The stored resource is in the hub API version unless it was written before the conversion rules were declared.
+	var stored map[string]any
+	if oldResource != nil && strings.EqualFold(oldResource.InternalMetadata.UpdatedAPIVersion, hubVersion) {
+		stored = oldResource.Properties
+	}
+
+	if err := schema.ConvertToHubVersion(newResource.Properties, stored, conversion); err != nil {
+		return nil, err
+	}
+	newResource.InternalMetadata.UpdatedAPIVersion = hubVersion
+
+	logger.V(ucplog.LevelDebug).Info("Converted resource to the hub API version",
+		"resourceType", resourceType, "apiVersion", apiVersion, "hubVersion", hubVersion)
+
+	return nil, nil
+}
+
+// makeResponseConversionFilter creates a ResponseFilter that converts the saved resource's Properties map from the hub
+// API version of the resource type back to the requested API version, so that PUT returns the resource in the API
+// version it was sent in.
+func makeResponseConversionFilter(ucpClient *v20231001preview.ClientFactory) controller.ResponseFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, resource *datamodel.DynamicResource, options *controller.Options) error {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		return newHubVersionConverter(ucpClient).Convert(ctx, resource, serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)
+	}
+}
+
+// hubVersionConverter converts resources stored in the hub API version of a resource type to the requested API
+// version. The conversion rules of the requested API version are fetched once, the first time a resource needs to be
+// converted.
+type hubVersionConverter struct {
+	ucpClient *v20231001preview.ClientFactory
+
+	loaded     bool
+	hubVersion string
+	conversion *v20231001preview.APIVersionConversion
+}
+
+// newHubVersionConverter creates a new hubVersionConverter.
+func newHubVersionConverter(ucpClient *v20231001preview.ClientFactory) *hubVersionConverter {
+	return &hubVersionConverter{ucpClient: ucpClient}
+}
+
+// Convert converts the resource's Properties map to the requested API version. Resources stored in the requested API
+// version, and resources not stored in the hub API version, are left unchanged.
+func (c *hubVersionConverter) Convert(ctx context.Context, resource *datamodel.DynamicResource, resourceType string, apiVersion string) error {
+	if resource.Properties == nil || strings.EqualFold(resource.InternalMetadata.UpdatedAPIVersion, apiVersion) {
+		return nil
+	}
+
+	if !c.loaded {
+		hubVersion, conversion, err := schema.GetConversion(ctx, c.ucpClient, resource.ID, resourceType, apiVersion)
+		if err != nil {
+			return err
+		}
+		c.hubVersion = hubVersion
+		c.conversion = conversion
+		c.loaded = true
+	}
+
+	if c.conversion == nil || !strings.EqualFold(resource.InternalMetadata.UpdatedAPIVersion, c.hubVersion) {
+		return nil
+	}
+
+	return schema.ConvertFromHubVersion(resource.Properties, c.conversion)
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHubAPIVersion     = "2025-01-01-preview"
+	testConvertAPIVersion = "2024-01-01-preview"
+)
+
+func TestMakeConversionFilter_ConvertsToHubVersion(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithConversion()
+	require.NoError(t, err)
+
+	filter := makeConversionFilter(ucpClient)
+
+	resource := &datamodel.DynamicResource{}
+	resource.InternalMetadata.UpdatedAPIVersion = testConvertAPIVersion
+	resource.Properties = map[string]any{
+		"size": "large",
+	}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Equal(t, testHubAPIVersion, resource.InternalMetadata.UpdatedAPIVersion)
+	require.Equal(t, map[string]any{
+		"sku":      map[string]any{"size": "large"},
+		"replicas": float64(1),
+	}, resource.Properties)
+}
+
+func TestMakeConversionFilter_KeepsStoredHubOnlyFields(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithConversion()
+	require.NoError(t, err)
+
+	filter := makeConversionFilter(ucpClient)
+
+	oldResource := &datamodel.DynamicResource{}
+	oldResource.InternalMetadata.UpdatedAPIVersion = testHubAPIVersion
+	oldResource.Properties = map[string]any{
+		"sku":      map[string]any{"size": "small"},
+		"replicas": float64(3),
+	}
+
+	resource := &datamodel.DynamicResource{}
+	resource.InternalMetadata.UpdatedAPIVersion = testConvertAPIVersion
+	resource.Properties = map[string]any{
+		"size": "large",
+	}
+
+	response, err := filter(createTestContext(), resource, oldResource, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Equal(t, map[string]any{
+		"sku":      map[string]any{"size": "large"},
+		"replicas": float64(3),
+	}, resource.Properties)
+}
+
+func TestMakeConversionFilter_NoConversion(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithConversion()
+	require.NoError(t, err)
+
+	filter := makeConversionFilter(ucpClient)
+
+	resource := &datamodel.DynamicResource{}
+	resource.InternalMetadata.UpdatedAPIVersion = testHubAPIVersion
+	resource.Properties = map[string]any{
+		"sku": map[string]any{"size": "large"},
+	}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Equal(t, testHubAPIVersion, resource.InternalMetadata.UpdatedAPIVersion)
+	require.Equal(t, map[string]any{
+		"sku": map[string]any{"size": "large"},
+	}, resource.Properties)
+}
+
+func TestMakeConversionFilter_FetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeConversionFilter(ucpClient)
+
+	resource := &datamodel.DynamicResource{}
+	resource.InternalMetadata.UpdatedAPIVersion = testConvertAPIVersion
+	resource.Properties = map[string]any{"size": "large"}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
+
+func TestMakeResponseConversionFilter(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithConversion()
+	require.NoError(t, err)
+
+	filter := makeResponseConversionFilter(ucpClient)
+
+	resource := &datamodel.DynamicResource{}
+	resource.ID = testResourceID
+	resource.InternalMetadata.UpdatedAPIVersion = testHubAPIVersion
+	resource.Properties = map[string]any{
+		"sku":      map[string]any{"size": "large"},
+		"replicas": float64(1),
+	}
+
+	ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+		ResourceID: mustParseResourceID(testResourceID),
+		APIVersion: testConvertAPIVersion,
+	})
+	err = filter(ctx, resource, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"size": "large"}, resource.Properties)
+}
+
+func TestHubVersionConverter_Convert(t *testing.T) {
+	newHubResource := func() *datamodel.DynamicResource {
+		resource := &datamodel.DynamicResource{}
+		resource.InternalMetadata.UpdatedAPIVersion = testHubAPIVersion
+		resource.ID = testResourceID
+		resource.Properties = map[string]any{
+			"sku":      map[string]any{"size": "large"},
+			"replicas": float64(1),
+		}
+		return resource
+	}
+
+	t.Run("converts from hub version", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithConversion()
+		require.NoError(t, err)
+
+		resource := newHubResource()
+		err = newHubVersionConverter(ucpClient).Convert(context.Background(), resource, "Applications.Test/testResources", testConvertAPIVersion)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"size": "large"}, resource.Properties)
+	})
+
+	t.Run("requested version is stored version", func(t *testing.T) {
+		// A nil client would return no conversion rules, so this also checks that nothing is fetched.
+		resource := newHubResource()
+		err := newHubVersionConverter(nil).Convert(context.Background(), resource, "Applications.Test/testResources", testHubAPIVersion)
+		require.NoError(t, err)
+		require.Equal(t, newHubResource().Properties, resource.Properties)
+	})
+
+	t.Run("resource not stored in hub version", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithConversion()
+		require.NoError(t, err)
+
+		resource := newHubResource()
+		resource.InternalMetadata.UpdatedAPIVersion = "2023-01-01-preview"
+		err = newHubVersionConverter(ucpClient).Convert(context.Background(), resource, "Applications.Test/testResources", testConvertAPIVersion)
+		require.NoError(t, err)
+		require.Equal(t, newHubResource().Properties, resource.Properties)
+	})
+}
+
+func testUCPClientFactoryWithConversion() (*v20231001preview.ClientFactory, error) {
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceTypesServer: fake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{
+									DefaultAPIVersion: to.Ptr(testHubAPIVersion),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+				APIVersionsServer: fake.APIVersionsServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+						properties := &v20231001preview.APIVersionProperties{}
+						if apiVersionName == testConvertAPIVersion {
+							properties.Conversion = &v20231001preview.APIVersionConversion{
+								Renames: []*v20231001preview.FieldRename{
+									{From: to.Ptr("size"), To: to.Ptr("sku.size")},
+								},
+								Defaults: map[string]any{"replicas": float64(1)},
+							}
+						}
+						resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+							APIVersionResource: v20231001preview.APIVersionResource{Properties: properties},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// defaultsFilter is a schemaFilter that sets the default values declared in the resource type schema on the
+// resource's Properties map before saving to the database.
+//
+// The stored resource reflects the effective configuration: defaults are validated by the backend, passed to recipes
+// and returned on GET. The filter must run before the encryption filter, so that sensitive defaults are encrypted.
+// Defaults are only read from the schema, so failing to apply them means the schema of the resource type is invalid and
+// the request is rejected with a 500.
+func defaultsFilter(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	options *controller.Options,
+	schemaData map[string]any,
+) (rest.Response, error) {
+	// No schema to apply defaults from
+	if schemaData == nil {
+		return nil, nil
+	}
+
+	if newResource.Properties == nil {
+		newResource.Properties = map[string]any{}
+	}
+
+	if err := schema.ApplyDefaults(newResource.Properties, schemaData); err != nil {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to apply schema defaults", "resourceType", serviceCtx.ResourceID.Type())
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: fmt.Sprintf("Failed to apply the default values of the schema of the resource type: %s", err.Error()),
+			},
+		}), nil
+	}
+
+	return nil, nil
+}
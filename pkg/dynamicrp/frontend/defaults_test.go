@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeDefaultsFilter_AppliesDefaults(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type":    "string",
+				"default": "small",
+			},
+			"backup": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"enabled": map[string]any{
+						"type":    "boolean",
+						"default": true,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, defaultsFilter)
+
+	resource := &datamodel.DynamicResource{}
+	resource.Properties = map[string]any{
+		"backup": map[string]any{},
+	}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Equal(t, map[string]any{
+		"size":   "small",
+		"backup": map[string]any{"enabled": true},
+	}, resource.Properties)
+}
+
+func TestMakeDefaultsFilter_NilProperties(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type":    "string",
+				"default": "small",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, defaultsFilter)
+
+	resource := &datamodel.DynamicResource{}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{"size": "small"}, resource.Properties)
+}
+
+func TestMakeDefaultsFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, defaultsFilter)
+
+	resource := &datamodel.DynamicResource{}
+	resource.Properties = map[string]any{"size": "large"}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
+
+func TestMakeDefaultsFilter_InvalidSchema(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type":      "string",
+				"default":   "small",
+				"maxLength": "ten",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, defaultsFilter)
+
+	resource := &datamodel.DynamicResource{}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.IsType(t, &rest.InternalServerErrorResponse{}, response)
+	require.Contains(t, response.(*rest.InternalServerErrorResponse).Body.Error.Message, "Failed to apply the default values")
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// headerDeprecation is the response header signaling that the resource type or API version is deprecated.
+	headerDeprecation = "Deprecation"
+
+	// headerSunset is the response header holding the date the resource type or API version is sunset.
+	headerSunset = "Sunset"
+
+	// headerWarning is the response header holding the deprecation warnings.
+	headerWarning = "Warning"
+
+	// deprecationCacheTTL is how long the deprecation of a resource type and API version is cached. Changes to the
+	// deprecation take effect on requests after at most this duration.
+	deprecationCacheTTL = 30 * time.Second
+)
+
+// deprecations is the deprecation of a resource type and of one of its API versions.
+type deprecations struct {
+	resourceType string
+	apiVersion   string
+
+	resourceTypeDeprecation *ucpdatamodel.Deprecation
+	apiVersionDeprecation   *ucpdatamodel.Deprecation
+}
+
+// isDeprecated returns true if the resource type or the API version is deprecated.
+func (d *deprecations) isDeprecated() bool {
+	return d.resourceTypeDeprecation != nil || d.apiVersionDeprecation != nil
+}
+
+// blocksCreate returns true if the creation of new resources is rejected at the given time.
+func (d *deprecations) blocksCreate(now time.Time) bool {
+	return d.resourceTypeDeprecation.BlocksCreate(now) || d.apiVersionDeprecation.BlocksCreate(now)
+}
+
+// sunsetDate returns the earliest sunset date of the resource type and API version, or nil if neither is sunset.
+func (d *deprecations) sunsetDate() *time.Time {
+	var sunset *time.Time
+	for _, deprecation := range []*ucpdatamodel.Deprecation{d.resourceTypeDeprecation, d.apiVersionDeprecation} {
+		if deprecation == nil || deprecation.SunsetDate == nil {
+			continue
+		}
+		if sunset == nil || deprecation.SunsetDate.Before(*sunset) {
+			sunset = deprecation.SunsetDate
+		}
+	}
+	return sunset
+}
+
+// warnings returns the deprecation warnings of the resource type and API version at the given time.
+func (d *deprecations) warnings(now time.Time) []string {
+	warnings := []string{}
+	if d.resourceTypeDeprecation != nil {
+		warnings = append(warnings, d.resourceTypeDeprecation.Warning("Resource type "+d.resourceType, now))
+	}
+	if d.apiVersionDeprecation != nil {
+		warnings = append(warnings, d.apiVersionDeprecation.Warning(fmt.Sprintf("API version %s of resource type %s", d.apiVersion, d.resourceType), now))
+	}
+	return warnings
+}
+
+// getDeprecations fetches the deprecation of the resource type and API version from UCP.
+func getDeprecations(ctx context.Context, ucpClient *v20231001preview.ClientFactory, id resources.ID, apiVersion string) (*deprecations, error) {
+	resourceType := id.Type()
+	result := &deprecations{resourceType: resourceType, apiVersion: apiVersion}
+	if ucpClient == nil {
+		return result, nil
+	}
+
+	planeName := strings.Split(id.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid resource type %q", resourceType)
+	}
+
+	resourceTypeResource, err := ucpClient.NewResourceTypesClient().Get(ctx, planeName, resourceProvider, resourceTypeName, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resourceTypeResource.Properties != nil {
+		result.resourceTypeDeprecation = v20231001preview.DeprecationToDataModel(resourceTypeResource.Properties.Deprecation)
+	}
+
+	apiVersionResource, err := ucpClient.NewAPIVersionsClient().Get(ctx, planeName, resourceProvider, resourceTypeName, apiVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+	if apiVersionResource.Properties != nil {
+		result.apiVersionDeprecation = v20231001preview.DeprecationToDataModel(apiVersionResource.Properties.Deprecation)
+	}
+
+	return result, nil
+}
+
+// deprecationCache caches the deprecation of resource types and API versions fetched from UCP, so that requests don't
+// each make round-trips to UCP. The deprecation headers middleware and the sunset filter share the cache. Failures to
+// fetch the deprecation are not cached.
+type deprecationCache struct {
+	ucpClient *v20231001preview.ClientFactory
+	ttl       time.Duration
+	now       func() time.Time
+
+	mutex   sync.Mutex
+	entries map[string]deprecationCacheEntry
+}
+
+// deprecationCacheEntry is the deprecation of a resource type and API version, and the time it expires from the cache.
+type deprecationCacheEntry struct {
+	deprecations *deprecations
+	expiresAt    time.Time
+}
+
+// newDeprecationCache creates a new deprecationCache fetching the deprecations from UCP.
+func newDeprecationCache(ucpClient *v20231001preview.ClientFactory) *deprecationCache {
+	return &deprecationCache{
+		ucpClient: ucpClient,
+		ttl:       deprecationCacheTTL,
+		now:       time.Now,
+		entries:   map[string]deprecationCacheEntry{},
+	}
+}
+
+// get returns the deprecation of the resource type and API version, fetching it from UCP when it isn't cached.
+func (c *deprecationCache) get(ctx context.Context, id resources.ID, apiVersion string) (*deprecations, error) {
+	key := strings.ToLower(id.PlaneNamespace() + "|" + id.Type() + "|" + apiVersion)
+
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	c.mutex.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.deprecations, nil
+	}
+
+	deprecations, err := getDeprecations(ctx, c.ucpClient, id, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.entries[key] = deprecationCacheEntry{deprecations: deprecations, expiresAt: c.now().Add(c.ttl)}
+	c.mutex.Unlock()
+
+	return deprecations, nil
+}
+
+// deprecationHeaders returns a middleware that adds deprecation headers to the responses of requests using a
+// deprecated resource type or API version: 'Deprecation: true', a 'Sunset' header holding the sunset date, and a
+// 'Warning' header for each deprecation. Failures to fetch the deprecation are logged and do not fail the request.
+func deprecationHeaders(cache *deprecationCache) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			serviceCtx := v1.ARMRequestContextFromContext(ctx)
+			if serviceCtx == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			deprecations, err := cache.get(ctx, serviceCtx.ResourceID, serviceCtx.APIVersion)
+			if err != nil {
+				ucplog.FromContextOrDiscard(ctx).V(ucplog.LevelDebug).Info("Failed to fetch deprecation of the resource type",
+					"resourceType", serviceCtx.ResourceID.Type(), "apiVersion", serviceCtx.APIVersion, "error", err.Error())
+			} else if deprecations.isDeprecated() {
+				now := time.Now()
+				w.Header().Set(headerDeprecation, "true")
+				if sunset := deprecations.sunsetDate(); sunset != nil {
+					w.Header().Set(headerSunset, sunset.UTC().Format(http.TimeFormat))
+				}
+				for _, warning := range deprecations.warnings(now) {
+					w.Header().Add(headerWarning, formatWarning(warning))
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// formatWarning formats the message as the value of a Warning header with the miscellaneous persistent warning code.
+func formatWarning(message string) string {
+	return fmt.Sprintf("299 - %q", message)
+}
+
+// makeSunsetFilter creates an UpdateFilter that rejects the creation of new resources of a resource type or API
+// version that is sunset and declares blockCreateAfterSunset. Existing resources can still be updated.
+func makeSunsetFilter(cache *deprecationCache) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		if oldResource != nil {
+			return nil, nil
+		}
+
+		return rejectSunsetCreate(ctx, cache)
+	}
+}
+
+// rejectSunsetCreate returns a BadRequest response if new resources can no longer be created using the resource type
+// and API version of the request.
+func rejectSunsetCreate(ctx context.Context, cache *deprecationCache) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	deprecations, err := cache.get(ctx, serviceCtx.ResourceID, serviceCtx.APIVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch deprecation of the resource type",
+			"resourceType", serviceCtx.ResourceID.Type(), "apiVersion", serviceCtx.APIVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch deprecation of the resource type",
+			},
+		}), nil
+	}
+
+	now := time.Now()
+	if !deprecations.blocksCreate(now) {
+		return nil, nil
+	}
+
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Message: fmt.Sprintf("New resources can no longer be created: %s", strings.Join(deprecations.warnings(now), " ")),
+		},
+	}), nil
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func testUCPClientFactoryWithDeprecation(resourceTypeDeprecation *v20231001preview.Deprecation, apiVersionDeprecation *v20231001preview.Deprecation) (*v20231001preview.ClientFactory, error) {
+	return testUCPClientFactoryWithDeprecationCalls(resourceTypeDeprecation, apiVersionDeprecation, &atomic.Int32{})
+}
+
+// testUCPClientFactoryWithDeprecationCalls returns a UCP client factory counting the requests fetching the resource type.
+func testUCPClientFactoryWithDeprecationCalls(resourceTypeDeprecation *v20231001preview.Deprecation, apiVersionDeprecation *v20231001preview.Deprecation, calls *atomic.Int32) (*v20231001preview.ClientFactory, error) {
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceTypesServer: fake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						calls.Add(1)
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{Deprecation: resourceTypeDeprecation},
+							},
+						}, nil)
+						return
+					},
+				},
+				APIVersionsServer: fake.APIVersionsServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+							APIVersionResource: v20231001preview.APIVersionResource{
+								Properties: &v20231001preview.APIVersionProperties{Deprecation: apiVersionDeprecation},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+}
+
+func TestDeprecationHeaders(t *testing.T) {
+	sunset := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("deprecated", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithDeprecation(
+			&v20231001preview.Deprecation{Replacement: new("Applications.Test/newResources")},
+			&v20231001preview.Deprecation{SunsetDate: &sunset, Message: new(`Set "size" instead of "sku".`)},
+		)
+		require.NoError(t, err)
+
+		called := false
+		handler := deprecationHeaders(newDeprecationCache(ucpClient))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil).WithContext(createTestContext()))
+
+		require.True(t, called)
+		require.Equal(t, "true", w.Header().Get(headerDeprecation))
+		require.Equal(t, "Thu, 01 Jan 2099 00:00:00 GMT", w.Header().Get(headerSunset))
+		require.Equal(t, []string{
+			`299 - "Resource type Applications.Test/testResources is deprecated. Use Applications.Test/newResources instead."`,
+			`299 - "API version 2023-10-01-preview of resource type Applications.Test/testResources is deprecated and will be sunset on 2099-01-01. Set \"size\" instead of \"sku\"."`,
+		}, w.Header().Values(headerWarning))
+	})
+
+	t.Run("not deprecated", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithDeprecation(nil, nil)
+		require.NoError(t, err)
+
+		handler := deprecationHeaders(newDeprecationCache(ucpClient))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil).WithContext(createTestContext()))
+
+		require.Empty(t, w.Header().Get(headerDeprecation))
+		require.Empty(t, w.Header().Get(headerSunset))
+		require.Empty(t, w.Header().Values(headerWarning))
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithError()
+		require.NoError(t, err)
+
+		called := false
+		handler := deprecationHeaders(newDeprecationCache(ucpClient))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil).WithContext(createTestContext()))
+
+		require.True(t, called)
+		require.Empty(t, w.Header().Get(headerDeprecation))
+	})
+}
+
+func TestMakeSunsetFilter(t *testing.T) {
+	past := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                    string
+		resourceTypeDeprecation *v20231001preview.Deprecation
+		apiVersionDeprecation   *v20231001preview.Deprecation
+		exists                  bool
+		err                     string
+	}{
+		{
+			name: "not deprecated",
+		},
+		{
+			name:                    "resource type sunset",
+			resourceTypeDeprecation: &v20231001preview.Deprecation{SunsetDate: &past, BlockCreateAfterSunset: new(true), Replacement: new("Applications.Test/newResources")},
+			err:                     "New resources can no longer be created: Resource type Applications.Test/testResources is deprecated and was sunset on 2020-01-01. Use Applications.Test/newResources instead.",
+		},
+		{
+			name:                  "API version sunset",
+			apiVersionDeprecation: &v20231001preview.Deprecation{SunsetDate: &past, BlockCreateAfterSunset: new(true)},
+			err:                   "New resources can no longer be created: API version 2023-10-01-preview of resource type Applications.Test/testResources is deprecated and was sunset on 2020-01-01.",
+		},
+		{
+			name:                    "sunset without blocking creates",
+			resourceTypeDeprecation: &v20231001preview.Deprecation{SunsetDate: &past},
+		},
+		{
+			name:                    "sunset date in the future",
+			resourceTypeDeprecation: &v20231001preview.Deprecation{SunsetDate: &future, BlockCreateAfterSunset: new(true)},
+		},
+		{
+			name:                    "update of existing resource",
+			resourceTypeDeprecation: &v20231001preview.Deprecation{SunsetDate: &past, BlockCreateAfterSunset: new(true)},
+			exists:                  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ucpClient, err := testUCPClientFactoryWithDeprecation(tt.resourceTypeDeprecation, tt.apiVersionDeprecation)
+			require.NoError(t, err)
+
+			var oldResource *datamodel.DynamicResource
+			if tt.exists {
+				oldResource = &datamodel.DynamicResource{}
+			}
+
+			filter := makeSunsetFilter(newDeprecationCache(ucpClient))
+			response, err := filter(createTestContext(), &datamodel.DynamicResource{}, oldResource, &controller.Options{})
+			require.NoError(t, err)
+
+			if tt.err == "" {
+				require.Nil(t, response)
+				return
+			}
+
+			require.IsType(t, &rest.BadRequestResponse{}, response)
+			require.Equal(t, tt.err, response.(*rest.BadRequestResponse).Body.Error.Message)
+		})
+	}
+}
+
+func TestMakeSunsetFilter_FetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSunsetFilter(newDeprecationCache(ucpClient))
+	response, err := filter(createTestContext(), &datamodel.DynamicResource{}, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.IsType(t, &rest.InternalServerErrorResponse{}, response)
+}
+
+func TestDeprecationCache(t *testing.T) {
+	calls := &atomic.Int32{}
+	ucpClient, err := testUCPClientFactoryWithDeprecationCalls(&v20231001preview.Deprecation{Replacement: new("Applications.Test/newResources")}, nil, calls)
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := newDeprecationCache(ucpClient)
+	cache.now = func() time.Time { return now }
+
+	ctx := createTestContext()
+	id := mustParseResourceID(testResourceID)
+
+	// The middleware and the sunset filter of a request share the cached deprecation.
+	deprecations, err := cache.get(ctx, id, testAPIVersion)
+	require.NoError(t, err)
+	require.True(t, deprecations.isDeprecated())
+
+	_, err = cache.get(ctx, id, testAPIVersion)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), calls.Load())
+
+	// Other API versions are cached separately.
+	_, err = cache.get(ctx, id, "2024-01-01")
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+
+	// The deprecation is fetched again once it expires.
+	now = now.Add(deprecationCacheTTL)
+	_, err = cache.get(ctx, id, testAPIVersion)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestDeprecationCache_FetchErrorNotCached(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	cache := newDeprecationCache(ucpClient)
+	_, err = cache.get(createTestContext(), mustParseResourceID(testResourceID), testAPIVersion)
+	require.Error(t, err)
+	require.Empty(t, cache.entries)
+}
@@ -18,6 +18,8 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
@@ -25,30 +27,28 @@ import (
 	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/schema"
-	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// makeEncryptionFilter creates an UpdateFilter that encrypts sensitive fields in the resource's
+// makeEncryptionFilter creates a schemaFilter that encrypts sensitive fields in the resource's
 // Properties map before saving to the database.
 //
 // The filter:
-// 1. Fetches the schema for the resource type to identify sensitive field paths (marked with x-radius-sensitive)
+// 1. Identifies sensitive field paths (marked with x-radius-sensitive) in the schema of the resource type
 // 2. Encrypts values at those paths using the SensitiveDataHandler
 // 3. Uses the resource ID as associated data for context binding (prevents moving encrypted values between resources)
+// 4. Keeps the values copied from the stored resource by the conversion filter, which are already encrypted
 //
 // If sensitive fields are not found, the resource passes through unchanged.
-func makeEncryptionFilter(
-	ucpClient *v20231001preview.ClientFactory,
-	handler *encryption.SensitiveDataHandler,
-) controller.UpdateFilter[datamodel.DynamicResource] {
+func makeEncryptionFilter(handler *encryption.SensitiveDataHandler) schemaFilter {
 	return func(
 		ctx context.Context,
 		newResource *datamodel.DynamicResource,
 		oldResource *datamodel.DynamicResource,
 		options *controller.Options,
+		schemaData map[string]any,
 	) (rest.Response, error) {
-		return encryptSensitiveFields(ctx, newResource, ucpClient, handler)
+		return encryptSensitiveFields(ctx, newResource, oldResource, schemaData, handler)
 	}
 }
 
@@ -56,7 +56,8 @@ func makeEncryptionFilter(
 func encryptSensitiveFields(
 	ctx context.Context,
 	newResource *datamodel.DynamicResource,
-	ucpClient *v20231001preview.ClientFactory,
+	oldResource *datamodel.DynamicResource,
+	schemaData map[string]any,
 	handler *encryption.SensitiveDataHandler,
 ) (rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -64,7 +65,6 @@ func encryptSensitiveFields(
 
 	resourceID := serviceCtx.ResourceID.String()
 	resourceType := serviceCtx.ResourceID.Type()
-	apiVersion := serviceCtx.APIVersion
 
 	// If encryption handler is not configured, return an error.
 	if handler == nil {
@@ -77,23 +77,10 @@ func encryptSensitiveFields(
 		}), nil
 	}
 
-	// Fetch sensitive field paths from schema
-	sensitiveFieldPaths, err := schema.GetSensitiveFieldPaths(
-		ctx,
-		ucpClient,
-		resourceID,
-		resourceType,
-		apiVersion,
-	)
-	if err != nil {
-		logger.Error(err, "Failed to fetch sensitive field paths",
-			"resourceType", resourceType, "apiVersion", apiVersion)
-		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
-			Error: &v1.ErrorDetails{
-				Code:    v1.CodeInternal,
-				Message: "Failed to fetch schema for sensitive field encryption",
-			},
-		}), nil
+	// Sensitive field paths from schema
+	var sensitiveFieldPaths []string
+	if schemaData != nil {
+		sensitiveFieldPaths = schema.ExtractSensitiveFieldPaths(schemaData, "")
 	}
 
 	// No sensitive fields to encrypt
@@ -106,12 +93,18 @@ func encryptSensitiveFields(
 		return nil, nil
 	}
 
+	var stored map[string]any
+	if oldResource != nil {
+		stored = oldResource.Properties
+	}
+
 	// Encrypt sensitive fields in the Properties map
 	// Field paths from schema are relative to "properties", so we operate on Properties directly
-	if err := handler.EncryptSensitiveFields(
+	if err := handler.EncryptSensitiveFieldsKeepingStored(
 		newResource.Properties,
 		sensitiveFieldPaths,
 		resourceID,
+		stored,
 	); err != nil {
 		logger.Error(err, "Failed to encrypt sensitive fields",
 			"resourceType", resourceType, "resourceID", resourceID)
@@ -128,3 +121,54 @@ func encryptSensitiveFields(
 
 	return nil, nil
 }
+
+// decryptedProperties returns a copy of the properties of the resource with the sensitive fields decrypted, so that
+// the stored values of the resource can be compared with the plain values of the request. The properties are returned
+// as is when the schema of the resource type declares no sensitive fields.
+func decryptedProperties(
+	ctx context.Context,
+	resource *datamodel.DynamicResource,
+	schemaData map[string]any,
+	handler *encryption.SensitiveDataHandler,
+) (map[string]any, error) {
+	var sensitiveFieldPaths []string
+	if schemaData != nil {
+		sensitiveFieldPaths = schema.ExtractSensitiveFieldPaths(schemaData, "")
+	}
+
+	if len(sensitiveFieldPaths) == 0 || resource.Properties == nil {
+		return resource.Properties, nil
+	}
+
+	if handler == nil {
+		return nil, errors.New("encryption handler is not configured but is required to read sensitive fields")
+	}
+
+	b, err := json.Marshal(resource.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]any{}
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return nil, err
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	if err := handler.DecryptSensitiveFieldsWithSchema(ctx, properties, sensitiveFieldPaths, serviceCtx.ResourceID.String(), schemaData); err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+// newDecryptionFailedResponse returns the response of a filter that failed to decrypt the sensitive fields of a
+// resource.
+func newDecryptionFailedResponse() rest.Response {
+	return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: "Failed to decrypt sensitive fields",
+		},
+	})
+}
@@ -41,7 +41,7 @@ const (
 
 func TestMakeEncryptionFilter_NilHandler(t *testing.T) {
 	// When handler is nil, filter should return an error response
-	filter := makeEncryptionFilter(nil, nil)
+	filter := makeSchemaFilter(nil, makeEncryptionFilter(nil))
 
 	ctx := createTestContext()
 	resource := &datamodel.DynamicResource{
@@ -64,7 +64,7 @@ func TestMakeEncryptionFilter_NoSensitiveFields(t *testing.T) {
 	require.NoError(t, err)
 
 	handler := createTestHandler(t)
-	filter := makeEncryptionFilter(ucpClient, handler)
+	filter := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))
 
 	ctx := createTestContext()
 	resource := &datamodel.DynamicResource{
@@ -89,7 +89,7 @@ func TestMakeEncryptionFilter_WithSensitiveFields(t *testing.T) {
 	require.NoError(t, err)
 
 	handler := createTestHandler(t)
-	filter := makeEncryptionFilter(ucpClient, handler)
+	filter := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))
 
 	ctx := createTestContext()
 	resource := &datamodel.DynamicResource{
@@ -114,13 +114,53 @@ func TestMakeEncryptionFilter_WithSensitiveFields(t *testing.T) {
 	require.Contains(t, encryptedData, "version")
 }
 
+func TestMakeEncryptionFilter_KeepsStoredEncryptedValues(t *testing.T) {
+	// Encrypted values copied from the stored resource by the conversion filter must not be encrypted again
+	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
+	require.NoError(t, err)
+
+	handler := createTestHandler(t)
+	filter := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))
+
+	ctx := createTestContext()
+	oldResource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"name":     "test",
+			"password": "secret123",
+		},
+	}
+	response, err := filter(ctx, oldResource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	stored := oldResource.Properties["password"]
+
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"name":     "test",
+			"password": stored,
+		},
+	}
+	response, err = filter(ctx, resource, oldResource, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, stored, resource.Properties["password"])
+
+	// A new value is encrypted.
+	resource.Properties["password"] = "secret456"
+	response, err = filter(ctx, resource, oldResource, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.NotEqual(t, stored, resource.Properties["password"])
+	require.Contains(t, resource.Properties["password"], "encrypted")
+}
+
 func TestMakeEncryptionFilter_NilProperties(t *testing.T) {
 	// When resource has nil properties, filter should pass through
 	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
 	require.NoError(t, err)
 
 	handler := createTestHandler(t)
-	filter := makeEncryptionFilter(ucpClient, handler)
+	filter := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))
 
 	ctx := createTestContext()
 	resource := &datamodel.DynamicResource{
@@ -138,7 +178,7 @@ func TestMakeEncryptionFilter_SchemaFetchError(t *testing.T) {
 	require.NoError(t, err)
 
 	handler := createTestHandler(t)
-	filter := makeEncryptionFilter(ucpClient, handler)
+	filter := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))
 
 	ctx := createTestContext()
 	resource := &datamodel.DynamicResource{
@@ -161,7 +201,7 @@ func TestMakeEncryptionFilter_NestedSensitiveFields(t *testing.T) {
 	require.NoError(t, err)
 
 	handler := createTestHandler(t)
-	filter := makeEncryptionFilter(ucpClient, handler)
+	filter := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))
 
 	ctx := createTestContext()
 	resource := &datamodel.DynamicResource{
@@ -47,7 +47,7 @@ func NewGetResourceWithRedaction(
 	}, nil
 }
 
-// Run returns the requested resource with sensitive fields redacted.
+// Run returns the requested resource with sensitive fields redacted, converted to the requested API version.
 //
 // Design consideration (GET Operation Update): When provisioningState is "Succeeded",
 // the backend has already redacted sensitive data from the database, so we skip the
@@ -105,5 +105,12 @@ func (c *GetResourceWithRedaction) Run(ctx context.Context, w http.ResponseWrite
 		}
 	}
 
+	// Resources are stored in the hub API version of the resource type when the requested API version declares
+	// conversion rules. Convert after redaction, which uses the schema of the stored API version.
+	err = newHubVersionConverter(c.ucpClient).Convert(ctx, resource, serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	return c.ConstructSyncResponse(ctx, req.Method, etag, resource)
 }
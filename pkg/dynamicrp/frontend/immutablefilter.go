@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// makeImmutableFilter creates a schemaFilter that rejects updates changing fields marked with x-radius-immutable in
+// the resource type schema.
+//
+// Immutable fields can only be set when the resource is created. Changing them would make the recipe destroy and
+// recreate the underlying infrastructure, so the update is rejected with a 409 Conflict listing the changed fields.
+// The filter must run after the defaults filter, so that defaulted fields are compared. Sensitive fields are stored
+// encrypted, so they are decrypted with the handler to compare the plain values.
+func makeImmutableFilter(handler *encryption.SensitiveDataHandler) schemaFilter {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+		schemaData map[string]any,
+	) (rest.Response, error) {
+		// Immutable fields can be set freely on create.
+		if oldResource == nil || schemaData == nil {
+			return nil, nil
+		}
+
+		immutableFieldPaths := schema.ExtractImmutableFieldPaths(schemaData, "")
+		if len(immutableFieldPaths) == 0 {
+			return nil, nil
+		}
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		// The new resource can have encrypted values copied from the stored resource by the conversion filter.
+		newProperties, err := decryptedProperties(ctx, newResource, schemaData, handler)
+		if err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to decrypt sensitive fields", "resourceID", serviceCtx.ResourceID.String())
+			return newDecryptionFailedResponse(), nil
+		}
+
+		oldProperties, err := decryptedProperties(ctx, oldResource, schemaData, handler)
+		if err != nil {
+			ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to decrypt sensitive fields", "resourceID", serviceCtx.ResourceID.String())
+			return newDecryptionFailedResponse(), nil
+		}
+
+		changed := schema.FindChangedFields(newProperties, oldProperties, immutableFieldPaths)
+		if len(changed) == 0 {
+			return nil, nil
+		}
+
+		return rest.NewConflictResponse(fmt.Sprintf(
+			"Resource %s cannot be updated: the following fields are immutable and cannot be changed after creation: %s",
+			serviceCtx.ResourceID.String(), strings.Join(changed, ", "))), nil
+	}
+}
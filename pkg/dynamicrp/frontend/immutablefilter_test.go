@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeImmutableFilter(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"region": map[string]any{
+				"type":               "string",
+				"x-radius-immutable": true,
+			},
+			"database": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"engine": map[string]any{
+						"type":               "string",
+						"x-radius-immutable": true,
+					},
+					"size": map[string]any{"type": "string"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeImmutableFilter(nil))
+
+	newResource := func(region string, engine string, size string) *datamodel.DynamicResource {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{
+			"region":   region,
+			"database": map[string]any{"engine": engine, "size": size},
+		}
+		return resource
+	}
+
+	t.Run("create", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource("westus", "postgres", "small"), nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("update mutable field", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource("westus", "postgres", "large"), newResource("westus", "postgres", "small"), nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("update immutable fields", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource("eastus", "mysql", "small"), newResource("westus", "postgres", "small"), nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.ConflictResponse{}, response)
+		require.Contains(t, response.(*rest.ConflictResponse).Body.Error.Message, "database.engine, region")
+	})
+
+	t.Run("remove immutable field", func(t *testing.T) {
+		resource := newResource("westus", "postgres", "small")
+		delete(resource.Properties, "region")
+
+		response, err := filter(createTestContext(), resource, newResource("westus", "postgres", "small"), nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.ConflictResponse{}, response)
+		require.Contains(t, response.(*rest.ConflictResponse).Body.Error.Message, "region")
+	})
+}
+
+func TestMakeImmutableFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeImmutableFilter(nil))
+
+	resource := &datamodel.DynamicResource{}
+	resource.Properties = map[string]any{"region": "westus"}
+
+	response, err := filter(createTestContext(), resource, resource, nil)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
+
+func TestMakeImmutableFilter_SensitiveFields(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"password": map[string]any{
+				"type":               "string",
+				"x-radius-immutable": true,
+				"x-radius-sensitive": true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	handler := createTestHandler(t)
+	filter := makeSchemaFilter(ucpClient, makeImmutableFilter(handler))
+
+	// The stored resource has the encrypted value of the field.
+	oldResource := &datamodel.DynamicResource{}
+	oldResource.Properties = map[string]any{"password": "secret123"}
+	response, err := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))(createTestContext(), oldResource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Contains(t, oldResource.Properties["password"], "encrypted")
+
+	t.Run("same value", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"password": "secret123"}
+
+		response, err := filter(createTestContext(), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, "secret123", resource.Properties["password"])
+	})
+
+	t.Run("stored value", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"password": oldResource.Properties["password"]}
+
+		response, err := filter(createTestContext(), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("changed value", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"password": "secret456"}
+
+		response, err := filter(createTestContext(), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.ConflictResponse{}, response)
+		require.Contains(t, response.(*rest.ConflictResponse).Body.Error.Message, "password")
+	})
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// maxActionInputSize is the maximum size of the action input in bytes. The input is stored in the status of the
+	// resource until the backend runs the action.
+	maxActionInputSize = 1 << 20
+)
+
+// InvokeAction is the async POST controller that invokes an action declared by the resource type of a resource.
+//
+// The request URL is the resource ID followed by the action name, and the request body is the input of the action.
+// The invocation is recorded in the status of the resource and run by the backend: the output of the action is stored
+// under ".properties.status.actions.<action name>" when the async operation completes. The input can hold credentials,
+// so it's encrypted until the backend runs the action.
+type InvokeAction struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucpClient *v20231001preview.ClientFactory
+	handler   *encryption.SensitiveDataHandler
+}
+
+// NewInvokeAction creates a new InvokeAction controller.
+func NewInvokeAction(
+	opts ctrl.Options,
+	resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource],
+	ucpClient *v20231001preview.ClientFactory,
+	handler *encryption.SensitiveDataHandler,
+) (ctrl.Controller, error) {
+	return &InvokeAction{
+		Operation: ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+		ucpClient: ucpClient,
+		handler:   handler,
+	}, nil
+}
+
+// Run validates the action input against the input schema of the action, records the invocation in the status of the
+// resource, and queues the async operation.
+func (c *InvokeAction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resource, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	resourceType := serviceCtx.ResourceID.Type()
+	requestedAction := actionNameFromPath(req.URL.Path)
+	actionName, action, err := schema.GetAction(ctx, c.ucpClient, serviceCtx.ResourceID.String(), resourceType, requestedAction)
+	if err != nil {
+		logger.Error(err, "Failed to fetch resource type actions", "resourceType", resourceType)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch the actions of the resource type",
+			},
+		}), nil
+	}
+	if action == nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: fmt.Sprintf("Action %q is not supported by resource type %q", requestedAction, resourceType),
+				Target:  serviceCtx.ResourceID.String(),
+			},
+		}), nil
+	}
+
+	input, err := readActionInput(w, req)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return rest.NewRequestEntityTooLargeResponse(fmt.Sprintf("the action input is larger than the maximum size of %d bytes", maxBytesErr.Limit)), nil
+	} else if err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Message: err.Error(),
+			},
+		}), nil
+	}
+
+	if err := schema.ValidateActionPayload(input, action.InputSchema); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Message: fmt.Sprintf("Action input validation failed: %v", err),
+			},
+		}), nil
+	}
+
+	// Actions change the resource, so they're prevented by the 'ReadOnly' locks that protect it.
+	if r, err := c.ValidateOperationLocks(ctx, ctrl.LockOperationWrite, nil, resource); r != nil || err != nil {
+		return r, err
+	}
+
+	// Actions cannot be invoked while another operation is in progress on the resource.
+	if r, err := c.PrepareResource(ctx, req, nil, resource, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	actionStatus := datamodel.ActionStatus{
+		OperationID: serviceCtx.OperationID.String(),
+		State:       v1.ProvisioningStateAccepted,
+	}
+	if len(input) > 0 {
+		if c.handler == nil {
+			logger.Error(nil, "Encryption handler not configured", "resourceType", resourceType, "action", actionName)
+			return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInternal,
+					Message: "Encryption handler is not configured but is required to store the action input",
+				},
+			}), nil
+		}
+
+		if err := actionStatus.SetInput(c.handler, serviceCtx.ResourceID.String(), actionName, input); err != nil {
+			return nil, err
+		}
+	}
+	resource.SetActionStatus(actionName, actionStatus)
+
+	if r, err := c.PrepareAsyncOperation(ctx, resource, v1.ProvisioningStateAccepted, c.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+		return r, err
+	}
+
+	logger.V(ucplog.LevelDebug).Info("Queued action invocation", "resourceType", resourceType, "action", actionName)
+
+	return rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", ""), nil
+}
+
+// actionNameFromPath returns the action name, the last segment of the request path.
+func actionNameFromPath(requestPath string) string {
+	return path.Base(strings.TrimSuffix(requestPath, "/"))
+}
+
+// readActionInput reads the action input from the request body. An empty body is a nil input. Returns an
+// *http.MaxBytesError if the body is larger than maxActionInputSize.
+func readActionInput(w http.ResponseWriter, req *http.Request) (map[string]any, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	content, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxActionInputSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request body: %w", err)
+	}
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return nil, nil
+	}
+
+	input := map[string]any{}
+	if err := json.Unmarshal(content, &input); err != nil {
+		return nil, fmt.Errorf("the action input must be a JSON object: %w", err)
+	}
+
+	return input, nil
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testActionURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource/rotateCredentials?api-version=2023-10-01-preview"
+)
+
+func newTestInvokeActionController(t *testing.T, provisioningState v1.ProvisioningState, queueErr error) (controller.Controller, database.Client) {
+	t.Helper()
+
+	databaseClient := inmemory.NewClient()
+	if provisioningState != "" {
+		err := databaseClient.Save(context.Background(), &database.Object{
+			Metadata: database.Metadata{ID: testResourceID},
+			Data:     newGetTestDynamicResource(provisioningState, map[string]any{"size": "large"}),
+		})
+		require.NoError(t, err)
+	}
+
+	statusManager := statusmanager.NewMockStatusManager(gomock.NewController(t))
+	statusManager.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(queueErr).AnyTimes()
+
+	ucpClient, err := testUCPClientFactoryWithActions()
+	require.NoError(t, err)
+
+	c, err := NewInvokeAction(controller.Options{
+		DatabaseClient: databaseClient,
+		StatusManager:  statusManager,
+	}, controller.ResourceOptions[datamodel.DynamicResource]{}, ucpClient, createTestHandler(t))
+	require.NoError(t, err)
+
+	return c, databaseClient
+}
+
+func runTestInvokeAction(t *testing.T, c controller.Controller, url string, body string) (rest.Response, context.Context) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	resp, err := c.Run(ctx, httptest.NewRecorder(), req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	return resp, ctx
+}
+
+func TestInvokeAction_Accepted(t *testing.T) {
+	c, databaseClient := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+
+	resp, ctx := runTestInvokeAction(t, c, testActionURL, `{"length": 32}`)
+
+	asyncResp, ok := resp.(*rest.AsyncOperationResponse)
+	require.True(t, ok)
+	require.Equal(t, http.StatusAccepted, asyncResp.Code)
+
+	obj, err := databaseClient.Get(context.Background(), testResourceID)
+	require.NoError(t, err)
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	require.Equal(t, v1.ProvisioningStateAccepted, resource.ProvisioningState())
+
+	name, status, ok := resource.FindActionStatus(v1.ARMRequestContextFromContext(ctx).OperationID.String())
+	require.True(t, ok)
+	require.Equal(t, "rotateCredentials", name)
+	require.Equal(t, v1.ProvisioningStateAccepted, status.State)
+
+	// The input is stored encrypted.
+	require.NotContains(t, status.Input, "length")
+	input, err := status.GetInput(ctx, c.(*InvokeAction).handler, testResourceID, name)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"length": float64(32)}, input)
+}
+
+func TestInvokeAction_EncryptionNotConfigured(t *testing.T) {
+	c, _ := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+	c.(*InvokeAction).handler = nil
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `{"length": 32}`)
+
+	armResp, ok := resp.(*rest.InternalServerErrorResponse)
+	require.True(t, ok)
+	require.Equal(t, v1.CodeInternal, armResp.Body.Error.Code)
+}
+
+func TestInvokeAction_ResourceNotFound(t *testing.T) {
+	c, _ := newTestInvokeActionController(t, "", nil)
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `{"length": 32}`)
+
+	_, ok := resp.(*rest.NotFoundResponse)
+	require.True(t, ok)
+}
+
+func TestInvokeAction_UnsupportedAction(t *testing.T) {
+	c, _ := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+
+	url := strings.Replace(testActionURL, "rotateCredentials", "backup", 1)
+	resp, _ := runTestInvokeAction(t, c, url, "")
+
+	badRequest, ok := resp.(*rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Equal(t, v1.CodeInvalid, badRequest.Body.Error.Code)
+	require.Contains(t, badRequest.Body.Error.Message, `Action "backup" is not supported by resource type "Applications.Test/testResources"`)
+}
+
+func TestInvokeAction_InvalidInput(t *testing.T) {
+	c, _ := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `{"length": "long"}`)
+
+	badRequest, ok := resp.(*rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Equal(t, v1.CodeInvalidRequestContent, badRequest.Body.Error.Code)
+	require.Contains(t, badRequest.Body.Error.Message, "Action input validation failed")
+}
+
+func TestInvokeAction_MalformedInput(t *testing.T) {
+	c, _ := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `[1, 2]`)
+
+	badRequest, ok := resp.(*rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Contains(t, badRequest.Body.Error.Message, "the action input must be a JSON object")
+}
+
+func TestInvokeAction_InputTooLarge(t *testing.T) {
+	c, databaseClient := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `{"length": "`+strings.Repeat("a", maxActionInputSize)+`"}`)
+
+	tooLarge, ok := resp.(*rest.RequestEntityTooLargeResponse)
+	require.True(t, ok)
+	require.Equal(t, v1.CodeInvalidRequestContent, tooLarge.Body.Error.Code)
+	require.Equal(t, "the action input is larger than the maximum size of 1048576 bytes", tooLarge.Body.Error.Message)
+
+	// The action is not recorded in the status of the resource.
+	obj, err := databaseClient.Get(context.Background(), testResourceID)
+	require.NoError(t, err)
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	require.Empty(t, resource.ActionStatuses())
+}
+
+func TestInvokeAction_OperationInProgress(t *testing.T) {
+	c, _ := newTestInvokeActionController(t, v1.ProvisioningStateUpdating, nil)
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `{"length": 32}`)
+
+	_, ok := resp.(*rest.ConflictResponse)
+	require.True(t, ok)
+}
+
+func TestInvokeAction_Locked(t *testing.T) {
+	c, databaseClient := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+	saveTestLock(t, databaseClient, ucp_datamodel.LockLevelReadOnly)
+	c.(*InvokeAction).Options().LockChecker = locks.NewChecker(databaseClient)
+
+	req, err := http.NewRequest(http.MethodPost, testActionURL, strings.NewReader(`{"length": 32}`))
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	resp, err := c.Run(ctx, httptest.NewRecorder(), req)
+	require.NoError(t, err)
+	requireScopeLocked(t, ctx, resp, req)
+
+	// The action is not recorded in the status of the resource.
+	obj, err := databaseClient.Get(context.Background(), testResourceID)
+	require.NoError(t, err)
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	require.Equal(t, v1.ProvisioningStateSucceeded, resource.ProvisioningState())
+}
+
+func TestInvokeAction_CanNotDeleteLock(t *testing.T) {
+	c, databaseClient := newTestInvokeActionController(t, v1.ProvisioningStateSucceeded, nil)
+	saveTestLock(t, databaseClient, ucp_datamodel.LockLevelCanNotDelete)
+	c.(*InvokeAction).Options().LockChecker = locks.NewChecker(databaseClient)
+
+	resp, _ := runTestInvokeAction(t, c, testActionURL, `{"length": 32}`)
+	_, ok := resp.(*rest.AsyncOperationResponse)
+	require.True(t, ok)
+}
+
+func Test_actionNameFromPath(t *testing.T) {
+	require.Equal(t, "restart", actionNameFromPath("/planes/radius/local/resourceGroups/rg/providers/A.B/c/d/restart"))
+	require.Equal(t, "restart", actionNameFromPath("/planes/radius/local/resourceGroups/rg/providers/A.B/c/d/restart/"))
+}
+
+func testUCPClientFactoryWithActions() (*v20231001preview.ClientFactory, error) {
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceTypesServer: fake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{
+									Actions: map[string]*v20231001preview.ResourceTypeAction{
+										"rotateCredentials": {
+											InputSchema: map[string]any{
+												"type": "object",
+												"properties": map[string]any{
+													"length": map[string]any{"type": "integer"},
+												},
+											},
+											Callback: &v20231001preview.ActionCallback{URL: to.Ptr("https://example.com/rotate")},
+										},
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+}
@@ -50,15 +50,22 @@ func NewListResourcesWithRedaction(
 	}, nil
 }
 
-// Run returns the list of resources with sensitive fields redacted.
+// Run returns the list of resources with sensitive fields redacted, converted to the requested API version. If the
+// request filters by tag only the resources that have the tag are returned.
 func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	tagFilter, err := v1.ParseTagFilter(req.URL.Query())
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
 	query := database.Query{
 		RootScope:      serviceCtx.ResourceID.RootScope(),
 		ResourceType:   serviceCtx.ResourceID.Type(),
 		ScopeRecursive: c.listRecursiveQuery,
+		Filters:        ctrl.TagQueryFilters(tagFilter, "tags"),
 	}
 
 	result, err := c.DatabaseClient().Query(ctx, query, database.WithPaginationToken(serviceCtx.SkipToken), database.WithMaxQueryItemCount(serviceCtx.Top))
@@ -70,6 +77,9 @@ func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWri
 	// Different resources in the list may have been created with different API versions
 	sensitiveFieldPathsCache := make(map[string][]string)
 
+	// Resources stored in the hub API version are converted to the requested API version after redaction.
+	versionConverter := newHubVersionConverter(c.ucpClient)
+
 	items := []any{}
 	for _, item := range result.Items {
 		resource := &datamodel.DynamicResource{}
@@ -116,6 +126,11 @@ func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWri
 			}
 		}
 
+		err = versionConverter.Convert(ctx, resource, serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
 		versioned, err := c.ResponseConverter()(resource, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
@@ -17,6 +17,7 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -586,6 +587,74 @@ func TestListResourcesWithRedaction_PaginationToken(t *testing.T) {
 	require.NotEmpty(t, paginatedList.NextLink)
 }
 
+func TestListResourcesWithRedaction_TagFilter(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newTestDynamicResource(
+		testResourceID,
+		"myResource",
+		v1.ProvisioningStateSucceeded,
+		map[string]any{
+			"name": "test",
+		},
+	)
+	resource.Tags = map[string]string{"team": "payments"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, query database.Query, _ ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+			require.Equal(t, []database.QueryFilter{{Field: "tags", Key: "team", Value: "payments"}}, query.Filters)
+			return &database.ObjectQueryResult{
+				Items:           []database.Object{*rpctest.FakeStoreObject(resource)},
+				PaginationToken: "next-page-token",
+			}, nil
+		})
+
+	ucpClient, err := testUCPClientFactoryNoSensitiveFields()
+	require.NoError(t, err)
+
+	c := newTestListController(t, databaseClient, ucpClient)
+
+	req, err := http.NewRequest(http.MethodGet, testListURL+"&tagName=team&tagValue=payments", nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	paginatedResp, ok := resp.(*rest.OKResponse)
+	require.True(t, ok)
+	paginatedList, ok := paginatedResp.Body.(*v1.PaginatedList)
+	require.True(t, ok)
+	require.Len(t, paginatedList.Value, 1)
+	// The next page should be filtered by the same tag.
+	require.Contains(t, paginatedList.NextLink, "tagName=team&tagValue=payments")
+}
+
+func TestListResourcesWithRedaction_InvalidTagFilter(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	databaseClient := database.NewMockClient(mctrl)
+
+	c := newTestListController(t, databaseClient, nil)
+
+	req, err := http.NewRequest(http.MethodGet, testListURL+"&tagName=team", nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
 func TestNewListResourcesWithRedaction(t *testing.T) {
 	opts := ctrl.Options{
 		DatabaseClient: nil,
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/stretchr/testify/require"
+)
+
+const testLockID = "/planes/radius/local/resourceGroups/test-group/providers/System.Authorization/locks/read-only"
+
+// saveTestLock saves a lock on the resource group of the test resource.
+func saveTestLock(t *testing.T, databaseClient database.Client, level ucp_datamodel.LockLevel) {
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: testLockID},
+		Data:     &ucp_datamodel.Lock{Properties: ucp_datamodel.LockProperties{Level: level}},
+	})
+	require.NoError(t, err)
+}
+
+// requireScopeLocked verifies that the response is a ScopeLocked error caused by the test lock.
+func requireScopeLocked(t *testing.T, ctx context.Context, resp rest.Response, req *http.Request) {
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.Apply(ctx, w, req))
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	errResp := &v1.ErrorResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), errResp))
+	require.Equal(t, v1.CodeScopeLocked, errResp.Error.Code)
+	require.Contains(t, errResp.Error.Message, testLockID)
+}
+
+func TestCreateOrUpdateResource_Locked(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+	saveTestLock(t, databaseClient, ucp_datamodel.LockLevelReadOnly)
+
+	c, err := defaultoperation.NewDefaultAsyncPut[*datamodel.DynamicResource](controller.Options{
+		DatabaseClient: databaseClient,
+		LockChecker:    locks.NewChecker(databaseClient),
+	}, controller.ResourceOptions[datamodel.DynamicResource]{
+		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
+		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, testResourceID+"?api-version=2023-10-01-preview", strings.NewReader(`{"location": "global", "properties": {"size": "large"}}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	ctx := rpctest.NewARMRequestContext(req)
+
+	resp, err := c.Run(ctx, httptest.NewRecorder(), req)
+	require.NoError(t, err)
+	requireScopeLocked(t, ctx, resp, req)
+
+	// The resource is not created.
+	_, err = databaseClient.Get(context.Background(), testResourceID)
+	require.ErrorIs(t, err, &database.ErrNotFound{ID: testResourceID})
+}
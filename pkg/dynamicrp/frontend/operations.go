@@ -47,7 +47,8 @@ import (
 // This code ensures that the controller will be provided with the correct resource type.
 func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := resources.Parse(r.URL.Path)
+		// Custom actions are POST requests to the resource ID followed by the action name.
+		id, err := resources.ParseByMethod(r.URL.Path, r.Method)
 		if err != nil {
 			result := rest.NewBadRequestResponse(err.Error())
 			err = result.Apply(r.Context(), w, r)
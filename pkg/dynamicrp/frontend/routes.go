@@ -50,15 +50,43 @@ func (s *Service) registerRoutes(
 		pathBase = pathBase + "/"
 	}
 
-	// Create encryption filter for sensitive fields
-	encryptionFilter := makeEncryptionFilter(ucpClient, handler)
-
-	// Resource options with encryption filter applied to PUT operations
+	// The deprecation of resource types and API versions is cached for the deprecation headers and the sunset filter.
+	deprecations := newDeprecationCache(ucpClient)
+
+	// Create sunset filter to reject creating resources of resource types and API versions that are sunset
+	sunsetFilter := makeSunsetFilter(deprecations)
+
+	// Create conversion filter to store resources in the hub API version of the resource type
+	conversionFilter := makeConversionFilter(ucpClient)
+
+	// Create the filters using the schema of the resource type, which is fetched once per request:
+	// - the defaults filter applies the default values declared in the schema
+	// - the immutable filter rejects updates changing fields marked with x-radius-immutable
+	// - the validation rules filter evaluates the CEL rules declared in the schema
+	// - the connections filter validates the targets of connections constrained in the schema
+	// - the encryption filter encrypts sensitive fields
+	schemaFilters := makeSchemaFilter(ucpClient,
+		defaultsFilter,
+		makeImmutableFilter(handler),
+		makeValidationRulesFilter(handler),
+		makeConnectionsFilter(ucpClient, s.options.UCP),
+		makeEncryptionFilter(handler),
+	)
+
+	// Resource options with sunset, conversion and schema filters applied to PUT operations. The sunset filter runs
+	// first so that rejected creates do no other work, and the conversion filter must run before the schema filters
+	// so that they use the schema of the stored API version. The response filter converts the saved resource back to
+	// the requested API version.
 	resourceOptions := controller.ResourceOptions[datamodel.DynamicResource]{
 		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
 		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
 		UpdateFilters: []controller.UpdateFilter[datamodel.DynamicResource]{
-			encryptionFilter,
+			sunsetFilter,
+			conversionFilter,
+			schemaFilters,
+		},
+		ResponseFilters: []controller.ResponseFilter[datamodel.DynamicResource]{
+			makeResponseConversionFilter(ucpClient),
 		},
 		AsyncOperationRetryAfter: time.Second * 5,
 		AsyncOperationTimeout:    time.Hour * 24,
@@ -86,6 +114,9 @@ func (s *Service) registerRoutes(
 
 		// Resource-group-scoped
 		r.Route("/{rg:resource[gG]roups}/{resourceGroupName}/providers/{providerNamespace}/{resourceType}", func(r chi.Router) {
+			// Warn the clients of deprecated resource types and API versions.
+			r.Use(deprecationHeaders(deprecations))
+
 			r.Get("/", dynamicOperationHandler(v1.OperationList, controllerOptions,
 				func(opts controller.Options) (controller.Controller, error) {
 					return NewListResourcesWithRedaction(opts, resourceOptions, ucpClient)
@@ -96,12 +127,16 @@ func (s *Service) registerRoutes(
 				}))
 			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions,
 				func(opts controller.Options) (controller.Controller, error) {
-					return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
+					return defaultoperation.NewDefaultAsyncPut[*datamodel.DynamicResource](opts, resourceOptions)
 				}))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions,
 				func(opts controller.Options) (controller.Controller, error) {
 					return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
 				}))
+			r.Post("/{resourceName}/{actionName}", dynamicOperationHandler(v1.OperationPost, controllerOptions,
+				func(opts controller.Options) (controller.Controller, error) {
+					return NewInvokeAction(opts, resourceOptions, ucpClient, handler)
+				}))
 		})
 	})
 
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// schemaFilter is an update filter that is passed the schema of the API version the resource is stored in. The schema
+// is nil when the API version doesn't declare one.
+type schemaFilter func(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	options *controller.Options,
+	schemaData map[string]any,
+) (rest.Response, error)
+
+// makeSchemaFilter creates an UpdateFilter that fetches the schema of the API version the resource is stored in once
+// per request, and runs the given filters in order with it. The first filter returning a response or an error stops
+// the request.
+//
+// The filter must run after the conversion filter, so that the schema of the API version the resource is stored in is
+// used.
+func makeSchemaFilter(ucpClient *v20231001preview.ClientFactory, filters ...schemaFilter) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		resourceType := serviceCtx.ResourceID.Type()
+		apiVersion := serviceCtx.APIVersion
+		if newResource.InternalMetadata.UpdatedAPIVersion != "" {
+			apiVersion = newResource.InternalMetadata.UpdatedAPIVersion
+		}
+
+		schemaData, err := schema.GetSchema(ctx, ucpClient, serviceCtx.ResourceID.String(), resourceType, apiVersion)
+		if err != nil {
+			logger.Error(err, "Failed to fetch schema", "resourceType", resourceType, "apiVersion", apiVersion)
+			return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInternal,
+					Message: "Failed to fetch the schema of the resource type",
+				},
+			}), nil
+		}
+
+		for _, filter := range filters {
+			if resp, err := filter(ctx, newResource, oldResource, options, schemaData); resp != nil || err != nil {
+				return resp, err
+			}
+		}
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeSchemaFilter_FetchesSchemaOnce(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{"type": "string"},
+		},
+	}
+
+	calls := &atomic.Int32{}
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			calls.Add(1)
+			resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Properties: &v20231001preview.APIVersionProperties{Schema: schema},
+				},
+			}, nil)
+			return
+		},
+	}
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+	require.NoError(t, err)
+
+	schemas := []map[string]any{}
+	recordSchema := func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options, schemaData map[string]any) (rest.Response, error) {
+		schemas = append(schemas, schemaData)
+		return nil, nil
+	}
+
+	filter := makeSchemaFilter(ucpClient, recordSchema, recordSchema)
+	response, err := filter(createTestContext(), &datamodel.DynamicResource{}, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, []map[string]any{schema, schema}, schemas)
+}
+
+func TestMakeSchemaFilter_StopsAtFirstResponse(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{"type": "object"})
+	require.NoError(t, err)
+
+	reject := func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options, schemaData map[string]any) (rest.Response, error) {
+		return rest.NewConflictResponse("rejected"), nil
+	}
+	called := false
+	next := func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options, schemaData map[string]any) (rest.Response, error) {
+		called = true
+		return nil, nil
+	}
+
+	filter := makeSchemaFilter(ucpClient, reject, next)
+	response, err := filter(createTestContext(), &datamodel.DynamicResource{}, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.IsType(t, &rest.ConflictResponse{}, response)
+	require.False(t, called)
+}
+
+func TestMakeSchemaFilter_FetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	called := false
+	next := func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options, schemaData map[string]any) (rest.Response, error) {
+		called = true
+		return nil, nil
+	}
+
+	filter := makeSchemaFilter(ucpClient, next)
+	response, err := filter(createTestContext(), &datamodel.DynamicResource{}, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.IsType(t, &rest.InternalServerErrorResponse{}, response)
+	require.False(t, called)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/go-chi/chi/v5"
@@ -84,6 +85,7 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 		PathBase:       s.options.Config.Server.PathBase,
 		DatabaseClient: databaseClient,
 		StatusManager:  s.options.StatusManager,
+		LockChecker:    locks.NewChecker(databaseClient),
 
 		KubeClient:   nil, // Unused by DynamicRP
 		ResourceType: "",  // Set dynamically
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// makeValidationRulesFilter creates a schemaFilter that evaluates the CEL validation rules declared in the
+// x-radius-validations extension of the resource type schema.
+//
+// Rules are evaluated against the resource and, on update, the existing resource. The filter must run after the
+// defaults filter, so that rules see the effective configuration, and before the encryption filter, so that rules
+// see the plain values of the new resource. Sensitive fields of the existing resource are stored encrypted, so they
+// are decrypted with the handler before the rules see them.
+func makeValidationRulesFilter(handler *encryption.SensitiveDataHandler) schemaFilter {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+		schemaData map[string]any,
+	) (rest.Response, error) {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		// The new resource can have encrypted values copied from the stored resource by the conversion filter.
+		newProperties, err := decryptedProperties(ctx, newResource, schemaData, handler)
+		if err != nil {
+			logger.Error(err, "Failed to decrypt sensitive fields", "resourceID", serviceCtx.ResourceID.String())
+			return newDecryptionFailedResponse(), nil
+		}
+
+		var oldProperties map[string]any
+		if oldResource != nil {
+			oldProperties, err = decryptedProperties(ctx, oldResource, schemaData, handler)
+			if err != nil {
+				logger.Error(err, "Failed to decrypt sensitive fields", "resourceID", serviceCtx.ResourceID.String())
+				return newDecryptionFailedResponse(), nil
+			}
+
+			if oldProperties == nil {
+				oldProperties = map[string]any{}
+			}
+		}
+
+		err = schema.ValidateResourceRules(ctx, newProperties, oldProperties, schemaData)
+		if err == nil {
+			return nil, nil
+		}
+
+		validationErrs := &schema.ValidationErrors{}
+		if !errors.As(err, &validationErrs) {
+			return nil, err
+		}
+
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Message: fmt.Sprintf("Validation rules failed: %v", err),
+			},
+		}), nil
+	}
+}
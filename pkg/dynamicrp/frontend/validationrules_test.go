@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func testValidationRulesSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{"type": "integer"},
+		},
+		"x-radius-validations": []any{
+			map[string]any{
+				"rule":    "self.size <= 10",
+				"message": "size must be at most 10",
+			},
+			map[string]any{
+				"rule":    "self.size >= oldSelf.size",
+				"message": "size cannot decrease",
+			},
+		},
+	}
+}
+
+func TestMakeValidationRulesFilter(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(testValidationRulesSchema())
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeValidationRulesFilter(nil))
+
+	newResource := func(size float64) *datamodel.DynamicResource {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"size": size}
+		return resource
+	}
+
+	t.Run("create - valid", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource(5), nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("create - rule fails", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource(20), nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Contains(t, response.(*rest.BadRequestResponse).Body.Error.Message, "size must be at most 10")
+	})
+
+	t.Run("update - valid", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource(5), newResource(3), nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("update - transition rule fails", func(t *testing.T) {
+		response, err := filter(createTestContext(), newResource(3), newResource(5), nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Contains(t, response.(*rest.BadRequestResponse).Body.Error.Message, "size cannot decrease")
+	})
+}
+
+func TestMakeValidationRulesFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient, makeValidationRulesFilter(nil))
+
+	resource := &datamodel.DynamicResource{}
+	resource.Properties = map[string]any{"size": float64(1)}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
+
+func TestMakeValidationRulesFilter_SensitiveFields(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"password": map[string]any{
+				"type":               "string",
+				"x-radius-sensitive": true,
+			},
+		},
+		"x-radius-validations": []any{
+			map[string]any{
+				"rule":    "self.password == oldSelf.password",
+				"message": "password cannot be changed",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	handler := createTestHandler(t)
+	filter := makeSchemaFilter(ucpClient, makeValidationRulesFilter(handler))
+
+	// The stored resource has the encrypted value of the field.
+	oldResource := &datamodel.DynamicResource{}
+	oldResource.Properties = map[string]any{"password": "secret123"}
+	response, err := makeSchemaFilter(ucpClient, makeEncryptionFilter(handler))(createTestContext(), oldResource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+
+	t.Run("same value", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"password": "secret123"}
+
+		response, err := filter(createTestContext(), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("changed value", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{}
+		resource.Properties = map[string]any{"password": "secret456"}
+
+		response, err := filter(createTestContext(), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Contains(t, response.(*rest.BadRequestResponse).Body.Error.Message, "password cannot be changed")
+	})
+}
@@ -17,12 +17,15 @@ limitations under the License.
 package dynamic
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	componenttesthost "github.com/radius-project/radius/pkg/components/testhost"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -129,6 +132,76 @@ func Test_Dynamic_Resource_Inert_Lifecycle(t *testing.T) {
 	response.EqualsErrorCode(404, v1.CodeNotFound)
 }
 
+// Test_Dynamic_Resource_Inert_Action tests invoking an action implemented by an HTTP callback on a dynamic resource.
+func Test_Dynamic_Resource_Inert_Action(t *testing.T) {
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		input, _ := body["input"].(map[string]any)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"restarted": input["force"]})
+	}))
+	defer callback.Close()
+
+	_, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceTypeWithActions(ucp, map[string]*v20231001preview.ResourceTypeAction{
+		"restart": {
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"force": map[string]any{"type": "boolean"},
+				},
+			},
+			Callback: &v20231001preview.ActionCallback{URL: to.Ptr(callback.URL)},
+		},
+	})
+	createAPIVersion(ucp, inertResourceTypeName, nil)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"foo": "bar",
+		},
+	}
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	// Invalid input is rejected by the frontend.
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/restart?api-version="+apiVersion, map[string]any{"force": "yes"})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalidRequestContent)
+
+	// Undeclared actions are rejected by the frontend.
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/backup?api-version="+apiVersion, map[string]any{})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/restart?api-version="+apiVersion, map[string]any{"force": true})
+	require.Equal(t, http.StatusAccepted, response.Raw.StatusCode)
+	response.WaitForOperationComplete(nil)
+
+	// The output of the action is recorded in the status of the resource.
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	body := map[string]any{}
+	response.ReadAs(&body)
+
+	properties := body["properties"].(map[string]any)
+	actions := properties["status"].(map[string]any)["actions"].(map[string]any)
+	restart := actions["restart"].(map[string]any)
+	require.Equal(t, "Succeeded", restart["state"])
+	require.Equal(t, map[string]any{"restarted": true}, restart["output"])
+	require.NotEmpty(t, restart["operationId"])
+
+	// The input of the action is never returned.
+	require.NotContains(t, restart, "input")
+}
+
 // Test_Dynamic_Resource_Inert_Schema_Validation_Failure tests that schema validation fails as expected
 // when a resource does not conform to the defined schema.
 func Test_Dynamic_Resource_Inert_Schema_Validation_Failure(t *testing.T) {
@@ -306,6 +379,13 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 					"port":     float64(8080), // This is an artifact of the JSON unmarshal process. It's wierd but intended.
 					"hostname": "example.com",
 				},
+				"conditions": []any{
+					map[string]any{
+						"type":   "Ready",
+						"status": "True",
+						"reason": "RecipeSucceeded",
+					},
+				},
 				"secrets": map[string]any{
 					"password": map[string]any{
 						"Value": "v3ryS3cr3t",
@@ -337,14 +417,17 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 
 	// GET (single)
 	response = ucp.MakeRequest(http.MethodGet, testRecipeResourceURL, nil)
+	removeTransitionTimes(t, response)
 	response.EqualsValue(200, expectedResource)
 
 	// GET (list at plane-scope)
 	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleRecipeResources"+"?api-version="+apiVersion, nil)
+	removeTransitionTimes(t, response)
 	response.EqualsValue(200, expectedList)
 
 	// GET (list at resourcegroup-scope)
 	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/providers/Applications.Test/exampleRecipeResources"+"?api-version="+apiVersion, nil)
+	removeTransitionTimes(t, response)
 	response.EqualsValue(200, expectedList)
 
 	// Now lets delete the resource
@@ -356,6 +439,40 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	response.EqualsErrorCode(404, v1.CodeNotFound)
 }
 
+// removeTransitionTimes removes the lastTransitionTime of the status conditions from the response body, since it
+// varies between runs.
+func removeTransitionTimes(t *testing.T, response *componenttesthost.TestResponse) {
+	var body any
+	err := json.Unmarshal(response.Body.Bytes(), &body)
+	require.NoError(t, err)
+
+	var walk func(value any)
+	walk = func(value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			if conditions, ok := v["conditions"].([]any); ok {
+				for _, condition := range conditions {
+					condition := condition.(map[string]any)
+					require.NotEmpty(t, condition["lastTransitionTime"], "condition is missing lastTransitionTime")
+					delete(condition, "lastTransitionTime")
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(body)
+
+	b, err := json.Marshal(body)
+	require.NoError(t, err)
+	response.Body = bytes.NewBuffer(b)
+}
+
 func createRadiusPlane(server *ucptesthost.TestHost) v20231001preview.RadiusPlanesClientCreateOrUpdateResponse {
 	ctx := context.Background()
 
@@ -414,6 +531,26 @@ func createInertResourceType(server *ucptesthost.TestHost) {
 	require.NoError(server.T(), err)
 }
 
+func createInertResourceTypeWithActions(server *ucptesthost.TestHost, actions map[string]*v20231001preview.ResourceTypeAction) {
+	ctx := context.Background()
+
+	resourceType := v20231001preview.ResourceTypeResource{
+		Properties: &v20231001preview.ResourceTypeProperties{
+			Capabilities: []*string{
+				to.Ptr(datamodel.CapabilityManualResourceProvisioning),
+			},
+			Actions: actions,
+		},
+	}
+
+	client := server.UCP().NewResourceTypesClient()
+	poller, err := client.BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, inertResourceTypeName, resourceType, nil)
+	require.NoError(server.T(), err)
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	require.NoError(server.T(), err)
+}
+
 func createRecipeResourceType(server *ucptesthost.TestHost) {
 	ctx := context.Background()
 
@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend"
+	"github.com/radius-project/radius/pkg/dynamicrp/backend/health"
 	"github.com/radius-project/radius/pkg/dynamicrp/frontend"
 )
 
@@ -48,6 +49,11 @@ func NewServer(options *dynamicrp.Options) (*hosting.Host, error) {
 	services = append(services, frontend.NewService(options))
 	services = append(services, backend.NewService(options))
 
+	// Conditions of dynamic resources are refreshed from the live health of their output resources via a service.
+	if options.Config.Health.Enabled {
+		services = append(services, health.NewRefresher(options))
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
@@ -27,6 +27,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
@@ -179,6 +180,19 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 
 	if config.Simulated {
 		logger.Info("The recipe was executed in simulation mode. No resources were deployed.")
+
+		// Processors that support simulation populate the resource from the simulated recipe output, so that
+		// connections to the resource can be exercised without deploying anything.
+		simulator, ok := c.processor.(processors.SimulatedResourceProcessor[P, T])
+		if ok && recipeOutput != nil && recipeOutput.Simulated {
+			err = simulator.ProcessSimulated(ctx, resource, processors.Options{RecipeOutput: recipeOutput, RuntimeConfiguration: config.Runtime, UcpClient: c.BaseController.UcpClient()})
+			if err != nil {
+				if redactionCompleted {
+					return ctrl.NewFailedResult(v1.ErrorDetails{Message: err.Error()}), err
+				}
+				return ctrl.Result{}, err
+			}
+		}
 	} else {
 		// Now we're ready to process the resource. This will handle the updates to any user-visible state.
 		err = c.processor.Process(ctx, resource, processors.Options{RecipeOutput: recipeOutput, RuntimeConfiguration: config.Runtime, UcpClient: c.BaseController.UcpClient()})
@@ -254,9 +268,14 @@ func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, er
 	return ctrl.Result{}, err
 }
 
+// copyOutputResources returns the IDs of the output resources that are garbage collected when the recipe no longer
+// deploys them. Resources that are not managed by Radius, like imported resources, are never garbage collected.
 func (c *CreateOrUpdateResource[P, T]) copyOutputResources(resource P) []string {
 	previousOutputResources := []string{}
 	for _, outputResource := range resource.OutputResources() {
+		if outputResource.RadiusManaged != nil && !*outputResource.RadiusManaged {
+			continue
+		}
 		previousOutputResources = append(previousOutputResources, outputResource.ID.String())
 	}
 	return previousOutputResources
@@ -295,17 +314,24 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 			return nil, fmt.Errorf("failed to get metadata from connected resource %s: %w", connectedResourceID, err)
 		}
 
+		connectedResourceOutputs, err := resourceutil.GetRecipeOutputsFromResource(connectedResource.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recipe outputs from connected resource %s: %w", connectedResourceID, err)
+		}
+
 		connectedResourcesMetadata[connName] = recipes.ConnectedResource{
 			ID:         connectedResourceMetadata.ID,
 			Name:       connectedResourceMetadata.Name,
 			Type:       connectedResourceMetadata.Type,
 			Properties: connectedResourceMetadata.Properties,
+			Outputs:    connectedResourceOutputs,
 		}
 	}
 
 	metadata := recipes.ResourceMetadata{
 		Name:                         recipe.Name,
 		Parameters:                   recipe.Parameters,
+		Imports:                      getRecipeImports(recipe.Import),
 		EnvironmentID:                resource.ResourceMetadata().EnvironmentID(),
 		ApplicationID:                resource.ResourceMetadata().ApplicationID(),
 		ResourceID:                   resource.GetBaseResource().ID,
@@ -326,6 +352,25 @@ func getResourceAPIVersion[P rpv1.RadiusResourceModel](resource P) string {
 	return resource.GetBaseResource().InternalMetadata.UpdatedAPIVersion
 }
 
+// getRecipeImports converts the resources to import specified on the resource recipe into the recipe engine format.
+func getRecipeImports(imports []portableresources.ResourceImport) []recipes.ImportResource {
+	if len(imports) == 0 {
+		return nil
+	}
+
+	result := make([]recipes.ImportResource, 0, len(imports))
+	for _, imported := range imports {
+		result = append(result, recipes.ImportResource{
+			ID:            imported.ID,
+			Address:       imported.Address,
+			ImportID:      imported.ImportID,
+			RadiusManaged: imported.RadiusManaged,
+		})
+	}
+
+	return result
+}
+
 func deepCopyProperties(source map[string]any) (map[string]any, error) {
 	if source == nil {
 		return map[string]any{}, nil
@@ -146,10 +146,23 @@ var errProcessor = errors.New("processor error")
 var errConfiguration = errors.New("configuration error")
 
 var oldOutputResourceResourceID = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Systems.Test/testResources/test1"
+var importedResourceID = "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders"
 
 var newOutputResourceResourceID = "/subscriptions/test-sub/resourceGroups/test-rg/providers/Systems.Test/testResources/test2"
 var newOutputResource = rpv1.OutputResource{ID: resources.MustParse(newOutputResourceResourceID)}
 
+type SimulatedProcessor struct {
+	SuccessProcessor
+	simulatedOutput *recipes.RecipeOutput
+}
+
+// ProcessSimulated records the simulated recipe output, and returns no error.
+func (p *SimulatedProcessor) ProcessSimulated(ctx context.Context, data *TestResource, options processors.Options) error {
+	p.simulatedOutput = options.RecipeOutput
+	data.Properties.IsProcessed = true
+	return nil
+}
+
 func TestCreateOrUpdateResource_Run(t *testing.T) {
 	setupTest := func() (*database.MockClient, *engine.MockEngine, *processors.MockResourceClient, *configloader.MockConfigurationLoader) {
 		mctrl := gomock.NewController(t)
@@ -313,6 +326,10 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 							{
 								"id": oldOutputResourceResourceID,
 							},
+							{
+								"id":            importedResourceID,
+								"radiusManaged": false,
+							},
 						},
 					},
 					"recipe": map[string]any{
@@ -320,6 +337,13 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 						"parameters": map[string]any{
 							"p1": "v1",
 						},
+						"import": []any{
+							map[string]any{
+								"id":       importedResourceID,
+								"address":  "aws_db_instance.db",
+								"importId": "orders",
+							},
+						},
 					},
 				},
 			}
@@ -360,6 +384,10 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 								{
 									ID: resources.MustParse(oldOutputResourceResourceID),
 								},
+								{
+									ID:            resources.MustParse(importedResourceID),
+									RadiusManaged: new(false),
+								},
 							},
 						},
 					},
@@ -369,6 +397,9 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 						Parameters: map[string]any{
 							"p1": "v1",
 						},
+						Import: []portableresources.ResourceImport{
+							{ID: importedResourceID, Address: "aws_db_instance.db", ImportID: "orders"},
+						},
 					},
 				},
 			}
@@ -383,10 +414,14 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 				Parameters: map[string]any{
 					"p1": "v1",
 				},
+				Imports: []recipes.ImportResource{
+					{ID: importedResourceID, Address: "aws_db_instance.db", ImportID: "orders"},
+				},
 				Properties:                   properties,
 				ConnectedResourcesProperties: map[string]recipes.ConnectedResource{},
 			}
 
+			// The imported resource is not managed by Radius, so it's never garbage collected.
 			prevState := []string{
 				oldOutputResourceResourceID,
 			}
@@ -487,6 +522,77 @@ func TestCreateOrUpdateResource_Run(t *testing.T) {
 	}
 }
 
+func TestCreateOrUpdateResource_Run_Simulated(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	msc := database.NewMockClient(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+	cfg := configloader.NewMockConfigurationLoader(mctrl)
+
+	req := &ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+		ResourceID:       TestResourceID,
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+	}
+
+	data := map[string]any{
+		"name":     "tr",
+		"type":     "Applications.Test/testResources",
+		"id":       TestResourceID,
+		"location": v1.LocationGlobal,
+		"properties": map[string]any{
+			"application":       TestApplicationID,
+			"environment":       TestEnvironmentID,
+			"provisioningState": "Accepted",
+			"recipe": map[string]any{
+				"name": "test-recipe",
+			},
+		},
+	}
+
+	simulatedOutput := &recipes.RecipeOutput{
+		Values:    map[string]any{"host": "simulated-host"},
+		Secrets:   map[string]any{},
+		Simulated: true,
+	}
+
+	msc.EXPECT().
+		Get(gomock.Any(), TestResourceID).
+		Return(&database.Object{Data: data}, nil).
+		Times(1)
+	cfg.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{Simulated: true}, nil).
+		Times(1)
+	eng.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(simulatedOutput, nil).
+		Times(1)
+
+	var saved *TestResource
+	msc.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved = obj.Data.(*TestResource)
+			return nil
+		}).
+		Times(1)
+
+	processor := &SimulatedProcessor{}
+	genCtrl, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: msc}, processors.ResourceProcessor[*TestResource, TestResource](processor), eng, cfg)
+	require.NoError(t, err)
+
+	res, err := genCtrl.Run(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, ctrl.Result{}, res)
+
+	require.Equal(t, simulatedOutput, processor.simulatedOutput)
+	require.NotNil(t, saved)
+	require.True(t, saved.Properties.IsProcessed)
+	require.Empty(t, saved.Properties.Status.OutputResources)
+}
+
 func TestCreateOrUpdateResource_Run_SensitiveRedaction(t *testing.T) {
 	mctrl := gomock.NewController(t)
 	msc := database.NewMockClient(mctrl)
@@ -88,6 +88,7 @@ func (c *DeleteResource[P, T]) Run(ctx context.Context, request *ctrl.Request) (
 			EnvironmentID: data.ResourceMetadata().EnvironmentID(),
 			ApplicationID: data.ResourceMetadata().ApplicationID(),
 			Parameters:    recipeDataModel.GetRecipe().Parameters,
+			Imports:       getRecipeImports(recipeDataModel.GetRecipe().Import),
 			ResourceID:    id.String(),
 			Properties:    resourceProperties,
 		}
@@ -26,6 +26,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/portableresources"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
@@ -182,3 +183,93 @@ func TestDeleteResourceRun_20231001Preview(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteResourceRun_ImportedResources(t *testing.T) {
+	tests := []struct {
+		name          string
+		radiusManaged bool
+	}{
+		{
+			// The imported resource is kept by the recipe driver.
+			name:          "kept by default",
+			radiusManaged: false,
+		},
+		{
+			// The imported resource is deleted by the recipe driver.
+			name:          "managed by radius",
+			radiusManaged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			msc := database.NewMockClient(mctrl)
+			eng := engine.NewMockEngine(mctrl)
+			configLoader := configloader.NewMockConfigurationLoader(mctrl)
+
+			// The imported resource is recorded as an output resource that is managed by Radius only when opted in.
+			importedOutputResource := rpv1.OutputResource{
+				ID:            resources.MustParse(importedResourceID),
+				RadiusManaged: new(tt.radiusManaged),
+			}
+			testResource := &TestResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   TestResourceID,
+						Name: "tr",
+						Type: "Applications.Test/testResources",
+					},
+				},
+				Properties: TestResourceProperties{
+					BasicResourceProperties: rpv1.BasicResourceProperties{
+						Application: TestApplicationID,
+						Environment: TestEnvironmentID,
+						Status: rpv1.ResourceStatus{
+							OutputResources: []rpv1.OutputResource{outputResource, importedOutputResource},
+						},
+					},
+					Recipe: portableresources.ResourceRecipe{
+						Name: "default",
+						Import: []portableresources.ResourceImport{
+							{ID: importedResourceID, Address: "aws_db_instance.db", ImportID: "orders", RadiusManaged: tt.radiusManaged},
+						},
+					},
+				},
+			}
+
+			msc.EXPECT().
+				Get(gomock.Any(), TestResourceID).
+				Return(&database.Object{Data: testResource}, nil).
+				Times(1)
+
+			eng.EXPECT().
+				Delete(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, opts engine.DeleteOptions) error {
+					require.Equal(t, []recipes.ImportResource{
+						{ID: importedResourceID, Address: "aws_db_instance.db", ImportID: "orders", RadiusManaged: tt.radiusManaged},
+					}, opts.Recipe.Imports)
+					require.Equal(t, []rpv1.OutputResource{outputResource, importedOutputResource}, opts.OutputResources)
+					return nil
+				}).
+				Times(1)
+
+			configLoader.EXPECT().
+				LoadConfiguration(gomock.Any(), gomock.Any()).
+				Return(&recipes.Configuration{Runtime: recipes.RuntimeConfiguration{Kubernetes: &recipes.KubernetesRuntime{Namespace: "test-namespace"}}}, nil).
+				Times(1)
+
+			msc.EXPECT().
+				Delete(gomock.Any(), TestResourceID).
+				Return(nil).
+				Times(1)
+
+			c, err := NewDeleteResource(ctrl.Options{DatabaseClient: msc}, successProcessorReference, eng, configLoader)
+			require.NoError(t, err)
+
+			result, err := c.Run(context.Background(), &ctrl.Request{ResourceID: TestResourceID})
+			require.NoError(t, err)
+			require.Equal(t, ctrl.Result{}, result)
+		})
+	}
+}
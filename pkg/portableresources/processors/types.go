@@ -40,6 +40,18 @@ type ResourceProcessor[P interface {
 	Delete(ctx context.Context, resource P, options Options) error
 }
 
+// SimulatedResourceProcessor is implemented by resource processors that can process the output synthesized by a recipe
+// executed in a simulated environment. Resource processors that do not implement it are skipped in simulated
+// environments.
+type SimulatedResourceProcessor[P interface {
+	*T
+	rpv1.RadiusResourceModel
+}, T any] interface {
+	// ProcessSimulated is called instead of Process in simulated environments. The recipe output is marked as
+	// simulated, and contains the outputs declared by the recipe when they are known.
+	ProcessSimulated(ctx context.Context, resource P, options Options) error
+}
+
 // Options defines the options passed to the resource processor.
 type Options struct {
 	// RuntimeConfiguration represents the configuration of the target runtime.
@@ -47,7 +47,8 @@ func GetOutputResourcesFromResourcesField(field []*portableresources.ResourceRef
 }
 
 // GetOutputResourcesFromRecipe parses the output resources from a recipe and returns a slice of OutputResource objects,
-// returning an error if any of the resources are invalid.
+// returning an error if any of the resources are invalid. The resources are managed by Radius unless the recipe reports
+// them as unmanaged.
 func GetOutputResourcesFromRecipe(output *recipes.RecipeOutput) ([]rpv1.OutputResource, error) {
 	results := []rpv1.OutputResource{}
 	for _, resource := range output.Resources {
@@ -58,7 +59,7 @@ func GetOutputResourcesFromRecipe(output *recipes.RecipeOutput) ([]rpv1.OutputRe
 
 		result := rpv1.OutputResource{
 			ID:            id,
-			RadiusManaged: new(true),
+			RadiusManaged: new(!output.IsUnmanaged(resource)),
 		}
 
 		results = append(results, result)
@@ -88,6 +88,33 @@ func Test_GetOutputResourcesFromRecipe(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func Test_GetOutputResourcesFromRecipe_Unmanaged(t *testing.T) {
+	output := recipes.RecipeOutput{
+		Resources: []string{
+			"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Cache/redis/test-resource1",
+			"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders",
+		},
+		UnmanagedResources: []string{
+			"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/ORDERS",
+		},
+	}
+
+	expected := []rpv1.OutputResource{
+		{
+			ID:            resources.MustParse(output.Resources[0]),
+			RadiusManaged: new(true),
+		},
+		{
+			ID:            resources.MustParse(output.Resources[1]),
+			RadiusManaged: new(false),
+		},
+	}
+
+	actual, err := GetOutputResourcesFromRecipe(&output)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
 func Test_GetOutputResourcesFromRecipe_Invalid(t *testing.T) {
 	output := recipes.RecipeOutput{
 		Resources: []string{
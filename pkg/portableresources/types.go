@@ -58,6 +58,22 @@ type ResourceRecipe struct {
 	Parameters map[string]any `json:"parameters,omitempty"`
 	// DeploymentStatus is the deployment status of the recipe
 	DeploymentStatus util.RecipeDeploymentStatus `json:"recipeStatus,omitempty"`
+	// Import is the list of existing resources to adopt into the recipe deployment instead of creating them
+	Import []ResourceImport `json:"import,omitempty"`
+}
+
+// ResourceImport is an existing resource that is adopted by the recipe of a resource, so that it's deployed by the
+// recipe without being recreated.
+type ResourceImport struct {
+	// ID is the fully qualified resource ID of the existing resource
+	ID string `json:"id"`
+	// Address is the address of the resource within the Terraform recipe module. Required for Terraform recipes.
+	Address string `json:"address,omitempty"`
+	// ImportID is the provider-specific identifier used to import the resource with Terraform. Required for Terraform recipes.
+	ImportID string `json:"importId,omitempty"`
+	// RadiusManaged opts in to Radius managing the lifecycle of the resource, so that it's deleted with the resource.
+	// Imported resources are kept when the resource is deleted by default.
+	RadiusManaged bool `json:"radiusManaged,omitempty"`
 }
 
 // ResourceReference represents a reference to a resource that was deployed by the user
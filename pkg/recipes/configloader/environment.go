@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"maps"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -28,11 +27,13 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipepacks"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/kube"
 	"github.com/radius-project/radius/pkg/rp/util"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
 var (
@@ -190,6 +191,10 @@ func getConfigurationV20250801(ctx context.Context, environment *v20250801previe
 		config.Simulated = true
 	}
 
+	if envDatamodel.Properties.TrustPolicy != nil {
+		config.TrustPolicy = &recipes.TrustPolicy{PublicKeys: envDatamodel.Properties.TrustPolicy.PublicKeys}
+	}
+
 	// Resolve TerraformConfig resource if referenced.
 	if envDatamodel.Properties.TerraformConfig != "" {
 		tfConfig, err := util.FetchTerraformConfig(ctx, envDatamodel.Properties.TerraformConfig, armOptions)
@@ -347,90 +352,43 @@ func getRecipeDefinitionFromEnvironmentV20250801(ctx context.Context, environmen
 		return nil, recipes.NewRecipeError(recipes.RecipeValidationFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
-	// First try to find recipe in environment's recipe packs
 	env, err := environment.ConvertTo()
 	if err != nil {
 		return nil, err
 	}
 	envDatamodel := env.(*datamodel.Environment_v20250801preview)
 
-	if envDatamodel.Properties.RecipePacks != nil {
-		recipeDefinition, err := fetchRecipeDefinition(ctx, envDatamodel.Properties.RecipePacks, armOptions, resource.Type())
-		if err != nil {
-			return nil, err
-		}
-
-		// Reconcile parameters from recipe pack and environment-level recipe parameters
-		parameters := reconcileRecipeParameters(recipeDefinition.Parameters, envDatamodel.Properties.RecipeParameters, resource.Type())
-
-		// TODO: For now, we can set "Name" to default as recipe packs don't have named recipes.
-		// We will remove this field from EnvironmentDefinition once we deprecate Applications.Core.
-		definition := &recipes.EnvironmentDefinition{
-			Name:         "default",
-			Driver:       recipeDefinition.RecipeKind,
-			ResourceType: resource.Type(),
-			Parameters:   parameters,
-			TemplatePath: recipeDefinition.RecipeLocation,
-			PlainHTTP:    recipeDefinition.PlainHTTP,
-		}
-		return definition, nil
-	}
-
-	return nil, fmt.Errorf("could not find any recipe pack for %q in environment %q", resource.Type(), recipe.EnvironmentID)
-}
-
-// fetchRecipeDefinition fetches recipe pack resources from the given recipe pack IDs and returns
-// the recipe definition from the first recipe pack that has a recipe defined for the specified resource type.
-// There cannot be more than one recipe pack with a recipe definition for the same resource type as part of an environment.
-func fetchRecipeDefinition(ctx context.Context, recipePackIDs []string, armOptions *arm.ClientOptions, resourceType string) (*recipes.RecipeDefinition, error) {
-	if recipePackIDs == nil {
-		return nil, fmt.Errorf("no recipe packs configured")
+	packs, err := LoadRecipePacks(ctx, envDatamodel, armOptions)
+	if err != nil {
+		return nil, err
 	}
 
-	if resourceType == "" {
-		return nil, fmt.Errorf("resource type cannot be empty")
+	resolution, err := recipepacks.Resolve(envDatamodel, packs)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, recipePackID := range recipePackIDs {
-		recipePackResource, err := FetchRecipePack(ctx, recipePackID, armOptions)
-		if err != nil {
-			return nil, err
-		}
-
-		// Convert recipes map
-		for recipePackResourceType, definition := range recipePackResource.Properties.Recipes {
-			if strings.EqualFold(recipePackResourceType, resourceType) {
-				var plainHTTP bool
-				if definition.PlainHTTP != nil {
-					plainHTTP = *definition.PlainHTTP
-				}
-				return &recipes.RecipeDefinition{
-					RecipeKind:     string(*definition.RecipeKind),
-					RecipeLocation: string(*definition.RecipeLocation),
-					Parameters:     definition.Parameters,
-					PlainHTTP:      plainHTTP,
-				}, nil
-			}
-		}
+	resolved, err := resolution.Find(resource.Type())
+	if errors.Is(err, recipepacks.ErrConflict) {
+		return nil, recipes.NewRecipeError(recipes.RecipeValidationFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	} else if err != nil {
+		err := fmt.Errorf("could not find any recipe pack for %q in environment %q", resource.Type(), recipe.EnvironmentID)
+		return nil, recipes.NewRecipeError(recipes.RecipeNotFoundFailure, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
 	}
 
-	return nil, fmt.Errorf("no recipe pack found with recipe for resource type %q", resourceType)
-}
-
-// reconcileRecipeParameters merges recipe pack parameters with environment-level recipe parameters.
-// Environment-level parameters override recipe pack parameters when the same key exists.
-func reconcileRecipeParameters(recipePackParams map[string]any, envRecipeParams map[string]map[string]any, resourceType string) map[string]any {
-	parameters := make(map[string]any)
-
-	// Start with recipe pack parameters
-	maps.Copy(parameters, recipePackParams)
-
-	// Override with environment-level recipe parameters for this resource type
-	if envRecipeParams != nil {
-		if params, ok := envRecipeParams[resourceType]; ok {
-			maps.Copy(parameters, params)
-		}
+	if len(resolved.Ignored) > 0 {
+		ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("Resource type %q is defined in multiple recipe packs of environment %q, using the recipe of %q and ignoring the recipes of %s", resource.Type(), recipe.EnvironmentID, resolved.RecipePackID, strings.Join(resolved.Ignored, ", ")))
 	}
 
-	return parameters
+	// TODO: For now, we can set "Name" to default as recipe packs don't have named recipes.
+	// We will remove this field from EnvironmentDefinition once we deprecate Applications.Core.
+	return &recipes.EnvironmentDefinition{
+		Name:          "default",
+		Driver:        resolved.Definition.RecipeKind,
+		ResourceType:  resource.Type(),
+		Parameters:    resolved.Definition.Parameters,
+		TemplatePath:  resolved.Definition.RecipeLocation,
+		PlainHTTP:     resolved.Definition.PlainHTTP,
+		TrustPolicies: resolved.Definition.TrustPolicies,
+	}, nil
 }
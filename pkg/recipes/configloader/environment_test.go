@@ -18,11 +18,17 @@ package configloader
 
 import (
 	"context"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	model "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	modelv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview/fake"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/to"
@@ -37,6 +43,7 @@ const (
 	appResourceId   = "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.Core/applications/app0"
 	azureScope      = "/subscriptions/test-sub/resourceGroups/testRG"
 	awsScope        = "/planes/aws/aws/accounts/000/regions/cool-region"
+	envRecipePackID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/recipePacks/kubernetes-pack"
 	mongoResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/mongoDatabases/mongo-database-0"
 	redisID         = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis-0"
 
@@ -541,6 +548,29 @@ func TestGetConfigurationV20250801(t *testing.T) {
 				Simulated: false,
 			},
 		},
+		{
+			name: "environment with trust policy v20250801",
+			envResource: &modelv20250801.EnvironmentResource{
+				Properties: &modelv20250801.EnvironmentProperties{
+					Providers: &modelv20250801.Providers{
+						Kubernetes: &modelv20250801.ProvidersKubernetes{
+							Namespace: new(envNamespace),
+						},
+					},
+					TrustPolicy: &modelv20250801.RecipeTrustPolicy{PublicKeys: []*string{new("env-public-key")}},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				TrustPolicy: &recipes.TrustPolicy{PublicKeys: []string{"env-public-key"}},
+			},
+		},
 	}
 
 	for _, tc := range configTests {
@@ -562,6 +592,7 @@ func TestGetRecipeDefinitionFromEnvironmentV20250801(t *testing.T) {
 	armOptions := &arm.ClientOptions{}
 
 	envResource := &modelv20250801.EnvironmentResource{
+		ID: new("/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/environments/env0"),
 		Properties: &modelv20250801.EnvironmentProperties{
 			Providers: &modelv20250801.Providers{
 				Kubernetes: &modelv20250801.ProvidersKubernetes{
@@ -569,7 +600,7 @@ func TestGetRecipeDefinitionFromEnvironmentV20250801(t *testing.T) {
 				},
 			},
 			RecipePacks: []*string{
-				new("/planes/radius/local/providers/Radius.Core/recipePacks/kubernetes-pack"),
+				new(envRecipePackID),
 			},
 		},
 	}
@@ -587,124 +618,195 @@ func TestGetRecipeDefinitionFromEnvironmentV20250801(t *testing.T) {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "'invalid-id' is not a valid resource id")
 	})
-}
 
-func Test_reconcileRecipeParameters(t *testing.T) {
-	tests := []struct {
-		name             string
-		recipePackParams map[string]any
-		envRecipeParams  map[string]map[string]any
-		resourceType     string
-		expected         map[string]any
+	packTrustPolicy := &modelv20250801.RecipeTrustPolicy{PublicKeys: []*string{to.Ptr("pack-public-key")}}
+	envTrustPolicy := &modelv20250801.RecipeTrustPolicy{PublicKeys: []*string{to.Ptr("env-public-key")}}
+
+	trustPolicyTests := []struct {
+		name            string
+		packTrustPolicy *modelv20250801.RecipeTrustPolicy
+		envTrustPolicy  *modelv20250801.RecipeTrustPolicy
+		expected        []recipes.TrustPolicy
 	}{
 		{
-			name: "no environment parameters - returns recipe pack parameters",
-			recipePackParams: map[string]any{
-				"param1": "value1",
-				"param2": 42,
-			},
-			envRecipeParams: nil,
-			resourceType:    "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "value1",
-				"param2": 42,
-			},
+			name:     "no trust policy",
+			expected: nil,
 		},
 		{
-			name: "environment parameters override recipe pack parameters",
-			recipePackParams: map[string]any{
-				"param1": "originalValue",
-				"param2": 42,
-			},
-			envRecipeParams: map[string]map[string]any{
-				"Radius.Compute/containers": {
-					"param1": "overriddenValue",
-				},
-			},
-			resourceType: "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "overriddenValue",
-				"param2": 42,
-			},
+			name:           "trust policy of environment",
+			envTrustPolicy: envTrustPolicy,
+			expected:       []recipes.TrustPolicy{{PublicKeys: []string{"env-public-key"}}},
 		},
 		{
-			name: "environment parameters add new parameters",
-			recipePackParams: map[string]any{
-				"param1": "value1",
-			},
-			envRecipeParams: map[string]map[string]any{
-				"Radius.Compute/containers": {
-					"param2": "value2",
-					"param3": true,
-				},
-			},
-			resourceType: "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "value1",
-				"param2": "value2",
-				"param3": true,
-			},
+			name:            "trust policy of recipe pack is required in addition to environment",
+			packTrustPolicy: packTrustPolicy,
+			envTrustPolicy:  envTrustPolicy,
+			expected:        []recipes.TrustPolicy{{PublicKeys: []string{"env-public-key"}}, {PublicKeys: []string{"pack-public-key"}}},
 		},
-		{
-			name: "environment parameters for different resource type - no override",
-			recipePackParams: map[string]any{
-				"param1": "value1",
-			},
-			envRecipeParams: map[string]map[string]any{
-				"Radius.Data/postgreSQL": {
-					"param2": "value2",
+	}
+	for _, tc := range trustPolicyTests {
+		t.Run(tc.name, func(t *testing.T) {
+			recipePacksServer := fake.RecipePacksServer{
+				Get: func(ctx context.Context, recipePackName string, options *modelv20250801.RecipePacksClientGetOptions) (resp azfake.Responder[modelv20250801.RecipePacksClientGetResponse], errResp azfake.ErrorResponder) {
+					resp.SetResponse(http.StatusOK, modelv20250801.RecipePacksClientGetResponse{
+						RecipePackResource: modelv20250801.RecipePackResource{
+							ID:   to.Ptr(envRecipePackID),
+							Name: to.Ptr(recipePackName),
+							Properties: &modelv20250801.RecipePackProperties{
+								Recipes: map[string]*modelv20250801.RecipeDefinition{
+									"Applications.Datastores/mongoDatabases": {
+										RecipeKind:     to.Ptr(modelv20250801.RecipeKindBicep),
+										RecipeLocation: to.Ptr("ghcr.io/radius-project/recipes/mongodatabases:latest"),
+									},
+								},
+								TrustPolicy: tc.packTrustPolicy,
+							},
+						},
+					}, nil)
+					return
 				},
-			},
-			resourceType: "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "value1",
-			},
-		},
-		{
-			name:             "empty recipe pack parameters with environment parameters",
-			recipePackParams: map[string]any{},
-			envRecipeParams: map[string]map[string]any{
-				"Radius.Compute/containers": {
-					"param1": "value1",
-					"param2": 42,
+				NewListByScopePager: func(options *modelv20250801.RecipePacksClientListByScopeOptions) (resp azfake.PagerResponder[modelv20250801.RecipePacksClientListByScopeResponse]) {
+					resp.AddPage(http.StatusOK, modelv20250801.RecipePacksClientListByScopeResponse{}, nil)
+					return
 				},
-			},
-			resourceType: "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "value1",
-				"param2": 42,
-			},
-		},
-		{
-			name:             "nil recipe pack parameters with environment parameters",
-			recipePackParams: nil,
-			envRecipeParams: map[string]map[string]any{
-				"Radius.Compute/containers": {
-					"param1": "value1",
+			}
+			options := &armpolicy.ClientOptions{
+				ClientOptions: policy.ClientOptions{
+					Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{RecipePacksServer: recipePacksServer}),
+				},
+			}
+
+			env := *envResource
+			properties := *envResource.Properties
+			properties.TrustPolicy = tc.envTrustPolicy
+			env.Properties = &properties
+
+			definition, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, &env, &recipeMetadata, options)
+			require.NoError(t, err)
+			require.Equal(t, "ghcr.io/radius-project/recipes/mongodatabases:latest", definition.TemplatePath)
+			require.Equal(t, tc.expected, definition.TrustPolicies)
+		})
+	}
+}
+
+func TestGetRecipeDefinitionFromEnvironmentV20250801_Layers(t *testing.T) {
+	ctx := context.Background()
+	orgRecipePackID := "/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/org-pack"
+	recipeLocation := "ghcr.io/radius-project/recipes/mongodatabases:latest"
+
+	newRecipePack := func(id string, layer modelv20250801.RecipePackLayer, parameters map[string]any) *modelv20250801.RecipePackResource {
+		return &modelv20250801.RecipePackResource{
+			ID: to.Ptr(id),
+			Properties: &modelv20250801.RecipePackProperties{
+				Layer: to.Ptr(layer),
+				Recipes: map[string]*modelv20250801.RecipeDefinition{
+					"Applications.Datastores/mongoDatabases": {
+						RecipeKind:     to.Ptr(modelv20250801.RecipeKindBicep),
+						RecipeLocation: to.Ptr(recipeLocation),
+						Parameters:     parameters,
+					},
 				},
 			},
-			resourceType: "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "value1",
+		}
+	}
+
+	// The fake server only lists the recipe packs of the requested layer, and only returns the other recipe packs
+	// when they're fetched by name.
+	newOptions := func(packs ...*modelv20250801.RecipePackResource) *arm.ClientOptions {
+		recipePacksServer := fake.RecipePacksServer{
+			Get: func(ctx context.Context, recipePackName string, options *modelv20250801.RecipePacksClientGetOptions) (resp azfake.Responder[modelv20250801.RecipePacksClientGetResponse], errResp azfake.ErrorResponder) {
+				for _, pack := range packs {
+					if strings.HasSuffix(*pack.ID, "/"+recipePackName) {
+						resp.SetResponse(http.StatusOK, modelv20250801.RecipePacksClientGetResponse{RecipePackResource: *pack}, nil)
+						return
+					}
+				}
+				errResp.SetResponseError(http.StatusNotFound, "NotFound")
+				return
+			},
+			NewListByScopePager: func(options *modelv20250801.RecipePacksClientListByScopeOptions) (resp azfake.PagerResponder[modelv20250801.RecipePacksClientListByScopeResponse]) {
+				listed := []*modelv20250801.RecipePackResource{}
+				for _, pack := range packs {
+					if options != nil && options.Layer != nil && *pack.Properties.Layer == *options.Layer {
+						listed = append(listed, pack)
+					}
+				}
+				resp.AddPage(http.StatusOK, modelv20250801.RecipePacksClientListByScopeResponse{
+					RecipePackResourceListResult: modelv20250801.RecipePackResourceListResult{Value: listed},
+				}, nil)
+				return
 			},
-		},
-		{
-			name: "empty environment parameters map",
-			recipePackParams: map[string]any{
-				"param1": "value1",
+		}
+		return &armpolicy.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{RecipePacksServer: recipePacksServer}),
 			},
-			envRecipeParams: map[string]map[string]any{},
-			resourceType:    "Radius.Compute/containers",
-			expected: map[string]any{
-				"param1": "value1",
+		}
+	}
+
+	newEnvironment := func(recipePacks ...string) *modelv20250801.EnvironmentResource {
+		return &modelv20250801.EnvironmentResource{
+			ID: new("/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/environments/env0"),
+			Properties: &modelv20250801.EnvironmentProperties{
+				RecipePacks: to.ArrayofStringPtrs(recipePacks),
+				RecipeParameters: map[string]map[string]any{
+					"Applications.Datastores/mongoDatabases": {"replicas": 3},
+				},
 			},
-		},
+		}
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := reconcileRecipeParameters(tt.recipePackParams, tt.envRecipeParams, tt.resourceType)
-			require.Equal(t, tt.expected, result)
-		})
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          recipeName,
+		EnvironmentID: envResourceId,
+		ResourceID:    mongoResourceID,
 	}
+
+	t.Run("organization recipe pack", func(t *testing.T) {
+		options := newOptions(newRecipePack(orgRecipePackID, modelv20250801.RecipePackLayerOrganization, map[string]any{"size": "small"}))
+
+		definition, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, newEnvironment(), &recipeMetadata, options)
+		require.NoError(t, err)
+		require.Equal(t, recipeLocation, definition.TemplatePath)
+		require.Equal(t, map[string]any{"size": "small", "replicas": 3}, definition.Parameters)
+	})
+
+	t.Run("environment recipe pack overrides organization recipe pack", func(t *testing.T) {
+		options := newOptions(
+			newRecipePack(orgRecipePackID, modelv20250801.RecipePackLayerOrganization, map[string]any{"size": "small", "tls": true}),
+			newRecipePack(envRecipePackID, modelv20250801.RecipePackLayerEnvironment, map[string]any{"size": "large"}),
+		)
+
+		definition, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, newEnvironment(envRecipePackID), &recipeMetadata, options)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"size": "large", "tls": true, "replicas": 3}, definition.Parameters)
+	})
+
+	t.Run("unreferenced environment recipe pack is ignored", func(t *testing.T) {
+		options := newOptions(newRecipePack(envRecipePackID, modelv20250801.RecipePackLayerEnvironment, nil))
+
+		_, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, newEnvironment(), &recipeMetadata, options)
+		var recipeErr *recipes.RecipeError
+		require.ErrorAs(t, err, &recipeErr)
+		require.Equal(t, recipes.RecipeNotFoundFailure, recipeErr.ErrorDetails.Code)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		options := newOptions(
+			newRecipePack(orgRecipePackID, modelv20250801.RecipePackLayerOrganization, nil),
+			newRecipePack(orgRecipePackID+"-2", modelv20250801.RecipePackLayerOrganization, nil),
+		)
+
+		_, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, newEnvironment(), &recipeMetadata, options)
+		var recipeErr *recipes.RecipeError
+		require.ErrorAs(t, err, &recipeErr)
+		require.Equal(t, recipes.RecipeValidationFailed, recipeErr.ErrorDetails.Code)
+	})
+
+	t.Run("no recipe pack", func(t *testing.T) {
+		_, err := getRecipeDefinitionFromEnvironmentV20250801(ctx, newEnvironment(), &recipeMetadata, newOptions())
+		var recipeErr *recipes.RecipeError
+		require.ErrorAs(t, err, &recipeErr)
+		require.Equal(t, recipes.RecipeNotFoundFailure, recipeErr.ErrorDetails.Code)
+	})
 }
@@ -18,10 +18,12 @@ package configloader
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	resources "github.com/radius-project/radius/pkg/ucp/resources"
 )
 
@@ -46,16 +48,17 @@ func FetchRecipePack(ctx context.Context, recipePackID string, ucpOptions *arm.C
 	return &response.RecipePackResource, nil
 }
 
-// ListRecipePacks fetches all recipe pack resources in the given scope using the provided ClientOptions,
-// and returns a slice of RecipePackResource or an error.
-func ListRecipePacks(ctx context.Context, scope string, ucpOptions *arm.ClientOptions) ([]*v20250801preview.RecipePackResource, error) {
+// ListRecipePacks fetches the recipe pack resources of the given layer in the given scope using the provided
+// ClientOptions, and returns a slice of RecipePackResource or an error. Listing at the scope of a plane returns the
+// recipe packs of all the resource groups of the plane.
+func ListRecipePacks(ctx context.Context, scope string, layer v20250801preview.RecipePackLayer, ucpOptions *arm.ClientOptions) ([]*v20250801preview.RecipePackResource, error) {
 	client, err := v20250801preview.NewRecipePacksClient(scope, &aztoken.AnonymousCredential{}, ucpOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	var recipePacks []*v20250801preview.RecipePackResource
-	pager := client.NewListByScopePager(nil)
+	pager := client.NewListByScopePager(&v20250801preview.RecipePacksClientListByScopeOptions{Layer: &layer})
 
 	for pager.More() {
 		page, err := pager.NextPage(ctx)
@@ -67,3 +70,64 @@ func ListRecipePacks(ctx context.Context, scope string, ucpOptions *arm.ClientOp
 
 	return recipePacks, nil
 }
+
+// LoadRecipePacks fetches the recipe packs that can apply to the environment: the organization recipe packs of the
+// plane of the environment, the resource group recipe packs of the resource group of the environment, and the recipe
+// packs referenced by the environment. The recipe packs are returned as datamodels so they can be resolved with the
+// recipepacks package.
+//
+// The recipe packs are loaded on every recipe execution, which lists the organization and resource group recipe packs
+// with two calls filtered by layer. They're not cached, so that changes to recipe packs apply to the next deployment
+// without waiting for a cache to expire.
+func LoadRecipePacks(ctx context.Context, environment *datamodel.Environment_v20250801preview, ucpOptions *arm.ClientOptions) ([]*datamodel.RecipePack, error) {
+	envID, err := resources.ParseResource(environment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipePackResources, err := ListRecipePacks(ctx, envID.PlaneScope(), v20250801preview.RecipePackLayerOrganization, ucpOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceGroupRecipePacks, err := ListRecipePacks(ctx, envID.RootScope(), v20250801preview.RecipePackLayerResourceGroup, ucpOptions)
+	if err != nil {
+		return nil, err
+	}
+	recipePackResources = append(recipePackResources, resourceGroupRecipePacks...)
+
+	listed := map[string]bool{}
+	for _, resource := range recipePackResources {
+		if resource.ID != nil {
+			listed[strings.ToLower(*resource.ID)] = true
+		}
+	}
+
+	// Referenced recipe packs can live in another resource group or plane.
+	for _, recipePackID := range environment.Properties.RecipePacks {
+		if listed[strings.ToLower(recipePackID)] {
+			continue
+		}
+
+		resource, err := FetchRecipePack(ctx, recipePackID, ucpOptions)
+		if err != nil {
+			return nil, err
+		}
+		recipePackResources = append(recipePackResources, resource)
+	}
+
+	packs := []*datamodel.RecipePack{}
+	for _, resource := range recipePackResources {
+		if resource.Properties == nil {
+			continue
+		}
+
+		dm, err := resource.ConvertTo()
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, dm.(*datamodel.RecipePack))
+	}
+
+	return packs, nil
+}
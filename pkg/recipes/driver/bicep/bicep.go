@@ -80,13 +80,17 @@ type bicepDriver struct {
 	RegistryClient remote.Client
 }
 
-// Execute fetches recipe contents from container registry, creates a deployment ID, a recipe context parameter, recipe parameters,
+// Execute fetches recipe contents from container registry, validates the recipe parameters, creates a deployment ID, a recipe context parameter, recipe parameters,
 // a provider config, and deploys a bicep template for the recipe using UCP deployment client, then polls until the deployment
 // is done and prepares the recipe response.
 func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
 	logger := logr.FromContextOrDiscard(ctx)
 	logger.Info(fmt.Sprintf("Deploying recipe: %q, template: %q", opts.Definition.Name, opts.Definition.TemplatePath))
 
+	if err := validateImports(opts.Recipe.Imports); err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeValidationFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
 	recipeData := make(map[string]any)
 	downloadStartTime := time.Now()
 	secrets, err := util.GetRegistrySecrets(opts.Configuration, opts.Definition.TemplatePath, opts.Secrets)
@@ -105,7 +109,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 		registryClient = authClient
 	}
 
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	templateDigest, err := util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
@@ -114,6 +118,12 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, metrics.SuccessfulOperationState))
 
+	// Fail fast on parameters that don't match the recipe before deploying anything.
+	err = driver.ValidateRecipeParameters(recipes.TemplateKindBicep, recipeData, opts.Definition.Parameters, opts.Recipe.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
 	// create the context object to be passed to the recipe deployment
 	recipeContext, err := recipecontext.New(&opts.Recipe, &opts.Configuration)
 	if err != nil {
@@ -173,6 +183,18 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
+	// Record the digest of the template when its signature was verified, so that the deployed template can be traced
+	// back to the signed artifact.
+	if len(opts.Definition.TrustPolicies) > 0 {
+		recipeResponse.Status.TemplateDigest = templateDigest
+	}
+
+	// Bicep deployments are incremental, so existing resources declared by the template are updated in place. Imported
+	// resources are recorded as output resources of the recipe so that they're tracked by Radius, and are not garbage
+	// collected when the template does not declare them. They're not deleted with the resource unless the user opted
+	// in to their management.
+	recipeResponse.AddImportedResources(opts.Recipe.Imports)
+
 	// When a Radius portable resource consuming a recipe is redeployed, Garbage collection of the recipe resources that aren't included
 	// in the currently deployed resources compared to the list of resources from the previous deployment needs to be deleted
 	// as bicep does not take care of automatically deleting the unused resources.
@@ -292,7 +314,7 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOpt
 		registryClient = authClient
 	}
 
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	_, err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +369,18 @@ func createDeploymentID(resourceID string, deploymentName string) (resources.ID,
 	return resources.ParseResource(fmt.Sprintf("/planes/radius/local/resourceGroups/%s/providers/Microsoft.Resources/deployments/%s", resourceGroup, deploymentName))
 }
 
+// validateImports validates that the resources to import are identified by valid resource IDs, which are
+// required to track them as output resources of the recipe.
+func validateImports(imports []recipes.ImportResource) error {
+	for _, imported := range imports {
+		if _, err := resources.ParseResource(imported.ID); err != nil {
+			return fmt.Errorf("imported resource %q is not a valid resource ID: %w", imported.ID, err)
+		}
+	}
+
+	return nil
+}
+
 func newProviderConfig(resourceGroup string, envProviders coredm.Providers) clients.ProviderConfig {
 	config := clients.NewDefaultProviderConfig(resourceGroup)
 
@@ -17,6 +17,11 @@ limitations under the License.
 package bicep
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"strings"
 	"testing"
@@ -459,6 +464,62 @@ func Test_Bicep_Delete_Error(t *testing.T) {
 	require.Equal(t, err, &recipeError)
 }
 
+func Test_Bicep_Delete_ImportedResources(t *testing.T) {
+	ctx := testcontext.New(t)
+	driverBicep, client := setupDeleteInputs(t)
+
+	deployedID := "/planes/kubernetes/local/namespaces/recipe-app/providers/apps/Deployment/redis"
+	importedID := "/planes/kubernetes/local/namespaces/recipe-app/providers/core/Service/redis"
+	managedImportedID := "/planes/kubernetes/local/namespaces/recipe-app/providers/core/Secret/redis"
+
+	recipeOutput := &recipes.RecipeOutput{Resources: []string{deployedID, importedID}}
+	recipeOutput.AddImportedResources([]recipes.ImportResource{
+		{ID: importedID},
+		{ID: managedImportedID, RadiusManaged: true},
+	})
+
+	// The output resources are recorded in the status of the resource as they're returned by the recipe.
+	outputResources, err := processors.GetOutputResourcesFromRecipe(recipeOutput)
+	require.NoError(t, err)
+
+	// The imported resource is kept unless the user opted in to its management.
+	client.EXPECT().Delete(gomock.Any(), deployedID).Times(1).Return(nil)
+	client.EXPECT().Delete(gomock.Any(), managedImportedID).Times(1).Return(nil)
+
+	err = driverBicep.Delete(ctx, driver.DeleteOptions{
+		OutputResources: outputResources,
+	})
+	require.NoError(t, err)
+}
+
+func Test_Bicep_Execute_InvalidParameters(t *testing.T) {
+	ts := registrytest.NewFakeRegistryServer(t)
+	t.Cleanup(ts.CloseServer)
+
+	// The parameters are validated against the downloaded template before anything is deployed, so the driver
+	// has no deployment client.
+	driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+	_, err := driverBicep.Execute(testcontext.New(t), driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Parameters: map[string]any{"size": "large"},
+			},
+			Definition: recipes.EnvironmentDefinition{
+				Name:         "mongo-azure",
+				Driver:       recipes.TemplateKindBicep,
+				TemplatePath: ts.TestImageURL,
+				ResourceType: "Applications.Datastores/mongoDatabases",
+			},
+		},
+	})
+
+	recipeErr, ok := err.(*recipes.RecipeError)
+	require.True(t, ok)
+	require.Equal(t, recipes.RecipeValidationFailed, recipeErr.ErrorDetails.Code)
+	require.Contains(t, recipeErr.ErrorDetails.Message, "parameter \"size\" set by the resource is not declared by the recipe")
+	require.Contains(t, recipeErr.ErrorDetails.Message, "required parameter \"documentdbName\" is not set by the environment or the resource")
+}
+
 func Test_Bicep_GetRecipeMetadata_Success(t *testing.T) {
 	ts := registrytest.NewFakeRegistryServer(t)
 	t.Cleanup(ts.CloseServer)
@@ -514,6 +575,60 @@ func Test_Bicep_GetRecipeMetadata_Error(t *testing.T) {
 	require.Equal(t, actualErr, &expErr)
 }
 
+func Test_Bicep_GetRecipeMetadata_TrustPolicy(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	b, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	trustPolicies := []recipes.TrustPolicy{
+		{PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))}},
+	}
+
+	t.Run("signed recipe", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, key)
+		t.Cleanup(ts.CloseServer)
+
+		driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+		recipeData, err := driverBicep.GetRecipeMetadata(testcontext.New(t), driver.BaseOptions{
+			Recipe: recipes.ResourceMetadata{},
+			Definition: recipes.EnvironmentDefinition{
+				Name:          "mongo-azure",
+				Driver:        recipes.TemplateKindBicep,
+				TemplatePath:  ts.TestImageURL,
+				ResourceType:  "Applications.Datastores/mongoDatabases",
+				TrustPolicies: trustPolicies,
+			},
+		})
+		require.NoError(t, err)
+		require.Contains(t, recipeData, "parameters")
+	})
+
+	t.Run("unsigned recipe", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, nil)
+		t.Cleanup(ts.CloseServer)
+
+		driverBicep := &bicepDriver{RegistryClient: ts.TestServer.Client()}
+		_, err := driverBicep.GetRecipeMetadata(testcontext.New(t), driver.BaseOptions{
+			Recipe: recipes.ResourceMetadata{},
+			Definition: recipes.EnvironmentDefinition{
+				Name:          "mongo-azure",
+				Driver:        recipes.TemplateKindBicep,
+				TemplatePath:  ts.TestImageURL,
+				ResourceType:  "Applications.Datastores/mongoDatabases",
+				TrustPolicies: trustPolicies,
+			},
+		})
+		expErr := recipes.RecipeError{
+			ErrorDetails: v1.ErrorDetails{
+				Code:    recipes.RecipeSignatureVerificationFailed,
+				Message: fmt.Sprintf("failed to verify the signature of the recipe %q: the artifact is not signed: no signature found for digest %s", ts.TestImageURL, ts.Digest),
+			},
+			DeploymentStatus: "setupError",
+		}
+		require.Equal(t, &expErr, err)
+	})
+}
+
 func Test_GetGCOutputResources(t *testing.T) {
 	d := &bicepDriver{}
 	before := []string{
@@ -586,3 +701,17 @@ func Test_Bicep_Delete_Success_AfterRetry(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_ValidateImports(t *testing.T) {
+	err := validateImports(nil)
+	require.NoError(t, err)
+
+	err = validateImports([]recipes.ImportResource{
+		{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache"},
+		{ID: "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders"},
+	})
+	require.NoError(t, err)
+
+	err = validateImports([]recipes.ImportResource{{ID: "orders"}})
+	require.ErrorContains(t, err, "imported resource \"orders\" is not a valid resource ID")
+}
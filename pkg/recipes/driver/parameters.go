@@ -0,0 +1,280 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/recipecontext"
+	"github.com/radius-project/radius/pkg/recipes/util"
+)
+
+const (
+	// recipeMetadataParameters is the key of the parameters section in the recipe metadata returned by the drivers.
+	recipeMetadataParameters = "parameters"
+
+	// parameterSourceOperator is used in error messages for parameters set on the environment or recipe pack.
+	parameterSourceOperator = "environment"
+
+	// parameterSourceDeveloper is used in error messages for parameters set on the resource.
+	parameterSourceDeveloper = "resource"
+)
+
+// ValidateRecipeParameters validates the operator (environment/recipe pack) and developer (resource) parameters
+// against the parameters declared by the recipe template. The recipe metadata is expected in the format returned by
+// Driver.GetRecipeMetadata:
+//
+//	{
+//		"parameters": {
+//			<parameter-name>: {
+//				"type": <parameter-type>,
+//				...
+//			}
+//		}
+//	}
+//
+// Drivers call it with the metadata of the recipe before deploying it. It returns a RecipeError with code RecipeValidationFailed listing every parameter that is unknown
+// to the recipe, has a value of the wrong type, or is required by the recipe and not set.
+//
+// The parameters are not validated when the recipe declares no parameters, because the metadata of some recipes
+// doesn't report them.
+func ValidateRecipeParameters(templateKind string, metadata map[string]any, operatorParams, devParams map[string]any) error {
+	declared, ok := metadata[recipeMetadataParameters].(map[string]any)
+	if !ok || len(declared) == 0 {
+		return nil
+	}
+
+	// Developer parameters take precedence over operator parameters, see createRecipeParameters in the bicep driver
+	// and the module configuration in the terraform driver.
+	sources := map[string]string{}
+	values := map[string]any{}
+	for name, value := range operatorParams {
+		sources[name] = parameterSourceOperator
+		values[name] = value
+	}
+	for name, value := range devParams {
+		sources[name] = parameterSourceDeveloper
+		values[name] = value
+	}
+
+	msgs := []string{}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if name == recipecontext.RecipeContextParamKey {
+			msgs = append(msgs, fmt.Sprintf("parameter %q set by the %s is reserved for the recipe context", name, sources[name]))
+			continue
+		}
+
+		declaration, ok := declared[name].(map[string]any)
+		if !ok {
+			msgs = append(msgs, fmt.Sprintf("parameter %q set by the %s is not declared by the recipe", name, sources[name]))
+			continue
+		}
+
+		expectedType, _ := declaration["type"].(string)
+		if !isParameterValueOfType(templateKind, expectedType, values[name]) {
+			msgs = append(msgs, fmt.Sprintf("parameter %q set by the %s must be of type %q, got %s", name, sources[name], expectedType, describeParameterValue(values[name])))
+			continue
+		}
+
+		if allowed, ok := declaration["allowedValues"].([]any); ok && len(allowed) > 0 && !containsParameterValue(allowed, values[name]) {
+			msgs = append(msgs, fmt.Sprintf("parameter %q set by the %s must be one of %v, got %v", name, sources[name], allowed, values[name]))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(declared)) {
+		if name == recipecontext.RecipeContextParamKey {
+			continue
+		}
+
+		declaration, ok := declared[name].(map[string]any)
+		if !ok || !isParameterRequired(templateKind, declaration) {
+			continue
+		}
+
+		if _, ok := values[name]; !ok {
+			msgs = append(msgs, fmt.Sprintf("required parameter %q is not set by the environment or the resource", name))
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("recipe parameters do not match the parameters declared by the recipe: %s", strings.Join(msgs, "; "))
+	return recipes.NewRecipeError(recipes.RecipeValidationFailed, msg, util.RecipeSetupError)
+}
+
+// isParameterRequired returns true if the recipe declares the parameter as required.
+func isParameterRequired(templateKind string, declaration map[string]any) bool {
+	switch templateKind {
+	case recipes.TemplateKindTerraform:
+		required, _ := declaration["required"].(bool)
+		return required
+	case recipes.TemplateKindBicep:
+		// Bicep parameters without a default value must be provided, unless they are nullable.
+		if _, ok := declaration["defaultValue"]; ok {
+			return false
+		}
+		nullable, _ := declaration["nullable"].(bool)
+		return !nullable
+	default:
+		return false
+	}
+}
+
+// isParameterValueOfType returns true if the value can be assigned to a parameter of the expected type.
+func isParameterValueOfType(templateKind string, expectedType string, value any) bool {
+	if value == nil || expectedType == "" {
+		return true
+	}
+
+	switch templateKind {
+	case recipes.TemplateKindBicep:
+		return isBicepParameterValueOfType(expectedType, value)
+	case recipes.TemplateKindTerraform:
+		return isTerraformParameterValueOfType(expectedType, value)
+	default:
+		return true
+	}
+}
+
+// isBicepParameterValueOfType checks a value against a compiled ARM template parameter type.
+// https://learn.microsoft.com/azure/azure-resource-manager/templates/data-types
+func isBicepParameterValueOfType(expectedType string, value any) bool {
+	switch strings.ToLower(expectedType) {
+	case "string", "securestring":
+		_, ok := value.(string)
+		return ok
+	case "int":
+		number, ok := toFloat64(value)
+		return ok && number == math.Trunc(number)
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "object", "secureobject":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		return isArray(value)
+	default:
+		return true
+	}
+}
+
+// isTerraformParameterValueOfType checks a value against a Terraform type constraint. Terraform converts between
+// primitive types automatically, so only conversions that Terraform would reject are reported.
+// https://developer.hashicorp.com/terraform/language/expressions/type-constraints
+func isTerraformParameterValueOfType(expectedType string, value any) bool {
+	expectedType = strings.TrimSpace(expectedType)
+	if i := strings.Index(expectedType, "("); i >= 0 {
+		expectedType = expectedType[:i]
+	}
+
+	switch expectedType {
+	case "string":
+		switch value.(type) {
+		case string, bool:
+			return true
+		}
+		_, ok := toFloat64(value)
+		return ok
+	case "number":
+		if s, ok := value.(string); ok {
+			_, err := strconv.ParseFloat(s, 64)
+			return err == nil
+		}
+		_, ok := toFloat64(value)
+		return ok
+	case "bool":
+		if s, ok := value.(string); ok {
+			return s == "true" || s == "false"
+		}
+		_, ok := value.(bool)
+		return ok
+	case "list", "set", "tuple":
+		return isArray(value)
+	case "map", "object":
+		_, ok := value.(map[string]any)
+		return ok
+	default:
+		// "any" and unrecognized type constraints accept every value.
+		return true
+	}
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func isArray(value any) bool {
+	switch value.(type) {
+	case []any, []string, []map[string]any:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsParameterValue(allowed []any, value any) bool {
+	return slices.ContainsFunc(allowed, func(v any) bool {
+		if a, ok := toFloat64(v); ok {
+			b, ok := toFloat64(value)
+			return ok && a == b
+		}
+		return reflect.DeepEqual(v, value)
+	})
+}
+
+func describeParameterValue(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case map[string]any:
+		return "object"
+	}
+
+	if isArray(value) {
+		return "array"
+	}
+	if _, ok := toFloat64(value); ok {
+		return "number"
+	}
+
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRecipeParameters_Bicep(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{
+				"type": "object",
+			},
+			"name": map[string]any{
+				"type": "string",
+			},
+			"replicas": map[string]any{
+				"type":         "int",
+				"defaultValue": float64(1),
+			},
+			"sku": map[string]any{
+				"type":          "string",
+				"defaultValue":  "Basic",
+				"allowedValues": []any{"Basic", "Standard"},
+			},
+			"tags": map[string]any{
+				"type":     "object",
+				"nullable": true,
+			},
+			"zones": map[string]any{
+				"type":         "array",
+				"defaultValue": []any{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		operatorParams map[string]any
+		devParams      map[string]any
+		expectedErrs   []string
+	}{
+		{
+			name:           "valid parameters",
+			operatorParams: map[string]any{"name": "operator", "sku": "Standard"},
+			devParams:      map[string]any{"replicas": float64(3), "zones": []any{"1", "2"}},
+		},
+		{
+			name:           "developer parameter overrides operator parameter",
+			operatorParams: map[string]any{"name": float64(1)},
+			devParams:      map[string]any{"name": "developer"},
+		},
+		{
+			name:         "required parameter missing",
+			devParams:    map[string]any{"replicas": float64(3)},
+			expectedErrs: []string{"required parameter \"name\" is not set by the environment or the resource"},
+		},
+		{
+			name:           "wrong types",
+			operatorParams: map[string]any{"name": "test", "replicas": float64(1.5)},
+			devParams:      map[string]any{"zones": "1"},
+			expectedErrs: []string{
+				"parameter \"replicas\" set by the environment must be of type \"int\", got number",
+				"parameter \"zones\" set by the resource must be of type \"array\", got string",
+			},
+		},
+		{
+			name:         "value not allowed",
+			devParams:    map[string]any{"name": "test", "sku": "Premium"},
+			expectedErrs: []string{"parameter \"sku\" set by the resource must be one of [Basic Standard], got Premium"},
+		},
+		{
+			name:         "undeclared parameter",
+			devParams:    map[string]any{"name": "test", "location": "westus"},
+			expectedErrs: []string{"parameter \"location\" set by the resource is not declared by the recipe"},
+		},
+		{
+			name:         "context parameter is reserved",
+			devParams:    map[string]any{"name": "test", "context": map[string]any{}},
+			expectedErrs: []string{"parameter \"context\" set by the resource is reserved for the recipe context"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRecipeParameters(recipes.TemplateKindBicep, metadata, tc.operatorParams, tc.devParams)
+			if len(tc.expectedErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			recipeErr, ok := err.(*recipes.RecipeError)
+			require.True(t, ok)
+			require.Equal(t, recipes.RecipeValidationFailed, recipeErr.ErrorDetails.Code)
+			for _, expected := range tc.expectedErrs {
+				require.Contains(t, recipeErr.ErrorDetails.Message, expected)
+			}
+		})
+	}
+}
+
+func Test_ValidateRecipeParameters_Terraform(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{
+				"type":     "any",
+				"required": true,
+			},
+			"name": map[string]any{
+				"type":     "string",
+				"required": true,
+			},
+			"port": map[string]any{
+				"type":     "number",
+				"required": false,
+			},
+			"enabled": map[string]any{
+				"type":     "bool",
+				"required": false,
+			},
+			"subnets": map[string]any{
+				"type":     "list(string)",
+				"required": false,
+			},
+			"labels": map[string]any{
+				"type":     "map(string)",
+				"required": false,
+			},
+			"extra": map[string]any{
+				"type":     "",
+				"required": false,
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		operatorParams map[string]any
+		devParams      map[string]any
+		expectedErrs   []string
+	}{
+		{
+			name:           "valid parameters",
+			operatorParams: map[string]any{"name": "test", "labels": map[string]any{"team": "payments"}},
+			devParams:      map[string]any{"port": float64(5432), "subnets": []any{"a"}, "extra": []any{1}},
+		},
+		{
+			name:      "primitive conversions accepted by terraform",
+			devParams: map[string]any{"name": float64(1), "port": "5432", "enabled": "true"},
+		},
+		{
+			name:         "required parameter missing",
+			devParams:    map[string]any{"port": float64(5432)},
+			expectedErrs: []string{"required parameter \"name\" is not set by the environment or the resource"},
+		},
+		{
+			name:      "wrong types",
+			devParams: map[string]any{"name": "test", "port": "abc", "enabled": "yes", "subnets": "a", "labels": []any{"a"}},
+			expectedErrs: []string{
+				"parameter \"port\" set by the resource must be of type \"number\", got string",
+				"parameter \"enabled\" set by the resource must be of type \"bool\", got string",
+				"parameter \"subnets\" set by the resource must be of type \"list(string)\", got string",
+				"parameter \"labels\" set by the resource must be of type \"map(string)\", got array",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRecipeParameters(recipes.TemplateKindTerraform, metadata, tc.operatorParams, tc.devParams)
+			if len(tc.expectedErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range tc.expectedErrs {
+				require.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func Test_ValidateRecipeParameters_NoDeclaredParameters(t *testing.T) {
+	err := ValidateRecipeParameters(recipes.TemplateKindBicep, map[string]any{}, nil, nil)
+	require.NoError(t, err)
+
+	err = ValidateRecipeParameters(recipes.TemplateKindBicep, map[string]any{}, nil, map[string]any{"name": "test"})
+	require.NoError(t, err)
+
+	err = ValidateRecipeParameters(recipes.TemplateKindTerraform, map[string]any{"parameters": map[string]any{}}, map[string]any{"name": "test"}, nil)
+	require.NoError(t, err)
+}
@@ -79,6 +79,8 @@ type terraformDriver struct {
 func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (*recipes.RecipeOutput, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	warnTrustPolicies(ctx, opts.Definition)
+
 	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.RecipeSetupError, recipes.GetErrorDetails(err))
@@ -101,22 +103,29 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		return nil, err
 	}
 
-	tfState, err := d.terraformExecutor.Deploy(ctx, terraform.Options{
-		RootDir:          requestDirPath,
-		EnvConfig:        &opts.Configuration,
-		ResourceRecipe:   &opts.Recipe,
-		EnvRecipe:        &opts.Definition,
-		Secrets:          opts.Secrets,
-		StateLockTimeout: terraform.DefaultStateLockTimeout,
-		LogLevel:         d.options.LogLevel,
-	})
+	// Fail fast on parameters that don't match the module before deploying anything.
+	var tfState *tfjson.State
+	err = d.validateRecipeParameters(ctx, requestDirPath, opts.BaseOptions)
+	if err == nil {
+		tfState, err = d.terraformExecutor.Deploy(ctx, terraform.Options{
+			RootDir:          requestDirPath,
+			EnvConfig:        &opts.Configuration,
+			ResourceRecipe:   &opts.Recipe,
+			EnvRecipe:        &opts.Definition,
+			Secrets:          opts.Secrets,
+			StateLockTimeout: terraform.DefaultStateLockTimeout,
+			LogLevel:         d.options.LogLevel,
+		})
+	}
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
 	if unsetError != nil {
 		return nil, unsetError
 	}
 
-	if err != nil {
+	if details := recipes.GetErrorDetails(err); details != nil && details.Code == recipes.RecipeValidationFailed {
+		return nil, err
+	} else if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
@@ -125,6 +134,9 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		return nil, recipes.NewRecipeError(recipes.InvalidRecipeOutputs, fmt.Sprintf("failed to read the recipe output %q: %s", recipes.ResultPropertyName, err.Error()), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
 	}
 
+	// Imported resources are part of the Terraform state now, but the state only reports resources of supported providers.
+	recipeOutputs.AddImportedResources(opts.Recipe.Imports)
+
 	return recipeOutputs, nil
 }
 
@@ -258,10 +270,39 @@ func (d *terraformDriver) createExecutionDirectory(ctx context.Context, recipe r
 	return requestDirPath, nil
 }
 
+// warnTrustPolicies logs a warning if the recipe has trust policies. Terraform modules are downloaded by Terraform
+// from module registries, git repositories or HTTP URLs, so their signatures cannot be verified before they are pulled.
+// Signature verification is only supported for Bicep recipes stored in OCI registries.
+func warnTrustPolicies(ctx context.Context, definition recipes.EnvironmentDefinition) {
+	if len(definition.TrustPolicies) == 0 {
+		return
+	}
+
+	ucplog.FromContextOrDiscard(ctx).Info(fmt.Sprintf("The signature of the Terraform recipe %q is not verified: signature verification is only supported for Bicep recipes", definition.TemplatePath))
+}
+
+// validateRecipeParameters validates the parameters of the recipe against the variables of the Terraform module, which is
+// downloaded to the execution directory of the recipe.
+func (d *terraformDriver) validateRecipeParameters(ctx context.Context, requestDirPath string, opts driver.BaseOptions) error {
+	metadata, err := d.terraformExecutor.GetRecipeMetadata(ctx, terraform.Options{
+		RootDir:        requestDirPath,
+		ResourceRecipe: &opts.Recipe,
+		EnvRecipe:      &opts.Definition,
+		LogLevel:       d.options.LogLevel,
+	})
+	if err != nil {
+		return err
+	}
+
+	return driver.ValidateRecipeParameters(recipes.TemplateKindTerraform, metadata, opts.Definition.Parameters, opts.Recipe.Parameters)
+}
+
 // GetRecipeMetadata returns the Terraform Recipe parameters by downloading the module and retrieving variable information
 func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	warnTrustPolicies(ctx, opts.Definition)
+
 	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
 	if err != nil {
 		return nil, recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), "", recipes.GetErrorDetails(err))
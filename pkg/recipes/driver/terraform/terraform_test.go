@@ -34,6 +34,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/terraform"
+	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
@@ -126,6 +127,7 @@ func Test_Terraform_Execute_Success(t *testing.T) {
 		},
 	}
 
+	tfExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(map[string]any{}, nil)
 	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(expectedTFState, nil)
 
 	recipeOutput, err := tfDriver.Execute(ctx, driver.ExecuteOptions{
@@ -156,6 +158,7 @@ func Test_Terraform_Execute_DeploymentFailure(t *testing.T) {
 		},
 		DeploymentStatus: "executionError",
 	}
+	tfExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(map[string]any{}, nil)
 	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(nil, errors.New("Failed to deploy terraform module"))
 
 	_, err := tfDriver.Execute(ctx, driver.ExecuteOptions{
@@ -170,6 +173,36 @@ func Test_Terraform_Execute_DeploymentFailure(t *testing.T) {
 	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
 }
 
+func Test_Terraform_Execute_InvalidParameters(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	recipeMetadata.Parameters["size"] = "large"
+
+	// The parameters are validated against the variables of the module before it's deployed.
+	tfExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(map[string]any{
+		"parameters": map[string]any{
+			"redis_cache_name": map[string]any{"type": "string", "required": true},
+		},
+	}, nil)
+	tfExecutor.EXPECT().Deploy(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := tfDriver.Execute(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.Equal(t, recipes.NewRecipeError(recipes.RecipeValidationFailed, "recipe parameters do not match the parameters declared by the recipe: parameter \"size\" set by the resource is not declared by the recipe", recipes_util.RecipeSetupError), err)
+	verifyDirectoryCleanup(t, tfDriver.options.Path, armCtx.OperationID.String())
+}
+
 func Test_Terraform_Execute_OutputsFailure(t *testing.T) {
 	ctx := testcontext.New(t)
 	armCtx := &v1.ARMRequestContext{
@@ -202,6 +235,7 @@ func Test_Terraform_Execute_OutputsFailure(t *testing.T) {
 		},
 		DeploymentStatus: "executionError",
 	}
+	tfExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(map[string]any{}, nil)
 	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(expectedTFState, nil)
 
 	_, err := tfDriver.Execute(ctx, driver.ExecuteOptions{
@@ -239,6 +273,38 @@ func Test_Terraform_Execute_EmptyPath(t *testing.T) {
 	require.Equal(t, err, &expErr)
 }
 
+func Test_Terraform_Execute_TrustPolicy(t *testing.T) {
+	ctx := testcontext.New(t)
+	armCtx := &v1.ARMRequestContext{
+		OperationID: uuid.New(),
+	}
+	ctx = v1.WithARMRequestContext(ctx, armCtx)
+
+	// The signatures of Terraform recipes are not verified, so the recipe is deployed.
+	tfExecutor, tfDriver := setup(t)
+	envConfig, recipeMetadata, envRecipe := buildTestInputs()
+	envRecipe.TrustPolicies = []recipes.TrustPolicy{{PublicKeys: []string{"test-public-key"}}}
+
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			Outputs: map[string]*tfjson.StateOutput{
+				recipes.ResultPropertyName: {Value: map[string]any{}},
+			},
+		},
+	}
+	tfExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(map[string]any{}, nil)
+	tfExecutor.EXPECT().Deploy(ctx, gomock.Any()).Times(1).Return(state, nil)
+
+	_, err := tfDriver.Execute(ctx, driver.ExecuteOptions{
+		BaseOptions: driver.BaseOptions{
+			Configuration: envConfig,
+			Recipe:        recipeMetadata,
+			Definition:    envRecipe,
+		},
+	})
+	require.NoError(t, err)
+}
+
 func Test_Terraform_Execute_EmptyOperationID_Success(t *testing.T) {
 	ctx := testcontext.New(t)
 	ctx = v1.WithARMRequestContext(ctx, &v1.ARMRequestContext{})
@@ -274,6 +340,7 @@ func Test_Terraform_Execute_EmptyOperationID_Success(t *testing.T) {
 		},
 	}
 
+	tfExecutor.EXPECT().GetRecipeMetadata(ctx, gomock.Any()).Times(1).Return(map[string]any{}, nil)
 	tfExecutor.EXPECT().
 		Deploy(ctx, gomock.Any()).
 		Times(1).
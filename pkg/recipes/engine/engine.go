@@ -55,7 +55,7 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 	executionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState)
+	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState, opts.RecipeDefinition)
 	if err != nil {
 		result = metrics.FailedOperationState
 		if recipes.GetErrorDetails(err) != nil {
@@ -72,7 +72,7 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 
 // executeCore function is the core logic of the Execute function.
 // Any changes to the core logic of the Execute function should be made here.
-func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
+func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string, recipeDefinition *recipes.EnvironmentDefinition) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
@@ -83,10 +83,11 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 	// No need to try executing the recipe if it's a simulated environment.
 	if configuration.Simulated {
 		logger.Info("simulated environment enabled, skipping deployment")
-		return nil, nil, nil
+		output, definition := e.simulate(ctx, recipe, configuration, recipeDefinition)
+		return output, definition, nil
 	}
 
-	definition, driver, err := e.getDriver(ctx, recipe)
+	definition, driver, err := e.getDriver(ctx, recipe, recipeDefinition)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,7 +118,7 @@ func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	definition, err := e.deleteCore(ctx, opts.Recipe, opts.OutputResources)
+	definition, err := e.deleteCore(ctx, opts.Recipe, opts.RecipeDefinition, opts.OutputResources)
 	if err != nil {
 		result = metrics.FailedOperationState
 		if recipes.GetErrorDetails(err) != nil {
@@ -134,7 +135,7 @@ func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 
 // deleteCore function is the core logic of the Delete function.
 // Any changes to the core logic of the Delete function should be made here.
-func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata, outputResources []rpv1.OutputResource) (*recipes.EnvironmentDefinition, error) {
+func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata, definition *recipes.EnvironmentDefinition, outputResources []rpv1.OutputResource) (*recipes.EnvironmentDefinition, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
 	if err != nil {
@@ -146,7 +147,7 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 		return nil, nil
 	}
 
-	definition, driver, err := e.getDriver(ctx, recipe)
+	definition, driver, err := e.getDriver(ctx, recipe, definition)
 	if err != nil {
 		return nil, err
 	}
@@ -210,11 +211,16 @@ func (e *engine) getRecipeMetadataCore(ctx context.Context, opts GetRecipeMetada
 	})
 }
 
-func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
-	// Load Recipe Definition from the environment.
-	definition, err := e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
-	if err != nil {
-		return nil, nil, err
+// getDriver loads the recipe definition from the environment, unless a definition is provided, and returns the driver
+// for the recipe.
+func (e *engine) getDriver(ctx context.Context, recipeMetadata recipes.ResourceMetadata, definition *recipes.EnvironmentDefinition) (*recipes.EnvironmentDefinition, recipedriver.Driver, error) {
+	if definition == nil {
+		// Load Recipe Definition from the environment.
+		var err error
+		definition, err = e.options.ConfigurationLoader.LoadRecipe(ctx, &recipeMetadata)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	// Determine Recipe driver type
@@ -135,6 +135,60 @@ func Test_Engine_Execute_Success(t *testing.T) {
 	require.Equal(t, result, recipeResult)
 }
 
+func Test_Engine_Execute_RecipeDefinition_Success(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/test1/actions/backup",
+		Parameters: map[string]any{
+			"retentionDays": "7d",
+		},
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeResult := &recipes.RecipeOutput{
+		Values: map[string]any{
+			"backupID": "backup-1",
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/backup:1.0",
+		ResourceType: "Applications.Test/testResources",
+	}
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	// The recipe definition is provided, so it must not be loaded from the environment.
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	driver.EXPECT().
+		Execute(ctx, recipedriver.ExecuteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    *recipeDefinition,
+			},
+		}).
+		Times(1).
+		Return(recipeResult, nil)
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		RecipeDefinition: recipeDefinition,
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipeResult, result)
+}
+
 func Test_Engine_Execute_SimulatedEnv_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -164,15 +218,45 @@ func Test_Engine_Execute_SimulatedEnv_Success(t *testing.T) {
 		Simulated: true,
 	}
 
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/dev/recipes/functionaltest/basic/mongodatabases/azure:1.0",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+
 	ctx := testcontext.New(t)
-	engine, configLoader, _, _, _ := setup(t)
+	engine, configLoader, driver, _, _ := setup(t)
 
 	configLoader.EXPECT().
 		LoadConfiguration(ctx, recipeMetadata).
 		Times(1).
 		Return(envConfig, nil)
 
-	// Note: LoadRecipe is not called as the environment is simulated
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		GetRecipeMetadata(ctx, gomock.Any()).
+		Times(1).
+		Return(map[string]any{
+			"outputs": map[string]any{
+				"result": map[string]any{
+					"type": "object",
+					"value": map[string]any{
+						"values": map[string]any{
+							"host": "[reference('account').documentEndpoint]",
+							"port": 10255,
+						},
+						"secrets": map[string]any{
+							"connectionString": "[listConnectionStrings('account').connectionStrings[0]]",
+						},
+					},
+				},
+			},
+		}, nil)
+
+	// Note: Execute is not called on the driver as the environment is simulated
 
 	result, err := engine.Execute(ctx, ExecuteOptions{
 		BaseOptions: BaseOptions{
@@ -181,7 +265,47 @@ func Test_Engine_Execute_SimulatedEnv_Success(t *testing.T) {
 		PreviousState: prevState,
 	})
 	require.NoError(t, err)
-	require.Nil(t, result)
+	require.Equal(t, &recipes.RecipeOutput{
+		Values: map[string]any{
+			"host": "simulated-host",
+			"port": 10255,
+		},
+		Secrets: map[string]any{
+			"connectionString": "simulated-connectionString",
+		},
+		Simulated: true,
+	}, result)
+}
+
+func Test_Engine_Execute_SimulatedEnv_RecipeNotFound(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Simulated: true,
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(nil, errors.New("recipe not found"))
+
+	result, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &recipes.RecipeOutput{Values: map[string]any{}, Secrets: map[string]any{}, Simulated: true}, result)
 }
 
 func Test_Engine_Execute_Failure(t *testing.T) {
@@ -675,6 +799,48 @@ func Test_Engine_Delete_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Engine_Delete_RecipeDefinition_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+
+	ctx := testcontext.New(t)
+	engine, configLoader, driver, _, _ := setup(t)
+
+	// The recipe definition is provided, so it must not be loaded from the environment.
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+
+	driver.EXPECT().
+		Delete(ctx, recipedriver.DeleteOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			OutputResources: outputResources,
+		}).
+		Times(1).
+		Return(nil)
+
+	err := engine.Delete(ctx, DeleteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources:  outputResources,
+		RecipeDefinition: &recipeDefinition,
+	})
+	require.NoError(t, err)
+}
+
 func Test_Engine_Delete_SimulatedEnv_Success(t *testing.T) {
 	recipeMetadata, _, outputResources := getRecipeInputs()
 
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// recipeMetadataOutputs is the key of the outputs section in the ARM template returned by the bicep driver.
+	recipeMetadataOutputs = "outputs"
+
+	// recipeOutputValues and recipeOutputSecrets are the keys of the values and secrets in the "result" output.
+	recipeOutputValues  = "values"
+	recipeOutputSecrets = "secrets"
+)
+
+// simulate returns the output of a recipe executed in a simulated environment. No resources are deployed: the output
+// contains the values and secrets declared by the recipe, with placeholders for values only known at deployment time.
+// The resource processor completes the output from the resource type schema.
+//
+// Declared outputs are best effort. Only bicep recipes declare their outputs in the template, and a recipe that
+// cannot be found or read results in an empty simulated output rather than a failure.
+func (e *engine) simulate(ctx context.Context, recipe recipes.ResourceMetadata, configuration *recipes.Configuration, recipeDefinition *recipes.EnvironmentDefinition) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	output := &recipes.RecipeOutput{
+		Values:    map[string]any{},
+		Secrets:   map[string]any{},
+		Simulated: true,
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe, recipeDefinition)
+	if err != nil {
+		logger.Info("simulated environment enabled, unable to load the recipe, simulating outputs from the resource type schema only", "error", err.Error())
+		return output, nil
+	}
+
+	// Terraform modules cannot declare their outputs statically, and reading them requires running terraform init.
+	if definition.Driver != recipes.TemplateKindBicep {
+		return output, definition
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		logger.Info("simulated environment enabled, unable to load the recipe secrets, simulating outputs from the resource type schema only", "error", err.Error())
+		return output, definition
+	}
+
+	metadata, err := driver.GetRecipeMetadata(ctx, recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        recipe,
+		Definition:    *definition,
+		Secrets:       secrets,
+	})
+	if err != nil {
+		logger.Info("simulated environment enabled, unable to read the recipe, simulating outputs from the resource type schema only", "error", err.Error())
+		return output, definition
+	}
+
+	output.Values, output.Secrets = declaredOutputs(metadata)
+	return output, definition
+}
+
+// declaredOutputs returns the values and secrets declared by the "result" output of an ARM template:
+//
+//	{
+//		"outputs": {
+//			"result": {
+//				"type": "object",
+//				"value": {
+//					"values": { <name>: <value> },
+//					"secrets": { <name>: <value> }
+//				}
+//			}
+//		}
+//	}
+//
+// Literal values are kept. Template expressions, which are only evaluated at deployment time, are replaced with a
+// placeholder.
+func declaredOutputs(metadata map[string]any) (map[string]any, map[string]any) {
+	values := map[string]any{}
+	secrets := map[string]any{}
+
+	outputs, _ := metadata[recipeMetadataOutputs].(map[string]any)
+	result, _ := outputs[recipes.ResultPropertyName].(map[string]any)
+	value, _ := result["value"].(map[string]any)
+
+	declaredValues, _ := value[recipeOutputValues].(map[string]any)
+	for name, v := range declaredValues {
+		values[name] = simulatedOutputValue(name, v)
+	}
+
+	declaredSecrets, _ := value[recipeOutputSecrets].(map[string]any)
+	for name, v := range declaredSecrets {
+		secrets[name] = simulatedOutputValue(name, v)
+	}
+
+	return values, secrets
+}
+
+// simulatedOutputValue returns the value of a declared output, with template expressions replaced by a placeholder.
+func simulatedOutputValue(name string, value any) any {
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "[") && strings.HasSuffix(v, "]") && !strings.HasPrefix(v, "[[") {
+			return fmt.Sprintf("simulated-%s", name)
+		}
+		return v
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			result[key] = simulatedOutputValue(key, item)
+		}
+		return result
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			result = append(result, simulatedOutputValue(name, item))
+		}
+		return result
+	default:
+		return v
+	}
+}
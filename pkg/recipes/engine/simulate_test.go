@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_declaredOutputs(t *testing.T) {
+	t.Run("no outputs", func(t *testing.T) {
+		values, secrets := declaredOutputs(map[string]any{"parameters": map[string]any{"name": map[string]any{"type": "string"}}})
+		require.Empty(t, values)
+		require.Empty(t, secrets)
+	})
+
+	t.Run("literals and expressions", func(t *testing.T) {
+		metadata := map[string]any{
+			"outputs": map[string]any{
+				"result": map[string]any{
+					"type": "object",
+					"value": map[string]any{
+						"values": map[string]any{
+							"host":     "[reference('cache').hostName]",
+							"port":     float64(6380),
+							"escaped":  "[[not an expression]",
+							"database": "cache",
+							"endpoint": map[string]any{
+								"url":  "[format('https://{0}', reference('cache').hostName)]",
+								"port": float64(443),
+							},
+							"ids": []any{"[resourceId('cache')]", "literal"},
+						},
+						"secrets": map[string]any{
+							"password": "[listKeys('cache').primaryKey]",
+						},
+					},
+				},
+			},
+		}
+
+		values, secrets := declaredOutputs(metadata)
+		require.Equal(t, map[string]any{
+			"host":     "simulated-host",
+			"port":     float64(6380),
+			"escaped":  "[[not an expression]",
+			"database": "cache",
+			"endpoint": map[string]any{
+				"url":  "simulated-url",
+				"port": float64(443),
+			},
+			"ids": []any{"simulated-ids", "literal"},
+		}, values)
+		require.Equal(t, map[string]any{"password": "simulated-password"}, secrets)
+	})
+}
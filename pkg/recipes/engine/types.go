@@ -50,6 +50,9 @@ type ExecuteOptions struct {
 	PreviousState []string
 	// Simulated is the flag to indicate if the execution is a simulation.
 	Simulated bool
+	// RecipeDefinition is the definition of the recipe to execute. When set, the recipe is not loaded from the
+	// environment. This is used to execute the recipes implementing the actions of resource types.
+	RecipeDefinition *recipes.EnvironmentDefinition
 }
 
 // DeleteOptions is the options for the Delete method.
@@ -58,6 +61,11 @@ type DeleteOptions struct {
 
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
+
+	// RecipeDefinition is the definition of the recipe to delete. When set, the recipe is not loaded from the
+	// environment. This is used to delete the resources deployed by the recipes implementing the actions of resource
+	// types.
+	RecipeDefinition *recipes.EnvironmentDefinition
 }
 
 type GetRecipeMetadataOptions struct {
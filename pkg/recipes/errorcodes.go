@@ -49,4 +49,7 @@ const (
 
 	// Used for errors encountered while loading recipe secrets.
 	LoadSecretsFailed = "LoadSecretsFailed"
+
+	// Used for recipes that are not signed by a key trusted by the trust policy.
+	RecipeSignatureVerificationFailed = "RecipeSignatureVerificationFailed"
 )
@@ -58,6 +58,7 @@ type Resource struct {
 	// The key is the connection name, and the value contains the connected resource's metadata and properties.
 	// We enrich the recipe context with this, allowing the recipe to access connected resource info using:
 	// context.resource.connections.[connection-name].properties.[property-name]
+	// context.resource.connections.[connection-name].outputs.[output-name]
 	// context.resource.connections.[connection-name].id
 	// context.resource.connections.[connection-name].name
 	// context.resource.connections.[connection-name].type
@@ -0,0 +1,307 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package recipepacks resolves the recipes of an environment from the recipe packs of its layers.
+//
+// Recipe packs apply at one of three layers:
+//
+//   - organization: the recipe pack applies to all environments in its plane.
+//   - resourceGroup: the recipe pack applies to all environments in its resource group.
+//   - environment: the recipe pack applies to the environments that reference it.
+//
+// A recipe pack referenced by an environment always applies at the environment layer. For each resource type, the recipe
+// of the most specific layer wins. The parameters of the winning recipe are merged on top of the parameters of the same
+// recipe (same kind and location) in less specific layers, and the recipe parameters of the environment are merged last.
+// Two organization or resource group recipe packs of the winning layer defining a recipe for the same resource type are a
+// conflict. Recipe packs referenced by the environment keep their precedence: the first one in the list of the
+// environment defining a recipe for the resource type wins, and the recipes of the others are ignored.
+package recipepacks
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ParameterSourceEnvironment is the source of the parameters set by the recipe parameters of the environment.
+const ParameterSourceEnvironment = "environment"
+
+var (
+	// ErrRecipeNotFound is returned when no recipe pack applying to the environment defines a recipe for a resource type.
+	ErrRecipeNotFound = errors.New("no recipe pack found with recipe for resource type")
+
+	// ErrConflict is returned when multiple organization or resource group recipe packs of the same layer define a recipe
+	// for a resource type.
+	ErrConflict = errors.New("resource type is defined in multiple recipe packs of the same layer")
+)
+
+// layers lists the recipe pack layers from the least to the most specific.
+var layers = []datamodel.RecipePackLayer{
+	datamodel.RecipePackLayerOrganization,
+	datamodel.RecipePackLayerResourceGroup,
+	datamodel.RecipePackLayerEnvironment,
+}
+
+// ResolvedRecipe is the recipe of a resource type after resolving the recipe packs of an environment.
+type ResolvedRecipe struct {
+	// ResourceType is the resource type of the recipe.
+	ResourceType string
+
+	// RecipePackID is the ID of the recipe pack that supplied the recipe.
+	RecipePackID string
+
+	// Layer is the layer the recipe pack applies at.
+	Layer datamodel.RecipePackLayer
+
+	// Definition is the recipe definition with the final parameters and trust policy.
+	Definition recipes.RecipeDefinition
+
+	// ParameterSources maps each parameter to the recipe pack ID that supplied its value, or to
+	// ParameterSourceEnvironment for values set by the recipe parameters of the environment.
+	ParameterSources map[string]string
+
+	// Overridden is the list of IDs of recipe packs of less specific layers whose recipe was overridden.
+	Overridden []string
+
+	// Ignored is the list of IDs of recipe packs referenced by the environment after the recipe pack that supplied the
+	// recipe, whose recipe for the resource type is ignored.
+	Ignored []string
+}
+
+// Conflict describes multiple organization or resource group recipe packs of the same layer defining a recipe for the
+// same resource type.
+type Conflict struct {
+	// ResourceType is the resource type defined by multiple recipe packs.
+	ResourceType string
+
+	// Layer is the layer of the recipe packs.
+	Layer datamodel.RecipePackLayer
+
+	// RecipePackIDs is the list of IDs of the conflicting recipe packs.
+	RecipePackIDs []string
+}
+
+// Resolution is the result of resolving the recipe packs of an environment.
+type Resolution struct {
+	// Recipes is the list of resolved recipes, sorted by resource type.
+	Recipes []*ResolvedRecipe
+
+	// Conflicts is the list of conflicts, sorted by resource type. A resource type with a conflict has no resolved recipe.
+	Conflicts []Conflict
+}
+
+// Find returns the resolved recipe of the resource type. It returns an error wrapping ErrConflict if the recipe of the
+// resource type is in conflict, or ErrRecipeNotFound if no recipe pack defines a recipe for the resource type.
+func (r *Resolution) Find(resourceType string) (*ResolvedRecipe, error) {
+	for _, conflict := range r.Conflicts {
+		if strings.EqualFold(conflict.ResourceType, resourceType) {
+			return nil, fmt.Errorf("%w: resource type %q is defined in multiple recipe packs of the %s layer: %s", ErrConflict, resourceType, conflict.Layer, strings.Join(conflict.RecipePackIDs, ", "))
+		}
+	}
+
+	for _, recipe := range r.Recipes {
+		if strings.EqualFold(recipe.ResourceType, resourceType) {
+			return recipe, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrRecipeNotFound, resourceType)
+}
+
+// candidate is a recipe defined by a recipe pack applying to the environment.
+type candidate struct {
+	resourceType string
+	pack         *datamodel.RecipePack
+	layer        datamodel.RecipePackLayer
+	definition   *datamodel.RecipeDefinition
+}
+
+// Resolve resolves the recipes of the environment from the given recipe packs. The recipe packs must include the recipe
+// packs referenced by the environment, and the organization and resource group recipe packs of the plane of the
+// environment. Recipe packs that do not apply to the environment are ignored.
+func Resolve(environment *datamodel.Environment_v20250801preview, packs []*datamodel.RecipePack) (*Resolution, error) {
+	envID, err := resources.ParseResource(environment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse environment ID %q: %w", environment.ID, err)
+	}
+
+	candidates := map[string][]candidate{}
+	seen := map[string]bool{}
+	for _, pack := range packs {
+		key := strings.ToLower(pack.ID)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		layer, ok := applicableLayer(envID, environment.Properties.RecipePacks, pack)
+		if !ok {
+			continue
+		}
+
+		for resourceType, definition := range pack.Properties.Recipes {
+			if definition == nil {
+				continue
+			}
+			typeKey := strings.ToLower(resourceType)
+			candidates[typeKey] = append(candidates[typeKey], candidate{resourceType: resourceType, pack: pack, layer: layer, definition: definition})
+		}
+	}
+
+	resolution := &Resolution{}
+	for _, typeKey := range slices.Sorted(maps.Keys(candidates)) {
+		recipe, conflict := resolveResourceType(environment, candidates[typeKey])
+		if conflict != nil {
+			resolution.Conflicts = append(resolution.Conflicts, *conflict)
+			continue
+		}
+		resolution.Recipes = append(resolution.Recipes, recipe)
+	}
+
+	return resolution, nil
+}
+
+// applicableLayer returns the layer the recipe pack applies at for the environment, or false if the recipe pack does not
+// apply to the environment.
+func applicableLayer(envID resources.ID, referenced []string, pack *datamodel.RecipePack) (datamodel.RecipePackLayer, bool) {
+	for _, id := range referenced {
+		if strings.EqualFold(id, pack.ID) {
+			return datamodel.RecipePackLayerEnvironment, true
+		}
+	}
+
+	packID, err := resources.ParseResource(pack.ID)
+	if err != nil {
+		return "", false
+	}
+
+	switch pack.Properties.Layer {
+	case datamodel.RecipePackLayerOrganization:
+		return datamodel.RecipePackLayerOrganization, strings.EqualFold(packID.PlaneScope(), envID.PlaneScope())
+	case datamodel.RecipePackLayerResourceGroup:
+		return datamodel.RecipePackLayerResourceGroup, strings.EqualFold(packID.RootScope(), envID.RootScope())
+	default:
+		return "", false
+	}
+}
+
+// resolveResourceType resolves the recipe of a resource type from the recipes defined for it by the recipe packs applying
+// to the environment.
+func resolveResourceType(environment *datamodel.Environment_v20250801preview, candidates []candidate) (*ResolvedRecipe, *Conflict) {
+	byLayer := map[datamodel.RecipePackLayer][]candidate{}
+	for _, c := range candidates {
+		byLayer[c.layer] = append(byLayer[c.layer], c)
+	}
+
+	// The most specific layer with a recipe for the resource type wins.
+	winningLayer := 0
+	for i, layer := range layers {
+		if len(byLayer[layer]) > 0 {
+			winningLayer = i
+		}
+	}
+
+	winners := byLayer[layers[winningLayer]]
+	var ignored []string
+	if layers[winningLayer] == datamodel.RecipePackLayerEnvironment {
+		// The first recipe pack in the list of the environment wins, as the environment orders its recipe packs.
+		slices.SortStableFunc(winners, func(a candidate, b candidate) int {
+			return referenceIndex(environment, a.pack.ID) - referenceIndex(environment, b.pack.ID)
+		})
+		for _, c := range winners[1:] {
+			ignored = append(ignored, c.pack.ID)
+		}
+		winners = winners[:1]
+	} else if len(winners) > 1 {
+		conflict := &Conflict{ResourceType: winners[0].resourceType, Layer: layers[winningLayer]}
+		for _, c := range winners {
+			conflict.RecipePackIDs = append(conflict.RecipePackIDs, c.pack.ID)
+		}
+		slices.Sort(conflict.RecipePackIDs)
+		return nil, conflict
+	}
+
+	winner := winners[0]
+	recipe := &ResolvedRecipe{
+		ResourceType: winner.resourceType,
+		RecipePackID: winner.pack.ID,
+		Layer:        winner.layer,
+		Definition: recipes.RecipeDefinition{
+			RecipeKind:     winner.definition.RecipeKind,
+			RecipeLocation: winner.definition.RecipeLocation,
+			Parameters:     map[string]any{},
+			PlainHTTP:      winner.definition.PlainHTTP,
+		},
+		ParameterSources: map[string]string{},
+		Ignored:          ignored,
+	}
+
+	// Less specific layers contribute parameters only when they define the same recipe. A less specific layer with a
+	// conflict does not contribute parameters.
+	for _, layer := range layers[:winningLayer] {
+		inherited := byLayer[layer]
+		for _, c := range inherited {
+			recipe.Overridden = append(recipe.Overridden, c.pack.ID)
+		}
+
+		if len(inherited) == 1 && sameRecipe(inherited[0].definition, winner.definition) {
+			mergeParameters(recipe, inherited[0].definition.Parameters, inherited[0].pack.ID)
+		}
+	}
+	mergeParameters(recipe, winner.definition.Parameters, winner.pack.ID)
+
+	for resourceType, parameters := range environment.Properties.RecipeParameters {
+		if strings.EqualFold(resourceType, winner.resourceType) {
+			mergeParameters(recipe, parameters, ParameterSourceEnvironment)
+		}
+	}
+
+	// The trust policy of the environment always applies. The trust policy of the recipe pack is an additional requirement,
+	// it cannot relax the policy of the environment. Drivers that cannot verify signatures reject recipes with policies.
+	if environment.Properties.TrustPolicy != nil {
+		recipe.Definition.TrustPolicies = append(recipe.Definition.TrustPolicies, recipes.TrustPolicy{PublicKeys: environment.Properties.TrustPolicy.PublicKeys})
+	}
+	if winner.pack.Properties.TrustPolicy != nil {
+		recipe.Definition.TrustPolicies = append(recipe.Definition.TrustPolicies, recipes.TrustPolicy{PublicKeys: winner.pack.Properties.TrustPolicy.PublicKeys})
+	}
+
+	return recipe, nil
+}
+
+// referenceIndex returns the index of the recipe pack in the list of recipe packs referenced by the environment.
+func referenceIndex(environment *datamodel.Environment_v20250801preview, id string) int {
+	return slices.IndexFunc(environment.Properties.RecipePacks, func(s string) bool { return strings.EqualFold(s, id) })
+}
+
+// sameRecipe returns true if the recipe definitions use the same recipe.
+func sameRecipe(a *datamodel.RecipeDefinition, b *datamodel.RecipeDefinition) bool {
+	return strings.EqualFold(a.RecipeKind, b.RecipeKind) && a.RecipeLocation == b.RecipeLocation
+}
+
+// mergeParameters merges the parameters into the parameters of the recipe, overriding existing values, and records the
+// source of the merged parameters.
+func mergeParameters(recipe *ResolvedRecipe, parameters map[string]any, source string) {
+	for name, value := range parameters {
+		recipe.Definition.Parameters[name] = value
+		recipe.ParameterSources[name] = source
+	}
+}
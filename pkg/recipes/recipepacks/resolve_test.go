@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipepacks
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	envID          = "/planes/radius/local/resourceGroups/app/providers/Radius.Core/environments/env"
+	orgPackID      = "/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/org"
+	orgPack2ID     = "/planes/radius/local/resourceGroups/platform/providers/Radius.Core/recipePacks/org2"
+	rgPackID       = "/planes/radius/local/resourceGroups/app/providers/Radius.Core/recipePacks/rg"
+	otherRGPackID  = "/planes/radius/local/resourceGroups/other/providers/Radius.Core/recipePacks/rg"
+	envPackID      = "/planes/radius/local/resourceGroups/app/providers/Radius.Core/recipePacks/env"
+	envPack2ID     = "/planes/radius/local/resourceGroups/app/providers/Radius.Core/recipePacks/env2"
+	redisType      = "Radius.Data/redisCaches"
+	sqlType        = "Radius.Data/sqlDatabases"
+	redisLocation  = "ghcr.io/org/recipes/redis:1.0"
+	redisLocation2 = "ghcr.io/team/recipes/redis:2.0"
+	sqlLocation    = "ghcr.io/org/recipes/sql:1.0"
+)
+
+func newPack(id string, layer datamodel.RecipePackLayer, recipes map[string]*datamodel.RecipeDefinition) *datamodel.RecipePack {
+	return &datamodel.RecipePack{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: id}},
+		Properties:   datamodel.RecipePackProperties{Layer: layer, Recipes: recipes},
+	}
+}
+
+func newEnvironment(recipePacks ...string) *datamodel.Environment_v20250801preview {
+	return &datamodel.Environment_v20250801preview{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: envID}},
+		Properties:   datamodel.EnvironmentProperties_v20250801preview{RecipePacks: recipePacks},
+	}
+}
+
+func Test_Resolve(t *testing.T) {
+	orgPack := newPack(orgPackID, datamodel.RecipePackLayerOrganization, map[string]*datamodel.RecipeDefinition{
+		redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation, Parameters: map[string]any{"size": "small", "tls": true}},
+		sqlType:   {RecipeKind: "bicep", RecipeLocation: sqlLocation},
+	})
+
+	t.Run("organization recipes apply to all environments of the plane", func(t *testing.T) {
+		resolution, err := Resolve(newEnvironment(), []*datamodel.RecipePack{orgPack})
+		require.NoError(t, err)
+		require.Empty(t, resolution.Conflicts)
+		require.Len(t, resolution.Recipes, 2)
+
+		recipe, err := resolution.Find("radius.data/rediscaches")
+		require.NoError(t, err)
+		require.Equal(t, orgPackID, recipe.RecipePackID)
+		require.Equal(t, datamodel.RecipePackLayerOrganization, recipe.Layer)
+		require.Equal(t, map[string]any{"size": "small", "tls": true}, recipe.Definition.Parameters)
+		require.Equal(t, map[string]string{"size": orgPackID, "tls": orgPackID}, recipe.ParameterSources)
+	})
+
+	t.Run("resource group recipes apply to environments of the resource group", func(t *testing.T) {
+		rgPack := newPack(rgPackID, datamodel.RecipePackLayerResourceGroup, map[string]*datamodel.RecipeDefinition{
+			sqlType: {RecipeKind: "terraform", RecipeLocation: "git::https://example.com/sql"},
+		})
+		otherRGPack := newPack(otherRGPackID, datamodel.RecipePackLayerResourceGroup, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "terraform", RecipeLocation: "git::https://example.com/redis"},
+		})
+
+		resolution, err := Resolve(newEnvironment(), []*datamodel.RecipePack{orgPack, rgPack, otherRGPack})
+		require.NoError(t, err)
+
+		recipe, err := resolution.Find(sqlType)
+		require.NoError(t, err)
+		require.Equal(t, rgPackID, recipe.RecipePackID)
+		require.Equal(t, datamodel.RecipePackLayerResourceGroup, recipe.Layer)
+		require.Equal(t, []string{orgPackID}, recipe.Overridden)
+
+		recipe, err = resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, orgPackID, recipe.RecipePackID)
+	})
+
+	t.Run("environment recipes override and inherit parameters of the same recipe", func(t *testing.T) {
+		envPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation, Parameters: map[string]any{"size": "large"}},
+		})
+		environment := newEnvironment(envPackID)
+		environment.Properties.RecipeParameters = map[string]map[string]any{
+			redisType: {"replicas": 3},
+		}
+
+		resolution, err := Resolve(environment, []*datamodel.RecipePack{orgPack, envPack})
+		require.NoError(t, err)
+
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, envPackID, recipe.RecipePackID)
+		require.Equal(t, datamodel.RecipePackLayerEnvironment, recipe.Layer)
+		require.Equal(t, map[string]any{"size": "large", "tls": true, "replicas": 3}, recipe.Definition.Parameters)
+		require.Equal(t, map[string]string{"size": envPackID, "tls": orgPackID, "replicas": ParameterSourceEnvironment}, recipe.ParameterSources)
+	})
+
+	t.Run("different recipe does not inherit parameters", func(t *testing.T) {
+		envPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation2},
+		})
+
+		resolution, err := Resolve(newEnvironment(envPackID), []*datamodel.RecipePack{orgPack, envPack})
+		require.NoError(t, err)
+
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, redisLocation2, recipe.Definition.RecipeLocation)
+		require.Empty(t, recipe.Definition.Parameters)
+		require.Equal(t, []string{orgPackID}, recipe.Overridden)
+	})
+
+	t.Run("referenced recipe pack applies at the environment layer", func(t *testing.T) {
+		resolution, err := Resolve(newEnvironment(orgPackID), []*datamodel.RecipePack{orgPack})
+		require.NoError(t, err)
+
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, datamodel.RecipePackLayerEnvironment, recipe.Layer)
+	})
+
+	t.Run("unreferenced environment recipe pack does not apply", func(t *testing.T) {
+		envPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation2},
+		})
+
+		resolution, err := Resolve(newEnvironment(), []*datamodel.RecipePack{envPack})
+		require.NoError(t, err)
+
+		_, err = resolution.Find(redisType)
+		require.ErrorIs(t, err, ErrRecipeNotFound)
+	})
+
+	t.Run("first referenced recipe pack wins in the environment layer", func(t *testing.T) {
+		envPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation},
+		})
+		envPack2 := newPack(envPack2ID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation2},
+		})
+
+		resolution, err := Resolve(newEnvironment(envPack2ID, envPackID), []*datamodel.RecipePack{orgPack, envPack, envPack2})
+		require.NoError(t, err)
+		require.Empty(t, resolution.Conflicts)
+
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, envPack2ID, recipe.RecipePackID)
+		require.Equal(t, redisLocation2, recipe.Definition.RecipeLocation)
+		require.Equal(t, []string{envPackID}, recipe.Ignored)
+	})
+
+	t.Run("conflict in the winning layer", func(t *testing.T) {
+		orgPack2 := newPack(orgPack2ID, datamodel.RecipePackLayerOrganization, map[string]*datamodel.RecipeDefinition{
+			sqlType: {RecipeKind: "bicep", RecipeLocation: sqlLocation},
+		})
+
+		resolution, err := Resolve(newEnvironment(), []*datamodel.RecipePack{orgPack, orgPack2})
+		require.NoError(t, err)
+		require.Equal(t, []Conflict{{ResourceType: sqlType, Layer: datamodel.RecipePackLayerOrganization, RecipePackIDs: []string{orgPackID, orgPack2ID}}}, resolution.Conflicts)
+
+		_, err = resolution.Find(sqlType)
+		require.ErrorIs(t, err, ErrConflict)
+		require.ErrorContains(t, err, orgPackID+", "+orgPack2ID)
+	})
+
+	t.Run("conflict in an overridden layer", func(t *testing.T) {
+		orgPack2 := newPack(orgPack2ID, datamodel.RecipePackLayerOrganization, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation, Parameters: map[string]any{"size": "medium"}},
+		})
+		envPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation},
+		})
+
+		resolution, err := Resolve(newEnvironment(envPackID), []*datamodel.RecipePack{orgPack, orgPack2, envPack})
+		require.NoError(t, err)
+		require.Empty(t, resolution.Conflicts)
+
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, envPackID, recipe.RecipePackID)
+		require.Empty(t, recipe.Definition.Parameters)
+	})
+
+	t.Run("trust policy", func(t *testing.T) {
+		trustedPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "bicep", RecipeLocation: redisLocation},
+		})
+		trustedPack.Properties.TrustPolicy = &datamodel.RecipeTrustPolicy{PublicKeys: []string{"pack-key"}}
+		environment := newEnvironment(envPackID)
+		environment.Properties.TrustPolicy = &datamodel.RecipeTrustPolicy{PublicKeys: []string{"env-key"}}
+
+		resolution, err := Resolve(environment, []*datamodel.RecipePack{orgPack, trustedPack})
+		require.NoError(t, err)
+
+		// The trust policy of the recipe pack is required in addition to the trust policy of the environment.
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, []recipes.TrustPolicy{{PublicKeys: []string{"env-key"}}, {PublicKeys: []string{"pack-key"}}}, recipe.Definition.TrustPolicies)
+
+		recipe, err = resolution.Find(sqlType)
+		require.NoError(t, err)
+		require.Equal(t, []recipes.TrustPolicy{{PublicKeys: []string{"env-key"}}}, recipe.Definition.TrustPolicies)
+	})
+
+	t.Run("trust policy is applied to terraform recipes", func(t *testing.T) {
+		terraformPack := newPack(envPackID, datamodel.RecipePackLayerEnvironment, map[string]*datamodel.RecipeDefinition{
+			redisType: {RecipeKind: "terraform", RecipeLocation: "git::https://example.com/redis"},
+		})
+		terraformPack.Properties.TrustPolicy = &datamodel.RecipeTrustPolicy{PublicKeys: []string{"pack-key"}}
+		environment := newEnvironment(envPackID)
+		environment.Properties.TrustPolicy = &datamodel.RecipeTrustPolicy{PublicKeys: []string{"env-key"}}
+
+		resolution, err := Resolve(environment, []*datamodel.RecipePack{terraformPack})
+		require.NoError(t, err)
+
+		recipe, err := resolution.Find(redisType)
+		require.NoError(t, err)
+		require.Equal(t, []recipes.TrustPolicy{{PublicKeys: []string{"env-key"}}, {PublicKeys: []string{"pack-key"}}}, recipe.Definition.TrustPolicies)
+	})
+
+	t.Run("invalid environment ID", func(t *testing.T) {
+		environment := newEnvironment()
+		environment.ID = "invalid"
+
+		_, err := Resolve(environment, nil)
+		require.Error(t, err)
+	})
+}
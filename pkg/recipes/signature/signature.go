@@ -0,0 +1,241 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+)
+
+const (
+	// SimpleSigningMediaType is the media type of the layers of a cosign signature manifest. The layer content is the
+	// signed payload.
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	// SignatureAnnotation is the annotation of a cosign signature layer holding the base64-encoded signature of the payload.
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// PayloadType is the type of the cosign signature payload.
+	PayloadType = "cosign container image signature"
+)
+
+var (
+	// ErrNotSigned is returned when an artifact has no signature.
+	ErrNotSigned = errors.New("the artifact is not signed")
+
+	// ErrUntrusted is returned when an artifact has no valid signature from a trusted key.
+	ErrUntrusted = errors.New("the artifact is not signed by a trusted key")
+)
+
+// Payload is the payload signed by cosign for an artifact, in the simple signing format.
+type Payload struct {
+	Critical Critical       `json:"critical"`
+	Optional map[string]any `json:"optional"`
+}
+
+// Critical is the critical section of a cosign signature payload.
+type Critical struct {
+	Identity Identity `json:"identity"`
+	Image    Image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+// Identity is the identity of the signed artifact.
+type Identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+// Image is the signed artifact.
+type Image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// SignatureTag returns the tag of the cosign signature manifest of the artifact with the given manifest digest.
+func SignatureTag(manifestDigest digest.Digest) string {
+	return fmt.Sprintf("%s-%s.sig", manifestDigest.Algorithm(), manifestDigest.Encoded())
+}
+
+// Verifier verifies the cosign signatures of artifacts stored in OCI registries against a trust policy.
+//
+// Signatures are expected to be stored the way 'cosign sign --key' stores them: as a manifest tagged with
+// SignatureTag in the repository of the artifact.
+type Verifier struct {
+	keys []crypto.PublicKey
+}
+
+// NewVerifier creates a verifier for the given trust policy. It returns an error if the policy has no public keys or if a
+// key cannot be parsed.
+func NewVerifier(policy recipes.TrustPolicy) (*Verifier, error) {
+	if len(policy.PublicKeys) == 0 {
+		return nil, errors.New("the trust policy must have at least one public key")
+	}
+
+	verifier := &Verifier{}
+	for i, encoded := range policy.PublicKeys {
+		key, err := parsePublicKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key at index %d of the trust policy: %w", i, err)
+		}
+		verifier.keys = append(verifier.keys, key)
+	}
+
+	return verifier, nil
+}
+
+// Verify verifies that the artifact described by the manifest descriptor has a valid signature from one of the trusted
+// keys, and that the signature was created for the manifest digest. ErrNotSigned or ErrUntrusted is returned when the
+// artifact is not signed or not signed by a trusted key.
+func (v *Verifier) Verify(ctx context.Context, target oras.ReadOnlyTarget, manifest ocispec.Descriptor) error {
+	signatureDesc, err := target.Resolve(ctx, SignatureTag(manifest.Digest))
+	if errors.Is(err, errdef.ErrNotFound) {
+		return fmt.Errorf("%w: no signature found for digest %s", ErrNotSigned, manifest.Digest)
+	} else if err != nil {
+		return fmt.Errorf("failed to resolve the signature of digest %s: %w", manifest.Digest, err)
+	}
+
+	signatureManifestBytes, err := content.FetchAll(ctx, target, signatureDesc)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the signature of digest %s: %w", manifest.Digest, err)
+	}
+
+	signatureManifest := ocispec.Manifest{}
+	if err := json.Unmarshal(signatureManifestBytes, &signatureManifest); err != nil {
+		return fmt.Errorf("failed to decode the signature of digest %s: %w", manifest.Digest, err)
+	}
+
+	// A signature manifest may have several signatures, e.g. from different keys. The artifact is trusted if any of
+	// them is a valid signature of the artifact from a trusted key.
+	var payloadErr error
+	for _, layer := range signatureManifest.Layers {
+		if layer.MediaType != SimpleSigningMediaType {
+			continue
+		}
+
+		encoded, ok := layer.Annotations[SignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+
+		// FetchAll checks the digest of the payload against the layer descriptor.
+		payload, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return fmt.Errorf("failed to fetch the signature payload of digest %s: %w", manifest.Digest, err)
+		}
+
+		if !v.verifySignature(payload, signature) {
+			continue
+		}
+
+		if err := checkPayload(payload, manifest.Digest); err != nil {
+			payloadErr = err
+			continue
+		}
+
+		return nil
+	}
+
+	// Report why a signature from a trusted key was rejected, which is more useful than the absence of a valid signature.
+	if payloadErr != nil {
+		return payloadErr
+	}
+
+	return fmt.Errorf("%w: no valid signature found for digest %s", ErrUntrusted, manifest.Digest)
+}
+
+// verifySignature returns true if the signature of the payload is valid for any of the trusted keys.
+func (v *Verifier) verifySignature(payload []byte, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	for _, key := range v.keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// checkPayload checks that the signed payload was created for the artifact with the given manifest digest. The
+// identity of the payload is not checked so that signed artifacts can be copied between registries.
+func checkPayload(payload []byte, manifestDigest digest.Digest) error {
+	decoded := Payload{}
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return fmt.Errorf("failed to decode the signature payload of digest %s: %w", manifestDigest, err)
+	}
+
+	if decoded.Critical.Type != PayloadType {
+		return fmt.Errorf("%w: unsupported signature payload type %q", ErrUntrusted, decoded.Critical.Type)
+	}
+
+	if decoded.Critical.Image.DockerManifestDigest != manifestDigest.String() {
+		return fmt.Errorf("%w: the signature was created for digest %s, not %s", ErrUntrusted, decoded.Critical.Image.DockerManifestDigest, manifestDigest)
+	}
+
+	return nil
+}
+
+// parsePublicKey parses a PEM-encoded PKIX public key.
+func parsePublicKey(encoded string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("the key is not PEM-encoded")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/signature"
+	"github.com/radius-project/radius/pkg/rp/util/registrytest"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+const testReference = "localhost:5000/recipes/redis"
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) string {
+	b, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))
+}
+
+func pushArtifact(t *testing.T, store *memory.Store, content string) ocispec.Descriptor {
+	desc, err := oras.TagBytes(testcontext.New(t), store, ocispec.MediaTypeImageManifest, []byte(content), "latest")
+	require.NoError(t, err)
+	return desc
+}
+
+func Test_SignatureTag(t *testing.T) {
+	require.Equal(t, "sha256-2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae.sig", signature.SignatureTag("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"))
+}
+
+func Test_NewVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		verifier, err := signature.NewVerifier(recipes.TrustPolicy{PublicKeys: []string{encodePublicKey(t, key.Public())}})
+		require.NoError(t, err)
+		require.NotNil(t, verifier)
+	})
+
+	t.Run("no keys", func(t *testing.T) {
+		_, err := signature.NewVerifier(recipes.TrustPolicy{})
+		require.EqualError(t, err, "the trust policy must have at least one public key")
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		_, err := signature.NewVerifier(recipes.TrustPolicy{PublicKeys: []string{encodePublicKey(t, key.Public()), "not-a-key"}})
+		require.EqualError(t, err, "invalid public key at index 1 of the trust policy: the key is not PEM-encoded")
+	})
+}
+
+func Test_Verify(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	verifier, err := signature.NewVerifier(recipes.TrustPolicy{
+		PublicKeys: []string{
+			encodePublicKey(t, ecdsaKey.Public()),
+			encodePublicKey(t, rsaKey.Public()),
+			encodePublicKey(t, ed25519Key.Public()),
+		},
+	})
+	require.NoError(t, err)
+
+	signers := map[string]crypto.Signer{
+		"ecdsa":   ecdsaKey,
+		"rsa":     rsaKey,
+		"ed25519": ed25519Key,
+	}
+	for name, signer := range signers {
+		t.Run("signed with trusted "+name+" key", func(t *testing.T) {
+			ctx := testcontext.New(t)
+			store := memory.New()
+			manifest := pushArtifact(t, store, `{"schemaVersion":2}`)
+
+			err := registrytest.Sign(ctx, store, testReference, manifest, signer)
+			require.NoError(t, err)
+
+			err = verifier.Verify(ctx, store, manifest)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("not signed", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		store := memory.New()
+		manifest := pushArtifact(t, store, `{"schemaVersion":2}`)
+
+		err := verifier.Verify(ctx, store, manifest)
+		require.ErrorIs(t, err, signature.ErrNotSigned)
+	})
+
+	t.Run("signed with untrusted key", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		store := memory.New()
+		manifest := pushArtifact(t, store, `{"schemaVersion":2}`)
+
+		err := registrytest.Sign(ctx, store, testReference, manifest, untrustedKey)
+		require.NoError(t, err)
+
+		err = verifier.Verify(ctx, store, manifest)
+		require.ErrorIs(t, err, signature.ErrUntrusted)
+	})
+
+	t.Run("signature created for another digest", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		store := memory.New()
+		manifest := pushArtifact(t, store, `{"schemaVersion":2}`)
+		other := pushArtifact(t, store, `{"schemaVersion":2,"annotations":{}}`)
+
+		// Sign the other artifact, and move its signature to the artifact being verified.
+		err := registrytest.Sign(ctx, store, testReference, other, ecdsaKey)
+		require.NoError(t, err)
+		_, err = oras.Tag(ctx, store, signature.SignatureTag(other.Digest), signature.SignatureTag(manifest.Digest))
+		require.NoError(t, err)
+
+		err = verifier.Verify(ctx, store, manifest)
+		require.ErrorIs(t, err, signature.ErrUntrusted)
+		require.ErrorContains(t, err, "the signature was created for digest "+other.Digest.String())
+	})
+
+	t.Run("signature created for another digest and valid signature", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		store := memory.New()
+		manifest := pushArtifact(t, store, `{"schemaVersion":2}`)
+		other := pushArtifact(t, store, `{"schemaVersion":2,"annotations":{}}`)
+
+		// The first signature is valid for the other artifact only, the second one is valid for the artifact.
+		invalid, err := registrytest.PushSignatureLayer(ctx, store, testReference, other.Digest, ecdsaKey)
+		require.NoError(t, err)
+		valid, err := registrytest.PushSignatureLayer(ctx, store, testReference, manifest.Digest, rsaKey)
+		require.NoError(t, err)
+		err = registrytest.TagSignature(ctx, store, manifest.Digest, invalid, valid)
+		require.NoError(t, err)
+
+		err = verifier.Verify(ctx, store, manifest)
+		require.NoError(t, err)
+	})
+}
@@ -68,6 +68,12 @@ type executor struct {
 // Deploy ensures Terraform is available, creates a working directory, generates a config, and runs Terraform init and
 // apply in the working directory, returning an error if any of these steps fail.
 func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
+	// Validate the existing resources to import before doing any work.
+	imports, err := getImportTargets(options)
+	if err != nil {
+		return nil, err
+	}
+
 	// Install Terraform
 	i := install.NewInstaller()
 	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
@@ -92,9 +98,9 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 		return nil, err
 	}
 
-	// Run TF Init and Apply in the working directory
+	// Run TF Init, Import and Apply in the working directory
 	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	state, err := initAndApply(ctx, tf, stateLockTimeout)
+	state, err := initAndApply(ctx, tf, stateLockTimeout, imports)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +127,12 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 func (e *executor) Delete(ctx context.Context, options Options) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	// Imported resources that are not managed by Radius are kept when the recipe resources are deleted.
+	imports, err := getImportTargets(options)
+	if err != nil {
+		return err
+	}
+
 	// Install Terraform
 	i := install.NewInstaller()
 	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
@@ -164,7 +176,7 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 
 	// Run TF Destroy in the working directory to delete the resources deployed by the recipe
 	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	err = initAndDestroy(ctx, tf, stateLockTimeout)
+	err = initAndDestroy(ctx, tf, stateLockTimeout, getUnmanagedImportTargets(imports))
 	if err != nil {
 		return err
 	}
@@ -426,8 +438,9 @@ func getStateLockTimeout(timeout string) string {
 	return timeout
 }
 
-// initAndApply runs Terraform init and apply in the provided working directory.
-func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) (*tfjson.State, error) {
+// initAndApply runs Terraform init and apply in the provided working directory. Existing resources in imports are
+// imported into the Terraform state before apply, so that apply adopts them instead of creating new resources.
+func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string, imports []importTarget) (*tfjson.State, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
@@ -442,6 +455,10 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
 
+	if err := importResources(ctx, tf, imports, stateLockTimeout); err != nil {
+		return nil, err
+	}
+
 	// Apply Terraform configuration with state lock timeout
 	logger.Info("Running Terraform apply with state lock timeout: " + stateLockTimeout)
 	if err := tf.Apply(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
@@ -467,8 +484,154 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	return tf.Show(ctx)
 }
 
-// initAndDestroy runs Terraform init and destroy in the provided working directory.
-func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) error {
+// importTarget is an existing resource to import into the Terraform state of a recipe.
+type importTarget struct {
+	// Address is the address of the resource in the Terraform configuration, including the recipe module.
+	Address string
+
+	// ID is the provider-specific identifier of the existing resource.
+	ID string
+
+	// RadiusManaged is true if the resource is deleted with the other resources of the recipe.
+	RadiusManaged bool
+}
+
+// getImportTargets returns the existing resources to import for the resource recipe. Addresses specified on the
+// resource are relative to the recipe module, which is referenced in the configuration by the recipe name.
+func getImportTargets(options Options) ([]importTarget, error) {
+	if options.ResourceRecipe == nil || len(options.ResourceRecipe.Imports) == 0 {
+		return nil, nil
+	}
+
+	if options.EnvRecipe == nil || options.EnvRecipe.Name == "" {
+		return nil, ErrRecipeNameEmpty
+	}
+
+	targets := []importTarget{}
+	for _, imported := range options.ResourceRecipe.Imports {
+		if imported.Address == "" {
+			return nil, fmt.Errorf("the Terraform address of the imported resource %q is required", imported.ID)
+		}
+
+		// The import ID is specific to the provider of the resource, the Radius resource ID is never a valid one.
+		if imported.ImportID == "" {
+			return nil, fmt.Errorf("the Terraform import ID of the imported resource %q is required", imported.ID)
+		}
+
+		targets = append(targets, importTarget{
+			Address:       fmt.Sprintf("module.%s.%s", options.EnvRecipe.Name, imported.Address),
+			ID:            imported.ImportID,
+			RadiusManaged: imported.RadiusManaged,
+		})
+	}
+
+	return targets, nil
+}
+
+// importResources runs Terraform import for each of the targets that is not already present in the Terraform state.
+// Resources imported by a previous deployment of the recipe are skipped, which makes the import idempotent.
+func importResources(ctx context.Context, tf *tfexec.Terraform, targets []importTarget, stateLockTimeout string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Suppress stdout during tf.Show to prevent Terraform state (which may
+	// contain sensitive values) from being written to the Radius logs.
+	tf.SetStdout(io.Discard)
+	state, err := tf.Show(ctx)
+	tf.SetStdout(&tfLogWrapper{logger: logger})
+	if err != nil {
+		return fmt.Errorf("terraform show failure: %w", err)
+	}
+
+	existing := getStateResourceAddresses(state)
+	for _, target := range targets {
+		if existing[target.Address] {
+			logger.Info(fmt.Sprintf("Resource %q is already present in the Terraform state, skipping import", target.Address))
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Running Terraform import of %q to %q", target.ID, target.Address))
+		if err := tf.Import(ctx, target.Address, target.ID, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+			return fmt.Errorf("terraform import failure for %q: %w", target.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// getUnmanagedImportTargets returns the imported resources whose lifecycle is not managed by Radius.
+func getUnmanagedImportTargets(targets []importTarget) []importTarget {
+	unmanaged := []importTarget{}
+	for _, target := range targets {
+		if !target.RadiusManaged {
+			unmanaged = append(unmanaged, target)
+		}
+	}
+
+	return unmanaged
+}
+
+// removeResources removes each of the targets that is present in the Terraform state from the state, so that
+// Terraform stops managing the resources without destroying them.
+func removeResources(ctx context.Context, tf *tfexec.Terraform, targets []importTarget, stateLockTimeout string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Suppress stdout during tf.Show to prevent Terraform state (which may
+	// contain sensitive values) from being written to the Radius logs.
+	tf.SetStdout(io.Discard)
+	state, err := tf.Show(ctx)
+	tf.SetStdout(&tfLogWrapper{logger: logger})
+	if err != nil {
+		return fmt.Errorf("terraform show failure: %w", err)
+	}
+
+	existing := getStateResourceAddresses(state)
+	for _, target := range targets {
+		if !existing[target.Address] {
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Removing imported resource %q from the Terraform state, it's not managed by Radius", target.Address))
+		if err := tf.StateRm(ctx, target.Address, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+			return fmt.Errorf("terraform state rm failure for %q: %w", target.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// getStateResourceAddresses returns the addresses of all resources in the Terraform state, including the resources
+// of child modules.
+func getStateResourceAddresses(state *tfjson.State) map[string]bool {
+	addresses := map[string]bool{}
+	if state == nil || state.Values == nil || state.Values.RootModule == nil {
+		return addresses
+	}
+
+	modules := []*tfjson.StateModule{state.Values.RootModule}
+	for len(modules) > 0 {
+		module := modules[0]
+		modules = modules[1:]
+
+		for _, resource := range module.Resources {
+			addresses[resource.Address] = true
+		}
+		modules = append(modules, module.ChildModules...)
+	}
+
+	return addresses
+}
+
+// initAndDestroy runs Terraform init and destroy in the provided working directory. The resources in retained are
+// removed from the Terraform state before destroy, so that they're not deleted.
+func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string, retained []importTarget) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Initialize Terraform
@@ -482,6 +645,10 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout
 	}
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime, nil)
 
+	if err := removeResources(ctx, tf, retained, stateLockTimeout); err != nil {
+		return err
+	}
+
 	// Destroy Terraform configuration with state lock timeout
 	logger.Info("Running Terraform destroy with state lock timeout: " + stateLockTimeout)
 	if err := tf.Destroy(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
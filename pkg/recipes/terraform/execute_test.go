@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
@@ -401,3 +402,125 @@ func TestSplitEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetImportTargets(t *testing.T) {
+	envRecipe := &recipes.EnvironmentDefinition{
+		Name:         "orders-db",
+		TemplatePath: "test/module/source",
+	}
+
+	tests := []struct {
+		name    string
+		options Options
+		want    []importTarget
+		wantErr string
+	}{
+		{
+			name:    "no imports",
+			options: Options{EnvRecipe: envRecipe, ResourceRecipe: &recipes.ResourceMetadata{}},
+		},
+		{
+			name: "imports",
+			options: Options{
+				EnvRecipe: envRecipe,
+				ResourceRecipe: &recipes.ResourceMetadata{
+					Imports: []recipes.ImportResource{
+						{
+							ID:       "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders",
+							Address:  "aws_db_instance.db",
+							ImportID: "orders",
+						},
+						{
+							ID:            "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache",
+							Address:       "azurerm_redis_cache.cache",
+							ImportID:      "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache",
+							RadiusManaged: true,
+						},
+					},
+				},
+			},
+			want: []importTarget{
+				{Address: "module.orders-db.aws_db_instance.db", ID: "orders"},
+				{Address: "module.orders-db.azurerm_redis_cache.cache", ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache", RadiusManaged: true},
+			},
+		},
+		{
+			name: "missing address",
+			options: Options{
+				EnvRecipe: envRecipe,
+				ResourceRecipe: &recipes.ResourceMetadata{
+					Imports: []recipes.ImportResource{{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache"}},
+				},
+			},
+			wantErr: "the Terraform address of the imported resource \"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache\" is required",
+		},
+		{
+			name: "missing import ID",
+			options: Options{
+				EnvRecipe: envRecipe,
+				ResourceRecipe: &recipes.ResourceMetadata{
+					Imports: []recipes.ImportResource{{ID: "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders", Address: "aws_db_instance.db"}},
+				},
+			},
+			wantErr: "the Terraform import ID of the imported resource \"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders\" is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getImportTargets(tt.options)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_GetUnmanagedImportTargets(t *testing.T) {
+	require.Empty(t, getUnmanagedImportTargets(nil))
+
+	targets := []importTarget{
+		{Address: "module.orders-db.aws_db_instance.db", ID: "orders"},
+		{Address: "module.orders-db.azurerm_redis_cache.cache", ID: "cache", RadiusManaged: true},
+	}
+	require.Equal(t, []importTarget{
+		{Address: "module.orders-db.aws_db_instance.db", ID: "orders"},
+	}, getUnmanagedImportTargets(targets))
+}
+
+func Test_GetStateResourceAddresses(t *testing.T) {
+	require.Empty(t, getStateResourceAddresses(nil))
+	require.Empty(t, getStateResourceAddresses(&tfjson.State{}))
+
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				ChildModules: []*tfjson.StateModule{
+					{
+						Address: "module.orders-db",
+						Resources: []*tfjson.StateResource{
+							{Address: "module.orders-db.aws_db_instance.db"},
+						},
+						ChildModules: []*tfjson.StateModule{
+							{
+								Address: "module.orders-db.module.network",
+								Resources: []*tfjson.StateResource{
+									{Address: "module.orders-db.module.network.aws_subnet.this[0]"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, map[string]bool{
+		"module.orders-db.aws_db_instance.db":                true,
+		"module.orders-db.module.network.aws_subnet.this[0]": true,
+	}, getStateResourceAddresses(state))
+}
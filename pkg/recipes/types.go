@@ -19,6 +19,8 @@ package recipes
 import (
 	"bytes"
 	"encoding/json"
+	"slices"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -34,6 +36,8 @@ type ConnectedResource struct {
 	Type string `json:"type"`
 	// Properties represents the resource properties
 	Properties map[string]any `json:"properties,omitempty"`
+	// Outputs represents the values output by the recipe of the connected resource
+	Outputs map[string]any `json:"outputs,omitempty"`
 }
 
 // Configuration represents runtime and cloud provider configuration, which is used by the driver while deploying recipes.
@@ -44,6 +48,8 @@ type Configuration struct {
 	Providers datamodel.Providers
 	// Simulated represents whether the environment is simulated or not.
 	Simulated bool
+	// TrustPolicy represents the policy used to verify the signatures of the recipes used in the environment.
+	TrustPolicy *TrustPolicy
 
 	RecipeConfig datamodel.RecipeConfigProperties
 }
@@ -83,6 +89,16 @@ type EnvironmentDefinition struct {
 	TemplateVersion string
 	// Allows insecure connections to registry without SSL check.
 	PlainHTTP bool
+	// TrustPolicies represents the policies used to verify the signature of the recipe before it is pulled. The recipe
+	// is trusted only if it satisfies every policy. Signatures are not verified when empty.
+	TrustPolicies []TrustPolicy
+}
+
+// TrustPolicy represents the policy used to verify the signatures of recipes stored in OCI registries.
+type TrustPolicy struct {
+	// PublicKeys represents the PEM-encoded public keys trusted to sign recipes. A recipe is trusted if it has a valid
+	// signature from any of the keys.
+	PublicKeys []string
 }
 
 // ResourceMetadata represents recipe details provided while deploying a portable or a user-defined resource.
@@ -103,6 +119,22 @@ type ResourceMetadata struct {
 	ConnectedResourcesProperties map[string]ConnectedResource
 	// Parameters represents key/value pairs to pass into the recipe template. Overrides any parameters set by the environment.
 	Parameters map[string]any
+	// Imports represents existing resources that the recipe adopts instead of creating them.
+	Imports []ImportResource
+}
+
+// ImportResource represents an existing resource that is brought under the management of a recipe.
+type ImportResource struct {
+	// ID represents the fully qualified resource ID of the existing resource.
+	ID string
+	// Address represents the address of the resource within the Terraform recipe module, for example "aws_db_instance.db".
+	// Required for Terraform recipes, ignored by Bicep recipes.
+	Address string
+	// ImportID represents the provider-specific identifier passed to "terraform import". Required for Terraform recipes.
+	ImportID string
+	// RadiusManaged represents whether Radius manages the lifecycle of the imported resource. Imported resources are
+	// not deleted with the resource or garbage collected unless it's set.
+	RadiusManaged bool
 }
 
 const (
@@ -130,6 +162,42 @@ type RecipeOutput struct {
 
 	// Status represents the recipe status at deployment time of resource.
 	Status *rpv1.RecipeStatus
+
+	// Conditions represents the conditions of the deployed resource reported by the recipe, for example a Ready
+	// condition derived from the health of the underlying infrastructure.
+	Conditions []rpv1.Condition
+
+	// Simulated indicates that the output was synthesized for a simulated environment and no resources were deployed.
+	Simulated bool
+
+	// UnmanagedResources represents the output resources whose lifecycle is not managed by Radius, like the imported
+	// resources. They're not deleted with the resource or garbage collected.
+	UnmanagedResources []string
+}
+
+// AddImportedResources adds the IDs of the imported resources to the output resources if they are not already
+// present, so that imported resources are tracked by Radius even when the recipe does not report them. Imported
+// resources are recorded as unmanaged unless the user opted in to their management.
+func (ro *RecipeOutput) AddImportedResources(imports []ImportResource) {
+	for _, imported := range imports {
+		found := slices.ContainsFunc(ro.Resources, func(id string) bool {
+			return strings.EqualFold(id, imported.ID)
+		})
+		if !found {
+			ro.Resources = append(ro.Resources, imported.ID)
+		}
+
+		if !imported.RadiusManaged && !ro.IsUnmanaged(imported.ID) {
+			ro.UnmanagedResources = append(ro.UnmanagedResources, imported.ID)
+		}
+	}
+}
+
+// IsUnmanaged returns true if the output resource with the ID is not managed by Radius.
+func (ro *RecipeOutput) IsUnmanaged(id string) bool {
+	return slices.ContainsFunc(ro.UnmanagedResources, func(unmanaged string) bool {
+		return strings.EqualFold(unmanaged, id)
+	})
 }
 
 // SecretData represents secrets data and includes secret type and a map of secret keys to their values.
@@ -160,6 +228,9 @@ type RecipeDefinition struct {
 	Parameters map[string]any
 	// PlainHTTP connects to the location using HTTP (not-HTTPS)
 	PlainHTTP bool
+	// TrustPolicies represents the trust policies of the environment and of the recipe pack the recipe is defined in.
+	// The recipe is trusted only if it satisfies every policy.
+	TrustPolicies []TrustPolicy
 }
 
 // PrepareRecipeOutput populates the recipe output from the recipe deployment output stored in the "result" object.
@@ -80,3 +80,37 @@ func TestRecipeOutput_PrepareRecipeResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestRecipeOutput_PrepareRecipeResponse_Conditions(t *testing.T) {
+	ro := &RecipeOutput{}
+	err := ro.PrepareRecipeResponse(map[string]any{
+		"values": map[string]any{"host": "testhost"},
+		"conditions": []any{
+			map[string]any{"type": "Ready", "status": "False", "reason": "FailingOver", "message": "the primary replica is failing over"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []rpv1.Condition{
+		{Type: rpv1.ConditionReady, Status: rpv1.ConditionFalse, Reason: "FailingOver", Message: "the primary replica is failing over"},
+	}, ro.Conditions)
+}
+
+func TestRecipeOutput_AddImportedResources(t *testing.T) {
+	ro := &RecipeOutput{
+		Resources: []string{"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders"},
+	}
+
+	ro.AddImportedResources([]ImportResource{
+		{ID: "/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/ORDERS"},
+		{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache", RadiusManaged: true},
+	})
+
+	require.Equal(t, []string{
+		"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders",
+		"/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache",
+	}, ro.Resources)
+	require.Equal(t, []string{"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/ORDERS"}, ro.UnmanagedResources)
+
+	require.True(t, ro.IsUnmanaged("/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.RDS/DBInstance/orders"))
+	require.False(t, ro.IsUnmanaged("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Cache/redis/cache"))
+}
@@ -19,6 +19,7 @@ package resourceutil
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 
 	"github.com/radius-project/radius/pkg/ucp/resources"
 )
@@ -137,3 +138,26 @@ func GetAllPropertiesFromResource[P any](resource P) (*ResourceMetadata, error)
 		Properties: partialResource.Properties,
 	}, nil
 }
+
+// GetRecipeOutputsFromResource extracts the values output by the recipe of the resource. Portable resources store them
+// in the "computedValues" field, and dynamic resources store them in the "properties.status.computedValues" field.
+func GetRecipeOutputsFromResource[P any](resource P) (map[string]any, error) {
+	var partialResource struct {
+		ComputedValues map[string]any `json:"computedValues"`
+		Properties     struct {
+			Status struct {
+				ComputedValues map[string]any `json:"computedValues"`
+			} `json:"status"`
+		} `json:"properties"`
+	}
+
+	if err := marshalAndUnmarshalResource(resource, &partialResource); err != nil {
+		return nil, err
+	}
+
+	outputs := map[string]any{}
+	maps.Copy(outputs, partialResource.ComputedValues)
+	maps.Copy(outputs, partialResource.Properties.Status.ComputedValues)
+
+	return outputs, nil
+}
@@ -550,3 +550,48 @@ func TestGetAllPropertiesFromResource(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRecipeOutputsFromResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource any
+		expected map[string]any
+	}{
+		{
+			name: "portable resource",
+			resource: map[string]any{
+				"id":             TestResourceID,
+				"computedValues": map[string]any{"host": "localhost", "port": float64(6379)},
+			},
+			expected: map[string]any{"host": "localhost", "port": float64(6379)},
+		},
+		{
+			name: "dynamic resource",
+			resource: map[string]any{
+				"id": TestResourceID,
+				"properties": map[string]any{
+					"status": map[string]any{
+						"computedValues": map[string]any{"subnetId": "subnet-1"},
+					},
+				},
+			},
+			expected: map[string]any{"subnetId": "subnet-1"},
+		},
+		{
+			name: "no outputs",
+			resource: &PropertiesTestResource{
+				ID:         TestResourceID,
+				Properties: map[string]any{"host": "localhost"},
+			},
+			expected: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputs, err := GetRecipeOutputsFromResource(tt.resource)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, outputs)
+		})
+	}
+}
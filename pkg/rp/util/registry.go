@@ -23,25 +23,30 @@ import (
 	"net/url"
 
 	dockerParser "github.com/novln/docker-parser"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/signature"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry/remote"
 )
 
-// ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. It returns an error if the path is invalid,
-// if the client to the registry fails to be created, if the manifest fails to be fetched, if the bytes fail to be fetched, or if
-// the data fails to be unmarshalled.
-func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client) error {
+// ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. It returns the digest of the manifest
+// the data was read from. If the definition has trust policies, the signature of the manifest is verified against each
+// policy before the data is read.
+//
+// It returns an error if the path is invalid, if the client to the registry fails to be created, if the manifest fails to
+// be fetched, if the signature verification fails, if the bytes fail to be fetched, or if the data fails to be unmarshalled.
+func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client) (string, error) {
 	registryRepo, tag, err := parsePath(definition.TemplatePath)
 	if err != nil {
-		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
 	}
 
 	repo, err := remote.NewRepository(registryRepo)
 	if err != nil {
-		return fmt.Errorf("failed to create client to registry %s", err.Error())
+		return "", fmt.Errorf("failed to create client to registry %s", err.Error())
 	}
 
 	repo.Client = client
@@ -50,31 +55,50 @@ func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinit
 		repo.PlainHTTP = true
 	}
 
-	digest, err := getDigestFromManifest(ctx, repo, tag)
+	// resolves a manifest descriptor with a Tag reference. The descriptor is used for both the signature verification and
+	// fetching the data, so that the verified manifest is the one that is read even if the tag is updated in between.
+	manifest, err := repo.Resolve(ctx, tag)
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		return "", recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+	}
+
+	for _, policy := range definition.TrustPolicies {
+		err = verifySignature(ctx, repo, manifest, policy)
+		if err != nil {
+			return "", recipes.NewRecipeError(recipes.RecipeSignatureVerificationFailed, fmt.Sprintf("failed to verify the signature of the recipe %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		}
+	}
+
+	digest, err := getDigestFromManifest(ctx, repo, manifest)
+	if err != nil {
+		return "", recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
 	}
 
 	bytes, err := getBytes(ctx, repo, digest)
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		return "", recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
 	}
 
 	err = json.Unmarshal(bytes, data)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return manifest.Digest.String(), nil
 }
 
-// getDigestFromManifest gets the layers digest from the manifest
-func getDigestFromManifest(ctx context.Context, repo *remote.Repository, tag string) (string, error) {
-	// resolves a manifest descriptor with a Tag reference
-	descriptor, err := repo.Resolve(ctx, tag)
+// verifySignature verifies the signature of the manifest against the trust policy.
+func verifySignature(ctx context.Context, repo *remote.Repository, manifest ocispec.Descriptor, policy recipes.TrustPolicy) error {
+	verifier, err := signature.NewVerifier(policy)
 	if err != nil {
-		return "", err
+		return err
 	}
+
+	return verifier.Verify(ctx, repo, manifest)
+}
+
+// getDigestFromManifest gets the layers digest from the manifest
+func getDigestFromManifest(ctx context.Context, repo *remote.Repository, descriptor ocispec.Descriptor) (string, error) {
 	// get the manifest data
 	rc, err := repo.Fetch(ctx, descriptor)
 	if err != nil {
@@ -17,10 +17,17 @@ limitations under the License.
 package util
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/rp/util/registrytest"
+	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/require"
 )
 
@@ -137,3 +144,89 @@ func Test_GetRegistrySecrets(t *testing.T) {
 		}
 	}
 }
+
+func Test_ReadFromRegistry(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	b, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	trustPolicies := []recipes.TrustPolicy{
+		{PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))}},
+	}
+	b, err = x509.MarshalPKIXPublicKey(untrustedKey.Public())
+	require.NoError(t, err)
+	untrustedPolicy := recipes.TrustPolicy{
+		PublicKeys: []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))},
+	}
+
+	expected := map[string]any{
+		"parameters": map[string]any{
+			"documentdbName": map[string]any{"type": "string"},
+			"location":       map[string]any{"defaultValue": "[resourceGroup().location]", "type": "string"},
+		},
+	}
+
+	t.Run("unsigned recipe without trust policy", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, nil)
+		t.Cleanup(ts.CloseServer)
+
+		data := map[string]any{}
+		digest, err := ReadFromRegistry(testcontext.New(t), recipes.EnvironmentDefinition{TemplatePath: ts.TestImageURL}, &data, ts.TestServer.Client())
+		require.NoError(t, err)
+		require.Equal(t, ts.Digest, digest)
+		require.Equal(t, expected, data)
+	})
+
+	t.Run("signed recipe with trust policy", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, key)
+		t.Cleanup(ts.CloseServer)
+
+		data := map[string]any{}
+		digest, err := ReadFromRegistry(testcontext.New(t), recipes.EnvironmentDefinition{TemplatePath: ts.TestImageURL, TrustPolicies: trustPolicies}, &data, ts.TestServer.Client())
+		require.NoError(t, err)
+		require.Equal(t, ts.Digest, digest)
+		require.Equal(t, expected, data)
+	})
+
+	t.Run("unsigned recipe with trust policy", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, nil)
+		t.Cleanup(ts.CloseServer)
+
+		data := map[string]any{}
+		_, err := ReadFromRegistry(testcontext.New(t), recipes.EnvironmentDefinition{TemplatePath: ts.TestImageURL, TrustPolicies: trustPolicies}, &data, ts.TestServer.Client())
+		recipeErr, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeSignatureVerificationFailed, recipeErr.ErrorDetails.Code)
+		require.Equal(t, "failed to verify the signature of the recipe \""+ts.TestImageURL+"\": the artifact is not signed: no signature found for digest "+ts.Digest, recipeErr.ErrorDetails.Message)
+		require.Empty(t, data)
+	})
+
+	t.Run("recipe signed with untrusted key", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, untrustedKey)
+		t.Cleanup(ts.CloseServer)
+
+		data := map[string]any{}
+		_, err := ReadFromRegistry(testcontext.New(t), recipes.EnvironmentDefinition{TemplatePath: ts.TestImageURL, TrustPolicies: trustPolicies}, &data, ts.TestServer.Client())
+		recipeErr, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeSignatureVerificationFailed, recipeErr.ErrorDetails.Code)
+		require.Equal(t, "failed to verify the signature of the recipe \""+ts.TestImageURL+"\": the artifact is not signed by a trusted key: no valid signature found for digest "+ts.Digest, recipeErr.ErrorDetails.Message)
+		require.Empty(t, data)
+	})
+
+	t.Run("recipe must satisfy every trust policy", func(t *testing.T) {
+		ts := registrytest.NewFakeSignedRegistryServer(t, key)
+		t.Cleanup(ts.CloseServer)
+
+		data := map[string]any{}
+		definition := recipes.EnvironmentDefinition{TemplatePath: ts.TestImageURL, TrustPolicies: append(trustPolicies, untrustedPolicy)}
+		_, err := ReadFromRegistry(testcontext.New(t), definition, &data, ts.TestServer.Client())
+		recipeErr, ok := err.(*recipes.RecipeError)
+		require.True(t, ok)
+		require.Equal(t, recipes.RecipeSignatureVerificationFailed, recipeErr.ErrorDetails.Code)
+		require.Empty(t, data)
+	})
+}
@@ -28,17 +28,8 @@ import (
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
-type fakeServerInfo struct {
-	TestServer   *httptest.Server
-	URL          *url.URL
-	CloseServer  func()
-	TestImageURL string
-	ImageName    string
-}
-
-// NewFakeRegistryServer creates a fake registry server that serves a single blob and index.
-func NewFakeRegistryServer(t *testing.T) fakeServerInfo {
-	blob := []byte(`{
+// testRecipe is the recipe served by the fake registry servers.
+var testRecipe = []byte(`{
 	"parameters": {
 		"documentdbName": {
 			"type": "string"
@@ -50,6 +41,21 @@ func NewFakeRegistryServer(t *testing.T) fakeServerInfo {
 	}
 }`)
 
+type fakeServerInfo struct {
+	TestServer   *httptest.Server
+	URL          *url.URL
+	CloseServer  func()
+	TestImageURL string
+	ImageName    string
+
+	// Digest is the digest of the manifest of the test image. Only set by NewFakeSignedRegistryServer.
+	Digest string
+}
+
+// NewFakeRegistryServer creates a fake registry server that serves a single blob and index.
+func NewFakeRegistryServer(t *testing.T) fakeServerInfo {
+	blob := testRecipe
+
 	blobDesc := ocispec.Descriptor{
 		MediaType: "recipe",
 		Digest:    digest.FromBytes(blob),
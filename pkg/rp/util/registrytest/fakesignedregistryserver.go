@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+)
+
+var _ oras.Target = (*fakeRepository)(nil)
+
+// fakeRepository is an in-memory OCI repository.
+type fakeRepository struct {
+	mu          sync.Mutex
+	descriptors map[digest.Digest]ocispec.Descriptor
+	contents    map[digest.Digest][]byte
+	tags        map[string]digest.Digest
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		descriptors: map[digest.Digest]ocispec.Descriptor{},
+		contents:    map[digest.Digest][]byte{},
+		tags:        map[string]digest.Digest{},
+	}
+}
+
+// Fetch implements content.Fetcher.
+func (r *fakeRepository) Fetch(ctx context.Context, target ocispec.Descriptor) (io.ReadCloser, error) {
+	_, b, err := r.get(target.Digest.String())
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+// Push implements content.Pusher.
+func (r *fakeRepository) Push(ctx context.Context, expected ocispec.Descriptor, reader io.Reader) error {
+	b, err := content.ReadAll(reader, expected)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.descriptors[expected.Digest] = content.NewDescriptorFromBytes(expected.MediaType, b)
+	r.contents[expected.Digest] = b
+	return nil
+}
+
+// Exists implements content.Storage.
+func (r *fakeRepository) Exists(ctx context.Context, target ocispec.Descriptor) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.contents[target.Digest]
+	return ok, nil
+}
+
+// Resolve implements content.Resolver. The reference is either a tag or a digest.
+func (r *fakeRepository) Resolve(ctx context.Context, reference string) (ocispec.Descriptor, error) {
+	desc, _, err := r.get(reference)
+	return desc, err
+}
+
+// Tag implements content.Tagger.
+func (r *fakeRepository) Tag(ctx context.Context, desc ocispec.Descriptor, reference string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tags[reference] = desc.Digest
+	return nil
+}
+
+func (r *fakeRepository) get(reference string) (ocispec.Descriptor, []byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, ok := r.tags[reference]
+	if !ok {
+		d = digest.Digest(reference)
+	}
+
+	desc, ok := r.descriptors[d]
+	if !ok {
+		return ocispec.Descriptor{}, nil, fmt.Errorf("%s: %w", reference, errdef.ErrNotFound)
+	}
+	return desc, r.contents[d], nil
+}
+
+// NewFakeSignedRegistryServer creates a fake registry server that serves the same recipe as NewFakeRegistryServer, as an
+// OCI image manifest. The recipe is signed with the given key the way 'cosign sign --key' does, unless signer is nil.
+func NewFakeSignedRegistryServer(t *testing.T, signer crypto.Signer) fakeServerInfo {
+	ctx := context.Background()
+	repository := newFakeRepository()
+
+	layer, err := oras.PushBytes(ctx, repository, "recipe", testRecipe)
+	if err != nil {
+		t.Fatalf("failed to push recipe: %v", err)
+	}
+
+	config, err := oras.PushBytes(ctx, repository, ocispec.MediaTypeImageConfig, []byte("{}"))
+	if err != nil {
+		t.Fatalf("failed to push config: %v", err)
+	}
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    config,
+		Layers:    []ocispec.Descriptor{layer},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal manifest: %v", err)
+	}
+
+	manifestDesc, err := oras.TagBytes(ctx, repository, ocispec.MediaTypeImageManifest, manifest, "latest")
+	if err != nil {
+		t.Fatalf("failed to push manifest: %v", err)
+	}
+
+	serve := func(w http.ResponseWriter, r *http.Request, reference string) {
+		desc, b, err := repository.get(reference)
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", desc.MediaType)
+		w.Header().Set("Docker-Content-Digest", desc.Digest.String())
+		w.Header().Set("Content-Length", strconv.Itoa(int(desc.Size)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			if _, err := w.Write(b); err != nil {
+				t.Errorf("failed to write %q: %v", r.URL, err)
+			}
+		}
+	}
+
+	r := chi.NewRouter()
+	r.Route("/v2/test", func(r chi.Router) {
+		r.Head("/manifests/{ref}", func(w http.ResponseWriter, r *http.Request) {
+			serve(w, r, chi.URLParam(r, "ref"))
+		})
+		r.Get("/manifests/{ref}", func(w http.ResponseWriter, r *http.Request) {
+			serve(w, r, chi.URLParam(r, "ref"))
+		})
+		r.Head("/blobs/{digest}", func(w http.ResponseWriter, r *http.Request) {
+			serve(w, r, chi.URLParam(r, "digest"))
+		})
+		r.Get("/blobs/{digest}", func(w http.ResponseWriter, r *http.Request) {
+			serve(w, r, chi.URLParam(r, "digest"))
+		})
+	})
+
+	ts := httptest.NewTLSServer(r)
+
+	url, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("failed to parse url: %v", err)
+	}
+
+	if signer != nil {
+		err = Sign(ctx, repository, url.Host+"/test", manifestDesc, signer)
+		if err != nil {
+			t.Fatalf("failed to sign manifest: %v", err)
+		}
+	}
+
+	return fakeServerInfo{
+		TestServer:   ts,
+		URL:          url,
+		CloseServer:  ts.Close,
+		TestImageURL: ts.URL + "/test:latest",
+		ImageName:    "test:latest",
+		Digest:       manifestDesc.Digest.String(),
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrytest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/radius-project/radius/pkg/recipes/signature"
+	"oras.land/oras-go/v2"
+)
+
+// Sign signs the artifact described by the manifest descriptor with the given key, and stores the signature in the
+// target the same way 'cosign sign --key' does. dockerReference is the repository of the artifact, for example
+// "ghcr.io/myorg/recipes/redis".
+func Sign(ctx context.Context, target oras.Target, dockerReference string, manifest ocispec.Descriptor, signer crypto.Signer) error {
+	layer, err := PushSignatureLayer(ctx, target, dockerReference, manifest.Digest, signer)
+	if err != nil {
+		return err
+	}
+
+	return TagSignature(ctx, target, manifest.Digest, layer)
+}
+
+// PushSignatureLayer pushes the signature payload of the artifact with the manifest digest to the target, and returns
+// the descriptor of the signature layer.
+func PushSignatureLayer(ctx context.Context, target oras.Target, dockerReference string, manifestDigest digest.Digest, signer crypto.Signer) (ocispec.Descriptor, error) {
+	payload, err := json.Marshal(signature.Payload{
+		Critical: signature.Critical{
+			Identity: signature.Identity{DockerReference: dockerReference},
+			Image:    signature.Image{DockerManifestDigest: manifestDigest.String()},
+			Type:     signature.PayloadType,
+		},
+	})
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+
+	var sig []byte
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		sig, err = signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	} else {
+		hash := sha256.Sum256(payload)
+		sig, err = signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+
+	layer, err := oras.PushBytes(ctx, target, signature.SimpleSigningMediaType, payload)
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	layer.Annotations = map[string]string{
+		signature.SignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	}
+
+	return layer, nil
+}
+
+// TagSignature stores a signature manifest with the signature layers for the artifact with the manifest digest.
+func TagSignature(ctx context.Context, target oras.Target, manifestDigest digest.Digest, layers ...ocispec.Descriptor) error {
+	config, err := oras.PushBytes(ctx, target, ocispec.MediaTypeImageConfig, []byte("{}"))
+	if err != nil {
+		return err
+	}
+
+	signatureManifest, err := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    config,
+		Layers:    layers,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = oras.TagBytes(ctx, target, ocispec.MediaTypeImageManifest, signatureManifest, signature.SignatureTag(manifestDigest))
+	return err
+}
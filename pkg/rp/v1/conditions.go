@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// ConditionReady is the condition type reporting whether the resource is ready to be used.
+	ConditionReady = "Ready"
+
+	// ConditionDegraded is the condition type reporting whether the resource is running with reduced functionality.
+	ConditionDegraded = "Degraded"
+
+	// ConditionProgressing is the condition type reporting whether the resource is moving towards its desired state.
+	ConditionProgressing = "Progressing"
+)
+
+// ConditionStatus is the status of a condition.
+type ConditionStatus string
+
+const (
+	// ConditionTrue means the resource is in the condition.
+	ConditionTrue ConditionStatus = "True"
+
+	// ConditionFalse means the resource is not in the condition.
+	ConditionFalse ConditionStatus = "False"
+
+	// ConditionUnknown means it is not known whether the resource is in the condition.
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// Condition is an observation of the state of a resource, modeled after Kubernetes status conditions.
+type Condition struct {
+	// Type is the type of the condition, for example "Ready".
+	Type string `json:"type"`
+
+	// Status is the status of the condition.
+	Status ConditionStatus `json:"status"`
+
+	// Reason is a machine-readable, UpperCamelCase reason for the last transition of the condition.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable message with details about the last transition of the condition.
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time the status of the condition last changed.
+	LastTransitionTime time.Time `json:"lastTransitionTime,omitzero"`
+}
+
+// Validate returns an error if the condition does not have a type or has an unknown status.
+func (c Condition) Validate() error {
+	if c.Type == "" {
+		return fmt.Errorf("condition type is required")
+	}
+
+	switch c.Status {
+	case ConditionTrue, ConditionFalse, ConditionUnknown:
+		return nil
+	default:
+		return fmt.Errorf("condition %q has invalid status %q, must be one of %q, %q or %q", c.Type, c.Status, ConditionTrue, ConditionFalse, ConditionUnknown)
+	}
+}
+
+// FindCondition returns the condition of the given type, or nil if the conditions do not contain it. Condition types
+// are compared case-insensitively.
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if strings.EqualFold(conditions[i].Type, conditionType) {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// SetCondition adds or replaces the condition of the same type and returns the updated conditions. The last transition
+// time is kept when the status of the condition does not change, and set to now otherwise.
+func SetCondition(conditions []Condition, condition Condition, now time.Time) []Condition {
+	existing := FindCondition(conditions, condition.Type)
+	if existing != nil && existing.Status == condition.Status && !existing.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	} else if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = now
+	}
+
+	if existing == nil {
+		return append(conditions, condition)
+	}
+
+	*existing = condition
+	return conditions
+}
+
+// SortConditions sorts the conditions so that Ready, Degraded and Progressing come first, followed by the other
+// condition types in alphabetical order.
+func SortConditions(conditions []Condition) {
+	rank := func(conditionType string) int {
+		switch conditionType {
+		case ConditionReady:
+			return 0
+		case ConditionDegraded:
+			return 1
+		case ConditionProgressing:
+			return 2
+		default:
+			return 3
+		}
+	}
+
+	slices.SortStableFunc(conditions, func(a, b Condition) int {
+		if ra, rb := rank(a.Type), rank(b.Type); ra != rb {
+			return ra - rb
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Condition_Validate(t *testing.T) {
+	require.NoError(t, Condition{Type: ConditionReady, Status: ConditionTrue}.Validate())
+	require.NoError(t, Condition{Type: "DatabaseReachable", Status: ConditionUnknown}.Validate())
+
+	err := Condition{Status: ConditionTrue}.Validate()
+	require.EqualError(t, err, "condition type is required")
+
+	err = Condition{Type: ConditionReady, Status: "Yes"}.Validate()
+	require.EqualError(t, err, `condition "Ready" has invalid status "Yes", must be one of "True", "False" or "Unknown"`)
+}
+
+func Test_SetCondition(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Hour)
+
+	t.Run("adds new condition", func(t *testing.T) {
+		conditions := SetCondition(nil, Condition{Type: ConditionReady, Status: ConditionTrue, Reason: "Available"}, now)
+		require.Equal(t, []Condition{{Type: ConditionReady, Status: ConditionTrue, Reason: "Available", LastTransitionTime: now}}, conditions)
+	})
+
+	t.Run("keeps transition time when status is unchanged", func(t *testing.T) {
+		conditions := []Condition{{Type: ConditionReady, Status: ConditionTrue, Reason: "Available", LastTransitionTime: earlier}}
+		conditions = SetCondition(conditions, Condition{Type: ConditionReady, Status: ConditionTrue, Reason: "ReplicasAvailable"}, now)
+		require.Equal(t, []Condition{{Type: ConditionReady, Status: ConditionTrue, Reason: "ReplicasAvailable", LastTransitionTime: earlier}}, conditions)
+	})
+
+	t.Run("updates transition time when status changes", func(t *testing.T) {
+		conditions := []Condition{{Type: ConditionReady, Status: ConditionTrue, LastTransitionTime: earlier}}
+		conditions = SetCondition(conditions, Condition{Type: "ready", Status: ConditionFalse, Reason: "FailingOver"}, now)
+		require.Equal(t, []Condition{{Type: "ready", Status: ConditionFalse, Reason: "FailingOver", LastTransitionTime: now}}, conditions)
+	})
+}
+
+func Test_FindCondition(t *testing.T) {
+	conditions := []Condition{{Type: ConditionReady, Status: ConditionTrue}, {Type: ConditionDegraded, Status: ConditionFalse}}
+
+	require.Equal(t, &conditions[1], FindCondition(conditions, "degraded"))
+	require.Nil(t, FindCondition(conditions, ConditionProgressing))
+}
+
+func Test_SortConditions(t *testing.T) {
+	conditions := []Condition{{Type: "Zeta"}, {Type: ConditionProgressing}, {Type: "Alpha"}, {Type: ConditionReady}, {Type: ConditionDegraded}}
+	SortConditions(conditions)
+
+	types := []string{}
+	for _, condition := range conditions {
+		types = append(types, condition.Type)
+	}
+	require.Equal(t, []string{ConditionReady, ConditionDegraded, ConditionProgressing, "Alpha", "Zeta"}, types)
+}
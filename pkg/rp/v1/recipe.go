@@ -26,4 +26,8 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// TemplateDigest specifies the digest of the template used for the recipe. It is only set when the signature
+	// of the template was verified against a trust policy.
+	TemplateDigest string `json:"templateDigest,omitempty"`
 }
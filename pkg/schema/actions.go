@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// GetAction fetches the action with the given name declared by a resource type. Action names are matched
+// case-insensitively, like the other segments of a resource ID. Returns the declared name of the action, or an empty
+// name and a nil action if the resource type does not declare the action.
+func GetAction(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string, actionName string) (string, *v20231001preview.ResourceTypeAction, error) {
+	if ucpClient == nil {
+		return "", nil, nil
+	}
+
+	ID, err := resources.Parse(resourceID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	planeName := strings.Split(ID.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok {
+		return "", nil, fmt.Errorf("invalid resource type %q", resourceType)
+	}
+
+	resourceTypeResource, err := ucpClient.NewResourceTypesClient().Get(ctx, planeName, resourceProvider, resourceTypeName, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	if resourceTypeResource.Properties == nil {
+		return "", nil, nil
+	}
+
+	for name, action := range resourceTypeResource.Properties.Actions {
+		if action != nil && strings.EqualFold(name, actionName) {
+			return name, action, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+// ValidateActionPayload validates the input or the output of an action against the schema declared by the action.
+// Returns nil if the schema is nil.
+func ValidateActionPayload(payload map[string]any, schemaData map[string]any) error {
+	if schemaData == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	// A missing payload is validated as an empty object, so that required properties are reported.
+	var data any = map[string]any{}
+	if payload != nil {
+		data = payload
+	}
+
+	if err := openAPISchema.VisitJSON(data); err != nil {
+		if schemaErr, ok := err.(*openapi3.SchemaError); ok {
+			fieldPath := strings.Trim(fmt.Sprintf("%v", schemaErr.JSONPointer()), "[]")
+			return fmt.Errorf("error at %q: %s", fieldPath, schemaErr.Reason)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+)
+
+func TestGetAction(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-group/providers/Test.Resource/testResources/test"
+	resourceType := "Test.Resource/testResources"
+	restart := &v20231001preview.ResourceTypeAction{
+		Callback: &v20231001preview.ActionCallback{URL: to.Ptr("https://example.com/restart")},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceTypesServer: fake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{
+									Actions: map[string]*v20231001preview.ResourceTypeAction{
+										"restart": restart,
+									},
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("declared action", func(t *testing.T) {
+		name, action, err := GetAction(context.Background(), clientFactory, resourceID, resourceType, "restart")
+		require.NoError(t, err)
+		require.Equal(t, "restart", name)
+		require.Equal(t, restart, action)
+	})
+
+	t.Run("case-insensitive match", func(t *testing.T) {
+		name, action, err := GetAction(context.Background(), clientFactory, resourceID, resourceType, "RESTART")
+		require.NoError(t, err)
+		require.Equal(t, "restart", name)
+		require.Equal(t, restart, action)
+	})
+
+	t.Run("undeclared action", func(t *testing.T) {
+		name, action, err := GetAction(context.Background(), clientFactory, resourceID, resourceType, "backup")
+		require.NoError(t, err)
+		require.Empty(t, name)
+		require.Nil(t, action)
+	})
+
+	t.Run("nil client", func(t *testing.T) {
+		name, action, err := GetAction(context.Background(), nil, resourceID, resourceType, "restart")
+		require.NoError(t, err)
+		require.Empty(t, name)
+		require.Nil(t, action)
+	})
+}
+
+func TestValidateActionPayload(t *testing.T) {
+	schemaData := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"retentionDays": map[string]any{"type": "integer"},
+		},
+		"required": []any{"retentionDays"},
+	}
+
+	t.Run("valid payload", func(t *testing.T) {
+		err := ValidateActionPayload(map[string]any{"retentionDays": float64(7)}, schemaData)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		err := ValidateActionPayload(map[string]any{"retentionDays": "seven"}, schemaData)
+		require.ErrorContains(t, err, `error at "retentionDays"`)
+	})
+
+	t.Run("missing payload", func(t *testing.T) {
+		err := ValidateActionPayload(nil, schemaData)
+		require.ErrorContains(t, err, "retentionDays")
+	})
+
+	t.Run("nil schema", func(t *testing.T) {
+		err := ValidateActionPayload(map[string]any{"anything": true}, nil)
+		require.NoError(t, err)
+	})
+}
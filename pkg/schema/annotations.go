@@ -18,6 +18,8 @@ package schema
 
 import (
 	"context"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -159,6 +161,76 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 	return paths
 }
 
+// GetImmutableFieldPaths fetches the schema for a resource and returns paths to fields marked with x-radius-immutable.
+// Paths are in dot notation, e.g., "region" or "database.engine".
+func GetImmutableFieldPaths(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string, apiVersion string) ([]string, error) {
+	schema, err := GetSchema(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, nil
+	}
+
+	return ExtractImmutableFieldPaths(schema, ""), nil
+}
+
+// ExtractImmutableFieldPaths recursively walks the schema and returns paths to fields marked with x-radius-immutable.
+// Only nested object properties are traversed: to make the values of an array or map immutable, the array or map
+// itself must be marked. If a field is marked immutable, its nested properties are not checked since the entire
+// field is considered immutable.
+func ExtractImmutableFieldPaths(schema map[string]any, prefix string) []string {
+	var paths []string
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return paths
+	}
+
+	for fieldName, fieldSchema := range properties {
+		fieldSchemaMap, ok := fieldSchema.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		fullPath := fieldName
+		if prefix != "" {
+			fullPath = prefix + "." + fieldName
+		}
+
+		if isImmutable, ok := fieldSchemaMap[annotationRadiusImmutable].(bool); ok && isImmutable {
+			paths = append(paths, fullPath)
+			continue
+		}
+
+		if _, ok := fieldSchemaMap["properties"].(map[string]any); ok {
+			paths = append(paths, ExtractImmutableFieldPaths(fieldSchemaMap, fullPath)...)
+		}
+	}
+
+	return paths
+}
+
+// FindChangedFields returns the field paths whose values differ between the new and the old properties, sorted.
+// Setting or removing a field is a change. Paths are in dot notation.
+func FindChangedFields(newProperties map[string]any, oldProperties map[string]any, paths []string) []string {
+	var changed []string
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+
+		newValue, newOK := getField(newProperties, path)
+		oldValue, oldOK := getField(oldProperties, path)
+		if newOK != oldOK || !reflect.DeepEqual(newValue, oldValue) {
+			changed = append(changed, path)
+		}
+	}
+
+	slices.Sort(changed)
+	return changed
+}
+
 // FieldPathSegment represents a single segment in a field path.
 // A field path can contain field names, wildcards, and array indices.
 type FieldPathSegment struct {
@@ -1013,3 +1013,88 @@ func TestRedactFields_FieldWithDifferentTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractImmutableFieldPaths(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"region": map[string]any{
+				"type":               "string",
+				"x-radius-immutable": true,
+			},
+			"size": map[string]any{
+				"type":               "string",
+				"x-radius-immutable": false,
+			},
+			"database": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"engine": map[string]any{
+						"type":               "string",
+						"x-radius-immutable": true,
+					},
+				},
+			},
+			"network": map[string]any{
+				"type":               "object",
+				"x-radius-immutable": true,
+				"properties": map[string]any{
+					"subnet": map[string]any{
+						"type":               "string",
+						"x-radius-immutable": true,
+					},
+				},
+			},
+		},
+	}
+
+	paths := ExtractImmutableFieldPaths(schema, "")
+	require.ElementsMatch(t, []string{"region", "database.engine", "network"}, paths)
+}
+
+func TestFindChangedFields(t *testing.T) {
+	oldProperties := map[string]any{
+		"region":   "westus",
+		"database": map[string]any{"engine": "postgres"},
+		"tags":     []any{"a", "b"},
+	}
+
+	tests := []struct {
+		name          string
+		newProperties map[string]any
+		expected      []string
+	}{
+		{
+			name: "unchanged",
+			newProperties: map[string]any{
+				"region":   "westus",
+				"database": map[string]any{"engine": "postgres"},
+				"tags":     []any{"a", "b"},
+			},
+		},
+		{
+			name: "changed",
+			newProperties: map[string]any{
+				"region":   "eastus",
+				"database": map[string]any{"engine": "mysql"},
+				"tags":     []any{"a"},
+			},
+			expected: []string{"database.engine", "region", "tags"},
+		},
+		{
+			name: "removed",
+			newProperties: map[string]any{
+				"region": "westus",
+				"tags":   []any{"a", "b"},
+			},
+			expected: []string{"database.engine"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := FindChangedFields(tt.newProperties, oldProperties, []string{"region", "database.engine", "tags", "missing"})
+			require.Equal(t, tt.expected, changed)
+		})
+	}
+}
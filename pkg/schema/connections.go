@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// annotationRadiusConnectionTypes is the schema extension declaring the resource types connections may target.
+	// It is declared on the schema of the connections map (connections) as a map of connection name to resource
+	// types, or on the schema of the connections (connections.additionalProperties) as a list of resource types.
+	annotationRadiusConnectionTypes = "x-radius-connection-types"
+
+	// connectionSource is the property of a connection holding the resource ID of its target.
+	connectionSource = "source"
+)
+
+// ConnectionTypes is the resource types the connections of a resource may target, declared with the
+// x-radius-connection-types extension of the resource type schema.
+type ConnectionTypes struct {
+	// Named is the resource types each named connection may target, keyed by connection name.
+	Named map[string][]string
+
+	// Default is the resource types the connections without a declaration of their own may target. Connections may
+	// target any resource when it is empty.
+	Default []string
+}
+
+// AllowedTypes returns the resource types the connection with the given name may target. It returns nil if the
+// connection is not constrained.
+func (c *ConnectionTypes) AllowedTypes(name string) []string {
+	if c == nil {
+		return nil
+	}
+	if allowed, ok := c.Named[name]; ok {
+		return allowed
+	}
+	return c.Default
+}
+
+// ConnectionTarget is the resource targeted by a constrained connection.
+type ConnectionTarget struct {
+	// Name is the name of the connection.
+	Name string
+
+	// ID is the resource ID of the target.
+	ID resources.ID
+}
+
+// ExtractConnectionTypes returns the connection types declared in the schema, or nil if the connections of the
+// resource type are not constrained.
+func ExtractConnectionTypes(schema map[string]any) *ConnectionTypes {
+	properties, _ := schema["properties"].(map[string]any)
+	connections, _ := properties[reservedPropConnections].(map[string]any)
+	if connections == nil {
+		return nil
+	}
+
+	result := &ConnectionTypes{Named: map[string][]string{}}
+	if named, err := parseNamedConnectionTypes(connections[annotationRadiusConnectionTypes]); err == nil {
+		result.Named = named
+	}
+
+	if additionalProperties, ok := connections["additionalProperties"].(map[string]any); ok {
+		if allowed, err := parseConnectionTypes(additionalProperties[annotationRadiusConnectionTypes]); err == nil {
+			result.Default = allowed
+		}
+	}
+
+	if len(result.Named) == 0 && len(result.Default) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// ValidateConnections validates that the constrained connections of the resource target resources of the allowed
+// types, and returns their targets so that the caller can check that they exist. Connections that are not
+// constrained are not validated.
+//
+// Returns ValidationErrors listing the invalid connections.
+func ValidateConnections(properties map[string]any, connectionTypes *ConnectionTypes) ([]ConnectionTarget, error) {
+	if connectionTypes == nil {
+		return nil, nil
+	}
+
+	connections, ok := properties[reservedPropConnections].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(connections))
+	for name := range connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errors ValidationErrors
+	targets := []ConnectionTarget{}
+	for _, name := range names {
+		allowed := connectionTypes.AllowedTypes(name)
+		if len(allowed) == 0 {
+			continue
+		}
+
+		field := joinPath(joinPath(reservedPropConnections, name), connectionSource)
+		connection, _ := connections[name].(map[string]any)
+		source, ok := connection[connectionSource].(string)
+		if !ok || source == "" {
+			errors.Add(NewConstraintError(field, fmt.Sprintf("connection %q must specify the resource ID of its target", name)))
+			continue
+		}
+
+		id, err := resources.ParseResource(source)
+		if err != nil {
+			errors.Add(NewFormatError(field, "resource-id", fmt.Sprintf("connection %q must target a resource, got %q", name, source)))
+			continue
+		}
+
+		if !MatchesConnectionType(id.Type(), allowed) {
+			errors.Add(NewConstraintError(field, fmt.Sprintf("connection %q targets a resource of type %q, expected %s", name, id.Type(), strings.Join(allowed, " or "))))
+			continue
+		}
+
+		targets = append(targets, ConnectionTarget{Name: name, ID: id})
+	}
+
+	if errors.HasErrors() {
+		return nil, &errors
+	}
+
+	return targets, nil
+}
+
+// MatchesConnectionType returns true if the resource type matches one of the allowed types. Matching is case
+// insensitive, and an allowed type of the form 'Namespace/*' matches all the resource types of the namespace.
+func MatchesConnectionType(resourceType string, allowed []string) bool {
+	return slices.ContainsFunc(allowed, func(allowedType string) bool {
+		if namespace, ok := strings.CutSuffix(allowedType, "/*"); ok {
+			resourceNamespace, _, _ := strings.Cut(resourceType, "/")
+			return strings.EqualFold(namespace, resourceNamespace)
+		}
+		return strings.EqualFold(allowedType, resourceType)
+	})
+}
+
+// checkConnectionTypesAnnotation validates that the x-radius-connection-types annotation is only used on the schema
+// of the connections of the root schema, and that it declares fully qualified resource types.
+func (v *Validator) checkConnectionTypesAnnotation(schema *openapi3.Schema, path string) error {
+	value, exists := schema.Extensions[annotationRadiusConnectionTypes]
+	if !exists {
+		return nil
+	}
+
+	switch path {
+	case reservedPropConnections:
+		named, err := parseNamedConnectionTypes(value)
+		if err != nil {
+			return NewConstraintError(path, err.Error())
+		}
+		for name, allowed := range named {
+			if len(allowed) == 0 {
+				return NewConstraintError(path, fmt.Sprintf("%s of connection %q must list at least one resource type", annotationRadiusConnectionTypes, name))
+			}
+		}
+	case joinPath(reservedPropConnections, "additionalProperties"):
+		allowed, err := parseConnectionTypes(value)
+		if err != nil {
+			return NewConstraintError(path, err.Error())
+		}
+		if len(allowed) == 0 {
+			return NewConstraintError(path, fmt.Sprintf("%s must list at least one resource type", annotationRadiusConnectionTypes))
+		}
+	default:
+		return NewConstraintError(path, fmt.Sprintf("%s annotation is only supported on 'connections' and 'connections.additionalProperties'", annotationRadiusConnectionTypes))
+	}
+
+	return nil
+}
+
+// parseNamedConnectionTypes parses the value of the x-radius-connection-types annotation of the connections map.
+func parseNamedConnectionTypes(value any) (map[string][]string, error) {
+	if value == nil {
+		return map[string][]string{}, nil
+	}
+
+	values, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s of 'connections' must be a map of connection name to resource types", annotationRadiusConnectionTypes)
+	}
+
+	named := map[string][]string{}
+	for name, v := range values {
+		allowed, err := parseConnectionTypes(v)
+		if err != nil {
+			return nil, err
+		}
+		named[name] = allowed
+	}
+
+	return named, nil
+}
+
+// parseConnectionTypes parses a list of resource types of the x-radius-connection-types annotation.
+func parseConnectionTypes(value any) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list of resource types", annotationRadiusConnectionTypes)
+	}
+
+	allowed := []string{}
+	for _, v := range values {
+		resourceType, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a list of resource types", annotationRadiusConnectionTypes)
+		}
+
+		namespace, typeName, found := strings.Cut(resourceType, "/")
+		if !found || namespace == "" || typeName == "" || strings.Contains(typeName, "/") {
+			return nil, fmt.Errorf("%s must list fully qualified resource types such as 'Radius.Data/redisCaches' or 'Radius.Data/*', got %q", annotationRadiusConnectionTypes, resourceType)
+		}
+
+		allowed = append(allowed, resourceType)
+	}
+
+	return allowed, nil
+}
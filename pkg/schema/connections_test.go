@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func testConnectionsSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"connections": map[string]any{
+				"type": "object",
+				annotationRadiusConnectionTypes: map[string]any{
+					"database": []any{"Radius.Data/postgreSqlDatabases", "Radius.Data/mySqlDatabases"},
+				},
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"source": map[string]any{"type": "string"},
+					},
+					"required":                      []any{"source"},
+					annotationRadiusConnectionTypes: []any{"Radius.Data/*"},
+				},
+			},
+		},
+	}
+}
+
+func TestExtractConnectionTypes(t *testing.T) {
+	connectionTypes := ExtractConnectionTypes(testConnectionsSchema())
+	require.Equal(t, &ConnectionTypes{
+		Named:   map[string][]string{"database": {"Radius.Data/postgreSqlDatabases", "Radius.Data/mySqlDatabases"}},
+		Default: []string{"Radius.Data/*"},
+	}, connectionTypes)
+
+	require.Equal(t, []string{"Radius.Data/postgreSqlDatabases", "Radius.Data/mySqlDatabases"}, connectionTypes.AllowedTypes("database"))
+	require.Equal(t, []string{"Radius.Data/*"}, connectionTypes.AllowedTypes("cache"))
+
+	require.Nil(t, ExtractConnectionTypes(map[string]any{
+		"properties": map[string]any{
+			"connections": map[string]any{
+				"type":                 "object",
+				"additionalProperties": map[string]any{"type": "object"},
+			},
+		},
+	}))
+	require.Nil(t, ExtractConnectionTypes(map[string]any{}))
+
+	var nilTypes *ConnectionTypes
+	require.Nil(t, nilTypes.AllowedTypes("database"))
+}
+
+func TestValidateConnections(t *testing.T) {
+	connectionTypes := ExtractConnectionTypes(testConnectionsSchema())
+	rootScope := "/planes/radius/local/resourceGroups/test-group"
+
+	tests := []struct {
+		name        string
+		connections map[string]any
+		targets     []string
+		err         string
+	}{
+		{
+			name: "valid",
+			connections: map[string]any{
+				"database": map[string]any{"source": rootScope + "/providers/Radius.Data/postgreSqlDatabases/db"},
+				"cache":    map[string]any{"source": rootScope + "/providers/radius.data/rediscaches/cache"},
+			},
+			targets: []string{
+				rootScope + "/providers/radius.data/rediscaches/cache",
+				rootScope + "/providers/Radius.Data/postgreSqlDatabases/db",
+			},
+		},
+		{
+			name:        "no connections",
+			connections: nil,
+			targets:     nil,
+		},
+		{
+			name: "wrong type",
+			connections: map[string]any{
+				"database": map[string]any{"source": rootScope + "/providers/Radius.Data/redisCaches/cache"},
+			},
+			err: `connection "database" targets a resource of type "Radius.Data/redisCaches", expected Radius.Data/postgreSqlDatabases or Radius.Data/mySqlDatabases`,
+		},
+		{
+			name: "wrong namespace",
+			connections: map[string]any{
+				"queue": map[string]any{"source": rootScope + "/providers/Radius.Messaging/queues/q"},
+			},
+			err: `connection "queue" targets a resource of type "Radius.Messaging/queues", expected Radius.Data/*`,
+		},
+		{
+			name: "missing source",
+			connections: map[string]any{
+				"database": map[string]any{},
+			},
+			err: `connection "database" must specify the resource ID of its target`,
+		},
+		{
+			name: "not a resource ID",
+			connections: map[string]any{
+				"database": map[string]any{"source": "http://example.com"},
+			},
+			err: `connection "database" must target a resource, got "http://example.com"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := map[string]any{}
+			if tt.connections != nil {
+				properties["connections"] = tt.connections
+			}
+
+			targets, err := ValidateConnections(properties, connectionTypes)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				require.IsType(t, &ValidationErrors{}, err)
+				return
+			}
+
+			require.NoError(t, err)
+			ids := []string{}
+			for _, target := range targets {
+				ids = append(ids, target.ID.String())
+			}
+			if tt.targets == nil {
+				require.Empty(t, ids)
+			} else {
+				require.Equal(t, tt.targets, ids)
+			}
+		})
+	}
+
+	targets, err := ValidateConnections(map[string]any{"connections": map[string]any{"x": map[string]any{}}}, nil)
+	require.NoError(t, err)
+	require.Nil(t, targets)
+}
+
+func TestMatchesConnectionType(t *testing.T) {
+	require.True(t, MatchesConnectionType("Radius.Data/redisCaches", []string{"radius.data/rediscaches"}))
+	require.True(t, MatchesConnectionType("Radius.Data/redisCaches", []string{"Radius.Data/*"}))
+	require.False(t, MatchesConnectionType("Radius.DataPlus/redisCaches", []string{"Radius.Data/*"}))
+	require.False(t, MatchesConnectionType("Radius.Data/redisCaches", []string{"Radius.Data/mySqlDatabases"}))
+	require.False(t, MatchesConnectionType("Radius.Data/redisCaches", nil))
+}
+
+func TestValidator_checkConnectionTypesAnnotation(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name  string
+		path  string
+		value any
+		err   string
+	}{
+		{
+			name:  "all connections",
+			path:  "connections.additionalProperties",
+			value: []any{"Radius.Data/redisCaches"},
+		},
+		{
+			name:  "named connections",
+			path:  "connections",
+			value: map[string]any{"database": []any{"Radius.Data/*"}},
+		},
+		{
+			name:  "not on connections",
+			path:  "database",
+			value: []any{"Radius.Data/redisCaches"},
+			err:   "x-radius-connection-types annotation is only supported on 'connections' and 'connections.additionalProperties'",
+		},
+		{
+			name:  "nested in a connection",
+			path:  "connections.additionalProperties.source",
+			value: []any{"Radius.Data/redisCaches"},
+			err:   "x-radius-connection-types annotation is only supported",
+		},
+		{
+			name:  "all connections: not a list",
+			path:  "connections.additionalProperties",
+			value: "Radius.Data/redisCaches",
+			err:   "x-radius-connection-types must be a list of resource types",
+		},
+		{
+			name:  "named connections: not a map",
+			path:  "connections",
+			value: []any{"Radius.Data/redisCaches"},
+			err:   "x-radius-connection-types of 'connections' must be a map of connection name to resource types",
+		},
+		{
+			name:  "not fully qualified",
+			path:  "connections.additionalProperties",
+			value: []any{"redisCaches"},
+			err:   `x-radius-connection-types must list fully qualified resource types such as 'Radius.Data/redisCaches' or 'Radius.Data/*', got "redisCaches"`,
+		},
+		{
+			name:  "all connections: empty",
+			path:  "connections.additionalProperties",
+			value: []any{},
+			err:   "x-radius-connection-types must list at least one resource type",
+		},
+		{
+			name:  "named connections: empty",
+			path:  "connections",
+			value: map[string]any{"database": []any{}},
+			err:   `x-radius-connection-types of connection "database" must list at least one resource type`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := &openapi3.Schema{
+				Type:       &openapi3.Types{"object"},
+				Extensions: map[string]any{annotationRadiusConnectionTypes: tt.value},
+			}
+
+			err := validator.checkConnectionTypesAnnotation(schema, tt.path)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_WithConnectionTypes(t *testing.T) {
+	validator := NewValidator()
+
+	openAPISchema, err := ConvertToOpenAPISchema(testConnectionsSchema())
+	require.NoError(t, err)
+	require.NoError(t, validator.ValidateSchema(context.Background(), openAPISchema))
+
+	invalid := testConnectionsSchema()
+	invalid["properties"].(map[string]any)["environment"].(map[string]any)[annotationRadiusConnectionTypes] = []any{"Radius.Data/redisCaches"}
+
+	openAPISchema, err = ConvertToOpenAPISchema(invalid)
+	require.NoError(t, err)
+	err = validator.ValidateSchema(context.Background(), openAPISchema)
+	require.ErrorContains(t, err, "x-radius-connection-types annotation is only supported")
+}
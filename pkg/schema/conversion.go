@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// GetConversion fetches the hub API version of a resource type and the conversion rules of an API version.
+//
+// The hub API version is the default API version of the resource type: resources of an API version that declares
+// conversion rules are stored in the hub API version. Returns an empty hub API version and nil rules if the client is
+// nil, the resource type has no default API version, or the API version does not declare conversion rules.
+func GetConversion(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string, apiVersion string) (string, *v20231001preview.APIVersionConversion, error) {
+	if ucpClient == nil {
+		return "", nil, nil
+	}
+
+	ID, err := resources.Parse(resourceID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	planeName := strings.Split(ID.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok {
+		return "", nil, fmt.Errorf("invalid resource type %q", resourceType)
+	}
+
+	resourceTypeResource, err := ucpClient.NewResourceTypesClient().Get(ctx, planeName, resourceProvider, resourceTypeName, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	if resourceTypeResource.Properties == nil || to.String(resourceTypeResource.Properties.DefaultAPIVersion) == "" {
+		return "", nil, nil
+	}
+
+	hubVersion := *resourceTypeResource.Properties.DefaultAPIVersion
+	if strings.EqualFold(hubVersion, apiVersion) {
+		return hubVersion, nil, nil
+	}
+
+	apiVersionResource, err := ucpClient.NewAPIVersionsClient().Get(ctx, planeName, resourceProvider, resourceTypeName, apiVersion, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	if apiVersionResource.Properties == nil || apiVersionResource.Properties.Conversion == nil {
+		return hubVersion, nil, nil
+	}
+
+	return hubVersion, apiVersionResource.Properties.Conversion, nil
+}
+
+// ValidateConversion validates the conversion rules of an API version. Field paths must be in dot notation, and must
+// not reference the properties managed by Radius.
+func ValidateConversion(conversion *v20231001preview.APIVersionConversion) error {
+	if conversion == nil {
+		return nil
+	}
+
+	for i, rename := range conversion.Renames {
+		if rename == nil {
+			return fmt.Errorf("renames[%d] must not be empty", i)
+		}
+		if err := validateConversionPath(to.String(rename.From)); err != nil {
+			return fmt.Errorf("renames[%d].from: %w", i, err)
+		}
+		if err := validateConversionPath(to.String(rename.To)); err != nil {
+			return fmt.Errorf("renames[%d].to: %w", i, err)
+		}
+	}
+
+	for path := range conversion.Defaults {
+		if err := validateConversionPath(path); err != nil {
+			return fmt.Errorf("defaults[%s]: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func validateConversionPath(path string) error {
+	if path == "" {
+		return fmt.Errorf("field path must not be empty")
+	}
+
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("field path %q must not contain empty segments", path)
+		}
+	}
+
+	switch segments[0] {
+	case reservedPropApplication, reservedPropEnvironment, reservedPropStatus, reservedPropConnections, reservedPropRecipe:
+		return fmt.Errorf("field path %q must not reference the reserved property %q", path, segments[0])
+	}
+
+	return nil
+}
+
+// ConvertToHubVersion converts resource properties from an API version to the hub API version of the resource type,
+// using the conversion rules of the API version. Renames are applied in order, then the fields that are only defined
+// in the hub API version are set: to their value in the stored properties, so that updating a resource with an older
+// API version keeps them, or else to their default. The stored properties must be in the hub API version, and may be
+// nil when the resource is created.
+func ConvertToHubVersion(properties map[string]any, stored map[string]any, conversion *v20231001preview.APIVersionConversion) error {
+	if properties == nil || conversion == nil {
+		return nil
+	}
+
+	for _, rename := range conversion.Renames {
+		if rename == nil {
+			continue
+		}
+		moveField(properties, to.String(rename.From), to.String(rename.To))
+	}
+
+	for path, value := range conversion.Defaults {
+		if _, ok := getField(properties, path); ok {
+			continue
+		}
+
+		if storedValue, ok := getField(stored, path); ok {
+			value = storedValue
+		}
+
+		copied, err := deepCopyValue(value)
+		if err != nil {
+			return fmt.Errorf("failed to copy the value of %q: %w", path, err)
+		}
+		setField(properties, path, copied)
+	}
+
+	return nil
+}
+
+// ConvertFromHubVersion converts resource properties from the hub API version of the resource type to an API version,
+// using the conversion rules of the API version. The fields that are only defined in the hub API version are removed,
+// then renames are reverted in reverse order. The removed fields are restored from the stored resource when it's
+// written back with the API version.
+func ConvertFromHubVersion(properties map[string]any, conversion *v20231001preview.APIVersionConversion) error {
+	if properties == nil || conversion == nil {
+		return nil
+	}
+
+	for path := range conversion.Defaults {
+		deleteField(properties, path)
+	}
+
+	for i := len(conversion.Renames) - 1; i >= 0; i-- {
+		rename := conversion.Renames[i]
+		if rename == nil {
+			continue
+		}
+		moveField(properties, to.String(rename.To), to.String(rename.From))
+	}
+
+	return nil
+}
+
+// moveField moves the value at the source path to the target path, if set.
+func moveField(properties map[string]any, source string, target string) {
+	value, ok := getField(properties, source)
+	if !ok {
+		return
+	}
+
+	deleteField(properties, source)
+	setField(properties, target, value)
+}
+
+// getField returns the value at a dot-separated path.
+func getField(properties map[string]any, path string) (any, bool) {
+	segments := strings.Split(path, ".")
+
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	value, ok := current[segments[len(segments)-1]]
+	return value, ok
+}
+
+// setField sets the value at a dot-separated path, creating the intermediate objects.
+func setField(properties map[string]any, path string, value any) {
+	segments := strings.Split(path, ".")
+
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+}
+
+// deleteField deletes the value at a dot-separated path. Intermediate objects left empty are deleted too.
+func deleteField(properties map[string]any, path string) {
+	segments := strings.Split(path, ".")
+	if len(segments) == 1 {
+		delete(properties, path)
+		return
+	}
+
+	next, ok := properties[segments[0]].(map[string]any)
+	if !ok {
+		return
+	}
+
+	deleteField(next, strings.Join(segments[1:], "."))
+	if len(next) == 0 {
+		delete(properties, segments[0])
+	}
+}
+
+func deepCopyValue(value any) (any, error) {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var copied any
+	if err := json.Unmarshal(bs, &copied); err != nil {
+		return nil, err
+	}
+
+	return copied, nil
+}
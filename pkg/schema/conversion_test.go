@@ -0,0 +1,318 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+)
+
+func testConversion() *v20231001preview.APIVersionConversion {
+	return &v20231001preview.APIVersionConversion{
+		Renames: []*v20231001preview.FieldRename{
+			{From: to.Ptr("size"), To: to.Ptr("sku.size")},
+			{From: to.Ptr("hostName"), To: to.Ptr("host")},
+		},
+		Defaults: map[string]any{
+			"sku.tier": "standard",
+			"replicas": float64(1),
+		},
+	}
+}
+
+func TestConvertToHubVersion(t *testing.T) {
+	t.Run("renames and defaults", func(t *testing.T) {
+		properties := map[string]any{
+			"environment": "env",
+			"size":        "small",
+			"hostName":    "localhost",
+		}
+
+		err := ConvertToHubVersion(properties, nil, testConversion())
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"environment": "env",
+			"sku": map[string]any{
+				"size": "small",
+				"tier": "standard",
+			},
+			"host":     "localhost",
+			"replicas": float64(1),
+		}, properties)
+	})
+
+	t.Run("defaults do not overwrite values", func(t *testing.T) {
+		properties := map[string]any{
+			"replicas": float64(3),
+		}
+
+		err := ConvertToHubVersion(properties, nil, testConversion())
+		require.NoError(t, err)
+		require.Equal(t, float64(3), properties["replicas"])
+		require.NotContains(t, properties, "host")
+	})
+
+	t.Run("stored values of hub-only fields are kept", func(t *testing.T) {
+		properties := map[string]any{
+			"size": "large",
+		}
+		stored := map[string]any{
+			"sku": map[string]any{
+				"size": "small",
+				"tier": "premium",
+			},
+			"host": "localhost",
+		}
+
+		err := ConvertToHubVersion(properties, stored, testConversion())
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"sku": map[string]any{
+				"size": "large",
+				"tier": "premium",
+			},
+			"replicas": float64(1),
+		}, properties)
+	})
+
+	t.Run("nil conversion", func(t *testing.T) {
+		properties := map[string]any{"size": "small"}
+
+		err := ConvertToHubVersion(properties, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"size": "small"}, properties)
+	})
+}
+
+func TestConvertFromHubVersion(t *testing.T) {
+	t.Run("renames and defaults", func(t *testing.T) {
+		properties := map[string]any{
+			"environment": "env",
+			"sku": map[string]any{
+				"size": "small",
+				"tier": "standard",
+			},
+			"host":     "localhost",
+			"replicas": float64(1),
+		}
+
+		err := ConvertFromHubVersion(properties, testConversion())
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"environment": "env",
+			"size":        "small",
+			"hostName":    "localhost",
+		}, properties)
+	})
+
+	t.Run("hub-only fields are removed", func(t *testing.T) {
+		properties := map[string]any{
+			"environment": "env",
+			"sku": map[string]any{
+				"size": "small",
+				"tier": "premium",
+			},
+			"host":     "localhost",
+			"replicas": 3,
+		}
+
+		err := ConvertFromHubVersion(properties, testConversion())
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"environment": "env",
+			"size":        "small",
+			"hostName":    "localhost",
+		}, properties)
+	})
+}
+
+func TestConvert_RoundTrip(t *testing.T) {
+	properties := map[string]any{
+		"size":     "small",
+		"hostName": "localhost",
+		"nested": map[string]any{
+			"value": "unchanged",
+		},
+	}
+	expected := map[string]any{
+		"size":     "small",
+		"hostName": "localhost",
+		"nested": map[string]any{
+			"value": "unchanged",
+		},
+	}
+
+	require.NoError(t, ConvertToHubVersion(properties, nil, testConversion()))
+	require.NoError(t, ConvertFromHubVersion(properties, testConversion()))
+	require.Equal(t, expected, properties)
+}
+
+// TestConvert_UpdateWithOlderVersion writes a resource with the hub API version, updates it with an older API version
+// and reads it with the hub API version. The hub-only fields set with the hub API version must be kept.
+func TestConvert_UpdateWithOlderVersion(t *testing.T) {
+	// Written with the hub API version, which is stored as is.
+	stored := map[string]any{
+		"sku": map[string]any{
+			"size": "small",
+			"tier": "premium",
+		},
+		"host":     "localhost",
+		"replicas": float64(3),
+	}
+
+	// Read with the older API version, which doesn't define the hub-only fields.
+	read, err := deepCopyValue(stored)
+	require.NoError(t, err)
+	require.NoError(t, ConvertFromHubVersion(read.(map[string]any), testConversion()))
+	require.Equal(t, map[string]any{"size": "small", "hostName": "localhost"}, read)
+
+	// Updated with the older API version.
+	updated := map[string]any{
+		"size":     "large",
+		"hostName": "localhost",
+	}
+	require.NoError(t, ConvertToHubVersion(updated, stored, testConversion()))
+
+	// Read with the hub API version.
+	require.Equal(t, map[string]any{
+		"sku": map[string]any{
+			"size": "large",
+			"tier": "premium",
+		},
+		"host":     "localhost",
+		"replicas": float64(3),
+	}, updated)
+}
+
+func TestValidateConversion(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion *v20231001preview.APIVersionConversion
+		err        string
+	}{
+		{
+			name:       "nil",
+			conversion: nil,
+		},
+		{
+			name:       "valid",
+			conversion: testConversion(),
+		},
+		{
+			name:       "empty path",
+			conversion: &v20231001preview.APIVersionConversion{Renames: []*v20231001preview.FieldRename{{From: to.Ptr(""), To: to.Ptr("size")}}},
+			err:        "renames[0].from: field path must not be empty",
+		},
+		{
+			name:       "empty segment",
+			conversion: &v20231001preview.APIVersionConversion{Renames: []*v20231001preview.FieldRename{{From: to.Ptr("size"), To: to.Ptr("sku..size")}}},
+			err:        "renames[0].to: field path \"sku..size\" must not contain empty segments",
+		},
+		{
+			name:       "reserved property",
+			conversion: &v20231001preview.APIVersionConversion{Defaults: map[string]any{"status.ready": true}},
+			err:        "defaults[status.ready]: field path \"status.ready\" must not reference the reserved property \"status\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConversion(tt.conversion)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestGetConversion(t *testing.T) {
+	resourceID := "/planes/radius/local/resourceGroups/test-group/providers/Test.Resource/testResources/test"
+	resourceType := "Test.Resource/testResources"
+
+	clientFactory, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				ResourceTypesServer: fake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{
+									DefaultAPIVersion: to.Ptr("2025-01-01"),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+				APIVersionsServer: fake.APIVersionsServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+						properties := &v20231001preview.APIVersionProperties{}
+						if apiVersionName == "2024-01-01" {
+							properties.Conversion = testConversion()
+						}
+						resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+							APIVersionResource: v20231001preview.APIVersionResource{Properties: properties},
+						}, nil)
+						return
+					},
+				},
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("api version with conversion", func(t *testing.T) {
+		hubVersion, conversion, err := GetConversion(context.Background(), clientFactory, resourceID, resourceType, "2024-01-01")
+		require.NoError(t, err)
+		require.Equal(t, "2025-01-01", hubVersion)
+		require.Equal(t, testConversion(), conversion)
+	})
+
+	t.Run("api version without conversion", func(t *testing.T) {
+		hubVersion, conversion, err := GetConversion(context.Background(), clientFactory, resourceID, resourceType, "2023-01-01")
+		require.NoError(t, err)
+		require.Equal(t, "2025-01-01", hubVersion)
+		require.Nil(t, conversion)
+	})
+
+	t.Run("hub api version", func(t *testing.T) {
+		hubVersion, conversion, err := GetConversion(context.Background(), clientFactory, resourceID, resourceType, "2025-01-01")
+		require.NoError(t, err)
+		require.Equal(t, "2025-01-01", hubVersion)
+		require.Nil(t, conversion)
+	})
+
+	t.Run("nil client", func(t *testing.T) {
+		hubVersion, conversion, err := GetConversion(context.Background(), nil, resourceID, resourceType, "2024-01-01")
+		require.NoError(t, err)
+		require.Empty(t, hubVersion)
+		require.Nil(t, conversion)
+	})
+}
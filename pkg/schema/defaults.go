@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ApplyDefaults sets the default values declared in the schema on the resource properties that are not set.
+//
+// Defaults are applied recursively to nested objects, array items and additionalProperties (maps). An object that is
+// not set is only created when the schema declares a default for it; the defaults of its nested properties are then
+// applied to the created object. Read-only properties are not defaulted, since they are set by recipes.
+//
+// Returns without changes if the properties or the schema are nil.
+func ApplyDefaults(properties map[string]any, schemaData any) error {
+	if properties == nil || schemaData == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	return applyObjectDefaults(properties, openAPISchema, "")
+}
+
+// applyObjectDefaults applies the defaults of the schema properties to an object.
+func applyObjectDefaults(object map[string]any, schema *openapi3.Schema, path string) error {
+	for name, propRef := range schema.Properties {
+		if propRef == nil || propRef.Value == nil {
+			continue
+		}
+
+		propPath := joinPath(path, name)
+		value, ok := object[name]
+		if !ok {
+			if propRef.Value.Default == nil || propRef.Value.ReadOnly {
+				continue
+			}
+
+			copied, err := deepCopyValue(propRef.Value.Default)
+			if err != nil {
+				return fmt.Errorf("failed to copy the default value of %q: %w", propPath, err)
+			}
+			object[name] = copied
+			value = copied
+		}
+
+		if err := applyValueDefaults(value, propRef.Value, propPath); err != nil {
+			return err
+		}
+	}
+
+	if schema.AdditionalProperties.Schema == nil || schema.AdditionalProperties.Schema.Value == nil {
+		return nil
+	}
+
+	for name, value := range object {
+		if _, ok := schema.Properties[name]; ok {
+			continue
+		}
+		if err := applyValueDefaults(value, schema.AdditionalProperties.Schema.Value, joinPath(path, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyValueDefaults applies the defaults of the schema to the nested properties of a value.
+func applyValueDefaults(value any, schema *openapi3.Schema, path string) error {
+	switch v := value.(type) {
+	case map[string]any:
+		return applyObjectDefaults(v, schema, path)
+	case []any:
+		if schema.Items == nil || schema.Items.Value == nil {
+			return nil
+		}
+		for i, item := range v {
+			if err := applyValueDefaults(item, schema.Items.Value, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
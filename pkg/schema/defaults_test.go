@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type":    "string",
+				"default": "small",
+			},
+			"replicas": map[string]any{
+				"type":    "integer",
+				"default": 1,
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+				"default":  "localhost",
+			},
+			"backup": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"enabled": map[string]any{
+						"type":    "boolean",
+						"default": true,
+					},
+				},
+			},
+			"network": map[string]any{
+				"type":    "object",
+				"default": map[string]any{},
+				"properties": map[string]any{
+					"public": map[string]any{
+						"type":    "boolean",
+						"default": false,
+					},
+				},
+			},
+			"ports": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"protocol": map[string]any{
+							"type":    "string",
+							"default": "TCP",
+						},
+					},
+				},
+			},
+			"labels": map[string]any{
+				"type": "object",
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"visible": map[string]any{
+							"type":    "boolean",
+							"default": true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "top-level and object defaults",
+			properties: map[string]any{},
+			expected: map[string]any{
+				"size":     "small",
+				"replicas": float64(1),
+				"network":  map[string]any{"public": false},
+			},
+		},
+		{
+			name: "set values are kept",
+			properties: map[string]any{
+				"size":    "large",
+				"network": map[string]any{"public": true},
+			},
+			expected: map[string]any{
+				"size":     "large",
+				"replicas": float64(1),
+				"network":  map[string]any{"public": true},
+			},
+		},
+		{
+			name: "nested objects, arrays and maps",
+			properties: map[string]any{
+				"backup": map[string]any{},
+				"ports": []any{
+					map[string]any{"port": float64(80)},
+					map[string]any{"port": float64(53), "protocol": "UDP"},
+				},
+				"labels": map[string]any{
+					"team": map[string]any{},
+				},
+			},
+			expected: map[string]any{
+				"size":     "small",
+				"replicas": float64(1),
+				"network":  map[string]any{"public": false},
+				"backup":   map[string]any{"enabled": true},
+				"ports": []any{
+					map[string]any{"port": float64(80), "protocol": "TCP"},
+					map[string]any{"port": float64(53), "protocol": "UDP"},
+				},
+				"labels": map[string]any{
+					"team": map[string]any{"visible": true},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ApplyDefaults(tt.properties, schema)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, tt.properties)
+		})
+	}
+
+	t.Run("defaults are not shared between resources", func(t *testing.T) {
+		first := map[string]any{}
+		require.NoError(t, ApplyDefaults(first, schema))
+		first["network"].(map[string]any)["public"] = true
+
+		second := map[string]any{}
+		require.NoError(t, ApplyDefaults(second, schema))
+		require.Equal(t, map[string]any{"public": false}, second["network"])
+	})
+
+	t.Run("nil schema", func(t *testing.T) {
+		properties := map[string]any{"size": "large"}
+		require.NoError(t, ApplyDefaults(properties, nil))
+		require.Equal(t, map[string]any{"size": "large"}, properties)
+	})
+}
@@ -0,0 +1,582 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// openAPIVersion is the version of the OpenAPI specification of the generated documents.
+	openAPIVersion = "3.0.3"
+
+	componentsSchemasPrefix    = "#/components/schemas/"
+	componentsParametersPrefix = "#/components/parameters/"
+	componentsResponsesPrefix  = "#/components/responses/"
+	componentsHeadersPrefix    = "#/components/headers/"
+)
+
+// DocumentResourceType describes a resource type included in a generated OpenAPI document.
+type DocumentResourceType struct {
+	// Name is the name of the resource type without the resource provider namespace. Example: 'redisCaches'.
+	Name string
+
+	// Description is the description of the resource type.
+	Description string
+
+	// Schema is the OpenAPI schema of the properties of the resource type for the API version of the document.
+	Schema map[string]any
+
+	// Actions are the actions that can be invoked on resources of the resource type, keyed by action name.
+	Actions map[string]DocumentAction
+}
+
+// DocumentAction describes an action of a resource type included in a generated OpenAPI document.
+type DocumentAction struct {
+	// Description is the description of the action.
+	Description string
+
+	// InputSchema is the OpenAPI schema of the action input.
+	InputSchema map[string]any
+
+	// OutputSchema is the OpenAPI schema of the action output.
+	OutputSchema map[string]any
+}
+
+// GenerateOpenAPIDocument generates an OpenAPI 3 document describing the API of the resource types of a resource
+// provider namespace for an API version. The document covers the CRUDL operations and actions of each resource type, the
+// headers of asynchronous operations, the operation status endpoints and the shape of errors, so that clients and API
+// documentation can be generated from it.
+func GenerateOpenAPIDocument(namespace string, apiVersion string, resourceTypes []DocumentResourceType) (map[string]any, error) {
+	schemas := commonSchemas()
+	paths := map[string]any{}
+
+	resourceTypes = slices.Clone(resourceTypes)
+	slices.SortFunc(resourceTypes, func(a, b DocumentResourceType) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	tags := []any{}
+	for _, resourceType := range resourceTypes {
+		typeName := pascalCase(resourceType.Name)
+		fullyQualifiedType := namespace + "/" + resourceType.Name
+
+		tag := map[string]any{"name": typeName}
+		if resourceType.Description != "" {
+			tag["description"] = resourceType.Description
+		}
+		tags = append(tags, tag)
+
+		properties, err := propertiesSchema(resourceType.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema for resource type %q: %w", fullyQualifiedType, err)
+		}
+
+		schemas[typeName+"Properties"] = properties
+		schemas[typeName+"Resource"] = resourceSchema(fullyQualifiedType, typeName)
+		schemas[typeName+"ResourceListResult"] = listResultSchema(typeName)
+
+		collectionPath := fmt.Sprintf("/{rootScope}/providers/%s/%s", namespace, resourceType.Name)
+		resourcePath := collectionPath + "/{resourceName}"
+
+		paths[collectionPath] = map[string]any{
+			"get": listOperation(fullyQualifiedType, typeName),
+		}
+		paths[resourcePath] = map[string]any{
+			"get":    getOperation(fullyQualifiedType, typeName),
+			"put":    createOrUpdateOperation(fullyQualifiedType, typeName),
+			"delete": deleteOperation(fullyQualifiedType, typeName),
+		}
+
+		for _, actionName := range slices.Sorted(maps.Keys(resourceType.Actions)) {
+			action := resourceType.Actions[actionName]
+			actionTypeName := typeName + pascalCase(actionName)
+
+			var input, output map[string]any
+			if action.InputSchema != nil {
+				input, err = copySchema(action.InputSchema)
+				if err != nil {
+					return nil, fmt.Errorf("invalid input schema for action %q of resource type %q: %w", actionName, fullyQualifiedType, err)
+				}
+				schemas[actionTypeName+"Input"] = input
+			}
+			if action.OutputSchema != nil {
+				output, err = copySchema(action.OutputSchema)
+				if err != nil {
+					return nil, fmt.Errorf("invalid output schema for action %q of resource type %q: %w", actionName, fullyQualifiedType, err)
+				}
+				schemas[actionTypeName+"Output"] = output
+			}
+
+			paths[resourcePath+"/"+actionName] = map[string]any{
+				"post": actionOperation(fullyQualifiedType, typeName, actionName, action, input != nil),
+			}
+		}
+	}
+
+	operationPath := fmt.Sprintf("/{rootScope}/providers/%s/locations/{location}", namespace)
+	paths[operationPath+"/operationStatuses/{operationId}"] = map[string]any{
+		"get": operationStatusOperation("OperationStatuses_Get", "Get the status of an asynchronous operation."),
+	}
+	paths[operationPath+"/operationResults/{operationId}"] = map[string]any{
+		"get": operationResultOperation(),
+	}
+
+	return map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":       namespace,
+			"version":     apiVersion,
+			"description": fmt.Sprintf("API of the resource types of the %s resource provider.", namespace),
+		},
+		"tags":  tags,
+		"paths": paths,
+		"components": map[string]any{
+			"parameters": commonParameters(apiVersion),
+			"headers":    commonHeaders(),
+			"responses":  commonResponses(),
+			"schemas":    schemas,
+		},
+	}, nil
+}
+
+// propertiesSchema returns the schema of the properties of a resource type with the properties managed by Radius added
+// when the schema does not declare them.
+func propertiesSchema(schema map[string]any) (map[string]any, error) {
+	result, err := copySchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		result = map[string]any{}
+	}
+	if _, ok := result["type"]; !ok {
+		result["type"] = "object"
+	}
+
+	properties, ok := result["properties"].(map[string]any)
+	if !ok {
+		properties = map[string]any{}
+		result["properties"] = properties
+	}
+
+	if _, ok := properties["provisioningState"]; !ok {
+		properties["provisioningState"] = ref(componentsSchemasPrefix + "ProvisioningState")
+	}
+	if _, ok := properties["status"]; !ok {
+		properties["status"] = ref(componentsSchemasPrefix + "ResourceStatus")
+	}
+
+	return result, nil
+}
+
+// copySchema returns a deep copy of the schema, so that the document does not share state with the registered schemas.
+func copySchema(schema map[string]any) (map[string]any, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	err = json.Unmarshal(bs, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func resourceSchema(fullyQualifiedType string, typeName string) map[string]any {
+	return map[string]any{
+		"type":        "object",
+		"description": fmt.Sprintf("A %s resource.", fullyQualifiedType),
+		"required":    []any{"properties"},
+		"properties": map[string]any{
+			"id":         map[string]any{"type": "string", "readOnly": true, "description": "Fully qualified resource ID for the resource."},
+			"name":       map[string]any{"type": "string", "readOnly": true, "description": "The name of the resource."},
+			"type":       map[string]any{"type": "string", "readOnly": true, "description": "The type of the resource."},
+			"location":   map[string]any{"type": "string", "description": "The geo-location where the resource lives."},
+			"tags":       map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}, "description": "Resource tags."},
+			"systemData": ref(componentsSchemasPrefix + "SystemData"),
+			"properties": ref(componentsSchemasPrefix + typeName + "Properties"),
+		},
+	}
+}
+
+func listResultSchema(typeName string) map[string]any {
+	return map[string]any{
+		"type":     "object",
+		"required": []any{"value"},
+		"properties": map[string]any{
+			"value":    map[string]any{"type": "array", "items": ref(componentsSchemasPrefix + typeName + "Resource")},
+			"nextLink": map[string]any{"type": "string", "format": "uri", "description": "The link to the next page of items."},
+		},
+	}
+}
+
+func listOperation(fullyQualifiedType string, typeName string) map[string]any {
+	return map[string]any{
+		"operationId": typeName + "_List",
+		"tags":        []any{typeName},
+		"description": fmt.Sprintf("List %s resources in the scope.", fullyQualifiedType),
+		"parameters":  []any{ref(componentsParametersPrefix + "ApiVersionParameter"), ref(componentsParametersPrefix + "RootScopeParameter")},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "The request has succeeded.",
+				"content":     jsonContent(ref(componentsSchemasPrefix + typeName + "ResourceListResult")),
+			},
+			"default": ref(componentsResponsesPrefix + "ErrorResponse"),
+		},
+		"x-ms-pageable": map[string]any{"nextLinkName": "nextLink"},
+	}
+}
+
+func getOperation(fullyQualifiedType string, typeName string) map[string]any {
+	return map[string]any{
+		"operationId": typeName + "_Get",
+		"tags":        []any{typeName},
+		"description": fmt.Sprintf("Get a %s resource.", fullyQualifiedType),
+		"parameters":  resourceParameters(),
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "The request has succeeded.",
+				"content":     jsonContent(ref(componentsSchemasPrefix + typeName + "Resource")),
+			},
+			"default": ref(componentsResponsesPrefix + "ErrorResponse"),
+		},
+	}
+}
+
+func createOrUpdateOperation(fullyQualifiedType string, typeName string) map[string]any {
+	return map[string]any{
+		"operationId": typeName + "_CreateOrUpdate",
+		"tags":        []any{typeName},
+		"description": fmt.Sprintf("Create or update a %s resource.", fullyQualifiedType),
+		"parameters":  resourceParameters(),
+		"requestBody": map[string]any{
+			"description": "Resource create parameters.",
+			"required":    true,
+			"content":     jsonContent(ref(componentsSchemasPrefix + typeName + "Resource")),
+		},
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "Resource update operation succeeded.",
+				"content":     jsonContent(ref(componentsSchemasPrefix + typeName + "Resource")),
+			},
+			"201": map[string]any{
+				"description": "Resource create operation accepted. The operation completes asynchronously.",
+				"headers":     asyncHeaders(),
+				"content":     jsonContent(ref(componentsSchemasPrefix + typeName + "Resource")),
+			},
+			"default": ref(componentsResponsesPrefix + "ErrorResponse"),
+		},
+		"x-ms-long-running-operation":         true,
+		"x-ms-long-running-operation-options": map[string]any{"final-state-via": "azure-async-operation"},
+	}
+}
+
+func deleteOperation(fullyQualifiedType string, typeName string) map[string]any {
+	return map[string]any{
+		"operationId": typeName + "_Delete",
+		"tags":        []any{typeName},
+		"description": fmt.Sprintf("Delete a %s resource.", fullyQualifiedType),
+		"parameters":  resourceParameters(),
+		"responses": map[string]any{
+			"200": map[string]any{"description": "Resource deleted successfully."},
+			"202": map[string]any{
+				"description": "Resource deletion accepted. The operation completes asynchronously.",
+				"headers":     asyncHeaders(),
+			},
+			"204":     map[string]any{"description": "Resource does not exist."},
+			"default": ref(componentsResponsesPrefix + "ErrorResponse"),
+		},
+		"x-ms-long-running-operation":         true,
+		"x-ms-long-running-operation-options": map[string]any{"final-state-via": "location"},
+	}
+}
+
+func actionOperation(fullyQualifiedType string, typeName string, actionName string, action DocumentAction, hasInput bool) map[string]any {
+	actionTypeName := typeName + pascalCase(actionName)
+
+	description := action.Description
+	if description == "" {
+		description = fmt.Sprintf("Invoke the %s action on a %s resource.", actionName, fullyQualifiedType)
+	}
+
+	operation := map[string]any{
+		"operationId": typeName + "_" + pascalCase(actionName),
+		"tags":        []any{typeName},
+		"description": description,
+		"parameters":  resourceParameters(),
+		"responses": map[string]any{
+			"202": map[string]any{
+				"description": "Action accepted. The operation completes asynchronously and the status of the resource reports its output.",
+				"headers":     asyncHeaders(),
+				"content":     jsonContent(ref(componentsSchemasPrefix + typeName + "Resource")),
+			},
+			"default": ref(componentsResponsesPrefix + "ErrorResponse"),
+		},
+		"x-ms-long-running-operation":         true,
+		"x-ms-long-running-operation-options": map[string]any{"final-state-via": "azure-async-operation"},
+	}
+
+	if hasInput {
+		operation["requestBody"] = map[string]any{
+			"description": "The input of the action.",
+			"required":    false,
+			"content":     jsonContent(ref(componentsSchemasPrefix + actionTypeName + "Input")),
+		}
+	}
+
+	return operation
+}
+
+func operationStatusOperation(operationID string, description string) map[string]any {
+	return map[string]any{
+		"operationId": operationID,
+		"tags":        []any{"Operations"},
+		"description": description,
+		"parameters":  operationParameters(),
+		"responses": map[string]any{
+			"200": map[string]any{
+				"description": "The request has succeeded.",
+				"content":     jsonContent(ref(componentsSchemasPrefix + "OperationStatusResult")),
+			},
+			"default": ref(componentsResponsesPrefix + "ErrorResponse"),
+		},
+	}
+}
+
+func operationResultOperation() map[string]any {
+	operation := operationStatusOperation("OperationResults_Get", "Get the result of an asynchronous operation.")
+	operation["responses"].(map[string]any)["202"] = map[string]any{
+		"description": "The operation is still in progress.",
+		"headers":     asyncHeaders(),
+	}
+	operation["responses"].(map[string]any)["204"] = map[string]any{
+		"description": "The operation completed successfully.",
+	}
+	return operation
+}
+
+func resourceParameters() []any {
+	return []any{
+		ref(componentsParametersPrefix + "ApiVersionParameter"),
+		ref(componentsParametersPrefix + "RootScopeParameter"),
+		ref(componentsParametersPrefix + "ResourceNameParameter"),
+	}
+}
+
+func operationParameters() []any {
+	return []any{
+		ref(componentsParametersPrefix + "ApiVersionParameter"),
+		ref(componentsParametersPrefix + "RootScopeParameter"),
+		ref(componentsParametersPrefix + "LocationParameter"),
+		ref(componentsParametersPrefix + "OperationIdParameter"),
+	}
+}
+
+func asyncHeaders() map[string]any {
+	return map[string]any{
+		"Azure-AsyncOperation": ref(componentsHeadersPrefix + "AzureAsyncOperation"),
+		"Location":             ref(componentsHeadersPrefix + "Location"),
+		"Retry-After":          ref(componentsHeadersPrefix + "RetryAfter"),
+	}
+}
+
+func commonParameters(apiVersion string) map[string]any {
+	return map[string]any{
+		"ApiVersionParameter": map[string]any{
+			"name":        "api-version",
+			"in":          "query",
+			"description": "The API version to use for this operation.",
+			"required":    true,
+			"schema":      map[string]any{"type": "string", "enum": []any{apiVersion}},
+		},
+		"RootScopeParameter": map[string]any{
+			"name":                    "rootScope",
+			"in":                      "path",
+			"description":             "The scope in which the resource is present. UCP Scope is /planes/{planeType}/{planeName}/resourceGroup/{resourcegroupID}.",
+			"required":                true,
+			"schema":                  map[string]any{"type": "string", "minLength": 1},
+			"x-ms-parameter-location": "client",
+			"x-ms-skip-url-encoding":  true,
+		},
+		"ResourceNameParameter": map[string]any{
+			"name":        "resourceName",
+			"in":          "path",
+			"description": "The name of the resource.",
+			"required":    true,
+			"schema":      map[string]any{"type": "string", "minLength": 1},
+		},
+		"LocationParameter": map[string]any{
+			"name":        "location",
+			"in":          "path",
+			"description": "The location of the operation.",
+			"required":    true,
+			"schema":      map[string]any{"type": "string"},
+		},
+		"OperationIdParameter": map[string]any{
+			"name":        "operationId",
+			"in":          "path",
+			"description": "The ID of the operation.",
+			"required":    true,
+			"schema":      map[string]any{"type": "string"},
+		},
+	}
+}
+
+func commonHeaders() map[string]any {
+	return map[string]any{
+		"AzureAsyncOperation": map[string]any{
+			"description": "A link to the status monitor of the operation.",
+			"schema":      map[string]any{"type": "string"},
+		},
+		"Location": map[string]any{
+			"description": "The URL where the result of the long running operation can be checked.",
+			"schema":      map[string]any{"type": "string"},
+		},
+		"RetryAfter": map[string]any{
+			"description": "How long the client should wait before polling the operation status, in seconds.",
+			"schema":      map[string]any{"type": "integer", "format": "int32"},
+		},
+	}
+}
+
+func commonResponses() map[string]any {
+	return map[string]any{
+		"ErrorResponse": map[string]any{
+			"description": "An unexpected error response.",
+			"content":     jsonContent(ref(componentsSchemasPrefix + "ErrorResponse")),
+		},
+	}
+}
+
+func commonSchemas() map[string]any {
+	provisioningStates := []any{"Succeeded", "Failed", "Canceled", "Provisioning", "Updating", "Deleting", "Accepted"}
+	return map[string]any{
+		"ErrorResponse": map[string]any{
+			"type":        "object",
+			"description": "Common error response for all APIs to return error details for failed operations.",
+			"properties": map[string]any{
+				"error": ref(componentsSchemasPrefix + "ErrorDetail"),
+			},
+		},
+		"ErrorDetail": map[string]any{
+			"type":        "object",
+			"description": "The error detail.",
+			"properties": map[string]any{
+				"code":           map[string]any{"type": "string", "readOnly": true, "description": "The error code."},
+				"message":        map[string]any{"type": "string", "readOnly": true, "description": "The error message."},
+				"target":         map[string]any{"type": "string", "readOnly": true, "description": "The error target."},
+				"details":        map[string]any{"type": "array", "readOnly": true, "items": ref(componentsSchemasPrefix + "ErrorDetail"), "description": "The error details."},
+				"additionalInfo": map[string]any{"type": "array", "readOnly": true, "items": ref(componentsSchemasPrefix + "ErrorAdditionalInfo"), "description": "The error additional info."},
+			},
+		},
+		"ErrorAdditionalInfo": map[string]any{
+			"type":        "object",
+			"description": "The resource management error additional info.",
+			"properties": map[string]any{
+				"type": map[string]any{"type": "string", "readOnly": true, "description": "The additional info type."},
+				"info": map[string]any{"type": "object", "readOnly": true, "description": "The additional info."},
+			},
+		},
+		"SystemData": map[string]any{
+			"type":        "object",
+			"readOnly":    true,
+			"description": "Metadata pertaining to creation and last modification of the resource.",
+			"properties": map[string]any{
+				"createdBy":          map[string]any{"type": "string"},
+				"createdByType":      map[string]any{"type": "string"},
+				"createdAt":          map[string]any{"type": "string", "format": "date-time"},
+				"lastModifiedBy":     map[string]any{"type": "string"},
+				"lastModifiedByType": map[string]any{"type": "string"},
+				"lastModifiedAt":     map[string]any{"type": "string", "format": "date-time"},
+			},
+		},
+		"ProvisioningState": map[string]any{
+			"type":        "string",
+			"readOnly":    true,
+			"description": "The provisioning state of the resource.",
+			"enum":        provisioningStates,
+		},
+		"ResourceStatus": map[string]any{
+			"type":                 "object",
+			"readOnly":             true,
+			"description":          "The status of the resource reported by Radius.",
+			"additionalProperties": true,
+			"properties": map[string]any{
+				"conditions":      map[string]any{"type": "array", "items": ref(componentsSchemasPrefix + "Condition")},
+				"outputResources": map[string]any{"type": "array", "items": map[string]any{"type": "object", "additionalProperties": true}},
+			},
+		},
+		"Condition": map[string]any{
+			"type":        "object",
+			"description": "An observation of the state of the resource, such as whether it is ready.",
+			"required":    []any{"type", "status"},
+			"properties": map[string]any{
+				"type":               map[string]any{"type": "string", "description": "The type of the condition, for example 'Ready'."},
+				"status":             map[string]any{"type": "string", "enum": []any{"True", "False", "Unknown"}},
+				"reason":             map[string]any{"type": "string"},
+				"message":            map[string]any{"type": "string"},
+				"lastTransitionTime": map[string]any{"type": "string", "format": "date-time"},
+			},
+		},
+		"OperationStatusResult": map[string]any{
+			"type":        "object",
+			"description": "The status of an asynchronous operation.",
+			"required":    []any{"status"},
+			"properties": map[string]any{
+				"id":        map[string]any{"type": "string", "description": "Fully qualified ID for the operation status."},
+				"name":      map[string]any{"type": "string", "description": "The ID of the operation."},
+				"status":    map[string]any{"type": "string", "enum": provisioningStates, "description": "The status of the operation."},
+				"startTime": map[string]any{"type": "string", "format": "date-time"},
+				"endTime":   map[string]any{"type": "string", "format": "date-time"},
+				"error":     ref(componentsSchemasPrefix + "ErrorDetail"),
+			},
+		},
+	}
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+func ref(path string) map[string]any {
+	return map[string]any{"$ref": path}
+}
+
+// pascalCase converts a resource type or action name to PascalCase for use in component names and operation IDs.
+func pascalCase(name string) string {
+	if name == "" {
+		return name
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
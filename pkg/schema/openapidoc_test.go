@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func testDocumentResourceTypes() []DocumentResourceType {
+	return []DocumentResourceType{
+		{
+			Name:        "redisCaches",
+			Description: "A Redis cache.",
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"environment": map[string]any{"type": "string"},
+					"size":        map[string]any{"type": "string", "enum": []any{"S", "M", "L"}},
+				},
+				"required": []any{"environment"},
+			},
+			Actions: map[string]DocumentAction{
+				"flush": {},
+				"backup": {
+					Description:  "Back up the cache.",
+					InputSchema:  map[string]any{"type": "object", "properties": map[string]any{"target": map[string]any{"type": "string"}}},
+					OutputSchema: map[string]any{"type": "object", "properties": map[string]any{"location": map[string]any{"type": "string"}}},
+				},
+			},
+		},
+		{
+			Name:   "databases",
+			Schema: map[string]any{"type": "object"},
+		},
+	}
+}
+
+func loadDocument(t *testing.T, document map[string]any) *openapi3.T {
+	bs, err := json.Marshal(document)
+	require.NoError(t, err)
+
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(bs)
+	require.NoError(t, err)
+	require.NoError(t, doc.Validate(context.Background()))
+
+	return doc
+}
+
+func TestGenerateOpenAPIDocument(t *testing.T) {
+	document, err := GenerateOpenAPIDocument("Contoso.Example", "2025-01-01", testDocumentResourceTypes())
+	require.NoError(t, err)
+
+	doc := loadDocument(t, document)
+	require.Equal(t, "Contoso.Example", doc.Info.Title)
+	require.Equal(t, "2025-01-01", doc.Info.Version)
+
+	t.Run("paths", func(t *testing.T) {
+		require.ElementsMatch(t, []string{
+			"/{rootScope}/providers/Contoso.Example/databases",
+			"/{rootScope}/providers/Contoso.Example/databases/{resourceName}",
+			"/{rootScope}/providers/Contoso.Example/redisCaches",
+			"/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}",
+			"/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}/backup",
+			"/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}/flush",
+			"/{rootScope}/providers/Contoso.Example/locations/{location}/operationStatuses/{operationId}",
+			"/{rootScope}/providers/Contoso.Example/locations/{location}/operationResults/{operationId}",
+		}, doc.Paths.InMatchingOrder())
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		collection := doc.Paths.Find("/{rootScope}/providers/Contoso.Example/redisCaches")
+		require.Equal(t, "RedisCaches_List", collection.Get.OperationID)
+		require.Equal(t, map[string]any{"nextLinkName": "nextLink"}, collection.Get.Extensions["x-ms-pageable"])
+
+		resource := doc.Paths.Find("/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}")
+		require.Equal(t, "RedisCaches_Get", resource.Get.OperationID)
+		require.Equal(t, "RedisCaches_CreateOrUpdate", resource.Put.OperationID)
+		require.Equal(t, "RedisCaches_Delete", resource.Delete.OperationID)
+
+		backup := doc.Paths.Find("/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}/backup")
+		require.Equal(t, "RedisCaches_Backup", backup.Post.OperationID)
+		require.Equal(t, "Back up the cache.", backup.Post.Description)
+		require.Equal(t, "#/components/schemas/RedisCachesBackupInput", backup.Post.RequestBody.Value.Content["application/json"].Schema.Ref)
+
+		flush := doc.Paths.Find("/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}/flush")
+		require.Nil(t, flush.Post.RequestBody)
+	})
+
+	t.Run("async headers", func(t *testing.T) {
+		resource := doc.Paths.Find("/{rootScope}/providers/Contoso.Example/redisCaches/{resourceName}")
+
+		created := resource.Put.Responses.Status(201).Value
+		require.Contains(t, created.Headers, "Azure-AsyncOperation")
+		require.Contains(t, created.Headers, "Retry-After")
+		require.Equal(t, true, resource.Put.Extensions["x-ms-long-running-operation"])
+
+		accepted := resource.Delete.Responses.Status(202).Value
+		require.Contains(t, accepted.Headers, "Location")
+		require.NotNil(t, resource.Delete.Responses.Status(204))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, pathItem := range doc.Paths.Map() {
+			for method, operation := range pathItem.Operations() {
+				require.NotNil(t, operation.Responses.Default(), "%s %s has no default response", method, operation.OperationID)
+				require.Equal(t, "#/components/responses/ErrorResponse", operation.Responses.Default().Ref)
+			}
+		}
+	})
+
+	t.Run("schemas", func(t *testing.T) {
+		properties := doc.Components.Schemas["RedisCachesProperties"].Value
+		require.Equal(t, []string{"environment"}, properties.Required)
+		require.Contains(t, properties.Properties, "size")
+		require.Equal(t, "#/components/schemas/ProvisioningState", properties.Properties["provisioningState"].Ref)
+		require.Equal(t, "#/components/schemas/ResourceStatus", properties.Properties["status"].Ref)
+
+		resource := doc.Components.Schemas["RedisCachesResource"].Value
+		require.True(t, resource.Properties["id"].Value.ReadOnly)
+		require.Equal(t, "#/components/schemas/RedisCachesProperties", resource.Properties["properties"].Ref)
+
+		require.Contains(t, doc.Components.Schemas, "RedisCachesBackupOutput")
+		require.Contains(t, doc.Components.Schemas, "DatabasesResourceListResult")
+	})
+}
+
+func TestGenerateOpenAPIDocument_DoesNotModifySchemas(t *testing.T) {
+	resourceTypes := testDocumentResourceTypes()
+
+	_, err := GenerateOpenAPIDocument("Contoso.Example", "2025-01-01", resourceTypes)
+	require.NoError(t, err)
+
+	require.NotContains(t, resourceTypes[0].Schema["properties"], "provisioningState")
+	require.Equal(t, "redisCaches", resourceTypes[0].Name)
+}
+
+func TestGenerateOpenAPIDocument_Empty(t *testing.T) {
+	document, err := GenerateOpenAPIDocument("Contoso.Example", "2025-01-01", nil)
+	require.NoError(t, err)
+
+	doc := loadDocument(t, document)
+	require.Equal(t, 2, doc.Paths.Len())
+}
+
+func TestGenerateOpenAPIDocument_InvalidSchema(t *testing.T) {
+	_, err := GenerateOpenAPIDocument("Contoso.Example", "2025-01-01", []DocumentResourceType{
+		{Name: "databases", Schema: map[string]any{"type": func() {}}},
+	})
+	require.ErrorContains(t, err, `invalid schema for resource type "Contoso.Example/databases"`)
+}
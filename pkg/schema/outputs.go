@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ValidateRecipeOutput validates the values and secrets returned by a recipe against the resource type schema.
+//
+// Only the outputs of the read-only properties of the schema, which are set by the recipe, and of the reserved
+// properties are validated: they must be assignable to the property. Read-only properties listed as required by the
+// schema must be returned by the recipe, since nothing else can set them. Outputs matching the other properties, which
+// are set by the user, and outputs that are not part of the schema are not validated; they remain available as
+// computed values and secrets for connections.
+//
+// Returns nil if the schema is nil, or *ValidationErrors describing every mismatch.
+func ValidateRecipeOutput(ctx context.Context, schemaData any, values map[string]any, secrets map[string]any) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	if schemaData == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	var errors ValidationErrors
+	for _, name := range slices.Sorted(maps.Keys(openAPISchema.Properties)) {
+		propRef := openAPISchema.Properties[name]
+		if propRef == nil || propRef.Value == nil {
+			continue
+		}
+
+		if !propRef.Value.ReadOnly && !isReservedOutputProperty(name) {
+			continue
+		}
+
+		value, ok := values[name]
+		if !ok {
+			value, ok = secrets[name]
+		}
+
+		if !ok {
+			if propRef.Value.ReadOnly && slices.Contains(openAPISchema.Required, name) {
+				errors.Add(NewSchemaError(name, "required read-only property is not returned by the recipe"))
+			} else if propRef.Value.ReadOnly {
+				logger.V(ucplog.LevelDebug).Info("Read-only property is not returned by the recipe", "property", name)
+			}
+			continue
+		}
+
+		if err := propRef.Value.VisitJSON(value, openapi3.MultiErrors()); err != nil {
+			errors.Add(NewSchemaError(name, fmt.Sprintf("recipe output does not match the schema: %s", describeVisitError(err))))
+		}
+	}
+
+	if errors.HasErrors() {
+		return &errors
+	}
+
+	return nil
+}
+
+// isReservedOutputProperty returns true for the reserved properties of resource types.
+func isReservedOutputProperty(name string) bool {
+	switch name {
+	case reservedPropApplication, reservedPropEnvironment, reservedPropStatus, reservedPropConnections, reservedPropRecipe:
+		return true
+	default:
+		return false
+	}
+}
+
+// describeVisitError returns a readable message for an error returned by openapi3.Schema.VisitJSON.
+func describeVisitError(err error) string {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		if len(e) > 0 {
+			return describeVisitError(e[0])
+		}
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			return fmt.Sprintf("error at %q: %s", strings.Join(pointer, "."), e.Reason)
+		}
+		return e.Reason
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRecipeOutput(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{
+				"type": "string",
+			},
+			"database": map[string]any{
+				"type": "string",
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+			"port": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+			},
+			"password": map[string]any{
+				"type":               "string",
+				"readOnly":           true,
+				"x-radius-sensitive": true,
+			},
+			"tags": map[string]any{
+				"type":     "array",
+				"readOnly": true,
+				"items": map[string]any{
+					"type": "string",
+				},
+			},
+		},
+		"required": []any{"environment", "host"},
+	}
+
+	tests := []struct {
+		name         string
+		schema       any
+		values       map[string]any
+		secrets      map[string]any
+		expectedErrs []string
+	}{
+		{
+			name:    "valid outputs",
+			schema:  schema,
+			values:  map[string]any{"host": "localhost", "port": float64(5432), "database": "db", "tags": []any{"a"}},
+			secrets: map[string]any{"password": "secret"},
+		},
+		{
+			name:   "outputs not in schema are ignored",
+			schema: schema,
+			values: map[string]any{"host": "localhost", "connectionString": float64(1)},
+		},
+		{
+			name:   "properties set by the user are ignored",
+			schema: schema,
+			values: map[string]any{"host": "localhost", "database": float64(1)},
+		},
+		{
+			name:         "reserved properties are validated",
+			schema:       schema,
+			values:       map[string]any{"host": "localhost", "environment": float64(1)},
+			expectedErrs: []string{"SchemaError error at \"environment\": recipe output does not match the schema"},
+		},
+		{
+			name:   "nil schema",
+			schema: nil,
+			values: map[string]any{"host": float64(1)},
+		},
+		{
+			name:         "required read-only property missing",
+			schema:       schema,
+			values:       map[string]any{"port": float64(5432)},
+			expectedErrs: []string{"SchemaError error at \"host\": required read-only property is not returned by the recipe"},
+		},
+		{
+			name:    "type mismatches",
+			schema:  schema,
+			values:  map[string]any{"host": "localhost", "port": "5432", "tags": []any{float64(1)}},
+			secrets: map[string]any{"password": map[string]any{}},
+			expectedErrs: []string{
+				"SchemaError error at \"port\": recipe output does not match the schema",
+				"SchemaError error at \"tags\": recipe output does not match the schema: error at \"0\"",
+				"SchemaError error at \"password\": recipe output does not match the schema",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateRecipeOutput(context.Background(), tc.schema, tc.values, tc.secrets)
+			if len(tc.expectedErrs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			validationErrs, ok := err.(*ValidationErrors)
+			require.True(t, ok)
+			require.Len(t, validationErrs.Errors, len(tc.expectedErrs))
+			for _, expected := range tc.expectedErrs {
+				require.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
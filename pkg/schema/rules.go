@@ -0,0 +1,273 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/cel-go/cel"
+)
+
+const (
+	// annotationRadiusValidations is the schema extension holding the CEL validation rules of a resource type.
+	annotationRadiusValidations = "x-radius-validations"
+
+	// ruleVariableSelf is the CEL variable bound to the properties of the resource.
+	ruleVariableSelf = "self"
+
+	// ruleVariableOldSelf is the CEL variable bound to the properties of the existing resource on update.
+	ruleVariableOldSelf = "oldSelf"
+
+	// ruleCostLimit is the maximum runtime cost of evaluating a single rule. Evaluation is aborted once the
+	// limit is exceeded, so that a rule iterating over large lists cannot exhaust the CPU of the resource provider.
+	ruleCostLimit = 1000000
+
+	// ruleInterruptCheckFrequency is the number of comprehension iterations between checks of the evaluation context.
+	ruleInterruptCheckFrequency = 100
+
+	// ruleEvaluationTimeout is the maximum duration of evaluating a single rule.
+	ruleEvaluationTimeout = time.Second
+
+	// maxCompiledRuleSets is the maximum number of rule sets kept in the compiled rules cache.
+	maxCompiledRuleSets = 1000
+)
+
+// compiledRulesCache caches the compiled validation rules, keyed by the rules declared in the schema, so that the
+// rules of a resource type are compiled once rather than on every request.
+var compiledRulesCache = &ruleCache{entries: map[string][]compiledRule{}}
+
+// ruleCache is a cache of compiled validation rules. The cache is reset once it holds maxCompiledRuleSets entries.
+type ruleCache struct {
+	mu      sync.Mutex
+	entries map[string][]compiledRule
+}
+
+// get returns the compiled rules, compiling and caching them on a miss. Compilation errors are not cached.
+func (c *ruleCache) get(rules []ValidationRule) ([]compiledRule, error) {
+	bs, err := json.Marshal(rules)
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid: %w", annotationRadiusValidations, err)
+	}
+	key := string(bs)
+
+	c.mu.Lock()
+	compiled, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		return compiled, nil
+	}
+
+	compiled, err = compileValidationRules(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxCompiledRuleSets {
+		c.entries = map[string][]compiledRule{}
+	}
+	c.entries[key] = compiled
+
+	return compiled, nil
+}
+
+// ValidationRule is a CEL validation rule declared in the x-radius-validations extension of a resource type schema.
+type ValidationRule struct {
+	// Rule is the CEL expression. It must evaluate to true for the resource to be valid.
+	Rule string `json:"rule"`
+
+	// Message is the error message returned when the rule evaluates to false.
+	Message string `json:"message,omitempty"`
+}
+
+// compiledRule is a validation rule compiled to a CEL program.
+type compiledRule struct {
+	ValidationRule
+	program cel.Program
+
+	// transition is true when the rule references oldSelf. Transition rules are only evaluated on update.
+	transition bool
+}
+
+// ValidateResourceRules evaluates the CEL validation rules declared in the x-radius-validations extension of the
+// schema against the resource properties.
+//
+// The properties are bound to the "self" variable. On update, the properties of the existing resource are bound to
+// the "oldSelf" variable: rules referencing oldSelf are skipped on create (oldProperties is nil).
+//
+// The evaluation of each rule is bounded by a cost limit and a timeout: a rule exceeding either fails with an
+// evaluation error.
+//
+// Returns nil if the schema is nil or declares no rules, and ValidationErrors listing the failed rules otherwise.
+func ValidateResourceRules(ctx context.Context, properties map[string]any, oldProperties map[string]any, schemaData any) error {
+	if schemaData == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	rules, err := getValidationRules(openAPISchema)
+	if err != nil {
+		return err
+	}
+
+	compiled, err := compiledRulesCache.get(rules)
+	if err != nil {
+		return err
+	}
+
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
+	var errors ValidationErrors
+	for _, rule := range compiled {
+		vars := map[string]any{ruleVariableSelf: properties}
+		if rule.transition {
+			if oldProperties == nil {
+				continue
+			}
+			vars[ruleVariableOldSelf] = oldProperties
+		}
+
+		valid, err := rule.eval(ctx, vars)
+		if err != nil {
+			errors.Add(NewConstraintError("", fmt.Sprintf("rule %q could not be evaluated: %v", rule.Rule, err)))
+			continue
+		}
+
+		if !valid {
+			errors.Add(NewConstraintError("", rule.failureMessage()))
+		}
+	}
+
+	if errors.HasErrors() {
+		return &errors
+	}
+
+	return nil
+}
+
+// eval evaluates the rule, aborting the evaluation once ruleEvaluationTimeout has elapsed. Returns true if the
+// rule evaluates to true.
+func (r *compiledRule) eval(ctx context.Context, vars map[string]any) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, ruleEvaluationTimeout)
+	defer cancel()
+
+	out, _, err := r.program.ContextEval(ctx, vars)
+	if err != nil {
+		return false, err
+	}
+
+	valid, ok := out.Value().(bool)
+	return ok && valid, nil
+}
+
+// failureMessage returns the message of a failed rule.
+func (r *compiledRule) failureMessage() string {
+	if r.Message != "" {
+		return r.Message
+	}
+	return fmt.Sprintf("failed rule: %s", r.Rule)
+}
+
+// getValidationRules returns the validation rules declared in the x-radius-validations extension of the schema.
+func getValidationRules(schema *openapi3.Schema) ([]ValidationRule, error) {
+	value, ok := schema.Extensions[annotationRadiusValidations]
+	if !ok {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid: %w", annotationRadiusValidations, err)
+	}
+
+	var rules []ValidationRule
+	if err := json.Unmarshal(bs, &rules); err != nil {
+		return nil, fmt.Errorf("%s must be a list of objects with rule and message properties", annotationRadiusValidations)
+	}
+
+	return rules, nil
+}
+
+// compileValidationRules compiles the validation rules. Each rule must be a valid CEL expression returning a boolean.
+func compileValidationRules(rules []ValidationRule) ([]compiledRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable(ruleVariableSelf, cel.DynType),
+		cel.Variable(ruleVariableOldSelf, cel.DynType),
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	for i, rule := range rules {
+		if rule.Rule == "" {
+			return nil, fmt.Errorf("%s[%d].rule must not be empty", annotationRadiusValidations, i)
+		}
+
+		ast, issues := env.Compile(rule.Rule)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("%s[%d].rule %q does not compile: %w", annotationRadiusValidations, i, rule.Rule, issues.Err())
+		}
+
+		if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("%s[%d].rule %q must evaluate to a boolean, got %s", annotationRadiusValidations, i, rule.Rule, ast.OutputType())
+		}
+
+		program, err := env.Program(ast,
+			cel.CostLimit(ruleCostLimit),
+			cel.InterruptCheckFrequency(ruleInterruptCheckFrequency),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d].rule %q is invalid: %w", annotationRadiusValidations, i, rule.Rule, err)
+		}
+
+		compiled = append(compiled, compiledRule{
+			ValidationRule: rule,
+			program:        program,
+			transition:     referencesVariable(ast, ruleVariableOldSelf),
+		})
+	}
+
+	return compiled, nil
+}
+
+// referencesVariable returns true if the checked expression references the variable.
+func referencesVariable(ast *cel.Ast, name string) bool {
+	for _, ref := range ast.NativeRep().ReferenceMap() {
+		if ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
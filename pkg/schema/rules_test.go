@@ -0,0 +1,255 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResourceRules(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"tier":        map[string]any{"type": "string"},
+			"replicas":    map[string]any{"type": "integer"},
+			"size":        map[string]any{"type": "integer"},
+		},
+		annotationRadiusValidations: []any{
+			map[string]any{
+				"rule":    "self.tier != 'dev' || self.replicas <= 10",
+				"message": "replicas must be at most 10 in dev",
+			},
+			map[string]any{
+				"rule": "self.size >= oldSelf.size",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		properties    map[string]any
+		oldProperties map[string]any
+		err           string
+	}{
+		{
+			name:       "create - valid",
+			properties: map[string]any{"tier": "dev", "replicas": float64(3), "size": float64(1)},
+		},
+		{
+			name:       "create - transition rule skipped",
+			properties: map[string]any{"tier": "prod", "replicas": float64(30), "size": float64(1)},
+		},
+		{
+			name:       "create - cross-field rule fails",
+			properties: map[string]any{"tier": "dev", "replicas": float64(30), "size": float64(1)},
+			err:        "ConstraintError error: replicas must be at most 10 in dev",
+		},
+		{
+			name:          "update - valid",
+			properties:    map[string]any{"tier": "dev", "replicas": float64(3), "size": float64(2)},
+			oldProperties: map[string]any{"tier": "dev", "replicas": float64(3), "size": float64(1)},
+		},
+		{
+			name:          "update - transition rule fails",
+			properties:    map[string]any{"tier": "dev", "replicas": float64(3), "size": float64(1)},
+			oldProperties: map[string]any{"tier": "dev", "replicas": float64(3), "size": float64(2)},
+			err:           "ConstraintError error: failed rule: self.size >= oldSelf.size",
+		},
+		{
+			name:       "evaluation error",
+			properties: map[string]any{"replicas": float64(30)},
+			err:        "rule \"self.tier != 'dev' || self.replicas <= 10\" could not be evaluated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateResourceRules(context.Background(), tt.properties, tt.oldProperties, schema)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	t.Run("no rules", func(t *testing.T) {
+		err := ValidateResourceRules(context.Background(), map[string]any{}, nil, map[string]any{"type": "object"})
+		require.NoError(t, err)
+	})
+
+	t.Run("nil schema", func(t *testing.T) {
+		err := ValidateResourceRules(context.Background(), map[string]any{}, nil, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestValidateResourceRules_CostLimitExceeded(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"items": map[string]any{"type": "array", "items": map[string]any{"type": "integer"}},
+		},
+		annotationRadiusValidations: []any{
+			map[string]any{"rule": "self.items.all(x, self.items.all(y, x == y || x != y))"},
+		},
+	}
+
+	items := make([]any, 2000)
+	for i := range items {
+		items[i] = float64(i)
+	}
+
+	err := ValidateResourceRules(context.Background(), map[string]any{"items": items}, nil, schema)
+	require.ErrorContains(t, err, "cost limit exceeded")
+}
+
+func TestRuleCache(t *testing.T) {
+	cache := &ruleCache{entries: map[string][]compiledRule{}}
+	rules := []ValidationRule{{Rule: "self.replicas <= 10"}}
+
+	first, err := cache.get(rules)
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+
+	second, err := cache.get([]ValidationRule{{Rule: "self.replicas <= 10"}})
+	require.NoError(t, err)
+	require.Same(t, &first[0], &second[0])
+	require.Len(t, cache.entries, 1)
+
+	_, err = cache.get([]ValidationRule{{Rule: "self.replicas <="}})
+	require.ErrorContains(t, err, "does not compile")
+	require.Len(t, cache.entries, 1)
+}
+
+func TestValidator_checkValidationRules(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name   string
+		schema *openapi3.Schema
+		path   string
+		err    string
+	}{
+		{
+			name: "valid rules",
+			schema: &openapi3.Schema{
+				Extensions: map[string]any{
+					annotationRadiusValidations: []any{
+						map[string]any{"rule": "self.replicas <= 10", "message": "too many replicas"},
+						map[string]any{"rule": "self.size >= oldSelf.size"},
+					},
+				},
+			},
+		},
+		{
+			name:   "no rules",
+			schema: &openapi3.Schema{},
+		},
+		{
+			name: "rule does not compile",
+			schema: &openapi3.Schema{
+				Extensions: map[string]any{
+					annotationRadiusValidations: []any{
+						map[string]any{"rule": "self.replicas <="},
+					},
+				},
+			},
+			err: "x-radius-validations[0].rule \"self.replicas <=\" does not compile",
+		},
+		{
+			name: "rule does not return a boolean",
+			schema: &openapi3.Schema{
+				Extensions: map[string]any{
+					annotationRadiusValidations: []any{
+						map[string]any{"rule": "'replicas'"},
+					},
+				},
+			},
+			err: "x-radius-validations[0].rule \"'replicas'\" must evaluate to a boolean, got string",
+		},
+		{
+			name: "empty rule",
+			schema: &openapi3.Schema{
+				Extensions: map[string]any{
+					annotationRadiusValidations: []any{
+						map[string]any{"message": "missing rule"},
+					},
+				},
+			},
+			err: "x-radius-validations[0].rule must not be empty",
+		},
+		{
+			name: "invalid format",
+			schema: &openapi3.Schema{
+				Extensions: map[string]any{
+					annotationRadiusValidations: "self.replicas <= 10",
+				},
+			},
+			err: "x-radius-validations must be a list of objects with rule and message properties",
+		},
+		{
+			name: "nested schema",
+			schema: &openapi3.Schema{
+				Extensions: map[string]any{
+					annotationRadiusValidations: []any{
+						map[string]any{"rule": "self.replicas <= 10"},
+					},
+				},
+			},
+			path: "config",
+			err:  "x-radius-validations annotation is only supported on the root schema",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.checkValidationRules(tt.schema, tt.path)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_WithValidationRules(t *testing.T) {
+	validator := NewValidator()
+
+	schema := &openapi3.Schema{
+		Type: &openapi3.Types{"object"},
+		Properties: openapi3.Schemas{
+			"replicas": {
+				Value: &openapi3.Schema{Type: &openapi3.Types{"integer"}},
+			},
+		},
+		Extensions: map[string]any{
+			annotationRadiusValidations: []any{
+				map[string]any{"rule": "self.replicas <=", "message": "too many replicas"},
+			},
+		},
+	}
+
+	err := validator.ValidateSchema(context.Background(), schema)
+	require.ErrorContains(t, err, "does not compile")
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"maps"
+	"math"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SimulateRecipeOutput synthesizes the values and secrets of a recipe executed in a simulated environment.
+//
+// The values and secrets declared by the recipe are kept. Every read-only top-level property of the schema that is
+// not declared by the recipe, or whose declared value does not match the schema, is set to a plausible value
+// generated from its schema: the default, the first enum value or the example when present, otherwise a value of
+// the right type and format. Properties marked with x-radius-sensitive are returned as secrets.
+//
+// Returns the declared values and secrets unchanged if the schema is nil.
+func SimulateRecipeOutput(schemaData any, values map[string]any, secrets map[string]any) (map[string]any, map[string]any, error) {
+	simulatedValues := map[string]any{}
+	maps.Copy(simulatedValues, values)
+	simulatedSecrets := map[string]any{}
+	maps.Copy(simulatedSecrets, secrets)
+
+	if schemaData == nil {
+		return simulatedValues, simulatedSecrets, nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	for name, propRef := range openAPISchema.Properties {
+		if isReservedOutputProperty(name) || propRef == nil || propRef.Value == nil || !propRef.Value.ReadOnly {
+			continue
+		}
+
+		value, ok := simulatedValues[name]
+		if !ok {
+			value, ok = simulatedSecrets[name]
+		}
+		if ok && propRef.Value.VisitJSON(value, openapi3.MultiErrors()) == nil {
+			continue
+		}
+
+		delete(simulatedValues, name)
+		delete(simulatedSecrets, name)
+
+		if sensitive, _ := propRef.Value.Extensions[annotationRadiusSensitive].(bool); sensitive {
+			simulatedSecrets[name] = simulateValue(name, propRef.Value)
+		} else {
+			simulatedValues[name] = simulateValue(name, propRef.Value)
+		}
+	}
+
+	return simulatedValues, simulatedSecrets, nil
+}
+
+// simulateValue generates a plausible value for a property from its schema.
+func simulateValue(name string, schema *openapi3.Schema) any {
+	switch {
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case schema.Example != nil:
+		return schema.Example
+	}
+
+	switch {
+	case schema.Type.Is(openapi3.TypeString):
+		return simulateString(name, schema)
+	case schema.Type.Is(openapi3.TypeInteger):
+		if schema.Min != nil {
+			return int64(math.Ceil(*schema.Min))
+		}
+		return int64(0)
+	case schema.Type.Is(openapi3.TypeNumber):
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return float64(0)
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return false
+	case schema.Type.Is(openapi3.TypeArray):
+		items := []any{}
+		if schema.Items != nil && schema.Items.Value != nil {
+			for i := uint64(0); i < schema.MinItems; i++ {
+				items = append(items, simulateValue(name, schema.Items.Value))
+			}
+		}
+		return items
+	case schema.Type.Is(openapi3.TypeObject):
+		object := map[string]any{}
+		for propName, propRef := range schema.Properties {
+			if propRef != nil && propRef.Value != nil {
+				object[propName] = simulateValue(propName, propRef.Value)
+			}
+		}
+		return object
+	default:
+		return simulateString(name, schema)
+	}
+}
+
+// simulateString generates a string matching the format of the schema.
+func simulateString(name string, schema *openapi3.Schema) string {
+	switch schema.Format {
+	case "uri", "url":
+		return fmt.Sprintf("https://%s.simulated.local", name)
+	case "hostname":
+		return fmt.Sprintf("%s.simulated.local", name)
+	case "email":
+		return fmt.Sprintf("%s@simulated.local", name)
+	case "ipv4":
+		return "127.0.0.1"
+	case "ipv6":
+		return "::1"
+	case "uuid":
+		return "00000000-0000-0000-0000-000000000000"
+	case "date":
+		return "1970-01-01"
+	case "date-time":
+		return "1970-01-01T00:00:00Z"
+	default:
+		return fmt.Sprintf("simulated-%s", name)
+	}
+}
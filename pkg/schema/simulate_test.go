@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateRecipeOutput(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{
+				"type": "string",
+			},
+			"database": map[string]any{
+				"type": "string",
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"format":   "hostname",
+				"readOnly": true,
+			},
+			"port": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+				"minimum":  1,
+			},
+			"tier": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+				"enum":     []any{"basic", "premium"},
+			},
+			"replicas": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+				"default":  3,
+			},
+			"password": map[string]any{
+				"type":               "string",
+				"readOnly":           true,
+				"x-radius-sensitive": true,
+			},
+			"endpoints": map[string]any{
+				"type":     "array",
+				"readOnly": true,
+				"minItems": 1,
+				"items": map[string]any{
+					"type":   "string",
+					"format": "uri",
+				},
+			},
+		},
+		"required": []any{"environment", "host"},
+	}
+
+	t.Run("nil schema", func(t *testing.T) {
+		values, secrets, err := SimulateRecipeOutput(nil, map[string]any{"host": "localhost"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"host": "localhost"}, values)
+		require.Empty(t, secrets)
+	})
+
+	t.Run("missing read-only properties are simulated", func(t *testing.T) {
+		values, secrets, err := SimulateRecipeOutput(schema, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"host":      "host.simulated.local",
+			"port":      int64(1),
+			"tier":      "basic",
+			"replicas":  float64(3),
+			"endpoints": []any{"https://endpoints.simulated.local"},
+		}, values)
+		require.Equal(t, map[string]any{"password": "simulated-password"}, secrets)
+
+		// The simulated output must be a valid recipe output for the schema.
+		require.NoError(t, ValidateRecipeOutput(context.Background(), schema, values, secrets))
+	})
+
+	t.Run("declared outputs are kept", func(t *testing.T) {
+		values, secrets, err := SimulateRecipeOutput(schema,
+			map[string]any{"host": "db.example.com", "port": float64(5432), "extra": "value"},
+			map[string]any{"password": "declared"})
+		require.NoError(t, err)
+		require.Equal(t, "db.example.com", values["host"])
+		require.Equal(t, float64(5432), values["port"])
+		require.Equal(t, "value", values["extra"])
+		require.Equal(t, map[string]any{"password": "declared"}, secrets)
+	})
+
+	t.Run("declared outputs that do not match the schema are replaced", func(t *testing.T) {
+		values, _, err := SimulateRecipeOutput(schema, map[string]any{"port": "simulated-port", "tier": "gold"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), values["port"])
+		require.Equal(t, "basic", values["tier"])
+	})
+
+	t.Run("properties that are not read-only are not simulated", func(t *testing.T) {
+		values, _, err := SimulateRecipeOutput(schema, nil, nil)
+		require.NoError(t, err)
+		require.NotContains(t, values, "environment")
+		require.NotContains(t, values, "database")
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, _, err := SimulateRecipeOutput("invalid", nil, nil)
+		require.Error(t, err)
+	})
+}
@@ -40,6 +40,7 @@ const (
 // Constants for annotation names
 const (
 	annotationRadiusSensitive = "x-radius-sensitive"
+	annotationRadiusImmutable = "x-radius-immutable"
 )
 
 // joinPath concatenates two path segments with a dot separator for property path tracking.
@@ -349,6 +350,33 @@ func (v *Validator) validateRadiusConstraintsWithPath(schema *openapi3.Schema, p
 		}
 	}
 
+	// Check x-radius-immutable annotation constraints
+	if err := v.checkImmutableAnnotation(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
+	// Check x-radius-connection-types annotation constraints
+	if err := v.checkConnectionTypesAnnotation(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
+	// Check x-radius-validations annotation constraints
+	if err := v.checkValidationRules(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
 	// Validate type constraints
 	if err := v.validateTypeConstraints(schema, path); err != nil {
 		if valErr, ok := err.(*ValidationError); ok {
@@ -594,6 +622,53 @@ func (v *Validator) checkSensitiveAnnotation(schema *openapi3.Schema, path strin
 	return nil
 }
 
+// checkImmutableAnnotation validates that x-radius-immutable annotation is a boolean, and is not combined with
+// x-radius-sensitive: encrypted values cannot be compared between updates.
+func (v *Validator) checkImmutableAnnotation(schema *openapi3.Schema, path string) error {
+	if schema.Extensions == nil {
+		return nil
+	}
+
+	immutable, exists := schema.Extensions[annotationRadiusImmutable]
+	if !exists {
+		return nil
+	}
+
+	boolVal, ok := immutable.(bool)
+	if !ok {
+		return NewConstraintError(path, fmt.Sprintf("%s must be a boolean value", annotationRadiusImmutable))
+	}
+
+	if sensitive, _ := schema.Extensions[annotationRadiusSensitive].(bool); boolVal && sensitive {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation cannot be combined with %s", annotationRadiusImmutable, annotationRadiusSensitive))
+	}
+
+	return nil
+}
+
+// checkValidationRules validates that the x-radius-validations annotation is only used on the root schema, and that
+// its CEL expressions compile.
+func (v *Validator) checkValidationRules(schema *openapi3.Schema, path string) error {
+	if _, exists := schema.Extensions[annotationRadiusValidations]; !exists {
+		return nil
+	}
+
+	if path != "" {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation is only supported on the root schema", annotationRadiusValidations))
+	}
+
+	rules, err := getValidationRules(schema)
+	if err != nil {
+		return NewConstraintError(annotationRadiusValidations, err.Error())
+	}
+
+	if _, err := compileValidationRules(rules); err != nil {
+		return NewConstraintError(annotationRadiusValidations, err.Error())
+	}
+
+	return nil
+}
+
 // isInternalRef checks if a $ref is an internal reference within the same document
 func (v *Validator) isInternalRef(ref string) bool {
 	// Internal references start with "#/" which means they reference within the same document
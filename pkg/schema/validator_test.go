@@ -3441,3 +3441,51 @@ func TestValidateResourceAgainstSchema_EncryptedSensitiveFields(t *testing.T) {
 	})
 
 }
+
+func TestValidator_checkImmutableAnnotation(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name   string
+		schema *openapi3.Schema
+		err    string
+	}{
+		{
+			name: "immutable string",
+			schema: &openapi3.Schema{
+				Type:       &openapi3.Types{"string"},
+				Extensions: map[string]any{annotationRadiusImmutable: true},
+			},
+		},
+		{
+			name: "not a boolean",
+			schema: &openapi3.Schema{
+				Type:       &openapi3.Types{"string"},
+				Extensions: map[string]any{annotationRadiusImmutable: "yes"},
+			},
+			err: "x-radius-immutable must be a boolean value",
+		},
+		{
+			name: "combined with sensitive",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+					annotationRadiusSensitive: true,
+				},
+			},
+			err: "x-radius-immutable annotation cannot be combined with x-radius-sensitive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.checkImmutableAnnotation(tt.schema, "region")
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+// Resource is a resource of a resource type with typed properties.
+type Resource[P any] struct {
+	// ID is the fully qualified resource ID of the resource. Read-only.
+	ID string `json:"id,omitempty"`
+
+	// Name is the name of the resource. Read-only.
+	Name string `json:"name,omitempty"`
+
+	// Type is the resource type of the resource. Read-only.
+	Type string `json:"type,omitempty"`
+
+	// Location is the location of the resource.
+	Location string `json:"location,omitempty"`
+
+	// Tags are the tags of the resource.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Properties are the properties of the resource.
+	Properties P `json:"properties"`
+}
+
+// ResourceListResult is a page of resources of a resource type.
+type ResourceListResult[P any] struct {
+	// Value is the resources of the page.
+	Value []*Resource[P] `json:"value"`
+
+	// NextLink is the link to the next page of resources.
+	NextLink string `json:"nextLink,omitempty"`
+}
+
+// ResourceStatus is the status of a resource reported by Radius.
+type ResourceStatus struct {
+	// Conditions are the observations of the state of the resource, such as whether it is ready.
+	Conditions []ResourceCondition `json:"conditions,omitempty"`
+
+	// Actions is the status of the last invocation of each action of the resource, keyed by action name.
+	Actions map[string]*ActionStatus `json:"actions,omitempty"`
+
+	// OutputResources are the resources deployed for the resource.
+	OutputResources []map[string]any `json:"outputResources,omitempty"`
+}
+
+// ResourceCondition is an observation of the state of a resource.
+type ResourceCondition struct {
+	// Type is the type of the condition, e.g. 'Ready'.
+	Type string `json:"type"`
+
+	// Status is the status of the condition: 'True', 'False' or 'Unknown'.
+	Status string `json:"status"`
+
+	// Reason is a machine-readable explanation of the status.
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable explanation of the status.
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time the status of the condition last changed.
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+}
+
+// ActionStatus is the status of the last invocation of an action.
+type ActionStatus struct {
+	// OperationID is the ID of the async operation invoking the action.
+	OperationID string `json:"operationId"`
+
+	// State is the provisioning state of the invocation.
+	State string `json:"state"`
+
+	// Input is the input the action was invoked with.
+	Input map[string]any `json:"input,omitempty"`
+
+	// Output is the output of the action. It is set when the invocation succeeds.
+	Output map[string]any `json:"output,omitempty"`
+
+	// Error is the error of the invocation. It is set when the invocation fails.
+	Error map[string]any `json:"error,omitempty"`
+}
+
+// ResourceDeleteResponse contains the response of a delete operation.
+type ResourceDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// ActionResponse contains the response of an action invocation. The output of the action is reported in the status of
+// the resource.
+type ActionResponse struct {
+	// placeholder for future response values
+}
+
+// ResourceClient is a client for the resources of a resource type, with the properties of the resources decoded to P.
+// It is used by clients generated from resource provider manifests, and can be used directly for types without one.
+type ResourceClient[P any] struct {
+	pipeline     runtime.Pipeline
+	endpoint     string
+	resourceType string
+	apiVersion   string
+}
+
+// NewResourceClient creates a new ResourceClient for the resource type, e.g. 'Radius.Data/redisCaches', using the given
+// API version.
+func NewResourceClient[P any](connection sdk.Connection, resourceType string, apiVersion string) (*ResourceClient[P], error) {
+	if connection == nil {
+		return nil, errors.New("connection cannot be nil")
+	}
+	if strings.Count(resourceType, "/") != 1 {
+		return nil, fmt.Errorf("resource type %q must be fully qualified, e.g. 'Radius.Data/redisCaches'", resourceType)
+	}
+	if apiVersion == "" {
+		return nil, errors.New("apiVersion cannot be empty")
+	}
+
+	options := sdk.NewClientOptions(connection)
+	return &ResourceClient[P]{
+		pipeline:     runtime.NewPipeline(ModuleName, ModuleVersion, runtime.PipelineOptions{}, &options.ClientOptions),
+		endpoint:     connection.Endpoint(),
+		resourceType: resourceType,
+		apiVersion:   apiVersion,
+	}, nil
+}
+
+// ResourceType returns the resource type of the client.
+func (c *ResourceClient[P]) ResourceType() string {
+	return c.resourceType
+}
+
+// APIVersion returns the API version of the client.
+func (c *ResourceClient[P]) APIVersion() string {
+	return c.apiVersion
+}
+
+// ResourceID returns the resource ID of the resource with the given name in the root scope, e.g.
+// '/planes/radius/local/resourceGroups/default'.
+func (c *ResourceClient[P]) ResourceID(rootScope string, name string) string {
+	return c.collectionPath(rootScope) + "/" + name
+}
+
+// Get gets the resource with the given name in the root scope.
+func (c *ResourceClient[P]) Get(ctx context.Context, rootScope string, name string) (*Resource[P], error) {
+	req, err := c.newRequest(ctx, http.MethodGet, c.ResourceID(rootScope, name))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := &Resource[P]{}
+	if err := runtime.UnmarshalAsJSON(resp, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// NewListPager returns a pager listing the resources in the root scope.
+func (c *ResourceClient[P]) NewListPager(rootScope string) *runtime.Pager[ResourceListResult[P]] {
+	return runtime.NewPager(runtime.PagingHandler[ResourceListResult[P]]{
+		More: func(page ResourceListResult[P]) bool {
+			return page.NextLink != ""
+		},
+		Fetcher: func(ctx context.Context, page *ResourceListResult[P]) (ResourceListResult[P], error) {
+			var req *policy.Request
+			var err error
+			if page == nil {
+				req, err = c.newRequest(ctx, http.MethodGet, c.collectionPath(rootScope))
+			} else {
+				req, err = runtime.NewRequest(ctx, http.MethodGet, page.NextLink)
+			}
+			if err != nil {
+				return ResourceListResult[P]{}, err
+			}
+
+			resp, err := c.pipeline.Do(req)
+			if err != nil {
+				return ResourceListResult[P]{}, err
+			}
+			if !runtime.HasStatusCode(resp, http.StatusOK) {
+				return ResourceListResult[P]{}, runtime.NewResponseError(resp)
+			}
+
+			result := ResourceListResult[P]{}
+			if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+				return ResourceListResult[P]{}, err
+			}
+
+			return result, nil
+		},
+	})
+}
+
+// List lists all the resources in the root scope.
+func (c *ResourceClient[P]) List(ctx context.Context, rootScope string) ([]*Resource[P], error) {
+	results := []*Resource[P]{}
+	pager := c.NewListPager(rootScope)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Value...)
+	}
+
+	return results, nil
+}
+
+// BeginCreateOrUpdate creates or updates the resource with the given name in the root scope and returns a poller to
+// track the progress of the operation.
+func (c *ResourceClient[P]) BeginCreateOrUpdate(ctx context.Context, rootScope string, name string, resource *Resource[P]) (Poller[Resource[P]], error) {
+	if resource == nil {
+		return nil, errors.New("resource cannot be nil")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPut, c.ResourceID(rootScope, name))
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.MarshalAsJSON(req, resource); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusCreated) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return runtime.NewPoller(resp, c.pipeline, &runtime.NewPollerOptions[Resource[P]]{
+		FinalStateVia: runtime.FinalStateViaAzureAsyncOp,
+	})
+}
+
+// CreateOrUpdate creates or updates the resource with the given name in the root scope and waits for the operation
+// to complete.
+func (c *ResourceClient[P]) CreateOrUpdate(ctx context.Context, rootScope string, name string, resource *Resource[P]) (*Resource[P], error) {
+	poller, err := c.BeginCreateOrUpdate(ctx, rootScope, name, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BeginDelete deletes the resource with the given name in the root scope and returns a poller to track the progress
+// of the operation.
+func (c *ResourceClient[P]) BeginDelete(ctx context.Context, rootScope string, name string) (Poller[ResourceDeleteResponse], error) {
+	req, err := c.newRequest(ctx, http.MethodDelete, c.ResourceID(rootScope, name))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted, http.StatusNoContent) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return runtime.NewPoller(resp, c.pipeline, &runtime.NewPollerOptions[ResourceDeleteResponse]{
+		FinalStateVia: runtime.FinalStateViaLocation,
+	})
+}
+
+// Delete deletes the resource with the given name in the root scope and waits for the operation to complete.
+func (c *ResourceClient[P]) Delete(ctx context.Context, rootScope string, name string) error {
+	poller, err := c.BeginDelete(ctx, rootScope, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
+// BeginInvokeAction invokes the action on the resource with the given name in the root scope and returns a poller to
+// track the progress of the invocation. The input is sent as the request body when it is not nil.
+func (c *ResourceClient[P]) BeginInvokeAction(ctx context.Context, rootScope string, name string, action string, input any) (Poller[ActionResponse], error) {
+	if action == "" {
+		return nil, errors.New("action cannot be empty")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, c.ResourceID(rootScope, name)+"/"+url.PathEscape(action))
+	if err != nil {
+		return nil, err
+	}
+	if input != nil {
+		if err := runtime.MarshalAsJSON(req, input); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	return runtime.NewPoller(resp, c.pipeline, &runtime.NewPollerOptions[ActionResponse]{
+		FinalStateVia: runtime.FinalStateViaAzureAsyncOp,
+	})
+}
+
+// InvokeAction invokes the action on the resource with the given name in the root scope, waits for the invocation to
+// complete and returns its status, which holds the output of the action.
+func (c *ResourceClient[P]) InvokeAction(ctx context.Context, rootScope string, name string, action string, input any) (*ActionStatus, error) {
+	poller, err := c.BeginInvokeAction(ctx, rootScope, name, action, input)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status of the action is read from the resource rather than decoded into P, because the properties of the
+	// resource do not have to declare the status.
+	resource, err := (&ResourceClient[struct {
+		Status ResourceStatus `json:"status"`
+	}]{pipeline: c.pipeline, endpoint: c.endpoint, resourceType: c.resourceType, apiVersion: c.apiVersion}).Get(ctx, rootScope, name)
+	if err != nil {
+		return nil, err
+	}
+
+	status, ok := resource.Properties.Status.Actions[action]
+	if !ok || status == nil {
+		return nil, fmt.Errorf("the status of action %q was not found in resource %q", action, resource.ID)
+	}
+
+	return status, nil
+}
+
+// DecodeActionOutput decodes the output of an action invocation to O.
+func DecodeActionOutput[O any](status *ActionStatus) (*O, error) {
+	result := new(O)
+	if status == nil || status.Output == nil {
+		return result, nil
+	}
+
+	bs, err := json.Marshal(status.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(bs, result); err != nil {
+		return nil, fmt.Errorf("failed to decode the action output: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *ResourceClient[P]) collectionPath(rootScope string) string {
+	return "/" + strings.Trim(rootScope, "/") + "/providers/" + c.resourceType
+}
+
+func (c *ResourceClient[P]) newRequest(ctx context.Context, method string, path string) (*policy.Request, error) {
+	req, err := runtime.NewRequest(ctx, method, runtime.JoinPaths(c.endpoint, path))
+	if err != nil {
+		return nil, err
+	}
+
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", c.apiVersion)
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
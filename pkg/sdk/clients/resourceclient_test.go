@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRootScope    = "/planes/radius/local/resourceGroups/test-group"
+	testResourceID   = testRootScope + "/providers/Contoso.Example/redisCaches/cache"
+	testResourceType = "Contoso.Example/redisCaches"
+	testAPIVersion   = "2025-01-01"
+)
+
+type testProperties struct {
+	Size   string          `json:"size"`
+	Status *ResourceStatus `json:"status,omitempty"`
+}
+
+// fakeResourceServer is a minimal resource provider storing a single resource and completing async operations on the
+// first poll.
+type fakeResourceServer struct {
+	t        *testing.T
+	resource map[string]any
+	requests []string
+}
+
+func (s *fakeResourceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	if r.URL.Path != "/operationStatuses/op" {
+		require.Equal(s.t, testAPIVersion, r.URL.Query().Get("api-version"))
+	}
+
+	asyncHeaders := func() {
+		w.Header().Set("Azure-AsyncOperation", "http://"+r.Host+"/operationStatuses/op")
+		w.Header().Set("Location", "http://"+r.Host+"/operationStatuses/op")
+	}
+
+	switch {
+	case r.URL.Path == "/operationStatuses/op":
+		writeJSON(w, http.StatusOK, map[string]any{"status": "Succeeded"})
+	case r.Method == http.MethodGet && r.URL.Path == testRootScope+"/providers/"+testResourceType:
+		value := []any{}
+		if s.resource != nil {
+			value = append(value, s.resource)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"value": value})
+	case r.URL.Path != testResourceID && r.URL.Path != testResourceID+"/backup":
+		writeJSON(w, http.StatusNotFound, map[string]any{"error": map[string]any{"code": "NotFound", "message": "not found"}})
+	case r.Method == http.MethodGet:
+		if s.resource == nil {
+			writeJSON(w, http.StatusNotFound, map[string]any{"error": map[string]any{"code": "NotFound", "message": "not found"}})
+			return
+		}
+		writeJSON(w, http.StatusOK, s.resource)
+	case r.Method == http.MethodPut:
+		body := map[string]any{}
+		require.NoError(s.t, json.NewDecoder(r.Body).Decode(&body))
+		body["id"] = testResourceID
+		body["name"] = "cache"
+		body["type"] = testResourceType
+		s.resource = body
+		asyncHeaders()
+		writeJSON(w, http.StatusCreated, body)
+	case r.Method == http.MethodDelete:
+		s.resource = nil
+		asyncHeaders()
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodPost:
+		input := map[string]any{}
+		bs, err := io.ReadAll(r.Body)
+		require.NoError(s.t, err)
+		if len(bs) > 0 {
+			require.NoError(s.t, json.Unmarshal(bs, &input))
+		}
+		properties := s.resource["properties"].(map[string]any)
+		properties["status"] = map[string]any{
+			"actions": map[string]any{
+				"backup": map[string]any{
+					"operationId": "op",
+					"state":       "Succeeded",
+					"input":       input,
+					"output":      map[string]any{"location": "s3://backups/cache"},
+				},
+			},
+		}
+		asyncHeaders()
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func requireStatusCode(t *testing.T, err error, statusCode int) {
+	responseError := &azcore.ResponseError{}
+	require.ErrorAs(t, err, &responseError)
+	require.Equal(t, statusCode, responseError.StatusCode)
+}
+
+func setupResourceClient(t *testing.T) (*ResourceClient[testProperties], *fakeResourceServer) {
+	fake := &fakeResourceServer{t: t}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	connection, err := sdk.NewDirectConnection(server.URL)
+	require.NoError(t, err)
+
+	client, err := NewResourceClient[testProperties](connection, testResourceType, testAPIVersion)
+	require.NoError(t, err)
+
+	return client, fake
+}
+
+func Test_NewResourceClient(t *testing.T) {
+	connection, err := sdk.NewDirectConnection("http://localhost:9443")
+	require.NoError(t, err)
+
+	_, err = NewResourceClient[testProperties](nil, testResourceType, testAPIVersion)
+	require.EqualError(t, err, "connection cannot be nil")
+
+	_, err = NewResourceClient[testProperties](connection, "redisCaches", testAPIVersion)
+	require.EqualError(t, err, `resource type "redisCaches" must be fully qualified, e.g. 'Radius.Data/redisCaches'`)
+
+	_, err = NewResourceClient[testProperties](connection, testResourceType, "")
+	require.EqualError(t, err, "apiVersion cannot be empty")
+
+	client, err := NewResourceClient[testProperties](connection, testResourceType, testAPIVersion)
+	require.NoError(t, err)
+	require.Equal(t, testResourceType, client.ResourceType())
+	require.Equal(t, testAPIVersion, client.APIVersion())
+	require.Equal(t, testResourceID, client.ResourceID(testRootScope+"/", "cache"))
+}
+
+func Test_ResourceClient_Lifecycle(t *testing.T) {
+	client, fake := setupResourceClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateOrUpdate(ctx, testRootScope, "cache", &Resource[testProperties]{
+		Location:   "global",
+		Properties: testProperties{Size: "M"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, testResourceID, created.ID)
+	require.Equal(t, "M", created.Properties.Size)
+
+	resource, err := client.Get(ctx, testRootScope, "cache")
+	require.NoError(t, err)
+	require.Equal(t, "global", resource.Location)
+	require.Equal(t, "M", resource.Properties.Size)
+
+	resources, err := client.List(ctx, testRootScope)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	require.Equal(t, "cache", resources[0].Name)
+
+	status, err := client.InvokeAction(ctx, testRootScope, "cache", "backup", map[string]any{"target": "s3"})
+	require.NoError(t, err)
+	require.Equal(t, "Succeeded", status.State)
+	require.Equal(t, map[string]any{"target": "s3"}, status.Input)
+
+	output, err := DecodeActionOutput[struct {
+		Location string `json:"location"`
+	}](status)
+	require.NoError(t, err)
+	require.Equal(t, "s3://backups/cache", output.Location)
+
+	resource, err = client.Get(ctx, testRootScope, "cache")
+	require.NoError(t, err)
+	require.Equal(t, "Succeeded", resource.Properties.Status.Actions["backup"].State)
+
+	err = client.Delete(ctx, testRootScope, "cache")
+	require.NoError(t, err)
+	require.Nil(t, fake.resource)
+
+	_, err = client.Get(ctx, testRootScope, "cache")
+	requireStatusCode(t, err, http.StatusNotFound)
+}
+
+func Test_ResourceClient_InvokeAction_NotFound(t *testing.T) {
+	client, fake := setupResourceClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateOrUpdate(ctx, testRootScope, "cache", &Resource[testProperties]{Properties: testProperties{Size: "S"}})
+	require.NoError(t, err)
+
+	_, err = client.InvokeAction(ctx, testRootScope, "cache", "restore", nil)
+	requireStatusCode(t, err, http.StatusNotFound)
+	require.Contains(t, fake.requests, "POST "+testResourceID+"/restore")
+}
+
+func Test_DecodeActionOutput(t *testing.T) {
+	type output struct {
+		Count int `json:"count"`
+	}
+
+	result, err := DecodeActionOutput[output](nil)
+	require.NoError(t, err)
+	require.Equal(t, &output{}, result)
+
+	result, err = DecodeActionOutput[output](&ActionStatus{Output: map[string]any{"count": 3}})
+	require.NoError(t, err)
+	require.Equal(t, &output{Count: 3}, result)
+
+	_, err = DecodeActionOutput[output](&ActionStatus{Output: map[string]any{"count": "three"}})
+	require.ErrorContains(t, err, "failed to decode the action output")
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func Test_NewBearerTokenConnection_Valid(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	source := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token", TokenType: "Bearer"})
+	connection, err := NewBearerTokenConnection(server.URL, source)
+	require.NoError(t, err)
+	require.Equal(t, server.URL, connection.Endpoint())
+
+	response, err := connection.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "Bearer token", authorization)
+}
+
+func Test_NewBearerTokenConnection_InvalidWithoutScheme(t *testing.T) {
+	connection, err := NewBearerTokenConnection("/just/a/path", oauth2.StaticTokenSource(&oauth2.Token{}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the endpoint must use the http or https scheme")
+	require.Nil(t, connection)
+}
@@ -26,6 +26,7 @@ import (
 	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 )
 
 // APIService is the restful API server for Radius Resource Provider.
@@ -76,6 +77,7 @@ func (s *APIService) Run(ctx context.Context) error {
 					Arm:            s.Options.Arm, // This is a temporary fix to avoid ARM initialization in the test environment.
 					KubeClient:     s.KubeClient,
 					StatusManager:  s.OperationStatusManager,
+					LockChecker:    locks.NewChecker(databaseClient),
 				}
 
 				validator, err := builder.NewOpenAPIValidator(ctx, opts.PathBase, b.Namespace())
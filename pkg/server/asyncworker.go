@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/corerp/model"
 	"github.com/radius-project/radius/pkg/kubeutil"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 )
 
 // AsyncWorker is a service to run AsyncRequestProcessWorker.
@@ -82,6 +83,19 @@ func (w *AsyncWorker) init(ctx context.Context) error {
 
 	statusManager := statusmanager.New(databaseClient, queueClient, w.options.Config.Env.RoleLocation)
 
+	if w.options.Config.Audit.Enabled {
+		chain, err := audit.LoadChain(w.options.Config.Audit.KeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to configure audit: %w", err)
+		}
+
+		recorder, err := audit.NewRecorder(w.options.Config.Audit, chain, databaseClient)
+		if err != nil {
+			return fmt.Errorf("failed to configure audit: %w", err)
+		}
+		workerOptions.OutcomeRecorder = audit.NewOutcomeRecorder(recorder)
+	}
+
 	w.Service = worker.Service{
 		DatabaseClient:         databaseClient,
 		OperationStatusManager: statusManager,
@@ -39,8 +39,15 @@ func (src *APIVersionResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 	}
 
+	deprecation, err := toDeprecationDataModel(src.Properties.Deprecation)
+	if err != nil {
+		return nil, err
+	}
+
 	dst.Properties = datamodel.APIVersionProperties{
-		Schema: src.Properties.Schema,
+		Schema:      src.Properties.Schema,
+		Conversion:  toAPIVersionConversionDataModel(src.Properties.Conversion),
+		Deprecation: deprecation,
 	}
 
 	return dst, nil
@@ -62,7 +69,48 @@ func (dst *APIVersionResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &APIVersionProperties{
 		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
 		Schema:            dm.Properties.Schema,
+		Conversion:        fromAPIVersionConversionDataModel(dm.Properties.Conversion),
+		Deprecation:       fromDeprecationDataModel(dm.Properties.Deprecation),
 	}
 
 	return nil
 }
+
+func toAPIVersionConversionDataModel(src *APIVersionConversion) *datamodel.APIVersionConversion {
+	if src == nil {
+		return nil
+	}
+
+	dst := &datamodel.APIVersionConversion{
+		Defaults: src.Defaults,
+	}
+	for _, rename := range src.Renames {
+		if rename == nil {
+			continue
+		}
+		dst.Renames = append(dst.Renames, datamodel.FieldRename{
+			From: to.String(rename.From),
+			To:   to.String(rename.To),
+		})
+	}
+
+	return dst
+}
+
+func fromAPIVersionConversionDataModel(src *datamodel.APIVersionConversion) *APIVersionConversion {
+	if src == nil {
+		return nil
+	}
+
+	dst := &APIVersionConversion{
+		Defaults: src.Defaults,
+	}
+	for _, rename := range src.Renames {
+		dst.Renames = append(dst.Renames, &FieldRename{
+			From: new(rename.From),
+			To:   new(rename.To),
+		})
+	}
+
+	return dst
+}
@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -50,6 +51,48 @@ func Test_APIVersion_VersionedToDataModel(t *testing.T) {
 				Properties: datamodel.APIVersionProperties{},
 			},
 		},
+		{
+			filename: "apiversion_resource_conversion.json",
+			expected: &datamodel.APIVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01",
+						Name: "2025-01-01",
+						Type: datamodel.APIVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.APIVersionProperties{
+					Conversion: &datamodel.APIVersionConversion{
+						Renames:  []datamodel.FieldRename{{From: "size", To: "sku.size"}},
+						Defaults: map[string]any{"sku.tier": "standard"},
+					},
+				},
+			},
+		},
+		{
+			filename: "apiversion_resource_deprecation.json",
+			expected: &datamodel.APIVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01",
+						Name: "2025-01-01",
+						Type: datamodel.APIVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.APIVersionProperties{
+					Deprecation: &datamodel.Deprecation{
+						SunsetDate:  new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Replacement: "2025-06-01",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -88,6 +131,36 @@ func Test_APIVersion_DataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "apiversion_datamodel_conversion.json",
+			expected: &APIVersionResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01"),
+				Type: to.Ptr(datamodel.APIVersionResourceType),
+				Name: new("2025-01-01"),
+				Properties: &APIVersionProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Conversion: &APIVersionConversion{
+						Renames:  []*FieldRename{{From: new("size"), To: new("sku.size")}},
+						Defaults: map[string]any{"sku.tier": "standard"},
+					},
+				},
+			},
+		},
+		{
+			filename: "apiversion_datamodel_deprecation.json",
+			expected: &APIVersionResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01"),
+				Type: to.Ptr(datamodel.APIVersionResourceType),
+				Name: new("2025-01-01"),
+				Properties: &APIVersionProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Deprecation: &Deprecation{
+						SunsetDate:  new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Replacement: new("2025-06-01"),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
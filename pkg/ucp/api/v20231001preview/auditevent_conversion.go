@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned AuditEventResource resource to version-agnostic datamodel.
+//
+// NOTE: AuditEventResource is READONLY. There is no conversion from versioned to datamodel.
+func (src *AuditEventResource) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("the AuditEventResource is READONLY. There is no conversion from versioned to datamodel")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned AuditEventResource resource.
+func (dst *AuditEventResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.AuditEvent)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = to.Ptr(datamodel.AuditEventResourceType)
+
+	dst.Properties = &AuditEventProperties{
+		Sequence:      new(dm.Properties.Sequence),
+		Timestamp:     new(dm.Properties.Timestamp),
+		Kind:          new(AuditEventKind(dm.Properties.Kind)),
+		Principal:     optionalString(dm.Properties.Principal),
+		Operation:     new(dm.Properties.Operation),
+		ResourceID:    new(dm.Properties.ResourceID),
+		APIVersion:    optionalString(dm.Properties.APIVersion),
+		RequestHash:   optionalString(dm.Properties.RequestHash),
+		Result:        new(dm.Properties.Result),
+		CorrelationID: optionalString(dm.Properties.CorrelationID),
+		PreviousHash:  optionalString(dm.Properties.PreviousHash),
+		Hash:          new(dm.Properties.Hash),
+	}
+
+	if len(dm.Properties.Groups) > 0 {
+		dst.Properties.Groups = to.SliceOfPtrs(dm.Properties.Groups...)
+	}
+
+	if dm.Properties.StatusCode != 0 {
+		dst.Properties.StatusCode = new(int32(dm.Properties.StatusCode))
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AuditEvent_VersionedToDataModel(t *testing.T) {
+	versioned := &AuditEventResource{}
+	dm, err := versioned.ConvertTo()
+	require.Error(t, err)
+	require.Nil(t, dm)
+}
+
+func Test_AuditEvent_DataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *AuditEventResource
+		err      error
+	}{
+		{
+			filename: "auditevent_datamodel.json",
+			expected: &AuditEventResource{
+				ID:   new("/planes/radius/local/providers/System.Audit/events/event-00000000000000000042"),
+				Type: to.Ptr(datamodel.AuditEventResourceType),
+				Name: new("event-00000000000000000042"),
+				Properties: &AuditEventProperties{
+					Sequence:      new(int64(42)),
+					Timestamp:     new(time.Date(2026, 10, 1, 17, 32, 45, 123000000, time.UTC)),
+					Kind:          new(AuditEventKindRequest),
+					Principal:     new("alice"),
+					Groups:        []*string{new("team-a")},
+					Operation:     new("PUT"),
+					ResourceID:    new("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"),
+					APIVersion:    new("2023-10-01-preview"),
+					RequestHash:   new("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"),
+					StatusCode:    new(int32(201)),
+					Result:        new("Succeeded"),
+					CorrelationID: new("5b0e3f2a-7d4c-4f8e-9a61-2c3d4e5f6a7b"),
+					PreviousHash:  new("60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"),
+					Hash:          new("fd61a03af4f77d870fc21e05e7e80678095c92d808cfb3b5c279ee04c74aca13"),
+				},
+			},
+		},
+		{
+			filename: "auditevent_datamodel_asyncoperation.json",
+			expected: &AuditEventResource{
+				ID:   new("/planes/radius/local/providers/System.Audit/events/event-00000000000000000043"),
+				Type: to.Ptr(datamodel.AuditEventResourceType),
+				Name: new("event-00000000000000000043"),
+				Properties: &AuditEventProperties{
+					Sequence:      new(int64(43)),
+					Timestamp:     new(time.Date(2026, 10, 1, 17, 33, 2, 0, time.UTC)),
+					Kind:          new(AuditEventKindAsyncOperation),
+					Operation:     new("APPLICATIONS.CORE/ENVIRONMENTS|PUT"),
+					ResourceID:    new("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"),
+					APIVersion:    new("2023-10-01-preview"),
+					Result:        new("Succeeded"),
+					CorrelationID: new("5b0e3f2a-7d4c-4f8e-9a61-2c3d4e5f6a7b"),
+					PreviousHash:  new("fd61a03af4f77d870fc21e05e7e80678095c92d808cfb3b5c279ee04c74aca13"),
+					Hash:          new("0d2b6d4f8a3e1c5b7a9f0e2d4c6b8a0f1e3d5c7b9a1f3e5d7c9b1a3f5e7d9c1b"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			data := &datamodel.AuditEvent{}
+			err := json.Unmarshal(rawPayload, data)
+			require.NoError(t, err)
+
+			versioned := &AuditEventResource{}
+
+			err = versioned.ConvertFrom(data)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
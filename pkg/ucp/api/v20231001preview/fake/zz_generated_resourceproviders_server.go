@@ -32,6 +32,10 @@ type ResourceProvidersServer struct {
 	// HTTP status codes to indicate success: http.StatusOK
 	Get func(ctx context.Context, planeName string, resourceProviderName string, options *v20231001preview.ResourceProvidersClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceProvidersClientGetResponse], errResp azfake.ErrorResponder)
 
+	// GetOpenAPIDocument is the fake for method ResourceProvidersClient.GetOpenAPIDocument
+	// HTTP status codes to indicate success: http.StatusOK
+	GetOpenAPIDocument func(ctx context.Context, planeName string, resourceProviderName string, apiVersionName string, options *v20231001preview.ResourceProvidersClientGetOpenAPIDocumentOptions) (resp azfake.Responder[v20231001preview.ResourceProvidersClientGetOpenAPIDocumentResponse], errResp azfake.ErrorResponder)
+
 	// GetProviderSummary is the fake for method ResourceProvidersClient.GetProviderSummary
 	// HTTP status codes to indicate success: http.StatusOK
 	GetProviderSummary func(ctx context.Context, planeName string, resourceProviderName string, options *v20231001preview.ResourceProvidersClientGetProviderSummaryOptions) (resp azfake.Responder[v20231001preview.ResourceProvidersClientGetProviderSummaryResponse], errResp azfake.ErrorResponder)
@@ -97,6 +101,8 @@ func (r *ResourceProvidersServerTransport) dispatchToMethodFake(req *http.Reques
 				res.resp, res.err = r.dispatchBeginDelete(req)
 			case "ResourceProvidersClient.Get":
 				res.resp, res.err = r.dispatchGet(req)
+			case "ResourceProvidersClient.GetOpenAPIDocument":
+				res.resp, res.err = r.dispatchGetOpenAPIDocument(req)
 			case "ResourceProvidersClient.GetProviderSummary":
 				res.resp, res.err = r.dispatchGetProviderSummary(req)
 			case "ResourceProvidersClient.NewListPager":
@@ -247,6 +253,43 @@ func (r *ResourceProvidersServerTransport) dispatchGet(req *http.Request) (*http
 	return resp, nil
 }
 
+func (r *ResourceProvidersServerTransport) dispatchGetOpenAPIDocument(req *http.Request) (*http.Response, error) {
+	if r.srv.GetOpenAPIDocument == nil {
+		return nil, &nonRetriableError{errors.New("fake for method GetOpenAPIDocument not implemented")}
+	}
+	const regexStr = `/planes/radius/(?P<planeName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/(?P<resourceProviderName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/openapi/(?P<apiVersionName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)`
+	regex := regexp.MustCompile(regexStr)
+	matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+	if len(matches) < 4 {
+		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+	}
+	planeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("planeName")])
+	if err != nil {
+		return nil, err
+	}
+	resourceProviderNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceProviderName")])
+	if err != nil {
+		return nil, err
+	}
+	apiVersionNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("apiVersionName")])
+	if err != nil {
+		return nil, err
+	}
+	respr, errRespr := r.srv.GetOpenAPIDocument(req.Context(), planeNameParam, resourceProviderNameParam, apiVersionNameParam, nil)
+	if respErr := server.GetError(errRespr, req); respErr != nil {
+		return nil, respErr
+	}
+	respContent := server.GetResponseContent(respr)
+	if !contains([]int{http.StatusOK}, respContent.HTTPStatus) {
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK", respContent.HTTPStatus)}
+	}
+	resp, err := server.MarshalResponseAsJSON(respContent, server.GetResponse(respr).Value, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (r *ResourceProvidersServerTransport) dispatchGetProviderSummary(req *http.Request) (*http.Response, error) {
 	if r.srv.GetProviderSummary == nil {
 		return nil, &nonRetriableError{errors.New("fake for method GetProviderSummary not implemented")}
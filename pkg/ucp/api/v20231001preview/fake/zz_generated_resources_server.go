@@ -105,7 +105,25 @@ func (r *ResourcesServerTransport) dispatchNewListPager(req *http.Request) (*htt
 		if err != nil {
 			return nil, err
 		}
-		resp := r.srv.NewListPager(planeNameParam, resourceGroupNameParam, nil)
+		qp := req.URL.Query()
+		tagNameUnescaped, err := url.QueryUnescape(qp.Get("tagName"))
+		if err != nil {
+			return nil, err
+		}
+		tagNameParam := getOptional(tagNameUnescaped)
+		tagValueUnescaped, err := url.QueryUnescape(qp.Get("tagValue"))
+		if err != nil {
+			return nil, err
+		}
+		tagValueParam := getOptional(tagValueUnescaped)
+		var options *v20231001preview.ResourcesClientListOptions
+		if tagNameParam != nil || tagValueParam != nil {
+			options = &v20231001preview.ResourcesClientListOptions{
+				TagName:  tagNameParam,
+				TagValue: tagValueParam,
+			}
+		}
+		resp := r.srv.NewListPager(planeNameParam, resourceGroupNameParam, options)
 		newListPager = &resp
 		r.newListPager.add(req, newListPager)
 		server.PagerResponderInjectNextLinks(newListPager, req, func(page *v20231001preview.ResourcesClientListResponse, createLink func() string) {
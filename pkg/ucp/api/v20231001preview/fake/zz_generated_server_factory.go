@@ -18,6 +18,9 @@ type ServerFactory struct {
 	// APIVersionsServer contains the fakes for client APIVersionsClient
 	APIVersionsServer APIVersionsServer
 
+	// AuditEventsServer contains the fakes for client AuditEventsClient
+	AuditEventsServer AuditEventsServer
+
 	// AwsCredentialsServer contains the fakes for client AwsCredentialsClient
 	AwsCredentialsServer AwsCredentialsServer
 
@@ -33,6 +36,9 @@ type ServerFactory struct {
 	// LocationsServer contains the fakes for client LocationsClient
 	LocationsServer LocationsServer
 
+	// LocksServer contains the fakes for client LocksClient
+	LocksServer LocksServer
+
 	// PlanesServer contains the fakes for client PlanesClient
 	PlanesServer PlanesServer
 
@@ -50,6 +56,12 @@ type ServerFactory struct {
 
 	// ResourcesServer contains the fakes for client ResourcesClient
 	ResourcesServer ResourcesServer
+
+	// RoleAssignmentsServer contains the fakes for client RoleAssignmentsClient
+	RoleAssignmentsServer RoleAssignmentsServer
+
+	// RoleDefinitionsServer contains the fakes for client RoleDefinitionsClient
+	RoleDefinitionsServer RoleDefinitionsServer
 }
 
 // NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.
@@ -67,17 +79,21 @@ type ServerFactoryTransport struct {
 	srv                       *ServerFactory
 	trMu                      sync.Mutex
 	trAPIVersionsServer       *APIVersionsServerTransport
+	trAuditEventsServer       *AuditEventsServerTransport
 	trAwsCredentialsServer    *AwsCredentialsServerTransport
 	trAwsPlanesServer         *AwsPlanesServerTransport
 	trAzureCredentialsServer  *AzureCredentialsServerTransport
 	trAzurePlanesServer       *AzurePlanesServerTransport
 	trLocationsServer         *LocationsServerTransport
+	trLocksServer             *LocksServerTransport
 	trPlanesServer            *PlanesServerTransport
 	trRadiusPlanesServer      *RadiusPlanesServerTransport
 	trResourceGroupsServer    *ResourceGroupsServerTransport
 	trResourceProvidersServer *ResourceProvidersServerTransport
 	trResourceTypesServer     *ResourceTypesServerTransport
 	trResourcesServer         *ResourcesServerTransport
+	trRoleAssignmentsServer   *RoleAssignmentsServerTransport
+	trRoleDefinitionsServer   *RoleDefinitionsServerTransport
 }
 
 // Do implements the policy.Transporter interface for ServerFactoryTransport.
@@ -96,6 +112,9 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "APIVersionsClient":
 		initServer(s, &s.trAPIVersionsServer, func() *APIVersionsServerTransport { return NewAPIVersionsServerTransport(&s.srv.APIVersionsServer) })
 		resp, err = s.trAPIVersionsServer.Do(req)
+	case "AuditEventsClient":
+		initServer(s, &s.trAuditEventsServer, func() *AuditEventsServerTransport { return NewAuditEventsServerTransport(&s.srv.AuditEventsServer) })
+		resp, err = s.trAuditEventsServer.Do(req)
 	case "AwsCredentialsClient":
 		initServer(s, &s.trAwsCredentialsServer, func() *AwsCredentialsServerTransport {
 			return NewAwsCredentialsServerTransport(&s.srv.AwsCredentialsServer)
@@ -115,6 +134,9 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "LocationsClient":
 		initServer(s, &s.trLocationsServer, func() *LocationsServerTransport { return NewLocationsServerTransport(&s.srv.LocationsServer) })
 		resp, err = s.trLocationsServer.Do(req)
+	case "LocksClient":
+		initServer(s, &s.trLocksServer, func() *LocksServerTransport { return NewLocksServerTransport(&s.srv.LocksServer) })
+		resp, err = s.trLocksServer.Do(req)
 	case "PlanesClient":
 		initServer(s, &s.trPlanesServer, func() *PlanesServerTransport { return NewPlanesServerTransport(&s.srv.PlanesServer) })
 		resp, err = s.trPlanesServer.Do(req)
@@ -139,6 +161,16 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "ResourcesClient":
 		initServer(s, &s.trResourcesServer, func() *ResourcesServerTransport { return NewResourcesServerTransport(&s.srv.ResourcesServer) })
 		resp, err = s.trResourcesServer.Do(req)
+	case "RoleAssignmentsClient":
+		initServer(s, &s.trRoleAssignmentsServer, func() *RoleAssignmentsServerTransport {
+			return NewRoleAssignmentsServerTransport(&s.srv.RoleAssignmentsServer)
+		})
+		resp, err = s.trRoleAssignmentsServer.Do(req)
+	case "RoleDefinitionsClient":
+		initServer(s, &s.trRoleDefinitionsServer, func() *RoleDefinitionsServerTransport {
+			return NewRoleDefinitionsServerTransport(&s.srv.RoleDefinitionsServer)
+		})
+		resp, err = s.trRoleDefinitionsServer.Do(req)
 	default:
 		err = fmt.Errorf("unhandled client %s", client)
 	}
@@ -20,6 +20,7 @@ import (
 	"errors"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
@@ -43,6 +44,9 @@ func (dst *GenericResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.ID = new(entry.Properties.ID)
 	dst.Name = new(entry.Properties.Name)
 	dst.Type = new(entry.Properties.Type)
+	if entry.Properties.Tags != nil {
+		dst.Tags = *to.StringMapPtr(entry.Properties.Tags)
+	}
 
 	return nil
 }
@@ -46,6 +46,7 @@ func Test_GenericResource_DataModelToVersioned(t *testing.T) {
 				ID:   new("/planes/radius/local/resourcegroups/rg1/providers/Applications.Core/applications/test-app"),
 				Type: new("Applications.Core/applications"),
 				Name: new("test-app"),
+				Tags: map[string]*string{"team": new("payments")},
 			},
 		},
 	}
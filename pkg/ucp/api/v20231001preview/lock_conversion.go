@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned LockResource resource to version-agnostic datamodel.
+func (src *LockResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.LockResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("properties must be specified")
+	}
+
+	level, err := toLockLevelDataModel(src.Properties.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	dst.Properties = datamodel.LockProperties{
+		Level: level,
+		Notes: to.String(src.Properties.Notes),
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned LockResource resource.
+func (dst *LockResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.Lock)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = to.Ptr(datamodel.LockResourceType)
+
+	dst.Properties = &LockProperties{
+		ProvisioningState: fromProvisioningStateDataModel(dm.InternalMetadata.AsyncProvisioningState),
+		Level:             new(LockLevel(dm.Properties.Level)),
+	}
+
+	if dm.Properties.Notes != "" {
+		dst.Properties.Notes = new(dm.Properties.Notes)
+	}
+
+	return nil
+}
+
+func toLockLevelDataModel(input *LockLevel) (datamodel.LockLevel, error) {
+	if input == nil {
+		return "", v1.NewClientErrInvalidRequest("level must be specified")
+	}
+
+	switch *input {
+	case LockLevelCanNotDelete:
+		return datamodel.LockLevelCanNotDelete, nil
+	case LockLevelReadOnly:
+		return datamodel.LockLevelReadOnly, nil
+	}
+
+	return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("level %q is not recognized. Supported levels: %s, %s", *input, LockLevelCanNotDelete, LockLevelReadOnly))
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lock_VersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.Lock
+		err      error
+	}{
+		{
+			filename: "lock_resource.json",
+			expected: &datamodel.Lock{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/applications/app/providers/System.Authorization/locks/do-not-delete",
+						Name: "do-not-delete",
+						Type: datamodel.LockResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.LockProperties{
+					Level: datamodel.LockLevelCanNotDelete,
+					Notes: "Production application.",
+				},
+			},
+		},
+		{
+			filename: "lock_resource_invalidlevel.json",
+			err:      v1.NewClientErrInvalidRequest("level \"NoTouching\" is not recognized. Supported levels: CanNotDelete, ReadOnly"),
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			versioned := &LockResource{}
+			err := json.Unmarshal(rawPayload, versioned)
+			require.NoError(t, err)
+
+			dm, err := versioned.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm)
+			}
+		})
+	}
+}
+
+func Test_Lock_DataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *LockResource
+		err      error
+	}{
+		{
+			filename: "lock_datamodel.json",
+			expected: &LockResource{
+				ID:   new("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/applications/app/providers/System.Authorization/locks/do-not-delete"),
+				Type: to.Ptr(datamodel.LockResourceType),
+				Name: new("do-not-delete"),
+				Properties: &LockProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Level:             new(LockLevelCanNotDelete),
+					Notes:             new("Production application."),
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			data := &datamodel.Lock{}
+			err := json.Unmarshal(rawPayload, data)
+			require.NoError(t, err)
+
+			versioned := &LockResource{}
+
+			err = versioned.ConvertFrom(data)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
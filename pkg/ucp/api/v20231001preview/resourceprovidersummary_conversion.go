@@ -51,7 +51,8 @@ func (dst *ResourceProviderSummary) ConvertFrom(src v1.DataModelInterface) error
 		apiVersions := map[string]*ResourceTypeSummaryResultAPIVersion{}
 		for k, v := range resourceType.APIVersions {
 			apiVersions[k] = &ResourceTypeSummaryResultAPIVersion{
-				Schema: v.Schema,
+				Schema:      v.Schema,
+				Deprecation: fromDeprecationDataModel(v.Deprecation),
 			}
 		}
 
@@ -60,6 +61,7 @@ func (dst *ResourceProviderSummary) ConvertFrom(src v1.DataModelInterface) error
 			DefaultAPIVersion: resourceType.DefaultAPIVersion,
 			APIVersions:       apiVersions,
 			Description:       resourceType.Description,
+			Deprecation:       fromDeprecationDataModel(resourceType.Deprecation),
 		}
 	}
 
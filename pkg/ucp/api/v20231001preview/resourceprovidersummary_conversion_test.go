@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/test/testutil"
@@ -45,8 +46,14 @@ func Test_ResourceProviderSummary_DataModelToVersioned(t *testing.T) {
 					"testResources": {
 						Capabilities:      []*string{},
 						DefaultAPIVersion: new("2025-01-01"),
+						Deprecation: &Deprecation{
+							Replacement: new("Applications.Test/newResources"),
+						},
 						APIVersions: map[string]*ResourceTypeSummaryResultAPIVersion{
 							"2025-01-01": {
+								Deprecation: &Deprecation{
+									SunsetDate: new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+								},
 								Schema: map[string]any{
 									"properties": map[string]any{
 										"name": map[string]any{
@@ -18,6 +18,7 @@ package v20231001preview
 
 import (
 	"fmt"
+	"net/url"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
@@ -58,6 +59,18 @@ func (src *ResourceTypeResource) ConvertTo() (v1.DataModelInterface, error) {
 
 	dst.Properties.Description = src.Properties.Description
 
+	actions, err := toResourceTypeActionsDataModel(src.Properties.Actions)
+	if err != nil {
+		return nil, err
+	}
+	dst.Properties.Actions = actions
+
+	deprecation, err := toDeprecationDataModel(src.Properties.Deprecation)
+	if err != nil {
+		return nil, err
+	}
+	dst.Properties.Deprecation = deprecation
+
 	return dst, nil
 }
 
@@ -79,6 +92,8 @@ func (dst *ResourceTypeResource) ConvertFrom(src v1.DataModelInterface) error {
 		Capabilities:      to.SliceOfPtrs(dm.Properties.Capabilities...),
 		DefaultAPIVersion: dm.Properties.DefaultAPIVersion,
 		Description:       dm.Properties.Description,
+		Actions:           fromResourceTypeActionsDataModel(dm.Properties.Actions),
+		Deprecation:       fromDeprecationDataModel(dm.Properties.Deprecation),
 	}
 
 	return nil
@@ -89,9 +104,155 @@ func validateCapability(input *string) error {
 		return v1.NewClientErrInvalidRequest("capability cannot be null")
 	}
 
-	if *input == datamodel.CapabilityManualResourceProvisioning {
+	switch *input {
+	case datamodel.CapabilityManualResourceProvisioning, datamodel.CapabilityKubernetesCustomResource:
+		return nil
+	}
+
+	return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q is not recognized. Supported capabilities: %s, %s", *input, datamodel.CapabilityManualResourceProvisioning, datamodel.CapabilityKubernetesCustomResource))
+}
+
+func toResourceTypeActionsDataModel(actions map[string]*ResourceTypeAction) (map[string]datamodel.ResourceTypeAction, error) {
+	if actions == nil {
+		return nil, nil
+	}
+
+	result := map[string]datamodel.ResourceTypeAction{}
+	for name, action := range actions {
+		if err := validateAction(name, action); err != nil {
+			return nil, err
+		}
+
+		dm := datamodel.ResourceTypeAction{
+			Description:  to.String(action.Description),
+			InputSchema:  action.InputSchema,
+			OutputSchema: action.OutputSchema,
+		}
+		if action.Recipe != nil {
+			dm.Recipe = &datamodel.ActionRecipe{
+				TemplateKind:    to.String(action.Recipe.TemplateKind),
+				TemplatePath:    to.String(action.Recipe.TemplatePath),
+				TemplateVersion: to.String(action.Recipe.TemplateVersion),
+			}
+		}
+		if action.Callback != nil {
+			dm.Callback = &datamodel.ActionCallback{
+				URL: to.String(action.Callback.URL),
+			}
+		}
+
+		result[name] = dm
+	}
+
+	return result, nil
+}
+
+func fromResourceTypeActionsDataModel(actions map[string]datamodel.ResourceTypeAction) map[string]*ResourceTypeAction {
+	if actions == nil {
+		return nil
+	}
+
+	result := map[string]*ResourceTypeAction{}
+	for name, action := range actions {
+		versioned := &ResourceTypeAction{
+			Description:  optionalString(action.Description),
+			InputSchema:  action.InputSchema,
+			OutputSchema: action.OutputSchema,
+		}
+		if action.Recipe != nil {
+			versioned.Recipe = &ActionRecipe{
+				TemplateKind:    to.Ptr(action.Recipe.TemplateKind),
+				TemplatePath:    to.Ptr(action.Recipe.TemplatePath),
+				TemplateVersion: optionalString(action.Recipe.TemplateVersion),
+			}
+		}
+		if action.Callback != nil {
+			versioned.Callback = &ActionCallback{
+				URL: to.Ptr(action.Callback.URL),
+			}
+		}
+
+		result[name] = versioned
+	}
+
+	return result
+}
+
+func validateAction(name string, action *ResourceTypeAction) error {
+	if name == "" {
+		return v1.NewClientErrInvalidRequest("action name cannot be empty")
+	}
+
+	if action == nil {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q cannot be null", name))
+	}
+
+	if (action.Recipe == nil) == (action.Callback == nil) {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q must set exactly one of recipe or callback", name))
+	}
+
+	if action.Recipe != nil && (to.String(action.Recipe.TemplateKind) == "" || to.String(action.Recipe.TemplatePath) == "") {
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q recipe must set templateKind and templatePath", name))
+	}
+
+	if action.Callback != nil {
+		u, err := url.Parse(to.String(action.Callback.URL))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q callback url must be an absolute http or https URL", name))
+		}
+	}
+
+	return nil
+}
+
+func toDeprecationDataModel(src *Deprecation) (*datamodel.Deprecation, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	if to.Bool(src.BlockCreateAfterSunset) && src.SunsetDate == nil {
+		return nil, v1.NewClientErrInvalidRequest("deprecation blockCreateAfterSunset requires a sunsetDate")
+	}
+
+	return DeprecationToDataModel(src), nil
+}
+
+// DeprecationToDataModel converts the deprecation of a resource type or API version to the datamodel, so that clients
+// of the UCP API can evaluate it. It returns nil if src is nil.
+func DeprecationToDataModel(src *Deprecation) *datamodel.Deprecation {
+	if src == nil {
+		return nil
+	}
+
+	return &datamodel.Deprecation{
+		Message:                to.String(src.Message),
+		SunsetDate:             src.SunsetDate,
+		Replacement:            to.String(src.Replacement),
+		BlockCreateAfterSunset: to.Bool(src.BlockCreateAfterSunset),
+	}
+}
+
+func fromDeprecationDataModel(src *datamodel.Deprecation) *Deprecation {
+	if src == nil {
 		return nil
 	}
 
-	return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q is not recognized. Supported capabilities: %s", *input, datamodel.CapabilityManualResourceProvisioning))
+	dst := &Deprecation{
+		Message:     optionalString(src.Message),
+		SunsetDate:  src.SunsetDate,
+		Replacement: optionalString(src.Replacement),
+	}
+	if src.BlockCreateAfterSunset {
+		dst.BlockCreateAfterSunset = to.Ptr(true)
+	}
+
+	return dst
+}
+
+// optionalString returns a pointer to the string, or nil if the string is empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
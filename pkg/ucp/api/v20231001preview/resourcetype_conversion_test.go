@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -53,6 +54,81 @@ func Test_ResourceType_VersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "resourcetype_resource_actions.json",
+			expected: &datamodel.ResourceType{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources",
+						Name: "testResources",
+						Type: datamodel.ResourceTypeResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.ResourceTypeProperties{
+					Capabilities:      []string{},
+					DefaultAPIVersion: new("2025-01-01"),
+					Actions: map[string]datamodel.ResourceTypeAction{
+						"backup": {
+							Description: "Backs up the database.",
+							InputSchema: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"retentionDays": map[string]any{
+										"type": "integer",
+									},
+								},
+							},
+							Recipe: &datamodel.ActionRecipe{
+								TemplateKind:    "terraform",
+								TemplatePath:    "git::https://github.com/example/recipes//backup",
+								TemplateVersion: "1.0.0",
+							},
+						},
+						"restart": {
+							Callback: &datamodel.ActionCallback{
+								URL: "https://operations.example.com/restart",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "resourcetype_resource_invalidaction.json",
+			err:      v1.NewClientErrInvalidRequest("action \"restart\" must set exactly one of recipe or callback"),
+		},
+		{
+			filename: "resourcetype_resource_deprecation.json",
+			expected: &datamodel.ResourceType{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources",
+						Name: "testResources",
+						Type: datamodel.ResourceTypeResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.ResourceTypeProperties{
+					Capabilities:      []string{},
+					DefaultAPIVersion: new("2025-01-01"),
+					Deprecation: &datamodel.Deprecation{
+						Message:                "See the migration guide.",
+						SunsetDate:             new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Replacement:            "Applications.Test/newResources",
+						BlockCreateAfterSunset: true,
+					},
+				},
+			},
+		},
+		{
+			filename: "resourcetype_resource_invaliddeprecation.json",
+			err:      v1.NewClientErrInvalidRequest("deprecation blockCreateAfterSunset requires a sunsetDate"),
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -93,6 +169,61 @@ func Test_ResourceType_DataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "resourcetype_datamodel_actions.json",
+			expected: &ResourceTypeResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"),
+				Type: to.Ptr(datamodel.ResourceTypeResourceType),
+				Name: new("testResources"),
+				Properties: &ResourceTypeProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Capabilities:      []*string{},
+					DefaultAPIVersion: new("2025-01-01"),
+					Actions: map[string]*ResourceTypeAction{
+						"backup": {
+							Description: new("Backs up the database."),
+							InputSchema: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"retentionDays": map[string]any{
+										"type": "integer",
+									},
+								},
+							},
+							Recipe: &ActionRecipe{
+								TemplateKind:    new("terraform"),
+								TemplatePath:    new("git::https://github.com/example/recipes//backup"),
+								TemplateVersion: new("1.0.0"),
+							},
+						},
+						"restart": {
+							Callback: &ActionCallback{
+								URL: new("https://operations.example.com/restart"),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "resourcetype_datamodel_deprecation.json",
+			expected: &ResourceTypeResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"),
+				Type: to.Ptr(datamodel.ResourceTypeResourceType),
+				Name: new("testResources"),
+				Properties: &ResourceTypeProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Capabilities:      []*string{},
+					DefaultAPIVersion: new("2025-01-01"),
+					Deprecation: &Deprecation{
+						Message:                new("See the migration guide."),
+						SunsetDate:             new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Replacement:            new("Applications.Test/newResources"),
+						BlockCreateAfterSunset: new(true),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -126,10 +257,14 @@ func Test_validateCapability(t *testing.T) {
 			name:  "valid capability",
 			input: to.Ptr(string(datamodel.CapabilityManualResourceProvisioning)),
 		},
+		{
+			name:  "valid capability: kubernetes custom resource",
+			input: new(datamodel.CapabilityKubernetesCustomResource),
+		},
 		{
 			name:        "invalid capability",
 			input:       new("InvalidCapability"),
-			expectedErr: v1.NewClientErrInvalidRequest("capability \"InvalidCapability\" is not recognized. Supported capabilities: ManualResourceProvisioning"),
+			expectedErr: v1.NewClientErrInvalidRequest("capability \"InvalidCapability\" is not recognized. Supported capabilities: ManualResourceProvisioning, KubernetesCustomResource"),
 		},
 		{
 			name:        "nil capability",
@@ -150,3 +285,65 @@ func Test_validateCapability(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAction(t *testing.T) {
+	tests := []struct {
+		name        string
+		actionName  string
+		action      *ResourceTypeAction
+		expectedErr error
+	}{
+		{
+			name:       "valid recipe action",
+			actionName: "backup",
+			action: &ResourceTypeAction{
+				Recipe: &ActionRecipe{TemplateKind: new("bicep"), TemplatePath: new("ghcr.io/example/backup:1.0")},
+			},
+		},
+		{
+			name:       "valid callback action",
+			actionName: "restart",
+			action: &ResourceTypeAction{
+				Callback: &ActionCallback{URL: new("http://operations.default.svc.cluster.local/restart")},
+			},
+		},
+		{
+			name:        "nil action",
+			actionName:  "restart",
+			expectedErr: v1.NewClientErrInvalidRequest("action \"restart\" cannot be null"),
+		},
+		{
+			name:        "no implementation",
+			actionName:  "restart",
+			action:      &ResourceTypeAction{},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"restart\" must set exactly one of recipe or callback"),
+		},
+		{
+			name:       "incomplete recipe",
+			actionName: "backup",
+			action: &ResourceTypeAction{
+				Recipe: &ActionRecipe{TemplateKind: new("bicep")},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"backup\" recipe must set templateKind and templatePath"),
+		},
+		{
+			name:       "relative callback url",
+			actionName: "restart",
+			action: &ResourceTypeAction{
+				Callback: &ActionCallback{URL: new("/restart")},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"restart\" callback url must be an absolute http or https URL"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAction(tt.actionName, tt.action)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
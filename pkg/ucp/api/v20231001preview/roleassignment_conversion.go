@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned RoleAssignmentResource resource to version-agnostic datamodel.
+func (src *RoleAssignmentResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.RoleAssignmentResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("properties must be specified")
+	}
+
+	principalType, err := toPrincipalTypeDataModel(src.Properties.PrincipalType)
+	if err != nil {
+		return nil, err
+	}
+
+	dst.Properties = datamodel.RoleAssignmentProperties{
+		PrincipalID:      to.String(src.Properties.PrincipalID),
+		PrincipalType:    principalType,
+		RoleDefinitionID: to.String(src.Properties.RoleDefinitionID),
+		Scope:            to.String(src.Properties.Scope),
+	}
+
+	if dst.Properties.PrincipalID == "" {
+		return nil, v1.NewClientErrInvalidRequest("principalId must be specified")
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleAssignmentResource resource.
+func (dst *RoleAssignmentResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleAssignment)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = to.Ptr(datamodel.RoleAssignmentResourceType)
+
+	dst.Properties = &RoleAssignmentProperties{
+		ProvisioningState: fromProvisioningStateDataModel(dm.InternalMetadata.AsyncProvisioningState),
+		PrincipalID:       new(dm.Properties.PrincipalID),
+		PrincipalType:     new(PrincipalType(dm.Properties.PrincipalType)),
+		RoleDefinitionID:  new(dm.Properties.RoleDefinitionID),
+		Scope:             new(dm.Properties.Scope),
+	}
+
+	return nil
+}
+
+func toPrincipalTypeDataModel(input *PrincipalType) (datamodel.PrincipalType, error) {
+	if input == nil {
+		return "", v1.NewClientErrInvalidRequest("principalType must be specified")
+	}
+
+	switch *input {
+	case PrincipalTypeUser:
+		return datamodel.PrincipalTypeUser, nil
+	case PrincipalTypeGroup:
+		return datamodel.PrincipalTypeGroup, nil
+	}
+
+	return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("principalType %q is not recognized. Supported principal types: %s, %s", *input, PrincipalTypeUser, PrincipalTypeGroup))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleAssignment_VersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.RoleAssignment
+		err      error
+	}{
+		{
+			filename: "roleassignment_resource.json",
+			expected: &datamodel.RoleAssignment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Authorization/roleAssignments/team-a-contributors",
+						Name: "team-a-contributors",
+						Type: datamodel.RoleAssignmentResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.RoleAssignmentProperties{
+					PrincipalID:      "team-a",
+					PrincipalType:    datamodel.PrincipalTypeGroup,
+					RoleDefinitionID: "/planes/radius/local/providers/System.Authorization/roleDefinitions/contributor",
+					Scope:            "/planes/radius/local/resourceGroups/team-a",
+				},
+			},
+		},
+		{
+			filename: "roleassignment_resource_invalidprincipaltype.json",
+			err:      v1.NewClientErrInvalidRequest("principalType \"ServicePrincipal\" is not recognized. Supported principal types: User, Group"),
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			versioned := &RoleAssignmentResource{}
+			err := json.Unmarshal(rawPayload, versioned)
+			require.NoError(t, err)
+
+			dm, err := versioned.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm)
+			}
+		})
+	}
+}
+
+func Test_RoleAssignment_DataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *RoleAssignmentResource
+		err      error
+	}{
+		{
+			filename: "roleassignment_datamodel.json",
+			expected: &RoleAssignmentResource{
+				ID:   new("/planes/radius/local/providers/System.Authorization/roleAssignments/team-a-contributors"),
+				Type: to.Ptr(datamodel.RoleAssignmentResourceType),
+				Name: new("team-a-contributors"),
+				Properties: &RoleAssignmentProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					PrincipalID:       new("team-a"),
+					PrincipalType:     new(PrincipalTypeGroup),
+					RoleDefinitionID:  new("/planes/radius/local/providers/System.Authorization/roleDefinitions/contributor"),
+					Scope:             new("/planes/radius/local/resourceGroups/team-a"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			data := &datamodel.RoleAssignment{}
+			err := json.Unmarshal(rawPayload, data)
+			require.NoError(t, err)
+
+			versioned := &RoleAssignmentResource{}
+
+			err = versioned.ConvertFrom(data)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned RoleDefinitionResource resource to version-agnostic datamodel.
+func (src *RoleDefinitionResource) ConvertTo() (v1.DataModelInterface, error) {
+	dst := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.RoleDefinitionResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+	}
+
+	if src.Properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("properties must be specified")
+	}
+
+	actions, err := toRoleDefinitionActionsDataModel("actions", src.Properties.Actions)
+	if err != nil {
+		return nil, err
+	}
+	if len(actions) == 0 {
+		return nil, v1.NewClientErrInvalidRequest("actions must contain at least one action")
+	}
+
+	notActions, err := toRoleDefinitionActionsDataModel("notActions", src.Properties.NotActions)
+	if err != nil {
+		return nil, err
+	}
+
+	dst.Properties = datamodel.RoleDefinitionProperties{
+		Description: to.String(src.Properties.Description),
+		Actions:     actions,
+		NotActions:  notActions,
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleDefinitionResource resource.
+func (dst *RoleDefinitionResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleDefinition)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = new(dm.ID)
+	dst.Name = new(dm.Name)
+	dst.Type = to.Ptr(datamodel.RoleDefinitionResourceType)
+
+	dst.Properties = &RoleDefinitionProperties{
+		ProvisioningState: fromProvisioningStateDataModel(dm.InternalMetadata.AsyncProvisioningState),
+		Actions:           to.SliceOfPtrs(dm.Properties.Actions...),
+	}
+
+	if dm.Properties.Description != "" {
+		dst.Properties.Description = new(dm.Properties.Description)
+	}
+
+	if len(dm.Properties.NotActions) > 0 {
+		dst.Properties.NotActions = to.SliceOfPtrs(dm.Properties.NotActions...)
+	}
+
+	return nil
+}
+
+func toRoleDefinitionActionsDataModel(field string, actions []*string) ([]string, error) {
+	result := []string{}
+	for _, action := range actions {
+		if action == nil || *action == "" {
+			return nil, v1.NewClientErrInvalidRequest(field + " cannot contain empty actions")
+		}
+
+		result = append(result, *action)
+	}
+
+	return result, nil
+}
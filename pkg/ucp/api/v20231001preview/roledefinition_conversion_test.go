@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleDefinition_VersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.RoleDefinition
+		err      error
+	}{
+		{
+			filename: "roledefinition_resource.json",
+			expected: &datamodel.RoleDefinition{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Authorization/roleDefinitions/environment-operator",
+						Name: "environment-operator",
+						Type: datamodel.RoleDefinitionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.RoleDefinitionProperties{
+					Description: "Read access to all resources and full access to environments, except deleting them.",
+					Actions:     []string{"*/read", "Applications.Core/environments/*"},
+					NotActions:  []string{"Applications.Core/environments/delete"},
+				},
+			},
+		},
+		{
+			filename: "roledefinition_resource_noactions.json",
+			err:      v1.NewClientErrInvalidRequest("actions must contain at least one action"),
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			versioned := &RoleDefinitionResource{}
+			err := json.Unmarshal(rawPayload, versioned)
+			require.NoError(t, err)
+
+			dm, err := versioned.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, dm)
+			}
+		})
+	}
+}
+
+func Test_RoleDefinition_DataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *RoleDefinitionResource
+		err      error
+	}{
+		{
+			filename: "roledefinition_datamodel.json",
+			expected: &RoleDefinitionResource{
+				ID:   new("/planes/radius/local/providers/System.Authorization/roleDefinitions/environment-operator"),
+				Type: to.Ptr(datamodel.RoleDefinitionResourceType),
+				Name: new("environment-operator"),
+				Properties: &RoleDefinitionProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Description:       new("Read access to all resources and full access to environments, except deleting them."),
+					Actions:           []*string{new("*/read"), new("Applications.Core/environments/*")},
+					NotActions:        []*string{new("Applications.Core/environments/delete")},
+				},
+			},
+		},
+	}
+
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			data := &datamodel.RoleDefinition{}
+			err := json.Unmarshal(rawPayload, data)
+			require.NoError(t, err)
+
+			versioned := &RoleDefinitionResource{}
+
+			err = versioned.ConvertFrom(data)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
@@ -36,6 +36,13 @@ func (c *ClientFactory) NewAPIVersionsClient() *APIVersionsClient {
 	}
 }
 
+// NewAuditEventsClient creates a new instance of AuditEventsClient.
+func (c *ClientFactory) NewAuditEventsClient() *AuditEventsClient {
+	return &AuditEventsClient{
+		internal: c.internal,
+	}
+}
+
 // NewAwsCredentialsClient creates a new instance of AwsCredentialsClient.
 func (c *ClientFactory) NewAwsCredentialsClient() *AwsCredentialsClient {
 	return &AwsCredentialsClient{
@@ -71,6 +78,13 @@ func (c *ClientFactory) NewLocationsClient() *LocationsClient {
 	}
 }
 
+// NewLocksClient creates a new instance of LocksClient.
+func (c *ClientFactory) NewLocksClient() *LocksClient {
+	return &LocksClient{
+		internal: c.internal,
+	}
+}
+
 // NewPlanesClient creates a new instance of PlanesClient.
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	return &PlanesClient{
@@ -112,3 +126,17 @@ func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 		internal: c.internal,
 	}
 }
+
+// NewRoleAssignmentsClient creates a new instance of RoleAssignmentsClient.
+func (c *ClientFactory) NewRoleAssignmentsClient() *RoleAssignmentsClient {
+	return &RoleAssignmentsClient{
+		internal: c.internal,
+	}
+}
+
+// NewRoleDefinitionsClient creates a new instance of RoleDefinitionsClient.
+func (c *ClientFactory) NewRoleDefinitionsClient() *RoleDefinitionsClient {
+	return &RoleDefinitionsClient{
+		internal: c.internal,
+	}
+}
@@ -22,6 +22,24 @@ func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 	}
 }
 
+// AuditEventKind - The kind of an audit event.
+type AuditEventKind string
+
+const (
+	// AuditEventKindAsyncOperation - The outcome of an async operation.
+	AuditEventKindAsyncOperation AuditEventKind = "AsyncOperation"
+	// AuditEventKindRequest - A mutating request.
+	AuditEventKindRequest AuditEventKind = "Request"
+)
+
+// PossibleAuditEventKindValues returns the possible values for the AuditEventKind const type.
+func PossibleAuditEventKindValues() []AuditEventKind {
+	return []AuditEventKind{
+		AuditEventKindAsyncOperation,
+		AuditEventKindRequest,
+	}
+}
+
 // AzureCredentialKind - Azure credential kinds supported.
 type AzureCredentialKind string
 
@@ -77,6 +95,42 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// LockLevel - The level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - The resources protected by the lock can be read and updated, but can't be deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - The resources protected by the lock can be read, but can't be updated or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
+// PrincipalType - The type of a principal.
+type PrincipalType string
+
+const (
+	// PrincipalTypeGroup - A group of users.
+	PrincipalTypeGroup PrincipalType = "Group"
+	// PrincipalTypeUser - A user.
+	PrincipalTypeUser PrincipalType = "User"
+)
+
+// PossiblePrincipalTypeValues returns the possible values for the PrincipalType const type.
+func PossiblePrincipalTypeValues() []PrincipalType {
+	return []PrincipalType{
+		PrincipalTypeGroup,
+		PrincipalTypeUser,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
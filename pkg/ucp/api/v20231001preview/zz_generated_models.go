@@ -6,8 +6,46 @@ package v20231001preview
 
 import "time"
 
+// ActionCallback - The HTTP callback implementing an action.
+type ActionCallback struct {
+	// REQUIRED; The URL the action invocation is posted to.
+	URL *string
+}
+
+// ActionRecipe - The recipe implementing an action.
+type ActionRecipe struct {
+	// REQUIRED; The kind of the recipe template, e.g. bicep or terraform.
+	TemplateKind *string
+
+	// REQUIRED; The path to the recipe template, e.g. an OCI registry path or a Terraform module source.
+	TemplatePath *string
+
+	// The version of the Terraform module.
+	TemplateVersion *string
+}
+
+// APIVersionConversion - The rules used to convert resources between an API version and the default API version of the
+// resource type.
+type APIVersionConversion struct {
+	// The default values of the fields that are only defined in the default API version, keyed by field path. Defaults are applied
+	// when converting to the default API version and the field is not set in the request or in the stored resource. The fields
+	// are removed when converting from the default API version.
+	Defaults map[string]any
+
+	// The fields renamed or moved in the default API version. Renames are applied in order when converting to the default API
+	// version, and in reverse order when converting from it.
+	Renames []*FieldRename
+}
+
 // APIVersionProperties - The properties of an API version.
 type APIVersionProperties struct {
+	// Conversion defines how resources are converted between this API version and the default API version of the resource type.
+	// Resources are stored in the default API version.
+	Conversion *APIVersionConversion
+
+	// The deprecation of the API version. The API version is deprecated when set.
+	Deprecation *Deprecation
+
 	// Schema is the schema for the resource type.
 	Schema map[string]any
 
@@ -43,6 +81,80 @@ type APIVersionResourceListResult struct {
 	NextLink *string
 }
 
+// AuditEventProperties - The properties of an audit event.
+type AuditEventProperties struct {
+	// READ-ONLY; The api-version of the request or async operation.
+	APIVersion *string
+
+	// READ-ONLY; The correlation ID of the request. Async operations have the correlation ID of the request that started them.
+	CorrelationID *string
+
+	// READ-ONLY; The groups of the user that made the request.
+	Groups []*string
+
+	// READ-ONLY; The SHA-256 hash of the event, hex encoded. The hash covers every other property of the event.
+	Hash *string
+
+	// READ-ONLY; The kind of the event.
+	Kind *AuditEventKind
+
+	// READ-ONLY; The HTTP method of the request, or the operation type of the async operation.
+	Operation *string
+
+	// READ-ONLY; The hash of the previous event in the chain. Not set for the first event.
+	PreviousHash *string
+
+	// READ-ONLY; The name of the user that made the request. Not set for async operations.
+	Principal *string
+
+	// READ-ONLY; The SHA-256 hash of the request body, hex encoded. Not set for async operations.
+	RequestHash *string
+
+	// READ-ONLY; The ID of the resource targeted by the request or async operation.
+	ResourceID *string
+
+	// READ-ONLY; The result of the request ('Succeeded', 'Accepted' or 'Failed'), or the final provisioning state of the async
+	// operation.
+	Result *string
+
+	// READ-ONLY; The position of the event in the chain, starting at 1.
+	Sequence *int64
+
+	// READ-ONLY; The HTTP status code of the response to the request. Not set for async operations.
+	StatusCode *int32
+
+	// READ-ONLY; The time the event was recorded.
+	Timestamp *time.Time
+}
+
+// AuditEventResource - The audit event resource. An audit event records a mutating request or the outcome of an async operation.
+// Audit events are read-only and form a hash chain, so that modifying or removing an event can be detected.
+type AuditEventResource struct {
+	// The resource-specific properties for this resource.
+	Properties *AuditEventProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// AuditEventResourceListResult - The response of a AuditEventResource list operation.
+type AuditEventResourceListResult struct {
+	// REQUIRED; The AuditEventResource items on this page
+	Value []*AuditEventResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // AwsAccessKeyCredentialProperties - AWS credential properties for Access Key
 type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; Access key ID for AWS identity
@@ -375,6 +487,21 @@ func (c *CredentialStorageProperties) GetCredentialStorageProperties() *Credenti
 	return c
 }
 
+// Deprecation - The deprecation of a resource type or API version.
+type Deprecation struct {
+	// Reject the creation of new resources after the sunset date. Existing resources can still be updated and deleted.
+	BlockCreateAfterSunset *bool
+
+	// The message shown to the users of the deprecated resource type or API version, e.g. how to migrate.
+	Message *string
+
+	// The resource type or API version replacing the deprecated one.
+	Replacement *string
+
+	// The date after which the resource type or API version is no longer supported.
+	SunsetDate *time.Time
+}
+
 // ErrorAdditionalInfo - The resource management error additional info.
 type ErrorAdditionalInfo struct {
 	// READ-ONLY; The additional info.
@@ -409,6 +536,17 @@ type ErrorResponse struct {
 	Error *ErrorDetail
 }
 
+// FieldRename - A field renamed or moved between an API version and the default API version of the resource type.
+type FieldRename struct {
+	// REQUIRED; The path of the field in the API version, relative to the resource properties. Nested fields are separated by
+	// '.'.
+	From *string
+
+	// REQUIRED; The path of the field in the default API version, relative to the resource properties. Nested fields are separated
+	// by '.'.
+	To *string
+}
+
 // GenericPlaneResource - The generic representation of a plane resource
 type GenericPlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -456,6 +594,9 @@ type GenericResource struct {
 	// READ-ONLY; The name of resource
 	Name *string
 
+	// READ-ONLY; Resource tags.
+	Tags map[string]*string
+
 	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
 	ID *string
 
@@ -537,6 +678,47 @@ type LocationResourceType struct {
 	APIVersions map[string]map[string]any
 }
 
+// LockProperties - The properties of a management lock.
+type LockProperties struct {
+	// REQUIRED; The level of the lock. 'CanNotDelete' prevents deletes, 'ReadOnly' prevents updates and deletes.
+	Level *LockLevel
+
+	// Notes about the lock, e.g. why it was applied.
+	Notes *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// LockResource - The management lock resource. A lock is an extension resource of a resource group, an environment, an
+// application or another resource. It protects the resource it's applied to, the resources it contains, and the resources
+// that belong to it, from being updated or deleted.
+type LockResource struct {
+	// The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // PagedResourceProviderSummary - Paged collection of ResourceProviderSummary items
 type PagedResourceProviderSummary struct {
 	// REQUIRED; The ResourceProviderSummary items on this page
@@ -739,18 +921,45 @@ type ResourceProviderSummaryResourceType struct {
 	// The default api version for the resource type.
 	DefaultAPIVersion *string
 
+	// The deprecation of the resource type. The resource type is deprecated when set.
+	Deprecation *Deprecation
+
 	// Description of the resource type.
 	Description *string
 }
 
+// ResourceTypeAction - An action that can be invoked on resources of a resource type.
+type ResourceTypeAction struct {
+	// The HTTP callback implementing the action. Exactly one of recipe or callback must be set.
+	Callback *ActionCallback
+
+	// Description of the action.
+	Description *string
+
+	// The schema of the action input.
+	InputSchema map[string]any
+
+	// The schema of the action output.
+	OutputSchema map[string]any
+
+	// The recipe implementing the action. Exactly one of recipe or callback must be set.
+	Recipe *ActionRecipe
+}
+
 // ResourceTypeProperties - The properties of a resource type.
 type ResourceTypeProperties struct {
+	// The actions that can be invoked on resources of this type, keyed by action name.
+	Actions map[string]*ResourceTypeAction
+
 	// The resource type capabilities.
 	Capabilities []*string
 
 	// The default api version for the resource type.
 	DefaultAPIVersion *string
 
+	// The deprecation of the resource type. The resource type is deprecated when set.
+	Deprecation *Deprecation
+
 	// Description of the resource type.
 	Description *string
 
@@ -787,10 +996,106 @@ type ResourceTypeResourceListResult struct {
 
 // ResourceTypeSummaryResultAPIVersion - The configuration of a resource type API version.
 type ResourceTypeSummaryResultAPIVersion struct {
+	// The deprecation of the API version. The API version is deprecated when set.
+	Deprecation *Deprecation
+
 	// Schema holds the resource type definitions for this API version.
 	Schema map[string]any
 }
 
+// RoleAssignmentProperties - The properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// REQUIRED; The name of the user or group the role is assigned to.
+	PrincipalID *string
+
+	// REQUIRED; The type of the principal the role is assigned to.
+	PrincipalType *PrincipalType
+
+	// REQUIRED; The resource ID of the role definition. Example: '/planes/radius/local/providers/System.Authorization/roleDefinitions/reader'.
+	RoleDefinitionID *string
+
+	// REQUIRED; The scope the role is assigned at. The scope is the containing plane, a resource group or a resource of the
+	// plane. Example: '/planes/radius/local/resourceGroups/team-a'.
+	Scope *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleAssignmentResource - The role assignment resource. A role assignment grants the actions of a role definition to a
+// principal at a scope.
+type RoleAssignmentResource struct {
+	// The resource-specific properties for this resource.
+	Properties *RoleAssignmentProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleAssignmentResourceListResult - The response of a RoleAssignmentResource list operation.
+type RoleAssignmentResourceListResult struct {
+	// REQUIRED; The RoleAssignmentResource items on this page
+	Value []*RoleAssignmentResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// RoleDefinitionProperties - The properties of a role definition.
+type RoleDefinitionProperties struct {
+	// REQUIRED; The actions granted by the role definition. An action has the form '{resourceType}/{operation}', e.g. 'Applications.Core/environments/delete'.
+	// The operation is one of 'read', 'write', 'delete' or '{actionName}/action'. '*' matches any sequence of characters, e.g.
+	// 'Applications.Core/*/read' or '*'.
+	Actions []*string
+
+	// Description of the role definition.
+	Description *string
+
+	// The actions excluded from the actions granted by the role definition.
+	NotActions []*string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleDefinitionResource - The role definition resource. A role definition is a named set of actions that can be granted
+// to principals with role assignments. The built-in role definitions 'reader', 'contributor' and 'owner' are always available
+// and can't be created, updated or deleted.
+type RoleDefinitionResource struct {
+	// The resource-specific properties for this resource.
+	Properties *RoleDefinitionProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleDefinitionResourceListResult - The response of a RoleDefinitionResource list operation.
+type RoleDefinitionResourceListResult struct {
+	// REQUIRED; The RoleDefinitionResource items on this page
+	Value []*RoleDefinitionResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
@@ -4,6 +4,8 @@
 
 package v20231001preview
 
+import "time"
+
 // APIVersionsClientBeginCreateOrUpdateOptions contains the optional parameters for the APIVersionsClient.BeginCreateOrUpdate
 // method.
 type APIVersionsClientBeginCreateOrUpdateOptions struct {
@@ -27,6 +29,20 @@ type APIVersionsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// AuditEventsClientGetOptions contains the optional parameters for the AuditEventsClient.Get method.
+type AuditEventsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// AuditEventsClientListOptions contains the optional parameters for the AuditEventsClient.NewListPager method.
+type AuditEventsClientListOptions struct {
+	// Only list the events of this resource and the resources it contains. Example: '/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod'.
+	Resource *string
+
+	// Only list the events recorded at or after this time.
+	Since *time.Time
+}
+
 // AwsCredentialsClientCreateOrUpdateOptions contains the optional parameters for the AwsCredentialsClient.CreateOrUpdate
 // method.
 type AwsCredentialsClientCreateOrUpdateOptions struct {
@@ -160,6 +176,26 @@ type LocationsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate method.
+type LocksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+type LocksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+type LocksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientListOptions contains the optional parameters for the LocksClient.NewListPager method.
+type LocksClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientListPlanesOptions contains the optional parameters for the PlanesClient.NewListPlanesPager method.
 type PlanesClientListPlanesOptions struct {
 	// placeholder for future optional parameters
@@ -239,6 +275,12 @@ type ResourceProvidersClientGetOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceProvidersClientGetOpenAPIDocumentOptions contains the optional parameters for the ResourceProvidersClient.GetOpenAPIDocument
+// method.
+type ResourceProvidersClientGetOpenAPIDocumentOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceProvidersClientGetProviderSummaryOptions contains the optional parameters for the ResourceProvidersClient.GetProviderSummary
 // method.
 type ResourceProvidersClientGetProviderSummaryOptions struct {
@@ -281,5 +323,51 @@ type ResourceTypesClientListOptions struct {
 
 // ResourcesClientListOptions contains the optional parameters for the ResourcesClient.NewListPager method.
 type ResourcesClientListOptions struct {
+	// Only list the resources that have a tag with this name. tagValue must also be specified.
+	TagName *string
+
+	// Only list the resources whose tag named tagName has this value.
+	TagValue *string
+}
+
+// RoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the RoleAssignmentsClient.CreateOrUpdate
+// method.
+type RoleAssignmentsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientDeleteOptions contains the optional parameters for the RoleAssignmentsClient.Delete method.
+type RoleAssignmentsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientGetOptions contains the optional parameters for the RoleAssignmentsClient.Get method.
+type RoleAssignmentsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientListOptions contains the optional parameters for the RoleAssignmentsClient.NewListPager method.
+type RoleAssignmentsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientCreateOrUpdateOptions contains the optional parameters for the RoleDefinitionsClient.CreateOrUpdate
+// method.
+type RoleDefinitionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientDeleteOptions contains the optional parameters for the RoleDefinitionsClient.Delete method.
+type RoleDefinitionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientGetOptions contains the optional parameters for the RoleDefinitionsClient.Get method.
+type RoleDefinitionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientListOptions contains the optional parameters for the RoleDefinitionsClient.NewListPager method.
+type RoleDefinitionsClientListOptions struct {
 	// placeholder for future optional parameters
 }
@@ -246,6 +246,73 @@ func (client *ResourceProvidersClient) getHandleResponse(resp *http.Response) (R
 	return result, nil
 }
 
+// GetOpenAPIDocument - Get the OpenAPI document of the resource types of the specified resource provider for an API version.
+// The document describes the operations, asynchronous operation headers and error shapes of each resource type.
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resourceProviderName - The resource provider name. This is also the resource provider namespace. Example: 'Applications.Datastores'.
+//   - apiVersionName - The API version of the resource types described by the document.
+//   - options - ResourceProvidersClientGetOpenAPIDocumentOptions contains the optional parameters for the ResourceProvidersClient.GetOpenAPIDocument
+//     method.
+func (client *ResourceProvidersClient) GetOpenAPIDocument(ctx context.Context, planeName string, resourceProviderName string, apiVersionName string, options *ResourceProvidersClientGetOpenAPIDocumentOptions) (ResourceProvidersClientGetOpenAPIDocumentResponse, error) {
+	var err error
+	const operationName = "ResourceProvidersClient.GetOpenAPIDocument"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.getOpenAPIDocumentCreateRequest(ctx, planeName, resourceProviderName, apiVersionName, options)
+	if err != nil {
+		return ResourceProvidersClientGetOpenAPIDocumentResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceProvidersClientGetOpenAPIDocumentResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceProvidersClientGetOpenAPIDocumentResponse{}, err
+	}
+	resp, err := client.getOpenAPIDocumentHandleResponse(httpResp)
+	return resp, err
+}
+
+// getOpenAPIDocumentCreateRequest creates the GetOpenAPIDocument request.
+func (client *ResourceProvidersClient) getOpenAPIDocumentCreateRequest(ctx context.Context, planeName string, resourceProviderName string, apiVersionName string, _ *ResourceProvidersClientGetOpenAPIDocumentOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/{resourceProviderName}/openapi/{apiVersionName}"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceProviderName == "" {
+		return nil, errors.New("parameter resourceProviderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceProviderName}", url.PathEscape(resourceProviderName))
+	if apiVersionName == "" {
+		return nil, errors.New("parameter apiVersionName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{apiVersionName}", url.PathEscape(apiVersionName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getOpenAPIDocumentHandleResponse handles the GetOpenAPIDocument response.
+func (client *ResourceProvidersClient) getOpenAPIDocumentHandleResponse(resp *http.Response) (ResourceProvidersClientGetOpenAPIDocumentResponse, error) {
+	result := ResourceProvidersClientGetOpenAPIDocumentResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.Value); err != nil {
+		return ResourceProvidersClientGetOpenAPIDocumentResponse{}, err
+	}
+	return result, nil
+}
+
 // GetProviderSummary - Get the specified resource provider summary. The resource provider summary aggregates the most commonly
 // used information including locations, api versions and resource types.
 // If the operation fails it returns an *azcore.ResponseError type.
@@ -66,7 +66,7 @@ func (client *ResourcesClient) NewListPager(planeName string, resourceGroupName
 }
 
 // listCreateRequest creates the List request.
-func (client *ResourcesClient) listCreateRequest(ctx context.Context, planeName string, resourceGroupName string, _ *ResourcesClientListOptions) (*policy.Request, error) {
+func (client *ResourcesClient) listCreateRequest(ctx context.Context, planeName string, resourceGroupName string, options *ResourcesClientListOptions) (*policy.Request, error) {
 	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}/resources"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
@@ -82,6 +82,12 @@ func (client *ResourcesClient) listCreateRequest(ctx context.Context, planeName
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.TagName != nil {
+		reqQP.Set("tagName", *options.TagName)
+	}
+	if options != nil && options.TagValue != nil {
+		reqQP.Set("tagValue", *options.TagValue)
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
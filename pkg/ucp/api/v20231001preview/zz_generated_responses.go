@@ -27,6 +27,19 @@ type APIVersionsClientListResponse struct {
 	APIVersionResourceListResult
 }
 
+// AuditEventsClientGetResponse contains the response from method AuditEventsClient.Get.
+type AuditEventsClientGetResponse struct {
+	// The audit event resource. An audit event records a mutating request or the outcome of an async operation. Audit events
+	// are read-only and form a hash chain, so that modifying or removing an event can be detected.
+	AuditEventResource
+}
+
+// AuditEventsClientListResponse contains the response from method AuditEventsClient.NewListPager.
+type AuditEventsClientListResponse struct {
+	// The response of a AuditEventResource list operation.
+	AuditEventResourceListResult
+}
+
 // AwsCredentialsClientCreateOrUpdateResponse contains the response from method AwsCredentialsClient.CreateOrUpdate.
 type AwsCredentialsClientCreateOrUpdateResponse struct {
 	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
@@ -168,6 +181,29 @@ type LocationsClientListResponse struct {
 	LocationResourceListResult
 }
 
+// LocksClientCreateOrUpdateResponse contains the response from method LocksClient.CreateOrUpdate.
+type LocksClientCreateOrUpdateResponse struct {
+	// The management lock resource. A lock is an extension resource of a resource group, an environment, an application or another resource. It protects the resource it's applied to, the resources it contains, and the resources that belong to it, from being updated or deleted.
+	LockResource
+}
+
+// LocksClientDeleteResponse contains the response from method LocksClient.Delete.
+type LocksClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// LocksClientGetResponse contains the response from method LocksClient.Get.
+type LocksClientGetResponse struct {
+	// The management lock resource. A lock is an extension resource of a resource group, an environment, an application or another resource. It protects the resource it's applied to, the resources it contains, and the resources that belong to it, from being updated or deleted.
+	LockResource
+}
+
+// LocksClientListResponse contains the response from method LocksClient.NewListPager.
+type LocksClientListResponse struct {
+	// The response of a LockResource list operation.
+	LockResourceListResult
+}
+
 // PlanesClientListPlanesResponse contains the response from method PlanesClient.NewListPlanesPager.
 type PlanesClientListPlanesResponse struct {
 	// The response of a GenericPlaneResource list operation.
@@ -243,6 +279,12 @@ type ResourceProvidersClientDeleteResponse struct {
 	// placeholder for future response values
 }
 
+// ResourceProvidersClientGetOpenAPIDocumentResponse contains the response from method ResourceProvidersClient.GetOpenAPIDocument.
+type ResourceProvidersClientGetOpenAPIDocumentResponse struct {
+	// Anything
+	Value map[string]any
+}
+
 // ResourceProvidersClientGetProviderSummaryResponse contains the response from method ResourceProvidersClient.GetProviderSummary.
 type ResourceProvidersClientGetProviderSummaryResponse struct {
 	// The summary of a resource provider configuration. This type is optimized for querying resource providers and supported
@@ -296,3 +338,49 @@ type ResourcesClientListResponse struct {
 	// The response of a GenericResource list operation.
 	GenericResourceListResult
 }
+
+// RoleAssignmentsClientCreateOrUpdateResponse contains the response from method RoleAssignmentsClient.CreateOrUpdate.
+type RoleAssignmentsClientCreateOrUpdateResponse struct {
+	// The role assignment resource. A role assignment grants the actions of a role definition to a principal at a scope.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientDeleteResponse contains the response from method RoleAssignmentsClient.Delete.
+type RoleAssignmentsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleAssignmentsClientGetResponse contains the response from method RoleAssignmentsClient.Get.
+type RoleAssignmentsClientGetResponse struct {
+	// The role assignment resource. A role assignment grants the actions of a role definition to a principal at a scope.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientListResponse contains the response from method RoleAssignmentsClient.NewListPager.
+type RoleAssignmentsClientListResponse struct {
+	// The response of a RoleAssignmentResource list operation.
+	RoleAssignmentResourceListResult
+}
+
+// RoleDefinitionsClientCreateOrUpdateResponse contains the response from method RoleDefinitionsClient.CreateOrUpdate.
+type RoleDefinitionsClientCreateOrUpdateResponse struct {
+	// The role definition resource. A role definition is a named set of actions that can be granted to principals with role assignments. The built-in role definitions 'reader', 'contributor' and 'owner' are always available and can't be created, updated or deleted.
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientDeleteResponse contains the response from method RoleDefinitionsClient.Delete.
+type RoleDefinitionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleDefinitionsClientGetResponse contains the response from method RoleDefinitionsClient.Get.
+type RoleDefinitionsClientGetResponse struct {
+	// The role definition resource. A role definition is a named set of actions that can be granted to principals with role assignments. The built-in role definitions 'reader', 'contributor' and 'owner' are always available and can't be created, updated or deleted.
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientListResponse contains the response from method RoleDefinitionsClient.NewListPager.
+type RoleDefinitionsClientListResponse struct {
+	// The response of a RoleDefinitionResource list operation.
+	RoleDefinitionResourceListResult
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultQueueSize is the default number of audit events that can wait to be recorded by an AsyncRecorder.
+	DefaultQueueSize = 1024
+)
+
+var (
+	_ Recorder        = (*AsyncRecorder)(nil)
+	_ hosting.Service = (*AsyncRecorder)(nil)
+
+	// ErrQueueFull is returned by AsyncRecorder when the event can't be queued because the queue is full.
+	ErrQueueFull = errors.New("the audit event queue is full")
+)
+
+// AsyncRecorder queues audit events and records them with another recorder from a single goroutine, so that
+// recording an event doesn't add the latency of the sink to the request that caused it. The events are recorded
+// in the order they were queued.
+//
+// AsyncRecorder must be run as a hosting service. When the service stops, the events in the queue are recorded
+// before Run returns, and the events queued after that are recorded synchronously.
+type AsyncRecorder struct {
+	recorder Recorder
+	queue    chan *datamodel.AuditEventProperties
+
+	// mu guards stopped. Record holds it for reading while queueing, so that no event is queued after the queue
+	// is drained.
+	mu      sync.RWMutex
+	stopped bool
+}
+
+// NewAsyncRecorder creates a recorder that queues up to size events and records them with the recorder.
+func NewAsyncRecorder(recorder Recorder, size int) *AsyncRecorder {
+	return &AsyncRecorder{
+		recorder: recorder,
+		queue:    make(chan *datamodel.AuditEventProperties, size),
+	}
+}
+
+// Name implements hosting.Service.
+func (r *AsyncRecorder) Name() string {
+	return "audit recorder"
+}
+
+// Record queues the event. It returns ErrQueueFull without blocking when the queue is full. Failures to record a
+// queued event are logged and counted by the audit.event.failed metric.
+func (r *AsyncRecorder) Record(ctx context.Context, event *datamodel.AuditEventProperties) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.stopped {
+		return r.recorder.Record(ctx, event)
+	}
+
+	select {
+	case r.queue <- event:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Run records the queued events until the context is canceled, and then records the events left in the queue.
+func (r *AsyncRecorder) Run(ctx context.Context) error {
+	// Events are recorded after the context is canceled, so that they are flushed on shutdown.
+	recordCtx := context.WithoutCancel(ctx)
+
+	for {
+		select {
+		case event := <-r.queue:
+			r.record(recordCtx, event)
+		case <-ctx.Done():
+			r.mu.Lock()
+			r.stopped = true
+			r.mu.Unlock()
+
+			for {
+				select {
+				case event := <-r.queue:
+					r.record(recordCtx, event)
+				default:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+func (r *AsyncRecorder) record(ctx context.Context, event *datamodel.AuditEventProperties) {
+	err := r.recorder.Record(ctx, event)
+	if err != nil {
+		logger := ucplog.FromContextOrDiscard(ctx)
+		logger.Error(err, "Failed to record audit event.", "resourceId", event.ResourceID, "operation", event.Operation, "correlationId", event.CorrelationID)
+		metrics.DefaultAuditMetrics.RecordAuditEventFailed(ctx, string(event.Kind))
+	}
+}
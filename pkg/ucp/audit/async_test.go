@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AsyncRecorder_Run(t *testing.T) {
+	inner := &testRecorder{}
+	recorder := NewAsyncRecorder(inner, 10)
+
+	for _, id := range []string{"/planes/radius/local/a", "/planes/radius/local/b"} {
+		require.NoError(t, recorder.Record(context.Background(), &datamodel.AuditEventProperties{ResourceID: id}))
+	}
+
+	// Nothing is recorded until the recorder runs.
+	require.Empty(t, inner.events)
+
+	// The queued events are flushed when the recorder stops.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, recorder.Run(ctx))
+
+	require.Len(t, inner.events, 2)
+	require.Equal(t, "/planes/radius/local/a", inner.events[0].ResourceID)
+	require.Equal(t, "/planes/radius/local/b", inner.events[1].ResourceID)
+	require.NoError(t, inner.ctxErr)
+
+	// Events are recorded synchronously once the recorder is stopped.
+	require.NoError(t, recorder.Record(context.Background(), &datamodel.AuditEventProperties{ResourceID: "/planes/radius/local/c"}))
+	require.Len(t, inner.events, 3)
+}
+
+func Test_AsyncRecorder_Record(t *testing.T) {
+	inner := &testRecorder{}
+	recorder := NewAsyncRecorder(inner, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- recorder.Run(ctx)
+	}()
+
+	require.NoError(t, recorder.Record(context.Background(), &datamodel.AuditEventProperties{ResourceID: "/planes/radius/local/a"}))
+	require.Eventually(t, func() bool {
+		return len(recorder.queue) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Len(t, inner.events, 1)
+}
+
+func Test_AsyncRecorder_QueueFull(t *testing.T) {
+	inner := &testRecorder{}
+	recorder := NewAsyncRecorder(inner, 1)
+
+	require.NoError(t, recorder.Record(context.Background(), &datamodel.AuditEventProperties{ResourceID: "/planes/radius/local/a"}))
+	err := recorder.Record(context.Background(), &datamodel.AuditEventProperties{ResourceID: "/planes/radius/local/b"})
+	require.ErrorIs(t, err, ErrQueueFull)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// MinKeySize is the minimum size of the secret key of a chain, in bytes.
+const MinKeySize = 32
+
+// Chain links and verifies audit events. The hash of each event is an HMAC-SHA256 keyed by the secret key of the
+// chain, so that only the holders of the key can compute the hash of an event. The key must be kept outside of the
+// store of the events: a writer with access to the store but not to the key can't modify, insert or remove events
+// without breaking the chain.
+type Chain struct {
+	key []byte
+}
+
+// NewChain creates a chain keyed by the secret key, which must be at least MinKeySize bytes.
+func NewChain(key []byte) (*Chain, error) {
+	if len(key) < MinKeySize {
+		return nil, fmt.Errorf("the audit key must be at least %d bytes", MinKeySize)
+	}
+
+	return &Chain{key: bytes.Clone(key)}, nil
+}
+
+// LoadChain creates a chain keyed by the secret key read from the file at the path. Leading and trailing whitespace
+// is removed from the key.
+func LoadChain(path string) (*Chain, error) {
+	if path == "" {
+		return nil, errors.New("the key file is required to record audit events")
+	}
+
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the audit key: %w", err)
+	}
+
+	return NewChain(bytes.TrimSpace(key))
+}
+
+// Link sets the sequence, previous hash and hash of the event so that it follows the head of the chain, and returns
+// the new head of the chain.
+func (c *Chain) Link(head datamodel.AuditChain, event *datamodel.AuditEventProperties) (datamodel.AuditChain, error) {
+	event.Sequence = head.Sequence + 1
+	event.PreviousHash = head.Hash
+
+	hash, err := c.ComputeHash(event)
+	if err != nil {
+		return datamodel.AuditChain{}, err
+	}
+	event.Hash = hash
+
+	return datamodel.AuditChain{Sequence: event.Sequence, Hash: event.Hash}, nil
+}
+
+// ComputeHash computes the keyed hash of the event. The hash covers every property of the event except Hash.
+func (c *Chain) ComputeHash(event *datamodel.AuditEventProperties) (string, error) {
+	unhashed := *event
+	unhashed.Hash = ""
+	unhashed.Timestamp = unhashed.Timestamp.UTC()
+
+	b, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, c.key)
+	_, _ = mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Verify verifies that the events, ordered by sequence, form an unbroken chain from the first event of the chain.
+// The chain is anchored at its first event, so removing events from the start of the chain is detected.
+func (c *Chain) Verify(events []datamodel.AuditEventProperties) error {
+	var previous *datamodel.AuditEventProperties
+	for i := range events {
+		err := c.VerifyEvent(previous, &events[i])
+		if err != nil {
+			return err
+		}
+		previous = &events[i]
+	}
+
+	return nil
+}
+
+// VerifyHash verifies that the hash of the event matches its content.
+func (c *Chain) VerifyHash(event *datamodel.AuditEventProperties) error {
+	hash, err := c.ComputeHash(event)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal([]byte(hash), []byte(event.Hash)) {
+		return fmt.Errorf("the hash of audit event %d does not match its content", event.Sequence)
+	}
+
+	return nil
+}
+
+// VerifyEvent verifies that the hash of the event matches its content, and that the event follows the previous event
+// of the chain. The previous event must be nil for the first event of the chain, which must have sequence 1.
+func (c *Chain) VerifyEvent(previous *datamodel.AuditEventProperties, event *datamodel.AuditEventProperties) error {
+	err := c.VerifyHash(event)
+	if err != nil {
+		return err
+	}
+
+	if previous == nil {
+		if event.Sequence != 1 {
+			return fmt.Errorf("the audit chain starts at audit event %d", event.Sequence)
+		}
+		if event.PreviousHash != "" {
+			return fmt.Errorf("the first audit event has a previous hash")
+		}
+		return nil
+	}
+
+	if event.Sequence != previous.Sequence+1 {
+		return fmt.Errorf("audit event %d is followed by audit event %d", previous.Sequence, event.Sequence)
+	}
+
+	if event.PreviousHash != previous.Hash {
+		return fmt.Errorf("the previous hash of audit event %d does not match the hash of audit event %d", event.Sequence, previous.Sequence)
+	}
+
+	return nil
+}
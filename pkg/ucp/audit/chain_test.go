@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// testKey is the secret key of the chains of the tests.
+var testKey = []byte(strings.Repeat("k", MinKeySize))
+
+func newTestAuditChain(t *testing.T) *Chain {
+	chain, err := NewChain(testKey)
+	require.NoError(t, err)
+	return chain
+}
+
+func newTestEvent(resourceID string) *datamodel.AuditEventProperties {
+	return &datamodel.AuditEventProperties{
+		Timestamp:  time.Date(2026, 10, 1, 17, 32, 45, 0, time.UTC),
+		Kind:       datamodel.AuditEventKindRequest,
+		Principal:  "alice",
+		Operation:  http.MethodPut,
+		ResourceID: resourceID,
+		StatusCode: http.StatusOK,
+		Result:     ResultSucceeded,
+	}
+}
+
+func newTestChain(t *testing.T, chain *Chain, n int) []datamodel.AuditEventProperties {
+	head := datamodel.AuditChain{}
+	events := []datamodel.AuditEventProperties{}
+	for range n {
+		event := newTestEvent("/planes/radius/local/resourceGroups/test")
+		var err error
+		head, err = chain.Link(head, event)
+		require.NoError(t, err)
+		events = append(events, *event)
+	}
+
+	return events
+}
+
+func Test_NewChain(t *testing.T) {
+	_, err := NewChain([]byte("short"))
+	require.EqualError(t, err, "the audit key must be at least 32 bytes")
+
+	_, err = LoadChain("")
+	require.EqualError(t, err, "the key file is required to record audit events")
+
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, append(testKey, '\n'), 0600))
+	chain, err := LoadChain(path)
+	require.NoError(t, err)
+	require.Equal(t, testKey, chain.key)
+}
+
+func Test_Link(t *testing.T) {
+	chain := newTestAuditChain(t)
+	first := newTestEvent("/planes/radius/local/resourceGroups/a")
+	head, err := chain.Link(datamodel.AuditChain{}, first)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first.Sequence)
+	require.Empty(t, first.PreviousHash)
+	require.NotEmpty(t, first.Hash)
+	require.Equal(t, datamodel.AuditChain{Sequence: 1, Hash: first.Hash}, head)
+
+	second := newTestEvent("/planes/radius/local/resourceGroups/b")
+	head, err = chain.Link(head, second)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), second.Sequence)
+	require.Equal(t, first.Hash, second.PreviousHash)
+	require.NotEqual(t, first.Hash, second.Hash)
+	require.Equal(t, datamodel.AuditChain{Sequence: 2, Hash: second.Hash}, head)
+}
+
+func Test_ComputeHash(t *testing.T) {
+	chain := newTestAuditChain(t)
+	event := newTestEvent("/planes/radius/local/resourceGroups/a")
+	hash, err := chain.ComputeHash(event)
+	require.NoError(t, err)
+
+	t.Run("ignores hash", func(t *testing.T) {
+		hashed := *event
+		hashed.Hash = hash
+		actual, err := chain.ComputeHash(&hashed)
+		require.NoError(t, err)
+		require.Equal(t, hash, actual)
+	})
+
+	t.Run("ignores time zone", func(t *testing.T) {
+		local := *event
+		local.Timestamp = event.Timestamp.In(time.FixedZone("test", 3600))
+		actual, err := chain.ComputeHash(&local)
+		require.NoError(t, err)
+		require.Equal(t, hash, actual)
+	})
+
+	t.Run("covers content", func(t *testing.T) {
+		modified := *event
+		modified.Principal = "mallory"
+		actual, err := chain.ComputeHash(&modified)
+		require.NoError(t, err)
+		require.NotEqual(t, hash, actual)
+	})
+
+	t.Run("covers key", func(t *testing.T) {
+		other, err := NewChain([]byte(strings.Repeat("o", MinKeySize)))
+		require.NoError(t, err)
+		actual, err := other.ComputeHash(event)
+		require.NoError(t, err)
+		require.NotEqual(t, hash, actual)
+	})
+}
+
+func Test_Verify(t *testing.T) {
+	testChain := newTestAuditChain(t)
+	tests := []struct {
+		name   string
+		tamper func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties
+		err    string
+	}{
+		{
+			name: "rewritten chain without the key",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties {
+				// A writer without the key rewrites every event with the hash of another key.
+				other, _ := NewChain([]byte(strings.Repeat("o", MinKeySize)))
+				events[1].Result = ResultFailed
+				head := datamodel.AuditChain{}
+				for i := range events {
+					head, _ = other.Link(head, &events[i])
+				}
+				return events
+			},
+			err: "the hash of audit event 1 does not match its content",
+		},
+		{
+			name:   "valid",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties { return events },
+		},
+		{
+			name:   "empty",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties { return nil },
+		},
+		{
+			name: "modified event",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties {
+				events[1].Result = ResultFailed
+				return events
+			},
+			err: "the hash of audit event 2 does not match its content",
+		},
+		{
+			name: "modified event with recomputed hash",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties {
+				events[1].Result = ResultFailed
+				events[1].Hash, _ = testChain.ComputeHash(&events[1])
+				return events
+			},
+			err: "the previous hash of audit event 3 does not match the hash of audit event 2",
+		},
+		{
+			name: "removed event",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties {
+				return append(events[:1], events[2:]...)
+			},
+			err: "audit event 1 is followed by audit event 3",
+		},
+		{
+			name: "removed first event",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties {
+				return events[1:]
+			},
+			err: "the audit chain starts at audit event 2",
+		},
+		{
+			name: "removed first event with renumbered sequence",
+			tamper: func(events []datamodel.AuditEventProperties) []datamodel.AuditEventProperties {
+				events[1].Sequence = 1
+				events[1].Hash, _ = testChain.ComputeHash(&events[1])
+				return events[1:]
+			},
+			err: "the first audit event has a previous hash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := tt.tamper(newTestChain(t, testChain, 3))
+			err := testChain.Verify(events)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
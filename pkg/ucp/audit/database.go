@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// chainName is the name of the chain of audit events of a scope.
+	chainName = "default"
+
+	// recordTimeout is the maximum duration of an attempt to append an event, including the retries when the chain
+	// is updated concurrently.
+	recordTimeout = 30 * time.Second
+
+	// maxRetryDelay is the maximum delay between the retries when the chain is updated concurrently.
+	maxRetryDelay = 500 * time.Millisecond
+)
+
+var _ Recorder = (*DatabaseRecorder)(nil)
+
+// DatabaseRecorder stores audit events as System.Audit/events resources of a scope. The head of the chain is stored
+// as a System.Audit/chains resource, which is updated with optimistic concurrency so that multiple recorders can
+// append to the same chain.
+//
+// The head stores the last event of the chain, so appending an event is a single write. The event resource is
+// written after the head, and the next recorder writes it again if it's missing, so a failure between the two
+// writes never leaves a gap in the chain.
+type DatabaseRecorder struct {
+	chain          *Chain
+	databaseClient database.Client
+	scope          string
+}
+
+// NewDatabaseRecorder creates a recorder that links audit events with the chain and stores them at the scope, e.g.
+// '/planes/radius/local'.
+func NewDatabaseRecorder(chain *Chain, databaseClient database.Client, scope string) *DatabaseRecorder {
+	return &DatabaseRecorder{chain: chain, databaseClient: databaseClient, scope: scope}
+}
+
+// EventName returns the name of the event with the sequence. Sequences are zero-padded so that event names sort in
+// the order of the chain.
+func EventName(sequence int64) string {
+	return fmt.Sprintf("event-%020d", sequence)
+}
+
+// EventID returns the resource ID of the event with the sequence at the scope.
+func EventID(scope string, sequence int64) string {
+	return fmt.Sprintf("%s/providers/%s/%s", scope, datamodel.AuditEventResourceType, EventName(sequence))
+}
+
+// ChainID returns the resource ID of the head of the chain of audit events at the scope.
+func ChainID(scope string) string {
+	return fmt.Sprintf("%s/providers/%s/%s", scope, datamodel.AuditChainResourceType, chainName)
+}
+
+// Record appends the event to the chain of the scope. Record retries until the event is appended when the chain is
+// updated concurrently, and returns an error if the event could not be appended within the record timeout.
+func (r *DatabaseRecorder) Record(ctx context.Context, event *datamodel.AuditEventProperties) error {
+	chainID := ChainID(r.scope)
+
+	// The event must be recorded even when the request that caused it is canceled.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		head := datamodel.AuditChain{}
+		etag := ""
+		obj, err := r.databaseClient.Get(ctx, chainID)
+		if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		} else if err == nil {
+			if err := obj.As(&head); err != nil {
+				return fmt.Errorf("failed to record audit event: %w", err)
+			}
+			etag = obj.ETag
+		}
+
+		// Store the last event of the chain if the recorder that appended it failed to store it, so that the
+		// events remain contiguous.
+		if head.Last != nil {
+			err = r.ensureEvent(ctx, head.Last)
+			if err != nil {
+				return fmt.Errorf("failed to record audit event %d: %w", head.Last.Sequence, err)
+			}
+		}
+
+		next, err := r.chain.Link(head, event)
+		if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+		next.Last = event
+
+		// Claim the sequence by updating the head of the chain with the event. A recorder that loses the race
+		// retries with the new head.
+		err = r.databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: chainID}, Data: &next}, database.WithETag(etag))
+		if errors.Is(err, &database.ErrConcurrency{}) {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("failed to record audit event: the audit chain of %q was updated concurrently %d times: %w", r.scope, attempt+1, ctx.Err())
+			case <-time.After(retryDelay(attempt)):
+			}
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to record audit event: %w", err)
+		}
+
+		err = r.saveEvent(ctx, event)
+		if err != nil {
+			return fmt.Errorf("failed to record audit event %d: %w", event.Sequence, err)
+		}
+
+		return nil
+	}
+}
+
+// ensureEvent stores the event if it's not stored yet.
+func (r *DatabaseRecorder) ensureEvent(ctx context.Context, event *datamodel.AuditEventProperties) error {
+	_, err := r.databaseClient.Get(ctx, EventID(r.scope, event.Sequence))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return r.saveEvent(ctx, event)
+	}
+
+	return err
+}
+
+// saveEvent stores the event as a System.Audit/events resource. Saving an event again is idempotent because its
+// content is fixed by the head of the chain.
+func (r *DatabaseRecorder) saveEvent(ctx context.Context, event *datamodel.AuditEventProperties) error {
+	resource := NewEventResource(r.scope, event)
+	return r.databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: resource.ID}, Data: resource})
+}
+
+// NewEventResource creates the System.Audit/events resource of the event at the scope.
+func NewEventResource(scope string, event *datamodel.AuditEventProperties) *datamodel.AuditEvent {
+	return &datamodel.AuditEvent{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   EventID(scope, event.Sequence),
+				Name: EventName(event.Sequence),
+				Type: datamodel.AuditEventResourceType,
+			},
+		},
+		Properties: *event,
+	}
+}
+
+// retryDelay returns the delay before the retry of the attempt, with jitter so that concurrent recorders don't
+// retry in lockstep.
+func retryDelay(attempt int) time.Duration {
+	delay := min(maxRetryDelay, time.Duration(1<<min(attempt, 10))*time.Millisecond)
+	return delay/2 + rand.N(delay/2+1)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EventName(t *testing.T) {
+	require.Equal(t, "event-00000000000000000042", EventName(42))
+	require.Equal(t, "/planes/radius/local/providers/System.Audit/events/event-00000000000000000042", EventID("/planes/radius/local", 42))
+}
+
+func Test_DatabaseRecorder(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	recorder := NewDatabaseRecorder(newTestAuditChain(t), client, DefaultScope)
+
+	for i := range 3 {
+		err := recorder.Record(ctx, newTestEvent(fmt.Sprintf("/planes/radius/local/resourceGroups/test-%d", i)))
+		require.NoError(t, err)
+	}
+
+	events := readDatabaseEvents(t, client)
+	require.Len(t, events, 3)
+	require.NoError(t, newTestAuditChain(t).Verify(events))
+
+	obj, err := client.Get(ctx, EventID(DefaultScope, 2))
+	require.NoError(t, err)
+	resource := datamodel.AuditEvent{}
+	require.NoError(t, obj.As(&resource))
+	require.Equal(t, EventName(2), resource.Name)
+	require.Equal(t, datamodel.AuditEventResourceType, resource.Type)
+	require.Equal(t, "/planes/radius/local/resourceGroups/test-1", resource.Properties.ResourceID)
+}
+
+func Test_DatabaseRecorder_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+
+	// Create the head of the chain, because recorders can only race to update it.
+	err := NewDatabaseRecorder(newTestAuditChain(t), client, DefaultScope).Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/first"))
+	require.NoError(t, err)
+
+	wg := sync.WaitGroup{}
+	for i := range 5 {
+		wg.Go(func() {
+			recorder := NewDatabaseRecorder(newTestAuditChain(t), client, DefaultScope)
+			err := recorder.Record(ctx, newTestEvent(fmt.Sprintf("/planes/radius/local/resourceGroups/test-%d", i)))
+			require.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	events := readDatabaseEvents(t, client)
+	require.Len(t, events, 6)
+	require.NoError(t, newTestAuditChain(t).Verify(events))
+}
+
+func readDatabaseEvents(t *testing.T, client database.Client) []datamodel.AuditEventProperties {
+	result, err := client.Query(context.Background(), database.Query{RootScope: DefaultScope, ResourceType: datamodel.AuditEventResourceType})
+	require.NoError(t, err)
+
+	events := make([]datamodel.AuditEventProperties, len(result.Items))
+	for _, item := range result.Items {
+		resource := datamodel.AuditEvent{}
+		require.NoError(t, item.As(&resource))
+		events[resource.Properties.Sequence-1] = resource.Properties
+	}
+
+	return events
+}
+
+// failingEventClient fails to save the first audit event, like a database that fails between the writes of Record.
+type failingEventClient struct {
+	database.Client
+	failed bool
+}
+
+func (c *failingEventClient) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	if !c.failed && strings.Contains(obj.ID, datamodel.AuditEventResourceType) {
+		c.failed = true
+		return errors.New("database unavailable")
+	}
+
+	return c.Client.Save(ctx, obj, options...)
+}
+
+func Test_DatabaseRecorder_EventSaveFailure(t *testing.T) {
+	ctx := context.Background()
+	client := &failingEventClient{Client: inmemory.NewClient()}
+	recorder := NewDatabaseRecorder(newTestAuditChain(t), client, DefaultScope)
+
+	err := recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/first"))
+	require.ErrorContains(t, err, "failed to record audit event 1: database unavailable")
+
+	// The next event stores the event that failed, so the chain has no gap.
+	err = recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/second"))
+	require.NoError(t, err)
+
+	events := readDatabaseEvents(t, client)
+	require.Len(t, events, 2)
+	require.NoError(t, newTestAuditChain(t).Verify(events))
+	require.Equal(t, "/planes/radius/local/resourceGroups/first", events[0].ResourceID)
+}
+
+func Test_DatabaseRecorder_CanceledRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := inmemory.NewClient()
+	err := NewDatabaseRecorder(newTestAuditChain(t), client, DefaultScope).Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/test"))
+	require.NoError(t, err)
+	require.Len(t, readDatabaseEvents(t, client), 1)
+}
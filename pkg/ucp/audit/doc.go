@@ -0,0 +1,27 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// audit records audit events for the mutating requests handled by UCP and for the outcome of async operations.
+//
+// Events form a hash chain: each event stores the hash of the previous event and its own hash, which covers all of
+// its properties. Modifying, inserting or removing an event breaks the chain, which is detected by Verify. Events
+// are stored in the database as System.Audit/events resources of a Radius plane, or appended to a file.
+//
+// The hashes are HMAC-SHA256 hashes keyed by a secret key that is read from a file and never stored with the events,
+// so the chain detects accidental corruption and tampering by a writer with access to the database or the audit
+// file but not to the key. A holder of the key, i.e. a process that records audit events, can rewrite the chain.
+// Truncating the chain after its last event is only detected by comparing its head with a copy kept elsewhere.
+package audit
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+var _ Recorder = (*FileRecorder)(nil)
+
+// FileRecorder appends audit events to a file, one JSON document per line. A FileRecorder must be the only writer
+// of its file.
+type FileRecorder struct {
+	chain *Chain
+	path  string
+
+	mu   sync.Mutex
+	head datamodel.AuditChain
+}
+
+// NewFileRecorder creates a recorder that links audit events with the chain and appends them to the file at the path.
+// The events already in the file are verified, and new events continue their chain.
+func NewFileRecorder(chain *Chain, path string) (*FileRecorder, error) {
+	if path == "" {
+		return nil, errors.New("the file path is required for the 'file' audit sink")
+	}
+
+	events, err := ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &FileRecorder{chain: chain, path: path}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = chain.Verify(events)
+	if err != nil {
+		return nil, fmt.Errorf("the audit file %q has been tampered with: %w", path, err)
+	}
+
+	recorder := &FileRecorder{chain: chain, path: path}
+	if len(events) > 0 {
+		last := events[len(events)-1]
+		recorder.head = datamodel.AuditChain{Sequence: last.Sequence, Hash: last.Hash}
+	}
+
+	return recorder, nil
+}
+
+// ReadFile reads the audit events of the file at the path.
+func ReadFile(path string) ([]datamodel.AuditEventProperties, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	events := []datamodel.AuditEventProperties{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		event := datamodel.AuditEventProperties{}
+		err := json.Unmarshal(scanner.Bytes(), &event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode audit event %d of %q: %w", len(events)+1, path, err)
+		}
+		events = append(events, event)
+	}
+
+	return events, scanner.Err()
+}
+
+// Record appends the event to the file.
+func (r *FileRecorder) Record(ctx context.Context, event *datamodel.AuditEventProperties) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := r.chain.Link(r.head, event)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(b, '\n'))
+	if err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+
+	err = f.Sync()
+	if err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+
+	r.head = next
+	return nil
+}
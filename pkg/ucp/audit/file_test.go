@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FileRecorder(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	recorder, err := NewFileRecorder(newTestAuditChain(t), path)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/a")))
+	require.NoError(t, recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/b")))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// A new recorder continues the chain of the file.
+	recorder, err = NewFileRecorder(newTestAuditChain(t), path)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/c")))
+
+	events, err := ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.NoError(t, newTestAuditChain(t).Verify(events))
+	require.Equal(t, int64(3), events[2].Sequence)
+	require.Equal(t, "/planes/radius/local/resourceGroups/c", events[2].ResourceID)
+}
+
+func Test_FileRecorder_Tampered(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	recorder, err := NewFileRecorder(newTestAuditChain(t), path)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/a")))
+	require.NoError(t, recorder.Record(ctx, newTestEvent("/planes/radius/local/resourceGroups/b")))
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	tampered := strings.Replace(string(b), "/planes/radius/local/resourceGroups/a", "/planes/radius/local/resourceGroups/x", 1)
+	require.NoError(t, os.WriteFile(path, []byte(tampered), 0600))
+
+	_, err = NewFileRecorder(newTestAuditChain(t), path)
+	require.ErrorContains(t, err, "has been tampered with: the hash of audit event 1 does not match its content")
+}
+
+func Test_NewFileRecorder_NoPath(t *testing.T) {
+	_, err := NewFileRecorder(newTestAuditChain(t), "")
+	require.EqualError(t, err, "the file path is required for the 'file' audit sink")
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	chi_middleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// ResultSucceeded is the result of a request that succeeded.
+	ResultSucceeded = "Succeeded"
+
+	// ResultAccepted is the result of a request that started an async operation.
+	ResultAccepted = "Accepted"
+
+	// ResultFailed is the result of a request that failed.
+	ResultFailed = "Failed"
+
+	// MaxRequestBodySize is the maximum size of the body of the requests that are audited. The body is read in
+	// memory to compute its hash. Other handlers that read the body of UCP requests in memory use the same limit.
+	MaxRequestBodySize = 32 << 20
+)
+
+// Middleware returns a middleware that records an audit event for each mutating request (PUT, PATCH, DELETE and
+// POST) for planes and their resources. The middleware must run after the ARM request context is created.
+//
+// Requests without a correlation ID are assigned one, so that the async operations they start can be related to
+// the request. The event is recorded after the request is handled, even when the request is canceled by the client.
+// Requests fail with 400 (Bad Request) when their body is larger than 32 MiB and with 500 (Internal Server Error)
+// when their body can't be read, and are not handled. The failure to record an event doesn't fail the request, which
+// has already been handled, so it's logged and counted by the audit.event.failed metric.
+//
+// The recorder is called before the response is sent, so it should be an AsyncRecorder that doesn't wait for the
+// sink.
+func Middleware(recorder Recorder, pathBase string) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, pathBase)
+			if !isMutating(r.Method) || !strings.HasPrefix(strings.ToLower(path), "/planes") {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBodySize))
+			if maxBytesErr := (*http.MaxBytesError)(nil); errors.As(err, &maxBytesErr) {
+				resp := rest.NewBadRequestARMResponse(v1.ErrorResponse{
+					Error: &v1.ErrorDetails{
+						Code:    v1.CodeInvalidRequestContent,
+						Message: fmt.Sprintf("the request body is larger than the maximum size of %d bytes", maxBytesErr.Limit),
+					},
+				})
+				_ = resp.Apply(r.Context(), w, r)
+				return
+			} else if err != nil {
+				resp := rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: &v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: "failed to read the request body: " + err.Error(),
+					},
+				})
+				_ = resp.Apply(r.Context(), w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			hash := sha256.Sum256(body)
+
+			correlationID := r.Header.Get(v1.CorrelationRequestIDHeader)
+			if correlationID == "" {
+				correlationID = uuid.New().String()
+				r.Header.Set(v1.CorrelationRequestIDHeader, correlationID)
+				if rpcCtx := v1.ARMRequestContextFromContext(r.Context()); rpcCtx != nil {
+					rpcCtx.CorrelationID = correlationID
+				}
+			}
+
+			ww := chi_middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			h.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			event := &datamodel.AuditEventProperties{
+				Timestamp:     time.Now().UTC(),
+				Kind:          datamodel.AuditEventKindRequest,
+				Operation:     r.Method,
+				ResourceID:    path,
+				APIVersion:    r.URL.Query().Get(v1.APIVersionParameterName),
+				RequestHash:   hex.EncodeToString(hash[:]),
+				StatusCode:    status,
+				Result:        result(status),
+				CorrelationID: correlationID,
+			}
+			if principal := authentication.PrincipalFromRequest(r); principal != nil {
+				event.Principal = principal.Name
+				event.Groups = principal.Groups
+			}
+
+			// The response is sent when the handler returns, so the event must be recorded without waiting for the
+			// sink. The request may be canceled by the client, so the event doesn't inherit its cancellation.
+			err = recorder.Record(context.WithoutCancel(r.Context()), event)
+			if err != nil {
+				logger := ucplog.FromContextOrDiscard(r.Context())
+				logger.Error(err, "Failed to record audit event.", "resourceId", event.ResourceID, "operation", event.Operation, "correlationId", correlationID)
+				metrics.DefaultAuditMetrics.RecordAuditEventFailed(r.Context(), string(event.Kind))
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
+func result(statusCode int) string {
+	switch {
+	case statusCode == http.StatusAccepted:
+		return ResultAccepted
+	case statusCode < 400:
+		return ResultSucceeded
+	default:
+		return ResultFailed
+	}
+}
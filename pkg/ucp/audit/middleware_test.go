@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPathBase   = "/apis/api.ucp.dev/v1alpha3"
+	testResourceID = "/planes/radius/local/resourceGroups/test/providers/Applications.Core/environments/env"
+)
+
+type testRecorder struct {
+	events []datamodel.AuditEventProperties
+	err    error
+	ctxErr error
+}
+
+func (r *testRecorder) Record(ctx context.Context, event *datamodel.AuditEventProperties) error {
+	r.events = append(r.events, *event)
+	r.ctxErr = ctx.Err()
+	return r.err
+}
+
+func Test_Middleware(t *testing.T) {
+	body := `{"properties":{}}`
+	sum := sha256.Sum256([]byte(body))
+	bodyHash := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		status   int
+		recorded bool
+		result   string
+	}{
+		{
+			name:   "read",
+			method: http.MethodGet,
+			path:   testPathBase + testResourceID,
+			status: http.StatusOK,
+		},
+		{
+			name:   "outside planes",
+			method: http.MethodPost,
+			path:   testPathBase + "/other",
+			status: http.StatusOK,
+		},
+		{
+			name:     "put",
+			method:   http.MethodPut,
+			path:     testPathBase + testResourceID,
+			status:   http.StatusCreated,
+			recorded: true,
+			result:   ResultSucceeded,
+		},
+		{
+			name:     "delete accepted",
+			method:   http.MethodDelete,
+			path:     testPathBase + testResourceID,
+			status:   http.StatusAccepted,
+			recorded: true,
+			result:   ResultAccepted,
+		},
+		{
+			name:     "post failed",
+			method:   http.MethodPost,
+			path:     testPathBase + testResourceID + "/listSecrets",
+			status:   http.StatusForbidden,
+			recorded: true,
+			result:   ResultFailed,
+		},
+		{
+			name:     "patch without status",
+			method:   http.MethodPatch,
+			path:     testPathBase + testResourceID,
+			recorded: true,
+			result:   ResultSucceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &testRecorder{}
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The body must still be readable by the handler.
+				b, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, body, string(b))
+
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+			})
+			handler := Middleware(recorder, testPathBase)(next)
+
+			req := httptest.NewRequest(tt.method, tt.path+"?api-version=2023-10-01-preview", strings.NewReader(body))
+			req.Header.Set(v1.CorrelationRequestIDHeader, "test-correlation-id")
+			req = req.WithContext(authentication.WithPrincipal(req.Context(), &authentication.Principal{Name: "alice", Groups: []string{"team-a"}}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if !tt.recorded {
+				require.Empty(t, recorder.events)
+				return
+			}
+
+			require.Len(t, recorder.events, 1)
+			event := recorder.events[0]
+			require.Equal(t, datamodel.AuditEventKindRequest, event.Kind)
+			require.Equal(t, tt.method, event.Operation)
+			require.Equal(t, strings.TrimPrefix(tt.path, testPathBase), event.ResourceID)
+			require.Equal(t, "2023-10-01-preview", event.APIVersion)
+			require.Equal(t, bodyHash, event.RequestHash)
+			require.Equal(t, w.Code, event.StatusCode)
+			require.Equal(t, tt.result, event.Result)
+			require.Equal(t, "test-correlation-id", event.CorrelationID)
+			require.Equal(t, "alice", event.Principal)
+			require.Equal(t, []string{"team-a"}, event.Groups)
+			require.False(t, event.Timestamp.IsZero())
+		})
+	}
+}
+
+func Test_Middleware_CorrelationID(t *testing.T) {
+	recorder := &testRecorder{}
+	var correlationID string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		correlationID = v1.ARMRequestContextFromContext(r.Context()).CorrelationID
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := Middleware(recorder, testPathBase)(next)
+
+	req := httptest.NewRequest(http.MethodPut, testPathBase+testResourceID, nil)
+	req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.NotEmpty(t, correlationID)
+	require.Equal(t, correlationID, req.Header.Get(v1.CorrelationRequestIDHeader))
+	require.Len(t, recorder.events, 1)
+	require.Equal(t, correlationID, recorder.events[0].CorrelationID)
+	require.Empty(t, recorder.events[0].Principal)
+}
+
+func Test_Middleware_RecordError(t *testing.T) {
+	recorder := &testRecorder{err: errors.New("database unavailable")}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := Middleware(recorder, testPathBase)(next)
+
+	req := httptest.NewRequest(http.MethodPut, testPathBase+testResourceID, nil)
+	req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	// The request has already been handled, so the failure is only logged.
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, recorder.events, 1)
+}
+
+func Test_Middleware_CanceledRequest(t *testing.T) {
+	recorder := &testRecorder{}
+	ctx, cancel := context.WithCancel(v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{}))
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+
+		// The client goes away once the response is sent.
+		cancel()
+	})
+	handler := Middleware(recorder, testPathBase)(next)
+
+	req := httptest.NewRequest(http.MethodPut, testPathBase+testResourceID, nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Len(t, recorder.events, 1)
+	require.NoError(t, recorder.ctxErr)
+}
+
+func Test_Middleware_BodyTooLarge(t *testing.T) {
+	recorder := &testRecorder{}
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+	handler := Middleware(recorder, testPathBase)(next)
+
+	req := httptest.NewRequest(http.MethodPut, testPathBase+testResourceID, strings.NewReader(strings.Repeat("a", MaxRequestBodySize+1)))
+	req = req.WithContext(v1.WithARMRequestContext(req.Context(), &v1.ARMRequestContext{}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), "the request body is larger than the maximum size of 33554432 bytes")
+	require.False(t, called)
+	require.Empty(t, recorder.events)
+}
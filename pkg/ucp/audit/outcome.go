@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+var _ worker.OutcomeRecorder = (*OutcomeRecorder)(nil)
+
+// OutcomeRecorder records the outcome of async operations as audit events.
+type OutcomeRecorder struct {
+	recorder Recorder
+}
+
+// NewOutcomeRecorder creates an OutcomeRecorder that records audit events with the recorder.
+func NewOutcomeRecorder(recorder Recorder) *OutcomeRecorder {
+	return &OutcomeRecorder{recorder: recorder}
+}
+
+// RecordOutcome implements worker.OutcomeRecorder. Failures to record the event are counted by the
+// audit.event.failed metric.
+func (r *OutcomeRecorder) RecordOutcome(ctx context.Context, outcome *worker.OperationOutcome) error {
+	event := &datamodel.AuditEventProperties{
+		Timestamp:     time.Now().UTC(),
+		Kind:          datamodel.AuditEventKindAsyncOperation,
+		Operation:     outcome.OperationType,
+		ResourceID:    outcome.ResourceID,
+		APIVersion:    outcome.APIVersion,
+		Result:        string(outcome.ProvisioningState),
+		CorrelationID: outcome.CorrelationID,
+	}
+
+	err := r.recorder.Record(ctx, event)
+	if err != nil {
+		metrics.DefaultAuditMetrics.RecordAuditEventFailed(ctx, string(event.Kind))
+		return err
+	}
+
+	return nil
+}
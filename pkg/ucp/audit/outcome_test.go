@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/worker"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OutcomeRecorder(t *testing.T) {
+	inner := &testRecorder{}
+	recorder := NewOutcomeRecorder(inner)
+
+	err := recorder.RecordOutcome(context.Background(), &worker.OperationOutcome{
+		OperationType:     "APPLICATIONS.CORE/CONTAINERS|PUT",
+		ResourceID:        testResourceID,
+		APIVersion:        "2023-10-01-preview",
+		CorrelationID:     "test-correlation-id",
+		ProvisioningState: v1.ProvisioningStateSucceeded,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, inner.events, 1)
+	event := inner.events[0]
+	require.Equal(t, datamodel.AuditEventKindAsyncOperation, event.Kind)
+	require.Equal(t, "APPLICATIONS.CORE/CONTAINERS|PUT", event.Operation)
+	require.Equal(t, testResourceID, event.ResourceID)
+	require.Equal(t, "2023-10-01-preview", event.APIVersion)
+	require.Equal(t, "test-correlation-id", event.CorrelationID)
+	require.Equal(t, string(v1.ProvisioningStateSucceeded), event.Result)
+	require.False(t, event.Timestamp.IsZero())
+
+	inner.err = errors.New("database unavailable")
+	err = recorder.RecordOutcome(context.Background(), &worker.OperationOutcome{ResourceID: testResourceID})
+	require.EqualError(t, err, "database unavailable")
+}
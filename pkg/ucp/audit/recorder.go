@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// DefaultScope is the scope of the audit events stored in the database.
+const DefaultScope = "/planes/radius/local"
+
+// Recorder records audit events.
+type Recorder interface {
+	// Record appends the event to the chain of audit events. The sequence and hashes of the event are set by
+	// Record.
+	Record(ctx context.Context, event *datamodel.AuditEventProperties) error
+}
+
+// NewRecorder creates the recorder configured by the options, which links audit events with the chain. The database
+// client is used by the 'database' sink.
+func NewRecorder(options hostoptions.AuditOptions, chain *Chain, databaseClient database.Client) (Recorder, error) {
+	switch options.Sink {
+	case "", hostoptions.AuditSinkDatabase:
+		return NewDatabaseRecorder(chain, databaseClient, DefaultScope), nil
+	case hostoptions.AuditSinkFile:
+		return NewFileRecorder(chain, options.FilePath)
+	default:
+		return nil, fmt.Errorf("unsupported audit sink %q", options.Sink)
+	}
+}
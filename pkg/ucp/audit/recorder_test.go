@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewRecorder(t *testing.T) {
+	client := inmemory.NewClient()
+
+	recorder, err := NewRecorder(hostoptions.AuditOptions{Enabled: true}, newTestAuditChain(t), client)
+	require.NoError(t, err)
+	require.IsType(t, &DatabaseRecorder{}, recorder)
+
+	recorder, err = NewRecorder(hostoptions.AuditOptions{Enabled: true, Sink: hostoptions.AuditSinkFile, FilePath: filepath.Join(t.TempDir(), "audit.log")}, newTestAuditChain(t), client)
+	require.NoError(t, err)
+	require.IsType(t, &FileRecorder{}, recorder)
+
+	_, err = NewRecorder(hostoptions.AuditOptions{Enabled: true, Sink: "syslog"}, newTestAuditChain(t), client)
+	require.EqualError(t, err, "unsupported audit sink \"syslog\"")
+}
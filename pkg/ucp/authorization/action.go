@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// OperationRead is the operation of requests that read resources.
+	OperationRead = "read"
+
+	// OperationWrite is the operation of requests that create or update resources.
+	OperationWrite = "write"
+
+	// OperationDelete is the operation of requests that delete resources.
+	OperationDelete = "delete"
+
+	// OperationAction is the operation of requests that invoke a custom action of a resource.
+	OperationAction = "action"
+)
+
+// Request is the action a request performs and the resource or scope it performs it on.
+type Request struct {
+	// Action is the action of the request, e.g. 'Applications.Core/environments/write'.
+	Action string
+
+	// Target is the resource ID of the resource or scope the action is performed on. Role assignments
+	// at the target or any of its parent scopes apply to the request.
+	Target string
+}
+
+// NewRequest computes the action and target of a request from its method and path. The path must not
+// include the path base of the server.
+func NewRequest(method string, path string) (*Request, error) {
+	id, err := resources.Parse(path)
+	if err != nil {
+		// The resource provider summaries are served at paths under the plane that are not resource IDs, e.g.
+		// '/planes/radius/local/providers/Applications.Core'.
+		planeID, planeErr := resources.ParseScope(planeScope(path))
+		if planeErr != nil {
+			return nil, err
+		}
+
+		return &Request{
+			Action: datamodel.ResourceProviderSummaryResourceType + "/" + operation(method),
+			Target: planeID.String(),
+		}, nil
+	}
+
+	switch {
+	case id.IsScope(), id.IsResource():
+		return &Request{Action: id.Type() + "/" + operation(method), Target: id.String()}, nil
+
+	case id.IsScopeCollection():
+		segments := id.ScopeSegments()
+		resourceType := "System.Resources/" + segments[len(segments)-1].Type
+		if strings.EqualFold(segments[len(segments)-1].Type, "resourcegroups") {
+			resourceType = resources.ResourceGroupType
+		}
+
+		return &Request{Action: resourceType + "/" + operation(method), Target: id.Truncate().String()}, nil
+
+	default:
+		// A collection of resources, or a custom action like 'Applications.Core/environments/getmetadata' when the
+		// parent is a resource.
+		parent := id.Truncate()
+		if !parent.IsResource() {
+			return &Request{Action: id.Type() + "/" + operation(method), Target: id.RootScope()}, nil
+		}
+
+		if method == http.MethodPost {
+			return &Request{Action: id.Type() + "/" + OperationAction, Target: parent.String()}, nil
+		}
+
+		return &Request{Action: id.Type() + "/" + operation(method), Target: parent.String()}, nil
+	}
+}
+
+// operation returns the operation of a request method.
+func operation(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return OperationRead
+	case http.MethodDelete:
+		return OperationDelete
+	default:
+		return OperationWrite
+	}
+}
+
+// planeScope returns the first three segments of a path, e.g. '/planes/radius/local'.
+func planeScope(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 3 {
+		return path
+	}
+
+	return "/" + strings.Join(segments[:3], "/")
+}
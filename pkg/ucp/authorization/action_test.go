@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewRequest(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected *Request
+	}{
+		{
+			method:   http.MethodGet,
+			path:     "/planes/radius/local",
+			expected: &Request{Action: "System.Radius/planes/read", Target: "/planes/radius/local"},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/resourcegroups",
+			expected: &Request{Action: "System.Resources/resourceGroups/read", Target: "/planes/radius/local"},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/planes/radius/local/resourcegroups/team-a",
+			expected: &Request{Action: "System.Resources/resourceGroups/write", Target: "/planes/radius/local/resourcegroups/team-a"},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/resourcegroups/team-a/resources",
+			expected: &Request{Action: "System.Resources/resources/read", Target: "/planes/radius/local/resourcegroups/team-a"},
+		},
+		{
+			method:   http.MethodDelete,
+			path:     "/planes/radius/local/resourcegroups/team-a/providers/Applications.Core/environments/env",
+			expected: &Request{Action: "Applications.Core/environments/delete", Target: "/planes/radius/local/resourcegroups/team-a/providers/Applications.Core/environments/env"},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/resourcegroups/team-a/providers/Applications.Core/environments",
+			expected: &Request{Action: "Applications.Core/environments/read", Target: "/planes/radius/local/resourcegroups/team-a"},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/providers/Applications.Core/environments",
+			expected: &Request{Action: "Applications.Core/environments/read", Target: "/planes/radius/local"},
+		},
+		{
+			method:   http.MethodPost,
+			path:     "/planes/radius/local/resourcegroups/team-a/providers/Applications.Core/environments/env/getmetadata",
+			expected: &Request{Action: "Applications.Core/environments/getmetadata/action", Target: "/planes/radius/local/resourcegroups/team-a/providers/Applications.Core/environments/env"},
+		},
+		{
+			method:   http.MethodPut,
+			path:     "/planes/radius/local/providers/System.Authorization/roleAssignments/team-a-contributors",
+			expected: &Request{Action: "System.Authorization/roleAssignments/write", Target: "/planes/radius/local/providers/System.Authorization/roleAssignments/team-a-contributors"},
+		},
+		{
+			method:   http.MethodGet,
+			path:     "/planes/radius/local/providers/Applications.Core",
+			expected: &Request{Action: "System.Resources/resourceProviderSummaries/read", Target: "/planes/radius/local"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			request, err := NewRequest(tt.method, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, request)
+		})
+	}
+}
+
+func Test_NewRequest_Invalid(t *testing.T) {
+	_, err := NewRequest(http.MethodGet, "not-a-resource-id")
+	require.Error(t, err)
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// cacheDuration is the duration the role assignments and role definitions of a plane are cached. The cache is
+	// invalidated when they're written through this server, so the duration bounds the delay before the writes
+	// through other replicas apply.
+	cacheDuration = 30 * time.Second
+)
+
+// Authorizer decides whether principals are allowed to perform requests, using the role assignments and role
+// definitions stored in the database. They are cached for each plane.
+type Authorizer struct {
+	// DatabaseClient is the client used to read role assignments and role definitions.
+	DatabaseClient database.Client
+
+	// TrustedUsers are the names of the users that are allowed to perform any request, e.g. the service accounts
+	// of the Radius components.
+	TrustedUsers []string
+
+	// TrustedGroups are the groups whose members are allowed to perform any request.
+	TrustedGroups []string
+
+	mu    sync.Mutex
+	cache map[string]*planeCache
+
+	// generation is incremented when the cache is invalidated, so that the role assignments read before are not
+	// cached.
+	generation int64
+}
+
+// planeCache stores the role assignments of a plane and the role definitions they reference.
+type planeCache struct {
+	assignments []datamodel.RoleAssignment
+	expires     time.Time
+
+	// roles are the role definitions read, by lowercased ID. It's guarded by the mutex of the authorizer.
+	roles map[string]*datamodel.RoleDefinitionProperties
+}
+
+// Authorize returns true if the principal is allowed to perform the request. The request is allowed if the principal
+// is trusted, or if a role assignment of the principal at the target or one of its parent scopes grants the action.
+func (a *Authorizer) Authorize(ctx context.Context, principal *authentication.Principal, request *Request) (bool, error) {
+	if a.isTrusted(principal) {
+		return true, nil
+	}
+
+	cache, err := a.loadPlane(ctx, planeScope(request.Target))
+	if err != nil {
+		return false, err
+	}
+
+	for _, assignment := range cache.assignments {
+		if !isAssignedTo(assignment, principal) || !isInScope(request.Target, assignment.Properties.Scope) {
+			continue
+		}
+
+		role, err := a.roleDefinition(ctx, cache, assignment.Properties.RoleDefinitionID)
+		if err != nil {
+			return false, err
+		}
+
+		if role != nil && Allows(*role, request.Action) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Invalidate removes the cached role assignments and role definitions. It must be called when they're written.
+func (a *Authorizer) Invalidate() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.cache = nil
+	a.generation++
+}
+
+// loadPlane returns the cached role assignments of a plane, reading them if they're not cached or expired.
+func (a *Authorizer) loadPlane(ctx context.Context, plane string) (*planeCache, error) {
+	key := strings.ToLower(plane)
+
+	a.mu.Lock()
+	cache, ok := a.cache[key]
+	generation := a.generation
+	a.mu.Unlock()
+	if ok && time.Now().Before(cache.expires) {
+		return cache, nil
+	}
+
+	assignments, err := a.listRoleAssignments(ctx, plane)
+	if err != nil {
+		return nil, err
+	}
+
+	cache = &planeCache{
+		assignments: assignments,
+		expires:     time.Now().Add(cacheDuration),
+		roles:       map[string]*datamodel.RoleDefinitionProperties{},
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// The role assignments may have been written while they were read.
+	if a.generation == generation {
+		if a.cache == nil {
+			a.cache = map[string]*planeCache{}
+		}
+		a.cache[key] = cache
+	}
+
+	return cache, nil
+}
+
+// roleDefinition returns the role definition with the ID, reading it if it's not cached.
+func (a *Authorizer) roleDefinition(ctx context.Context, cache *planeCache, roleDefinitionID string) (*datamodel.RoleDefinitionProperties, error) {
+	id := strings.ToLower(roleDefinitionID)
+
+	a.mu.Lock()
+	role, ok := cache.roles[id]
+	a.mu.Unlock()
+	if ok {
+		return role, nil
+	}
+
+	role, err := a.getRoleDefinition(ctx, roleDefinitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	cache.roles[id] = role
+
+	return role, nil
+}
+
+// isTrusted returns true if the principal is a trusted user or a member of a trusted group.
+func (a *Authorizer) isTrusted(principal *authentication.Principal) bool {
+	if slices.Contains(a.TrustedUsers, principal.Name) {
+		return true
+	}
+
+	for _, group := range a.TrustedGroups {
+		if principal.IsMemberOf(group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listRoleAssignments lists the role assignments of a plane, including the role assignments of its resource groups
+// and resources.
+func (a *Authorizer) listRoleAssignments(ctx context.Context, plane string) ([]datamodel.RoleAssignment, error) {
+	query := database.Query{RootScope: plane, ScopeRecursive: true, ResourceType: datamodel.RoleAssignmentResourceType}
+
+	assignments := []datamodel.RoleAssignment{}
+	token := ""
+	for {
+		result, err := a.DatabaseClient.Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query role assignments: %w", err)
+		}
+
+		for _, item := range result.Items {
+			assignment := datamodel.RoleAssignment{}
+			if err := item.As(&assignment); err != nil {
+				return nil, err
+			}
+			assignments = append(assignments, assignment)
+		}
+
+		if result.PaginationToken == "" {
+			return assignments, nil
+		}
+		token = result.PaginationToken
+	}
+}
+
+// getRoleDefinition returns the properties of a built-in or stored role definition, or nil if the role
+// definition doesn't exist.
+func (a *Authorizer) getRoleDefinition(ctx context.Context, roleDefinitionID string) (*datamodel.RoleDefinitionProperties, error) {
+	id, err := resources.ParseResource(roleDefinitionID)
+	if err != nil || !strings.EqualFold(id.Type(), datamodel.RoleDefinitionResourceType) {
+		// Role assignments are validated when they're created, so this is unexpected. The assignment grants nothing.
+		return nil, nil
+	}
+
+	if role, ok := BuiltInRole(id.Name()); ok {
+		return &role, nil
+	}
+
+	obj, err := a.DatabaseClient.Get(ctx, id.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch role definition %q: %w", roleDefinitionID, err)
+	}
+
+	role := datamodel.RoleDefinition{}
+	if err := obj.As(&role); err != nil {
+		return nil, err
+	}
+
+	return &role.Properties, nil
+}
+
+// isAssignedTo returns true if the role assignment is assigned to the principal, directly or through one of its groups.
+func isAssignedTo(assignment datamodel.RoleAssignment, principal *authentication.Principal) bool {
+	switch assignment.Properties.PrincipalType {
+	case datamodel.PrincipalTypeUser:
+		return assignment.Properties.PrincipalID == principal.Name
+	case datamodel.PrincipalTypeGroup:
+		return principal.IsMemberOf(assignment.Properties.PrincipalID)
+	default:
+		return false
+	}
+}
+
+// isInScope returns true if the target is the scope or is contained by the scope.
+func isInScope(target string, scope string) bool {
+	target = strings.ToLower(strings.TrimSuffix(target, "/"))
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	return target == scope || strings.HasPrefix(target, scope+"/")
+}
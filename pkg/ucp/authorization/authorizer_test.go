@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPlane        = "/planes/radius/local"
+	testRoleBasePath = testPlane + "/providers/System.Authorization"
+)
+
+func saveRoleAssignment(t *testing.T, ctx context.Context, client database.Client, name string, properties datamodel.RoleAssignmentProperties) {
+	saveRoleAssignmentWithID(t, ctx, client, testRoleBasePath+"/roleAssignments/"+name, properties)
+}
+
+func saveRoleAssignmentWithID(t *testing.T, ctx context.Context, client database.Client, id string, properties datamodel.RoleAssignmentProperties) {
+	assignment := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: id[strings.LastIndex(id, "/")+1:],
+				Type: datamodel.RoleAssignmentResourceType,
+			},
+		},
+		Properties: properties,
+	}
+
+	err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: assignment.ID}, Data: assignment})
+	require.NoError(t, err)
+}
+
+func saveRoleDefinition(t *testing.T, ctx context.Context, client database.Client, name string, properties datamodel.RoleDefinitionProperties) {
+	definition := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   testRoleBasePath + "/roleDefinitions/" + name,
+				Name: name,
+				Type: datamodel.RoleDefinitionResourceType,
+			},
+		},
+		Properties: properties,
+	}
+
+	err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: definition.ID}, Data: definition})
+	require.NoError(t, err)
+}
+
+func setupAuthorizer(t *testing.T) (context.Context, *Authorizer) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	saveRoleDefinition(t, ctx, client, "environment-operator", datamodel.RoleDefinitionProperties{
+		Actions:    []string{"*/read", "Applications.Core/environments/*"},
+		NotActions: []string{"Applications.Core/environments/delete"},
+	})
+
+	saveRoleAssignment(t, ctx, client, "team-a-contributors", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "team-a",
+		PrincipalType:    datamodel.PrincipalTypeGroup,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/contributor",
+		Scope:            testPlane + "/resourceGroups/team-a",
+	})
+	saveRoleAssignment(t, ctx, client, "alice-reader", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "alice",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+		Scope:            testPlane,
+	})
+	saveRoleAssignment(t, ctx, client, "bob-environment-operator", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "bob",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/environment-operator",
+		Scope:            testPlane + "/resourceGroups/team-b",
+	})
+	saveRoleAssignment(t, ctx, client, "carol-deleted-role", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "carol",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/deleted",
+		Scope:            testPlane,
+	})
+
+	// A role assignment created by an owner of the resource group.
+	saveRoleAssignmentWithID(t, ctx, client, testPlane+"/resourceGroups/team-a/providers/System.Authorization/roleAssignments/frank-reader", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "frank",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+		Scope:            testPlane + "/resourceGroups/team-a",
+	})
+	saveRoleAssignmentWithID(t, ctx, client, testPlane+"/resourceGroups/team-a/providers/System.Authorization/roleAssignments/grace-owner", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "grace",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/owner",
+		Scope:            testPlane + "/resourceGroups/team-a",
+	})
+
+	return ctx, &Authorizer{
+		DatabaseClient: client,
+		TrustedUsers:   []string{"system:serviceaccount:radius-system:dynamic-rp"},
+		TrustedGroups:  []string{"system:masters"},
+	}
+}
+
+func Test_Authorizer_Authorize(t *testing.T) {
+	ctx, authorizer := setupAuthorizer(t)
+
+	tests := []struct {
+		name      string
+		principal *authentication.Principal
+		request   *Request
+		expected  bool
+	}{
+		{
+			name:      "trusted user",
+			principal: &authentication.Principal{Name: "system:serviceaccount:radius-system:dynamic-rp"},
+			request:   &Request{Action: "System.Authorization/roleAssignments/write", Target: testPlane},
+			expected:  true,
+		},
+		{
+			name:      "trusted group",
+			principal: &authentication.Principal{Name: "admin", Groups: []string{"system:masters"}},
+			request:   &Request{Action: "System.Radius/planes/delete", Target: testPlane},
+			expected:  true,
+		},
+		{
+			name:      "no role assignments",
+			principal: &authentication.Principal{Name: "mallory"},
+			request:   &Request{Action: "Applications.Core/environments/read", Target: testPlane + "/resourcegroups/team-a"},
+			expected:  false,
+		},
+		{
+			name:      "group assignment in scope",
+			principal: &authentication.Principal{Name: "dave", Groups: []string{"team-a"}},
+			request:   &Request{Action: "Applications.Core/environments/write", Target: testPlane + "/resourcegroups/team-a/providers/Applications.Core/environments/env"},
+			expected:  true,
+		},
+		{
+			name:      "group assignment out of scope",
+			principal: &authentication.Principal{Name: "dave", Groups: []string{"team-a"}},
+			request:   &Request{Action: "Applications.Core/environments/write", Target: testPlane + "/resourcegroups/team-ab/providers/Applications.Core/environments/env"},
+			expected:  false,
+		},
+		{
+			name:      "built-in role excludes actions",
+			principal: &authentication.Principal{Name: "dave", Groups: []string{"team-a"}},
+			request:   &Request{Action: "System.Authorization/roleAssignments/write", Target: testPlane + "/resourcegroups/team-a"},
+			expected:  false,
+		},
+		{
+			name:      "built-in role allows locks",
+			principal: &authentication.Principal{Name: "dave", Groups: []string{"team-a"}},
+			request:   &Request{Action: "System.Authorization/locks/write", Target: testPlane + "/resourcegroups/team-a/providers/System.Authorization/locks/do-not-delete"},
+			expected:  true,
+		},
+		{
+			name:      "assignment of resource group",
+			principal: &authentication.Principal{Name: "frank"},
+			request:   &Request{Action: "Applications.Core/environments/read", Target: testPlane + "/resourcegroups/team-a/providers/Applications.Core/environments/env"},
+			expected:  true,
+		},
+		{
+			name:      "assignment of resource group out of scope",
+			principal: &authentication.Principal{Name: "frank"},
+			request:   &Request{Action: "Applications.Core/environments/read", Target: testPlane + "/resourcegroups/team-b/providers/Applications.Core/environments/env"},
+			expected:  false,
+		},
+		{
+			name:      "resource group owner delegates access",
+			principal: &authentication.Principal{Name: "grace"},
+			request:   &Request{Action: "System.Authorization/roleAssignments/write", Target: testPlane + "/resourcegroups/team-a/providers/Applications.Core/environments/env/providers/System.Authorization/roleAssignments/env-operators"},
+			expected:  true,
+		},
+		{
+			name:      "resource group owner can't assign roles at the plane",
+			principal: &authentication.Principal{Name: "grace"},
+			request:   &Request{Action: "System.Authorization/roleAssignments/write", Target: testRoleBasePath + "/roleAssignments/plane-owners"},
+			expected:  false,
+		},
+		{
+			name:      "user assignment at plane",
+			principal: &authentication.Principal{Name: "alice"},
+			request:   &Request{Action: "Applications.Core/containers/read", Target: testPlane + "/resourcegroups/team-b/providers/Applications.Core/containers/c"},
+			expected:  true,
+		},
+		{
+			name:      "user assignment at plane denies writes",
+			principal: &authentication.Principal{Name: "alice"},
+			request:   &Request{Action: "Applications.Core/containers/write", Target: testPlane + "/resourcegroups/team-b/providers/Applications.Core/containers/c"},
+			expected:  false,
+		},
+		{
+			name:      "custom role allows action",
+			principal: &authentication.Principal{Name: "bob"},
+			request:   &Request{Action: "Applications.Core/environments/write", Target: testPlane + "/resourcegroups/team-b/providers/Applications.Core/environments/env"},
+			expected:  true,
+		},
+		{
+			name:      "custom role excludes action",
+			principal: &authentication.Principal{Name: "bob"},
+			request:   &Request{Action: "Applications.Core/environments/delete", Target: testPlane + "/resourcegroups/team-b/providers/Applications.Core/environments/env"},
+			expected:  false,
+		},
+		{
+			name:      "deleted role definition",
+			principal: &authentication.Principal{Name: "carol"},
+			request:   &Request{Action: "Applications.Core/environments/read", Target: testPlane},
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := authorizer.Authorize(ctx, tt.principal, tt.request)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func Test_Authorizer_Cache(t *testing.T) {
+	ctx, authorizer := setupAuthorizer(t)
+	request := &Request{Action: "Applications.Core/environments/read", Target: testPlane}
+
+	allowed, err := authorizer.Authorize(ctx, &authentication.Principal{Name: "erin"}, request)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	saveRoleAssignment(t, ctx, authorizer.DatabaseClient, "erin-reader", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "erin",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+		Scope:            testPlane,
+	})
+
+	// The role assignments are cached until they're invalidated.
+	allowed, err = authorizer.Authorize(ctx, &authentication.Principal{Name: "erin"}, request)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	authorizer.Invalidate()
+
+	allowed, err = authorizer.Authorize(ctx, &authentication.Principal{Name: "erin"}, request)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// authorization implements role-based authorization of UCP requests.
+//
+// Role definitions (System.Authorization/roleDefinitions) are named sets of actions, and role assignments
+// (System.Authorization/roleAssignments) grant the actions of a role definition to a user or group at a scope. Role
+// definitions are resources of a Radius plane. Role assignments are resources of a plane, or extension resources of
+// a resource group or resource, so that principals allowed to write role assignments at a resource group, like its
+// owners, can delegate access to it. An assignment applies to its scope and everything below it, so an assignment at
+// a resource group grants access to the resource group and its resources.
+//
+// Actions have the form '{resourceType}/{operation}' where the operation is 'read', 'write', 'delete' or
+// '{actionName}/action', e.g. 'Applications.Core/environments/write'. They're computed from the method and URL
+// of each request, so the same rules apply to the routes served by UCP and to the routes proxied to resource
+// providers.
+package authorization
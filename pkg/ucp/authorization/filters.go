@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRoleDefinition is the update filter of role definitions. It rejects requests to create or update
+// the built-in role definitions.
+func ValidateRoleDefinition(ctx context.Context, newResource, oldResource *datamodel.RoleDefinition, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	if _, ok := BuiltInRole(serviceCtx.ResourceID.Name()); ok {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition %q is a built-in role definition and can't be created or updated.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	return nil, nil
+}
+
+// ValidateRoleAssignment is the update filter of role assignments. It validates that the scope of the role
+// assignment is the plane, resource group or resource the role assignment is created at, or a resource group or
+// resource it contains, and that the role definition exists in the plane. The scope defaults to the scope the role
+// assignment is created at.
+//
+// Principals allowed to write role assignments at a scope can only grant access within that scope.
+func ValidateRoleAssignment(ctx context.Context, newResource, oldResource *datamodel.RoleAssignment, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	plane := serviceCtx.ResourceID.PlaneScope()
+	assignmentScope := datamodel.RoleAssignmentScope(serviceCtx.ResourceID)
+
+	if newResource.Properties.Scope == "" {
+		newResource.Properties.Scope = assignmentScope
+	}
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil || !(scope.IsScope() || scope.IsResource()) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not a valid resource ID.", newResource.Properties.Scope)), nil
+	} else if !isInScope(scope.String(), assignmentScope) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q must be %q, or a resource group or resource it contains.", newResource.Properties.Scope, assignmentScope)), nil
+	}
+
+	if !strings.EqualFold(assignmentScope, plane) {
+		// Role assignments of resource groups and resources are stored with them, so the scope must exist.
+		_, err := options.DatabaseClient.Get(ctx, assignmentScope)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return rest.NewNotFoundMessageResponse(fmt.Sprintf("The scope %q of the role assignment doesn't exist.", assignmentScope)), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	roleDefinitionID, err := resources.ParseResource(newResource.Properties.RoleDefinitionID)
+	if err != nil || !strings.EqualFold(roleDefinitionID.Type(), datamodel.RoleDefinitionResourceType) || !strings.EqualFold(roleDefinitionID.PlaneScope(), plane) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition ID %q must be the ID of a role definition of the plane %q.", newResource.Properties.RoleDefinitionID, plane)), nil
+	}
+
+	if _, ok := BuiltInRole(roleDefinitionID.Name()); ok {
+		return nil, nil
+	}
+
+	_, err = options.DatabaseClient.Get(ctx, roleDefinitionID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition %q does not exist.", newResource.Properties.RoleDefinitionID)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
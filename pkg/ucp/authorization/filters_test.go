@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRoleDefinition(t *testing.T) {
+	ctx, _ := setupAuthorizer(t)
+
+	serviceCtx := &v1.ARMRequestContext{ResourceID: resources.MustParse(testRoleBasePath + "/roleDefinitions/environment-operator")}
+	resp, err := ValidateRoleDefinition(v1.WithARMRequestContext(ctx, serviceCtx), &datamodel.RoleDefinition{}, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	serviceCtx = &v1.ARMRequestContext{ResourceID: resources.MustParse(testRoleBasePath + "/roleDefinitions/Owner")}
+	resp, err = ValidateRoleDefinition(v1.WithARMRequestContext(ctx, serviceCtx), &datamodel.RoleDefinition{}, nil, &controller.Options{})
+	require.NoError(t, err)
+	require.IsType(t, &rest.BadRequestResponse{}, resp)
+}
+
+func Test_ValidateRoleAssignment(t *testing.T) {
+	ctx, authorizer := setupAuthorizer(t)
+	options := &controller.Options{DatabaseClient: authorizer.DatabaseClient}
+
+	resourceGroupID := testPlane + "/resourceGroups/team-a"
+	environmentID := resourceGroupID + "/providers/Applications.Core/environments/env"
+	for _, id := range []string{resourceGroupID, environmentID} {
+		err := authorizer.DatabaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{"id": id}})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name             string
+		id               string
+		scope            string
+		roleDefinitionID string
+		expectedScope    string
+		expected         rest.Response
+	}{
+		{
+			name:             "built-in role at plane",
+			scope:            testPlane,
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+		},
+		{
+			name:             "custom role at resource",
+			scope:            environmentID,
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/environment-operator",
+		},
+		{
+			name:             "role assignment of resource group",
+			id:               resourceGroupID + "/providers/System.Authorization/roleAssignments/assignment",
+			scope:            environmentID,
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/owner",
+		},
+		{
+			name:             "role assignment of resource defaults to the resource",
+			id:               environmentID + "/providers/System.Authorization/roleAssignments/assignment",
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/environment-operator",
+			expectedScope:    environmentID,
+		},
+		{
+			name:             "invalid scope",
+			scope:            "team-a",
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+			expected:         rest.NewBadRequestResponse(`The scope "team-a" is not a valid resource ID.`),
+		},
+		{
+			name:             "scope in other plane",
+			scope:            "/planes/radius/other/resourceGroups/team-a",
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+			expected:         rest.NewBadRequestResponse(`The scope "/planes/radius/other/resourceGroups/team-a" must be "/planes/radius/local", or a resource group or resource it contains.`),
+		},
+		{
+			name:             "scope outside resource group",
+			id:               resourceGroupID + "/providers/System.Authorization/roleAssignments/assignment",
+			scope:            testPlane,
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/owner",
+			expected:         rest.NewBadRequestResponse(`The scope "/planes/radius/local" must be "/planes/radius/local/resourceGroups/team-a", or a resource group or resource it contains.`),
+		},
+		{
+			name:             "resource group does not exist",
+			id:               testPlane + "/resourceGroups/team-b/providers/System.Authorization/roleAssignments/assignment",
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+			expected:         rest.NewNotFoundMessageResponse(`The scope "/planes/radius/local/resourceGroups/team-b" of the role assignment doesn't exist.`),
+		},
+		{
+			name:             "role definition in other plane",
+			scope:            testPlane,
+			roleDefinitionID: "/planes/radius/other/providers/System.Authorization/roleDefinitions/reader",
+			expected:         rest.NewBadRequestResponse(`The role definition ID "/planes/radius/other/providers/System.Authorization/roleDefinitions/reader" must be the ID of a role definition of the plane "/planes/radius/local".`),
+		},
+		{
+			name:             "not a role definition",
+			scope:            testPlane,
+			roleDefinitionID: testPlane + "/providers/System.Resources/resourceProviders/Applications.Core",
+			expected:         rest.NewBadRequestResponse(`The role definition ID "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Core" must be the ID of a role definition of the plane "/planes/radius/local".`),
+		},
+		{
+			name:             "role definition does not exist",
+			scope:            testPlane,
+			roleDefinitionID: testRoleBasePath + "/roleDefinitions/deleted",
+			expected:         rest.NewBadRequestResponse(`The role definition "/planes/radius/local/providers/System.Authorization/roleDefinitions/deleted" does not exist.`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := tt.id
+			if id == "" {
+				id = testRoleBasePath + "/roleAssignments/assignment"
+			}
+			serviceCtx := &v1.ARMRequestContext{ResourceID: resources.MustParse(id)}
+
+			assignment := &datamodel.RoleAssignment{
+				Properties: datamodel.RoleAssignmentProperties{
+					PrincipalID:      "team-a",
+					PrincipalType:    datamodel.PrincipalTypeGroup,
+					RoleDefinitionID: tt.roleDefinitionID,
+					Scope:            tt.scope,
+				},
+			}
+
+			resp, err := ValidateRoleAssignment(v1.WithARMRequestContext(ctx, serviceCtx), assignment, nil, options)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp)
+			if tt.expectedScope != "" {
+				require.Equal(t, tt.expectedScope, assignment.Properties.Scope)
+			}
+		})
+	}
+}
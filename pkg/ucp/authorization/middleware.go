@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Middleware returns a middleware that authorizes the requests for planes and their resources.
+//
+// Requests for Radius planes are authorized using the role assignments of the plane. Requests for other planes, and
+// requests for the collection of planes, are authorized for reads only, and other requests require a trusted principal.
+// Requests outside '/planes' like the Kubernetes discovery documents are not authorized.
+//
+// Requests are authorized against the request path and, when set, the path of the Referer header, because UCP
+// resolves the resource ID of a request from the Referer header.
+func Middleware(authorizer *Authorizer, pathBase string) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			paths := []string{r.URL.Path}
+			if referer, err := url.Parse(r.Header.Get(v1.RefererHeader)); err == nil && referer.Path != "" && referer.Path != r.URL.Path {
+				paths = append(paths, referer.Path)
+			}
+
+			for _, path := range paths {
+				resp, err := authorize(r, authorizer, strings.TrimPrefix(path, pathBase))
+				if err != nil {
+					resp = rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+						Error: &v1.ErrorDetails{
+							Code:    v1.CodeInternal,
+							Message: err.Error(),
+						},
+					})
+				}
+
+				if resp != nil {
+					_ = resp.Apply(r.Context(), w, r)
+					return
+				}
+			}
+
+			h.ServeHTTP(w, r)
+
+			// The role assignments and role definitions are cached by the authorizer.
+			if operation(r.Method) != OperationRead && isRoleResource(strings.TrimPrefix(r.URL.Path, pathBase)) {
+				authorizer.Invalidate()
+			}
+		}
+
+		return http.HandlerFunc(fn)
+	}
+}
+
+// isRoleResource returns true if the path is a role assignment or a role definition, or a collection of them.
+func isRoleResource(path string) bool {
+	id, err := resources.Parse(path)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(id.Type(), datamodel.RoleAssignmentResourceType) || strings.EqualFold(id.Type(), datamodel.RoleDefinitionResourceType)
+}
+
+// authorize authorizes a request for a path. Returns nil if the request is allowed.
+func authorize(r *http.Request, authorizer *Authorizer, path string) (rest.Response, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if !strings.EqualFold(segments[0], "planes") {
+		return nil, nil
+	}
+
+	principal := authentication.PrincipalFromRequest(r)
+	if principal == nil {
+		return rest.NewClientAuthenticationFailedARMResponse(), nil
+	}
+
+	// Role assignments are only supported for Radius planes.
+	if len(segments) < 3 || !strings.EqualFold(segments[1], "radius") {
+		if operation(r.Method) == OperationRead || authorizer.isTrusted(principal) {
+			return nil, nil
+		}
+
+		return rest.NewForbiddenResponse(path, fmt.Sprintf("The principal %q does not have authorization to perform %s requests on %q.", principal.Name, r.Method, path)), nil
+	}
+
+	request, err := NewRequest(r.Method, path)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	allowed, err := authorizer.Authorize(r.Context(), principal, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		logger := ucplog.FromContextOrDiscard(r.Context())
+		logger.Info("Request is not authorized.", "principal", principal.Name, "action", request.Action, "target", request.Target)
+		return rest.NewForbiddenResponse(request.Target, fmt.Sprintf("The principal %q does not have authorization to perform action %q over scope %q.", principal.Name, request.Action, request.Target)), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/radius-project/radius/test/testoidc"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testPathBase = "/apis/api.ucp.dev/v1alpha3"
+
+func Test_Middleware(t *testing.T) {
+	_, authorizer := setupAuthorizer(t)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		user     string
+		groups   []string
+		referer  string
+		expected int
+		code     string
+	}{
+		{
+			name:     "outside planes",
+			method:   http.MethodGet,
+			path:     testPathBase,
+			expected: http.StatusOK,
+		},
+		{
+			name:     "unauthenticated",
+			method:   http.MethodGet,
+			path:     testPathBase + testPlane,
+			expected: http.StatusUnauthorized,
+			code:     v1.CodeInvalidAuthenticationInfo,
+		},
+		{
+			name:     "allowed",
+			method:   http.MethodPut,
+			path:     testPathBase + testPlane + "/resourcegroups/team-a/providers/Applications.Core/environments/env",
+			user:     "dave",
+			groups:   []string{"team-a"},
+			expected: http.StatusOK,
+		},
+		{
+			name:     "forbidden",
+			method:   http.MethodPut,
+			path:     testPathBase + testPlane + "/resourcegroups/team-b/providers/Applications.Core/environments/env",
+			user:     "dave",
+			groups:   []string{"team-a"},
+			expected: http.StatusForbidden,
+			code:     v1.CodeAuthorizationFailed,
+		},
+		{
+			name:     "forbidden referer",
+			method:   http.MethodPut,
+			path:     testPathBase + testPlane + "/resourcegroups/team-a/providers/Applications.Core/environments/env",
+			user:     "dave",
+			groups:   []string{"team-a"},
+			referer:  "https://localhost" + testPathBase + testPlane + "/resourcegroups/team-b/providers/Applications.Core/environments/env",
+			expected: http.StatusForbidden,
+			code:     v1.CodeAuthorizationFailed,
+		},
+		{
+			name:     "list planes",
+			method:   http.MethodGet,
+			path:     testPathBase + "/planes/radius",
+			user:     "mallory",
+			expected: http.StatusOK,
+		},
+		{
+			name:     "write other plane type",
+			method:   http.MethodPut,
+			path:     testPathBase + "/planes/aws/aws/accounts/0000/regions/us-east-1/providers/AWS.S3/Bucket/bucket",
+			user:     "alice",
+			expected: http.StatusForbidden,
+			code:     v1.CodeAuthorizationFailed,
+		},
+		{
+			name:     "write other plane type trusted",
+			method:   http.MethodPut,
+			path:     testPathBase + "/planes/aws/aws/accounts/0000/regions/us-east-1/providers/AWS.S3/Bucket/bucket",
+			user:     "admin",
+			groups:   []string{"system:masters"},
+			expected: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := Middleware(authorizer, testPathBase)(next)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.user != "" {
+				req = req.WithContext(authentication.WithPrincipal(req.Context(), &authentication.Principal{Name: tt.user, Groups: tt.groups}))
+			}
+			if tt.referer != "" {
+				req.Header.Set(v1.RefererHeader, tt.referer)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, tt.expected, w.Code)
+
+			if tt.code != "" {
+				body := v1.ErrorResponse{}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				require.Equal(t, tt.code, body.Error.Code)
+			}
+		})
+	}
+}
+
+func Test_Middleware_InvalidatesRoleAssignments(t *testing.T) {
+	ctx, authorizer := setupAuthorizer(t)
+	request := &Request{Action: "Applications.Core/environments/read", Target: testPlane}
+
+	allowed, err := authorizer.Authorize(ctx, &authentication.Principal{Name: "erin"}, request)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	path := testRoleBasePath + "/roleAssignments/erin-reader"
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		saveRoleAssignment(t, ctx, authorizer.DatabaseClient, "erin-reader", datamodel.RoleAssignmentProperties{
+			PrincipalID:      "erin",
+			PrincipalType:    datamodel.PrincipalTypeUser,
+			RoleDefinitionID: testRoleBasePath + "/roleDefinitions/reader",
+			Scope:            testPlane,
+		})
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := Middleware(authorizer, testPathBase)(next)
+
+	req := httptest.NewRequest(http.MethodPut, testPathBase+path, nil)
+	req = req.WithContext(authentication.WithPrincipal(req.Context(), &authentication.Principal{Name: "admin", Groups: []string{"system:masters"}}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	allowed, err = authorizer.Authorize(ctx, &authentication.Principal{Name: "erin"}, request)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+// Test_Middleware_PodIdentity verifies that the deployment engine, which calls UCP without credentials,
+// is authorized as a trusted service account while other pods are not authenticated.
+func Test_Middleware_PodIdentity(t *testing.T) {
+	authorizer := &Authorizer{
+		DatabaseClient: inmemory.NewClient(),
+		TrustedGroups:  []string{"system:serviceaccounts:radius-system"},
+	}
+	handler := authentication.PodIdentityValidator(newTestPodIdentityAuthenticator(t))(
+		Middleware(authorizer, "")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})))
+
+	tests := []struct {
+		name          string
+		remoteAddr    string
+		authenticated *authentication.Principal
+		expected      int
+	}{
+		{
+			name:       "deployment engine",
+			remoteAddr: "10.0.0.1:51234",
+			expected:   http.StatusOK,
+		},
+		{
+			name:       "other pod",
+			remoteAddr: "10.0.0.3:51234",
+			expected:   http.StatusUnauthorized,
+		},
+		{
+			name:          "already authenticated",
+			remoteAddr:    "10.0.0.1:51234",
+			authenticated: &authentication.Principal{Name: "alice"},
+			expected:      http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/planes/radius/local/resourcegroups/default/providers/Applications.Core/environments/env", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.authenticated != nil {
+				req = req.WithContext(authentication.WithPrincipal(req.Context(), tt.authenticated))
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+// Test_Middleware_OIDC verifies that the principals of tokens are not trusted when they claim the names of trusted
+// Kubernetes principals.
+func Test_Middleware_OIDC(t *testing.T) {
+	issuer := testoidc.NewIssuer(t)
+	authenticator, err := authentication.NewOIDCAuthenticator(&hostoptions.OIDCOptions{
+		Issuers: []hostoptions.OIDCIssuerOptions{{Issuer: issuer.URL, Audiences: []string{issuer.ClientID}}},
+	}, http.DefaultClient)
+	require.NoError(t, err)
+
+	authorizer := &Authorizer{
+		DatabaseClient: inmemory.NewClient(),
+		TrustedUsers:   []string{"system:serviceaccount:radius-system:dynamic-rp"},
+		TrustedGroups:  []string{"system:masters"},
+	}
+	handler := authentication.BearerTokenValidator(authenticator)(
+		Middleware(authorizer, "")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})))
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+	}{
+		{
+			name:   "trusted group",
+			claims: jwt.MapClaims{"sub": "mallory", "groups": []string{"system:masters"}},
+		},
+		{
+			name:   "trusted user",
+			claims: jwt.MapClaims{"sub": "system:serviceaccount:radius-system:dynamic-rp"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/planes/radius/local/resourcegroups/default/providers/Applications.Core/environments/env", nil)
+			req.Header.Set(authentication.AuthorizationHeader, "Bearer "+issuer.Token(t, tt.claims))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			require.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func newTestPodIdentityAuthenticator(t *testing.T) *authentication.PodIdentityAuthenticator {
+	clientset := fake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "bicep-de", Namespace: "radius-system"},
+			Spec:       corev1.PodSpec{ServiceAccountName: "bicep-de"},
+			Status:     corev1.PodStatus{PodIP: "10.0.0.1", Phase: corev1.PodRunning},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "radius-system"},
+			Spec:       corev1.PodSpec{ServiceAccountName: "other"},
+			Status:     corev1.PodStatus{PodIP: "10.0.0.3", Phase: corev1.PodRunning},
+		},
+	)
+
+	authenticator, err := authentication.NewPodIdentityAuthenticator(&hostoptions.PodIdentityOptions{Namespace: "radius-system", ServiceAccounts: []string{"bicep-de"}}, clientset)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.Start(testcontext.New(t)))
+
+	return authenticator
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// ReaderRoleName is the name of the built-in role definition that grants read access to all resources.
+	ReaderRoleName = "reader"
+
+	// ContributorRoleName is the name of the built-in role definition that grants full access to all resources,
+	// except writing and deleting role definitions and role assignments.
+	ContributorRoleName = "contributor"
+
+	// OwnerRoleName is the name of the built-in role definition that grants full access to all resources.
+	OwnerRoleName = "owner"
+)
+
+// builtInRoles are the role definitions available in every plane. They can't be created, updated or deleted.
+var builtInRoles = map[string]datamodel.RoleDefinitionProperties{
+	ReaderRoleName: {
+		Description: "Read access to all resources.",
+		Actions:     []string{"*/" + OperationRead},
+	},
+	ContributorRoleName: {
+		Description: "Full access to all resources, except writing and deleting role definitions and role assignments.",
+		Actions:     []string{"*"},
+		// Role definitions are excluded too, since a contributor could otherwise add role assignment actions to
+		// a custom role assigned to them. Other System.Authorization resources, like locks, are allowed.
+		NotActions: []string{
+			datamodel.RoleAssignmentResourceType + "/" + OperationWrite,
+			datamodel.RoleAssignmentResourceType + "/" + OperationDelete,
+			datamodel.RoleDefinitionResourceType + "/" + OperationWrite,
+			datamodel.RoleDefinitionResourceType + "/" + OperationDelete,
+		},
+	},
+	OwnerRoleName: {
+		Description: "Full access to all resources.",
+		Actions:     []string{"*"},
+	},
+}
+
+// BuiltInRole returns the properties of a built-in role definition by name, and false if the name is not
+// the name of a built-in role definition.
+func BuiltInRole(name string) (datamodel.RoleDefinitionProperties, bool) {
+	role, ok := builtInRoles[strings.ToLower(name)]
+	return role, ok
+}
+
+// Allows returns true if the role definition grants an action.
+func Allows(role datamodel.RoleDefinitionProperties, action string) bool {
+	for _, notAction := range role.NotActions {
+		if MatchAction(notAction, action) {
+			return false
+		}
+	}
+
+	for _, allowed := range role.Actions {
+		if MatchAction(allowed, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchAction returns true if the action matches the pattern. Actions are case-insensitive, and '*' in the
+// pattern matches any sequence of characters.
+func MatchAction(pattern string, action string) bool {
+	pattern = strings.ToLower(pattern)
+	action = strings.ToLower(action)
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == action
+	}
+
+	// The first part must be a prefix, and the last part must be a suffix. The parts in between are matched
+	// greedily from the left.
+	if !strings.HasPrefix(action, parts[0]) {
+		return false
+	}
+	action = action[len(parts[0]):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(action, part)
+		if index < 0 {
+			return false
+		}
+		action = action[index+len(part):]
+	}
+
+	return strings.HasSuffix(action, parts[len(parts)-1])
+}
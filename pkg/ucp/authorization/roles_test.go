@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MatchAction(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		action   string
+		expected bool
+	}{
+		{pattern: "*", action: "Applications.Core/environments/write", expected: true},
+		{pattern: "*/read", action: "Applications.Core/environments/read", expected: true},
+		{pattern: "*/read", action: "Applications.Core/environments/write", expected: false},
+		{pattern: "Applications.Core/environments/write", action: "applications.core/Environments/WRITE", expected: true},
+		{pattern: "Applications.Core/environments/write", action: "Applications.Core/environments/delete", expected: false},
+		{pattern: "Applications.Core/*", action: "Applications.Core/containers/getmetadata/action", expected: true},
+		{pattern: "Applications.Core/*", action: "Applications.Dapr/stateStores/read", expected: false},
+		{pattern: "System.Authorization/*/write", action: "System.Authorization/roleAssignments/write", expected: true},
+		{pattern: "System.Authorization/*/write", action: "System.Authorization/roleAssignments/read", expected: false},
+		{pattern: "*/environments/*", action: "Applications.Core/environments/delete", expected: true},
+		{pattern: "a*b*b", action: "abb", expected: true},
+		{pattern: "ab*b", action: "ab", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.action, func(t *testing.T) {
+			require.Equal(t, tt.expected, MatchAction(tt.pattern, tt.action))
+		})
+	}
+}
+
+func Test_Allows(t *testing.T) {
+	role := datamodel.RoleDefinitionProperties{
+		Actions:    []string{"*/read", "Applications.Core/environments/*"},
+		NotActions: []string{"Applications.Core/environments/delete"},
+	}
+
+	require.True(t, Allows(role, "Applications.Dapr/stateStores/read"))
+	require.True(t, Allows(role, "Applications.Core/environments/write"))
+	require.False(t, Allows(role, "Applications.Core/environments/delete"))
+	require.False(t, Allows(role, "Applications.Core/containers/write"))
+}
+
+func Test_BuiltInRole(t *testing.T) {
+	reader, ok := BuiltInRole("Reader")
+	require.True(t, ok)
+	require.True(t, Allows(reader, "Applications.Core/environments/read"))
+	require.False(t, Allows(reader, "Applications.Core/environments/write"))
+
+	contributor, ok := BuiltInRole(ContributorRoleName)
+	require.True(t, ok)
+	require.True(t, Allows(contributor, "Applications.Core/environments/write"))
+	require.True(t, Allows(contributor, "System.Authorization/roleAssignments/read"))
+	require.False(t, Allows(contributor, "System.Authorization/roleAssignments/write"))
+	require.False(t, Allows(contributor, "System.Authorization/roleAssignments/delete"))
+	require.False(t, Allows(contributor, "System.Authorization/roleDefinitions/delete"))
+	require.True(t, Allows(contributor, "System.Authorization/locks/write"))
+	require.True(t, Allows(contributor, "System.Authorization/locks/delete"))
+
+	owner, ok := BuiltInRole(OwnerRoleName)
+	require.True(t, ok)
+	require.True(t, Allows(owner, "System.Authorization/roleAssignments/write"))
+
+	_, ok = BuiltInRole("environment-operator")
+	require.False(t, ok)
+}
@@ -86,7 +86,8 @@ func (c *APIVersionPutController) updateSummary(id resources.ID, apiVersion *dat
 
 		apiVersionName := id.Name()
 		resourceTypeEntry.APIVersions[apiVersionName] = datamodel.ResourceProviderSummaryPropertiesAPIVersion{
-			Schema: apiVersion.Properties.Schema,
+			Schema:      apiVersion.Properties.Schema,
+			Deprecation: apiVersion.Properties.Deprecation,
 		}
 
 		summary.Properties.ResourceTypes[resourceTypeName] = resourceTypeEntry
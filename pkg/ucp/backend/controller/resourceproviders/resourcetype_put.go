@@ -81,6 +81,7 @@ func (c *ResourceTypePutController) updateSummary(id resources.ID, resourceType
 		resourceTypeEntry.Capabilities = resourceType.Properties.Capabilities
 		resourceTypeEntry.DefaultAPIVersion = resourceType.Properties.DefaultAPIVersion
 		resourceTypeEntry.Description = resourceType.Properties.Description
+		resourceTypeEntry.Deprecation = resourceType.Properties.Deprecation
 		summary.Properties.ResourceTypes[resourceTypeName] = resourceTypeEntry
 		return nil
 	}
@@ -29,6 +29,7 @@ func TestResourceTypePutController_updateSummary(t *testing.T) {
 	resourceType := &datamodel.ResourceType{
 		Properties: datamodel.ResourceTypeProperties{
 			DefaultAPIVersion: new("2025-01-01"),
+			Deprecation:       &datamodel.Deprecation{Replacement: "Applications.Test/newResources"},
 		},
 	}
 
@@ -43,6 +44,7 @@ func TestResourceTypePutController_updateSummary(t *testing.T) {
 			ResourceTypes: map[string]datamodel.ResourceProviderSummaryPropertiesResourceType{
 				"testResources": {
 					DefaultAPIVersion: new("2025-01-01"),
+					Deprecation:       &datamodel.Deprecation{Replacement: "Applications.Test/newResources"},
 				},
 			},
 		},
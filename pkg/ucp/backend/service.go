@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/backend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/ucp/backend/controller/resourceproviders"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -78,6 +79,9 @@ func (w *Service) Run(ctx context.Context) error {
 	w.Service.DatabaseClient = databaseClient
 	w.Service.QueueClient = queueClient
 	w.Service.OperationStatusManager = w.options.StatusManager
+	if w.options.AuditRecorder != nil {
+		w.Service.Options.OutcomeRecorder = audit.NewOutcomeRecorder(w.options.AuditRecorder)
+	}
 
 	opts := ctrl.Options{
 		DatabaseClient: databaseClient,
@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics/metricsservice"
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
@@ -35,6 +36,12 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Audit is the configuration for the audit log of mutating requests and async operations.
+	Audit hostoptions.AuditOptions `yaml:"audit"`
+
+	// Authorization is the configuration for role-based authorization of requests.
+	Authorization AuthorizationConfig `yaml:"authorization"`
+
 	// Database is the configuration for the database used for resource data.
 	Database databaseprovider.Options `yaml:"databaseProvider"`
 
@@ -47,6 +54,9 @@ type Config struct {
 	// Initialization is the configuration for initializing the UCP server.
 	Initialization InitializationConfig `yaml:"initialization"`
 
+	// Kubernetes is the configuration for the Kubernetes client used to authenticate requests. It's optional.
+	Kubernetes kubernetesclientprovider.Options `yaml:"kubernetes"`
+
 	// Logging is the configuration for the logging system.
 	Logging ucplog.LoggingOptions `yaml:"logging"`
 
@@ -86,6 +96,19 @@ const (
 	AuthDefault = "default"
 )
 
+// AuthorizationConfig is the configuration for role-based authorization of requests.
+type AuthorizationConfig struct {
+	// Enabled enables role-based authorization. When disabled, any authenticated principal can perform any request.
+	Enabled bool `yaml:"enabled"`
+
+	// TrustedUsers are the names of the users that are allowed to perform any request, e.g. the service accounts
+	// of the Radius components.
+	TrustedUsers []string `yaml:"trustedUsers,omitempty"`
+
+	// TrustedGroups are the groups whose members are allowed to perform any request.
+	TrustedGroups []string `yaml:"trustedGroups,omitempty"`
+}
+
 // Identity represents configuration options for authenticating with external systems like Azure and AWS.
 type IdentityConfig struct {
 	// AuthMethod represents the method of authentication for authenticating with external systems like Azure and AWS.
@@ -40,4 +40,30 @@ func (r *APIVersion) ResourceTypeName() string {
 type APIVersionProperties struct {
 	// Schema is the schema for the resource type.
 	Schema map[string]any
+
+	// Conversion defines how resources are converted between this API version and the default API version of the
+	// resource type.
+	Conversion *APIVersionConversion
+
+	// Deprecation is the deprecation of the API version. The API version is deprecated when set.
+	Deprecation *Deprecation
+}
+
+// APIVersionConversion stores the rules used to convert resources between an API version and the default API version
+// of the resource type.
+type APIVersionConversion struct {
+	// Renames is the list of fields renamed or moved in the default API version.
+	Renames []FieldRename
+
+	// Defaults is the default values of the fields only defined in the default API version, keyed by field path.
+	Defaults map[string]any
+}
+
+// FieldRename stores a field renamed or moved between an API version and the default API version of the resource type.
+type FieldRename struct {
+	// From is the path of the field in the API version, relative to the resource properties.
+	From string
+
+	// To is the path of the field in the default API version, relative to the resource properties.
+	To string
 }
@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// AuditEventResourceType is the resource type for an audit event.
+	AuditEventResourceType = "System.Audit/events"
+
+	// AuditChainResourceType is the resource type for the head of a chain of audit events.
+	AuditChainResourceType = "System.Audit/chains"
+)
+
+// AuditEventKind is the kind of an audit event.
+type AuditEventKind string
+
+const (
+	// AuditEventKindRequest is the kind of an audit event recorded for a mutating request.
+	AuditEventKindRequest AuditEventKind = "Request"
+
+	// AuditEventKindAsyncOperation is the kind of an audit event recorded for the outcome of an async operation.
+	AuditEventKindAsyncOperation AuditEventKind = "AsyncOperation"
+)
+
+// AuditEvent represents an audit event. Audit events are read-only and form a hash chain: each event stores
+// the hash of the previous event, so modifying or removing an event can be detected.
+type AuditEvent struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the audit event.
+	Properties AuditEventProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (e *AuditEvent) ResourceTypeName() string {
+	return AuditEventResourceType
+}
+
+// AuditEventProperties stores the properties of an audit event.
+type AuditEventProperties struct {
+	// Sequence is the position of the event in its chain, starting at 1.
+	Sequence int64 `json:"sequence"`
+
+	// Timestamp is the time the event was recorded.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Kind is the kind of the event.
+	Kind AuditEventKind `json:"kind"`
+
+	// Principal is the name of the user that made the request. It is empty for async operations.
+	Principal string `json:"principal,omitempty"`
+
+	// Groups are the groups of the user that made the request.
+	Groups []string `json:"groups,omitempty"`
+
+	// Operation is the HTTP method of a request, or the operation type of an async operation.
+	Operation string `json:"operation"`
+
+	// ResourceID is the ID of the resource targeted by the request or async operation.
+	ResourceID string `json:"resourceId"`
+
+	// APIVersion is the api-version of the request or async operation.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// RequestHash is the SHA-256 hash of the request body, hex encoded. It is empty for async operations.
+	RequestHash string `json:"requestHash,omitempty"`
+
+	// StatusCode is the HTTP status code of the response to a request. It is zero for async operations.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// Result is the result of the request ('Succeeded', 'Accepted' or 'Failed') or the final provisioning
+	// state of the async operation.
+	Result string `json:"result"`
+
+	// CorrelationID is the correlation ID of the request. Async operations have the correlation ID of the
+	// request that started them.
+	CorrelationID string `json:"correlationId,omitempty"`
+
+	// PreviousHash is the hash of the previous event in the chain. It is empty for the first event.
+	PreviousHash string `json:"previousHash,omitempty"`
+
+	// Hash is the SHA-256 hash of the event, hex encoded. The hash covers every other property of the event.
+	Hash string `json:"hash"`
+}
+
+// AuditChain stores the head of a chain of audit events.
+type AuditChain struct {
+	// Sequence is the sequence of the last event of the chain.
+	Sequence int64 `json:"sequence"`
+
+	// Hash is the hash of the last event of the chain.
+	Hash string `json:"hash"`
+
+	// Last is the last event of the chain. It's stored with the head so that the event can be stored again when
+	// the recorder that appended it failed to store it.
+	Last *AuditEventProperties `json:"last,omitempty"`
+}
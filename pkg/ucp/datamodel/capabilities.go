@@ -19,4 +19,8 @@ package datamodel
 const (
 	// CapabilityManualResourceProvisioning is a capability that indicates the resource type supports manual resource provisioning.
 	CapabilityManualResourceProvisioning = "ManualResourceProvisioning"
+
+	// CapabilityKubernetesCustomResource is a capability that indicates the resource type is projected into Kubernetes
+	// as a custom resource definition, so that its resources can be managed as Kubernetes objects.
+	CapabilityKubernetesCustomResource = "KubernetesCustomResource"
 )
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Deprecation represents the deprecation of a resource type or API version.
+type Deprecation struct {
+	// Message is the message shown to the users of the deprecated resource type or API version, e.g. how to migrate.
+	Message string `json:"message,omitempty"`
+
+	// SunsetDate is the date after which the resource type or API version is no longer supported.
+	SunsetDate *time.Time `json:"sunsetDate,omitempty"`
+
+	// Replacement is the resource type or API version replacing the deprecated one.
+	Replacement string `json:"replacement,omitempty"`
+
+	// BlockCreateAfterSunset rejects the creation of new resources after the sunset date. Existing resources can
+	// still be updated and deleted.
+	BlockCreateAfterSunset bool `json:"blockCreateAfterSunset,omitempty"`
+}
+
+// IsSunset returns true if the sunset date has passed at the given time.
+func (d *Deprecation) IsSunset(now time.Time) bool {
+	return d != nil && d.SunsetDate != nil && !now.Before(*d.SunsetDate)
+}
+
+// BlocksCreate returns true if the creation of new resources is rejected at the given time.
+func (d *Deprecation) BlocksCreate(now time.Time) bool {
+	return d.IsSunset(now) && d.BlockCreateAfterSunset
+}
+
+// Warning returns the warning shown to the users of the deprecated subject at the given time, where subject
+// describes what is deprecated, e.g. "Resource type Applications.Test/exampleResources".
+func (d *Deprecation) Warning(subject string, now time.Time) string {
+	if d == nil {
+		return ""
+	}
+
+	b := strings.Builder{}
+	b.WriteString(subject)
+	b.WriteString(" is deprecated")
+	if d.SunsetDate != nil {
+		if d.IsSunset(now) {
+			fmt.Fprintf(&b, " and was sunset on %s", d.SunsetDate.UTC().Format(time.DateOnly))
+		} else {
+			fmt.Fprintf(&b, " and will be sunset on %s", d.SunsetDate.UTC().Format(time.DateOnly))
+		}
+	}
+	b.WriteString(".")
+
+	if d.Replacement != "" {
+		fmt.Fprintf(&b, " Use %s instead.", d.Replacement)
+	}
+	if d.Message != "" {
+		b.WriteString(" ")
+		b.WriteString(d.Message)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecation_IsSunset(t *testing.T) {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var nilDeprecation *Deprecation
+	require.False(t, nilDeprecation.IsSunset(sunset))
+	require.False(t, nilDeprecation.BlocksCreate(sunset))
+
+	require.False(t, (&Deprecation{}).IsSunset(sunset))
+
+	deprecation := &Deprecation{SunsetDate: &sunset}
+	require.False(t, deprecation.IsSunset(sunset.Add(-time.Second)))
+	require.True(t, deprecation.IsSunset(sunset))
+	require.False(t, deprecation.BlocksCreate(sunset))
+
+	deprecation.BlockCreateAfterSunset = true
+	require.False(t, deprecation.BlocksCreate(sunset.Add(-time.Second)))
+	require.True(t, deprecation.BlocksCreate(sunset))
+}
+
+func TestDeprecation_Warning(t *testing.T) {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	subject := "Resource type Applications.Test/oldResources"
+
+	tests := []struct {
+		name        string
+		deprecation *Deprecation
+		now         time.Time
+		expected    string
+	}{
+		{
+			name:        "nil",
+			deprecation: nil,
+			expected:    "",
+		},
+		{
+			name:        "deprecated",
+			deprecation: &Deprecation{},
+			expected:    "Resource type Applications.Test/oldResources is deprecated.",
+		},
+		{
+			name: "before sunset",
+			deprecation: &Deprecation{
+				SunsetDate:  &sunset,
+				Replacement: "Applications.Test/newResources",
+				Message:     "See the migration guide.",
+			},
+			now:      sunset.Add(-24 * time.Hour),
+			expected: "Resource type Applications.Test/oldResources is deprecated and will be sunset on 2026-01-01. Use Applications.Test/newResources instead. See the migration guide.",
+		},
+		{
+			name:        "after sunset",
+			deprecation: &Deprecation{SunsetDate: &sunset},
+			now:         sunset.Add(24 * time.Hour),
+			expected:    "Resource type Applications.Test/oldResources is deprecated and was sunset on 2026-01-01.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.deprecation.Warning(subject, tt.now))
+		})
+	}
+}
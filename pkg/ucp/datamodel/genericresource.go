@@ -62,6 +62,8 @@ type GenericResourceProperties struct {
 	Name string `json:"name"`
 	// Type is the resource type.
 	Type string `json:"type"`
+	// Tags are the tags of the resource. Tags are stored so that resources can be listed by tag.
+	Tags map[string]string `json:"tags,omitempty"`
 
 	// APIVersion is the version of the API that can be used to query the resource.
 	APIVersion string `json:"apiVersion"`
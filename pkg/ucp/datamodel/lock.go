@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// LockResourceType is the resource type for a management lock.
+	LockResourceType = "System.Authorization/locks"
+)
+
+// LockLevel is the level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete prevents the locked scope from being deleted. The scope can still be updated.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+
+	// LockLevelReadOnly prevents the locked scope from being updated or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// Lock represents a management lock. A lock is an extension resource of the resource group or resource it
+// protects, and applies to the resources the scope contains.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the lock.
+	Properties LockProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (l *Lock) ResourceTypeName() string {
+	return LockResourceType
+}
+
+// LockProperties stores the properties of a lock.
+type LockProperties struct {
+	// Level is the level of the lock.
+	Level LockLevel `json:"level"`
+
+	// Notes describes why the scope is locked.
+	Notes string `json:"notes,omitempty"`
+}
+
+// IsLock returns true if the ID is the ID of a lock or a collection of locks.
+func IsLock(id resources.ID) bool {
+	return strings.EqualFold(id.Type(), LockResourceType)
+}
+
+// LockScope returns the scope a lock applies to: the resource of a lock on a resource, or the resource group
+// of a lock on a resource group.
+//
+// Examples:
+//
+//	/planes/radius/local/resourceGroups/rg/providers/System.Authorization/locks/my-lock
+//	=> /planes/radius/local/resourceGroups/rg
+//	/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env/providers/System.Authorization/locks/my-lock
+//	=> /planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env
+func LockScope(id resources.ID) string {
+	if len(id.ExtensionSegments()) > 0 {
+		return id.ParentResource()
+	}
+
+	return id.RootScope()
+}
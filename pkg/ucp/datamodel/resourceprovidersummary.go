@@ -78,6 +78,9 @@ type ResourceProviderSummaryPropertiesResourceType struct {
 	//Description of the resource type.
 	Description *string `json:"description,omitempty"`
 
+	// Deprecation is the deprecation of the resource type.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+
 	// APIVersions is the list of API versions available for the resource type.
 	APIVersions map[string]ResourceProviderSummaryPropertiesAPIVersion `json:"apiVersions,omitempty"`
 }
@@ -86,4 +89,7 @@ type ResourceProviderSummaryPropertiesResourceType struct {
 type ResourceProviderSummaryPropertiesAPIVersion struct {
 	// Schema holds the resource type definitions for this API version.
 	Schema map[string]any `json:"schema,omitempty"`
+
+	// Deprecation is the deprecation of the API version.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
 }
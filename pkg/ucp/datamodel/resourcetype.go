@@ -49,4 +49,48 @@ type ResourceTypeProperties struct {
 
 	// Description of the resource type.
 	Description *string `json:"description,omitempty"`
+
+	// Actions are the actions that can be invoked on resources of this type, keyed by action name.
+	Actions map[string]ResourceTypeAction `json:"actions,omitempty"`
+
+	// Deprecation is the deprecation of the resource type. The resource type is deprecated when set.
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+}
+
+// ResourceTypeAction represents an action that can be invoked on resources of a resource type.
+//
+// An action is implemented by either a recipe or an HTTP callback.
+type ResourceTypeAction struct {
+	// Description of the action.
+	Description string `json:"description,omitempty"`
+
+	// InputSchema is the OpenAPI schema of the action input.
+	InputSchema map[string]any `json:"inputSchema,omitempty"`
+
+	// OutputSchema is the OpenAPI schema of the action output.
+	OutputSchema map[string]any `json:"outputSchema,omitempty"`
+
+	// Recipe is the recipe implementing the action.
+	Recipe *ActionRecipe `json:"recipe,omitempty"`
+
+	// Callback is the HTTP callback implementing the action.
+	Callback *ActionCallback `json:"callback,omitempty"`
+}
+
+// ActionRecipe represents the recipe implementing an action.
+type ActionRecipe struct {
+	// TemplateKind is the kind of the recipe template, e.g. bicep or terraform.
+	TemplateKind string `json:"templateKind"`
+
+	// TemplatePath is the path to the recipe template.
+	TemplatePath string `json:"templatePath"`
+
+	// TemplateVersion is the version of the Terraform module.
+	TemplateVersion string `json:"templateVersion,omitempty"`
+}
+
+// ActionCallback represents the HTTP callback implementing an action.
+type ActionCallback struct {
+	// URL is the URL the action invocation is posted to.
+	URL string `json:"url"`
 }
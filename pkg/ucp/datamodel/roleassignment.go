@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// RoleAssignmentResourceType is the resource type for a role assignment.
+	RoleAssignmentResourceType = "System.Authorization/roleAssignments"
+)
+
+// PrincipalType is the type of the principal of a role assignment.
+type PrincipalType string
+
+const (
+	// PrincipalTypeUser is the principal type of a user.
+	PrincipalTypeUser PrincipalType = "User"
+
+	// PrincipalTypeGroup is the principal type of a group of users.
+	PrincipalTypeGroup PrincipalType = "Group"
+)
+
+// RoleAssignment represents a role assignment. A role assignment grants the actions of a role definition to a
+// principal at a scope. Role assignments are created in a plane, or as extension resources of a resource group or
+// resource, so that the principals allowed to write role assignments at a resource group can delegate access to it.
+type RoleAssignment struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role assignment.
+	Properties RoleAssignmentProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *RoleAssignment) ResourceTypeName() string {
+	return RoleAssignmentResourceType
+}
+
+// RoleAssignmentProperties stores the properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// PrincipalID is the name of the user or group the role is assigned to.
+	PrincipalID string `json:"principalId"`
+
+	// PrincipalType is the type of the principal the role is assigned to.
+	PrincipalType PrincipalType `json:"principalType"`
+
+	// RoleDefinitionID is the resource ID of the role definition.
+	RoleDefinitionID string `json:"roleDefinitionId"`
+
+	// Scope is the plane, resource group or resource the role is assigned at. It must be the scope the role
+	// assignment is created at, or a resource group or resource it contains.
+	Scope string `json:"scope"`
+}
+
+// IsRoleAssignment returns true if the ID is the ID of a role assignment or a collection of role assignments.
+func IsRoleAssignment(id resources.ID) bool {
+	return strings.EqualFold(id.Type(), RoleAssignmentResourceType)
+}
+
+// RoleAssignmentScope returns the scope a role assignment is created at: the resource of a role assignment on a
+// resource, or the plane or resource group of other role assignments.
+//
+// Examples:
+//
+//	/planes/radius/local/providers/System.Authorization/roleAssignments/team-a
+//	=> /planes/radius/local
+//	/planes/radius/local/resourceGroups/rg/providers/System.Authorization/roleAssignments/team-a
+//	=> /planes/radius/local/resourceGroups/rg
+//	/planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env/providers/System.Authorization/roleAssignments/team-a
+//	=> /planes/radius/local/resourceGroups/rg/providers/Applications.Core/environments/env
+func RoleAssignmentScope(id resources.ID) string {
+	if len(id.ExtensionSegments()) > 0 {
+		return id.ParentResource()
+	}
+
+	return id.RootScope()
+}
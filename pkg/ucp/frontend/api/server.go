@@ -19,19 +19,26 @@ package api
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/authentication"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
 	"github.com/radius-project/radius/pkg/components/hosting"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
@@ -45,6 +52,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
+	"k8s.io/client-go/kubernetes"
 )
 
 // Service implements the hosting.Service interface for the UCP frontend API.
@@ -99,6 +107,76 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 	}
 
 	app := http.Handler(r)
+	if s.options.Config.Authorization.Enabled {
+		db, err := s.options.DatabaseProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authorizer := &authorization.Authorizer{
+			DatabaseClient: db,
+			TrustedUsers:   s.options.Config.Authorization.TrustedUsers,
+			TrustedGroups:  s.options.Config.Authorization.TrustedGroups,
+		}
+		app = authorization.Middleware(authorizer, s.options.Config.Server.PathBase)(app)
+	}
+
+	// Audit events are recorded for requests denied by authorization too.
+	if s.options.AuditRecorder != nil {
+		app = audit.Middleware(s.options.AuditRecorder, s.options.Config.Server.PathBase)(app)
+	}
+
+	// In-cluster callers without credentials, like the deployment engine, are authenticated by the
+	// service account of their pod.
+	if s.options.Config.Server.PodIdentity != nil {
+		clientset, err := s.kubernetesClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure pod identity authentication: %w", err)
+		}
+		authenticator, err := authentication.NewPodIdentityAuthenticator(s.options.Config.Server.PodIdentity, clientset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure pod identity authentication: %w", err)
+		}
+		err = authenticator.Start(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure pod identity authentication: %w", err)
+		}
+		app = authentication.PodIdentityValidator(authenticator)(app)
+	}
+
+	// Bearer tokens are validated before auditing and authorization so that the authenticated
+	// identity replaces any principal supplied by the Kubernetes aggregator.
+	if s.options.Config.Server.AuthType == hostoptions.OIDCAuthType {
+		authenticator, err := authentication.NewOIDCAuthenticator(s.options.Config.Server.OIDC, &http.Client{Timeout: 30 * time.Second})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure OIDC authentication: %w", err)
+		}
+		app = authentication.BearerTokenValidator(authenticator)(app)
+	}
+
+	// The user headers of the Kubernetes aggregator are only trusted on connections with its client certificate,
+	// and are removed from every other request.
+	var tlsConfig *tls.Config
+	var requestHeaderAuthenticator *authentication.RequestHeaderAuthenticator
+	if s.options.Config.Server.RequestHeader != nil {
+		var clientset kubernetes.Interface
+		if s.options.Config.Server.RequestHeader.ClientCAFile == "" {
+			clientset, err = s.kubernetesClient()
+			if err != nil {
+				return nil, fmt.Errorf("failed to configure request header authentication: %w", err)
+			}
+		}
+
+		requestHeaderAuthenticator, err = authentication.LoadRequestHeaderAuthenticator(ctx, s.options.Config.Server.RequestHeader, clientset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure request header authentication: %w", err)
+		}
+
+		// Client certificates are verified by the authenticator, so that callers without one can still connect.
+		tlsConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
+	}
+	app = authentication.RequestHeaderValidator(requestHeaderAuthenticator)(app)
+
 	app = servicecontext.ARMRequestCtx(s.options.Config.Server.PathBase, s.options.Config.Environment.RoleLocation)(app)
 	app = middleware.WithLogger(app)
 
@@ -118,14 +196,25 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 		// AWS SDK is case sensitive. Therefore, cannot use lowercase middleware. Therefore, introducing a new middleware that translates
 		// the path for only these segments and preserves the case for the other parts of the path.
 		// TODO: https://github.com/radius-project/radius/issues/5921
-		Handler: app,
+		Handler:   app,
+		TLSConfig: tlsConfig,
 		BaseContext: func(ln net.Listener) context.Context {
 			return ctx
 		},
+		ConnContext: authentication.ConnContext,
 	}
 	return server, nil
 }
 
+// kubernetesClient returns the Kubernetes client used to authenticate requests.
+func (s *Service) kubernetesClient() (kubernetes.Interface, error) {
+	if s.options.KubernetesProvider == nil {
+		return nil, errors.New("the Kubernetes client is not configured")
+	}
+
+	return s.options.KubernetesProvider.ClientGoClient()
+}
+
 // configureDefaultPlanes reads the configuration file specified by the env var to configure default planes into UCP
 func (s *Service) configureDefaultPlanes(ctx context.Context) error {
 	for _, plane := range s.options.Config.Initialization.Planes {
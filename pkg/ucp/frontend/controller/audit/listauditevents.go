@@ -0,0 +1,246 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	ucp_audit "github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ResourceQueryParameter is the query parameter used to filter audit events by resource.
+	ResourceQueryParameter = "resource"
+
+	// SinceQueryParameter is the query parameter used to filter audit events by time.
+	SinceQueryParameter = "since"
+
+	// maxScannedEvents is the maximum number of events read for a page. A page has fewer events than requested
+	// when the events are filtered by resource, and the next page continues after the last event read.
+	maxScannedEvents = 200
+)
+
+var _ armrpc_controller.Controller = (*ListAuditEvents)(nil)
+
+// ListAuditEvents is the controller implementation to list the audit events of a plane.
+type ListAuditEvents struct {
+	armrpc_controller.Operation[*datamodel.AuditEvent, datamodel.AuditEvent]
+
+	chain *ucp_audit.Chain
+}
+
+// NewListAuditEvents creates a new controller for listing audit events. The events are verified with the chain,
+// which is nil when auditing is disabled.
+func NewListAuditEvents(opts armrpc_controller.Options, chain *ucp_audit.Chain) (armrpc_controller.Controller, error) {
+	return &ListAuditEvents{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.AuditEvent]{
+				RequestConverter:  converter.AuditEventDataModelFromVersioned,
+				ResponseConverter: converter.AuditEventDataModelToVersioned,
+			},
+		),
+		chain: chain,
+	}, nil
+}
+
+// Run implements controller.Controller.
+//
+// The events are returned from the most recent to the oldest, a page at a time. They can be filtered by the resource
+// they target, which includes the resources it contains, and by the time they were recorded. Listing stops at the
+// first event recorded before the time, so older events are not read.
+//
+// The events are read by sequence from the head of the chain, and every event read is verified against the event
+// that follows it, so that modified and removed events are reported even when they are filtered out. The most recent
+// event is verified against the head of the chain, and the first event of the chain must have sequence 1.
+func (r *ListAuditEvents) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The events can't be verified without the key of the chain.
+	if r.chain == nil {
+		return armrpc_rest.NewNotFoundMessageResponse("the audit log is not enabled"), nil
+	}
+
+	resource := strings.TrimSuffix(req.URL.Query().Get(ResourceQueryParameter), resources.SegmentSeparator)
+
+	var since time.Time
+	if value := req.URL.Query().Get(SinceQueryParameter); value != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("the value of %q must be a RFC 3339 date-time: %q", SinceQueryParameter, value)), nil
+		}
+	}
+
+	scope := serviceCtx.ResourceID.RootScope()
+	items := v1.PaginatedList{
+		Value: []any{}, // Initialize to empty list for testability
+	}
+
+	obj, err := r.DatabaseClient().Get(ctx, ucp_audit.ChainID(scope))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewOKResponse(&items), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	head := datamodel.AuditChain{}
+	if err := obj.As(&head); err != nil {
+		return nil, err
+	}
+
+	// The skip token is the sequence of the next event to read. The event that follows it was returned by the
+	// previous page, and is read again to verify the chain across pages.
+	sequence := head.Sequence
+	var next *datamodel.AuditEventProperties
+	if serviceCtx.SkipToken != "" {
+		sequence, err = strconv.ParseInt(serviceCtx.SkipToken, 10, 64)
+		if err != nil || sequence < 1 || sequence >= head.Sequence {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("invalid skip token %q", serviceCtx.SkipToken)), nil
+		}
+
+		next, err = r.getEvent(ctx, scope, head, sequence+1)
+		if err != nil {
+			return nil, err
+		} else if next == nil {
+			return newChainBrokenResponse(fmt.Errorf("audit event %d is missing", sequence+1)), nil
+		}
+	}
+
+	for scanned := 0; sequence > 0 && scanned < maxScannedEvents && len(items.Value) < serviceCtx.Top; scanned++ {
+		event, err := r.getEvent(ctx, scope, head, sequence)
+		if err != nil {
+			return nil, err
+		} else if event == nil {
+			return newChainBrokenResponse(fmt.Errorf("audit event %d is missing", sequence)), nil
+		}
+
+		if err := r.chain.VerifyHash(event); err != nil {
+			return newChainBrokenResponse(err), nil
+		}
+		if next == nil {
+			// The most recent event is anchored at the head of the chain.
+			if event.Sequence != sequence || event.Hash != head.Hash {
+				return newChainBrokenResponse(fmt.Errorf("audit event %d does not match the head of the audit chain", sequence)), nil
+			}
+		} else if err := r.chain.VerifyEvent(event, next); err != nil {
+			return newChainBrokenResponse(err), nil
+		}
+		if sequence == 1 {
+			if err := r.chain.VerifyEvent(nil, event); err != nil {
+				return newChainBrokenResponse(err), nil
+			}
+		}
+		next = event
+		sequence--
+
+		if !since.IsZero() && event.Timestamp.Before(since) {
+			sequence = 0
+			break
+		}
+
+		if resource != "" && !matchesResource(event.ResourceID, resource) {
+			continue
+		}
+
+		versioned, err := converter.AuditEventDataModelToVersioned(ucp_audit.NewEventResource(scope, event), serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		items.Value = append(items.Value, versioned)
+	}
+
+	if sequence > 0 {
+		items.NextLink = getNextLinkURL(ctx, req, strconv.FormatInt(sequence, 10))
+	}
+
+	return armrpc_rest.NewOKResponse(&items), nil
+}
+
+// getEvent reads the event with the sequence, or returns nil if the event is missing. The most recent event may not
+// be stored yet if the recorder that appended it failed, in which case it's read from the head of the chain.
+func (r *ListAuditEvents) getEvent(ctx context.Context, scope string, head datamodel.AuditChain, sequence int64) (*datamodel.AuditEventProperties, error) {
+	obj, err := r.DatabaseClient().Get(ctx, ucp_audit.EventID(scope, sequence))
+	if errors.Is(err, &database.ErrNotFound{}) {
+		if head.Last != nil && head.Last.Sequence == sequence {
+			return head.Last, nil
+		}
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	event := datamodel.AuditEvent{}
+	if err := obj.As(&event); err != nil {
+		return nil, err
+	}
+
+	return &event.Properties, nil
+}
+
+// getNextLinkURL returns the URL of the next page, which is filtered the same way as the request.
+func getNextLinkURL(ctx context.Context, req *http.Request, skipToken string) string {
+	next, err := url.Parse(armrpc_controller.GetNextLinkURL(ctx, req, skipToken))
+	if err != nil {
+		return ""
+	}
+
+	qps := next.Query()
+	for _, key := range []string{ResourceQueryParameter, SinceQueryParameter} {
+		if value := req.URL.Query().Get(key); value != "" {
+			qps.Set(key, value)
+		}
+	}
+	next.RawQuery = qps.Encode()
+
+	return next.String()
+}
+
+// newChainBrokenResponse creates the response reporting that the chain of audit events is broken, which means that
+// events were modified or removed.
+func newChainBrokenResponse(err error) armrpc_rest.Response {
+	return armrpc_rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeAuditChainBroken,
+			Message: fmt.Sprintf("the audit log has been tampered with: %s", err.Error()),
+		},
+	})
+}
+
+// matchesResource returns true if the resource ID is the resource or one of the resources it contains.
+// Resource IDs are compared case-insensitively.
+func matchesResource(id string, resource string) bool {
+	if strings.EqualFold(id, resource) {
+		return true
+	}
+
+	prefix := strings.ToLower(resource + resources.SegmentSeparator)
+	return strings.HasPrefix(strings.ToLower(id), prefix)
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_audit "github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	planeID       = "/planes/radius/local"
+	environmentID = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"
+	containerID   = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/frontend"
+)
+
+// testKey is the secret key of the audit chain of the tests.
+var testKey = []byte(strings.Repeat("k", ucp_audit.MinKeySize))
+
+func newTestChain(t *testing.T) *ucp_audit.Chain {
+	chain, err := ucp_audit.NewChain(testKey)
+	require.NoError(t, err)
+	return chain
+}
+
+func Test_ListAuditEvents(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		expected []int64
+	}{
+		{
+			name:     "all events from the most recent",
+			query:    url.Values{},
+			expected: []int64{4, 3, 2, 1},
+		},
+		{
+			name:     "resource and contained resources",
+			query:    url.Values{ResourceQueryParameter: {"/PLANES/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod/"}},
+			expected: []int64{2, 1},
+		},
+		{
+			name:     "since",
+			query:    url.Values{SinceQueryParameter: {start.Add(time.Hour).Format(time.RFC3339)}},
+			expected: []int64{4, 3, 2},
+		},
+		{
+			name: "resource and since",
+			query: url.Values{
+				ResourceQueryParameter: {environmentID},
+				SinceQueryParameter:    {start.Add(30 * time.Minute).Format(time.RFC3339)},
+			},
+			expected: []int64{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient, ctrl := setupListAuditEvents(t)
+			recordEvents(t, databaseClient,
+				newEvent(start, environmentID),
+				newEvent(start.Add(time.Hour), environmentID+"/extenders/ignored"),
+				newEvent(start.Add(2*time.Hour), containerID),
+				newEvent(start.Add(3*time.Hour), environmentID+"-staging"),
+			)
+
+			list := listAuditEvents(t, ctrl, tt.query)
+			require.Equal(t, tt.expected, sequences(list))
+			require.Empty(t, list.NextLink)
+		})
+	}
+
+	t.Run("no events", func(t *testing.T) {
+		_, ctrl := setupListAuditEvents(t)
+
+		list := listAuditEvents(t, ctrl, url.Values{})
+		require.Empty(t, list.Value)
+		require.Empty(t, list.NextLink)
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		databaseClient, ctrl := setupListAuditEvents(t)
+		events := []*datamodel.AuditEventProperties{}
+		for i := range 12 {
+			events = append(events, newEvent(start.Add(time.Duration(i)*time.Minute), environmentID))
+		}
+		recordEvents(t, databaseClient, events...)
+
+		list := listAuditEvents(t, ctrl, url.Values{"top": {"5"}, ResourceQueryParameter: {environmentID}})
+		require.Equal(t, []int64{12, 11, 10, 9, 8}, sequences(list))
+
+		next, err := url.Parse(list.NextLink)
+		require.NoError(t, err)
+		require.Equal(t, "7", next.Query().Get("skipToken"))
+		require.Equal(t, environmentID, next.Query().Get(ResourceQueryParameter))
+
+		list = listAuditEvents(t, ctrl, next.Query())
+		require.Equal(t, []int64{7, 6, 5, 4, 3}, sequences(list))
+
+		next, err = url.Parse(list.NextLink)
+		require.NoError(t, err)
+
+		list = listAuditEvents(t, ctrl, next.Query())
+		require.Equal(t, []int64{2, 1}, sequences(list))
+		require.Empty(t, list.NextLink)
+	})
+
+	t.Run("last event is not stored", func(t *testing.T) {
+		databaseClient, ctrl := setupListAuditEvents(t)
+		recordEvents(t, databaseClient, newEvent(start, environmentID), newEvent(start.Add(time.Hour), containerID))
+
+		err := databaseClient.Delete(testcontext.New(t), ucp_audit.EventID(planeID, 2))
+		require.NoError(t, err)
+
+		list := listAuditEvents(t, ctrl, url.Values{})
+		require.Equal(t, []int64{2, 1}, sequences(list))
+	})
+
+	t.Run("modified event", func(t *testing.T) {
+		databaseClient, ctrl := setupListAuditEvents(t)
+		recordEvents(t, databaseClient, newEvent(start, environmentID), newEvent(start.Add(time.Hour), containerID), newEvent(start.Add(2*time.Hour), containerID))
+
+		event := ucp_audit.NewEventResource(planeID, newEvent(start, environmentID))
+		obj, err := databaseClient.Get(testcontext.New(t), ucp_audit.EventID(planeID, 1))
+		require.NoError(t, err)
+		require.NoError(t, obj.As(event))
+		event.Properties.Result = "Failed"
+		err = databaseClient.Save(testcontext.New(t), &database.Object{Metadata: database.Metadata{ID: event.ID}, Data: event})
+		require.NoError(t, err)
+
+		// The modified event is reported even though it's filtered out.
+		response := runListAuditEvents(t, ctrl, url.Values{ResourceQueryParameter: {containerID}})
+		require.Equal(t, newChainBrokenResponse(errors.New("the hash of audit event 1 does not match its content")), response)
+	})
+
+	t.Run("rewritten chain", func(t *testing.T) {
+		databaseClient, ctrl := setupListAuditEvents(t)
+
+		// A writer with access to the database but not to the key records a chain with another key.
+		other, err := ucp_audit.NewChain([]byte(strings.Repeat("o", ucp_audit.MinKeySize)))
+		require.NoError(t, err)
+		recorder := ucp_audit.NewDatabaseRecorder(other, databaseClient, planeID)
+		require.NoError(t, recorder.Record(testcontext.New(t), newEvent(start, environmentID)))
+
+		response := runListAuditEvents(t, ctrl, url.Values{})
+		require.Equal(t, newChainBrokenResponse(errors.New("the hash of audit event 1 does not match its content")), response)
+	})
+
+	t.Run("audit disabled", func(t *testing.T) {
+		c, err := NewListAuditEvents(armrpc_controller.Options{DatabaseClient: inmemory.NewClient()}, nil)
+		require.NoError(t, err)
+
+		response := runListAuditEvents(t, c.(*ListAuditEvents), url.Values{})
+		require.Equal(t, armrpc_rest.NewNotFoundMessageResponse("the audit log is not enabled"), response)
+	})
+
+	t.Run("removed event", func(t *testing.T) {
+		databaseClient, ctrl := setupListAuditEvents(t)
+		recordEvents(t, databaseClient, newEvent(start, environmentID), newEvent(start.Add(time.Hour), containerID), newEvent(start.Add(2*time.Hour), containerID))
+
+		err := databaseClient.Delete(testcontext.New(t), ucp_audit.EventID(planeID, 2))
+		require.NoError(t, err)
+
+		response := runListAuditEvents(t, ctrl, url.Values{})
+		require.Equal(t, newChainBrokenResponse(errors.New("audit event 2 is missing")), response)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		_, ctrl := setupListAuditEvents(t)
+
+		response := runListAuditEvents(t, ctrl, url.Values{SinceQueryParameter: {"yesterday"}})
+		expected := armrpc_rest.NewBadRequestResponse(`the value of "since" must be a RFC 3339 date-time: "yesterday"`)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("invalid skip token", func(t *testing.T) {
+		databaseClient, ctrl := setupListAuditEvents(t)
+		recordEvents(t, databaseClient, newEvent(start, environmentID))
+
+		response := runListAuditEvents(t, ctrl, url.Values{"skipToken": {"event-1"}})
+		expected := armrpc_rest.NewBadRequestResponse(`invalid skip token "event-1"`)
+		require.Equal(t, expected, response)
+	})
+}
+
+func newEvent(timestamp time.Time, resourceID string) *datamodel.AuditEventProperties {
+	return &datamodel.AuditEventProperties{
+		Timestamp:  timestamp,
+		Kind:       datamodel.AuditEventKindRequest,
+		Operation:  http.MethodPut,
+		ResourceID: resourceID,
+		Result:     "Succeeded",
+	}
+}
+
+func recordEvents(t *testing.T, databaseClient database.Client, events ...*datamodel.AuditEventProperties) {
+	recorder := ucp_audit.NewDatabaseRecorder(newTestChain(t), databaseClient, planeID)
+	for _, event := range events {
+		err := recorder.Record(testcontext.New(t), event)
+		require.NoError(t, err)
+	}
+}
+
+func runListAuditEvents(t *testing.T, ctrl *ListAuditEvents, query url.Values) armrpc_rest.Response {
+	query.Set("api-version", v20231001preview.Version)
+	request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+planeID+"/providers/System.Audit/events?"+query.Encode(), nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(request)
+	response, err := ctrl.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	return response
+}
+
+func listAuditEvents(t *testing.T, ctrl *ListAuditEvents, query url.Values) *v1.PaginatedList {
+	response := runListAuditEvents(t, ctrl, query)
+	ok, isOK := response.(*armrpc_rest.OKResponse)
+	require.True(t, isOK, "unexpected response: %+v", response)
+
+	return ok.Body.(*v1.PaginatedList)
+}
+
+func sequences(list *v1.PaginatedList) []int64 {
+	sequences := []int64{}
+	for _, item := range list.Value {
+		sequences = append(sequences, *item.(*v20231001preview.AuditEventResource).Properties.Sequence)
+	}
+	return sequences
+}
+
+func setupListAuditEvents(t *testing.T) (*inmemory.Client, *ListAuditEvents) {
+	databaseClient := inmemory.NewClient()
+
+	c, err := NewListAuditEvents(armrpc_controller.Options{DatabaseClient: databaseClient, PathBase: "/" + uuid.New().String()}, newTestChain(t))
+	require.NoError(t, err)
+
+	return databaseClient, c.(*ListAuditEvents)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	http "net/http"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ armrpc_controller.Controller = (*ListLocks)(nil)
+
+// ListLocks is the controller implementation to list the locks of a resource group or resource.
+type ListLocks struct {
+	armrpc_controller.Operation[*datamodel.Lock, datamodel.Lock]
+}
+
+// NewListLocks creates a new controller for listing locks.
+func NewListLocks(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListLocks{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.Lock]{
+				RequestConverter:  converter.LockDataModelFromVersioned,
+				ResponseConverter: converter.LockDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run implements controller.Controller.
+//
+// The locks of a resource group include the locks of the resources it contains. The locks of a resource include the
+// locks of its child resources.
+func (r *ListLocks) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	scope := datamodel.LockScope(serviceCtx.ResourceID)
+
+	// Locks are stored at the root scope of the resource group or resource they're applied to.
+	query := database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: datamodel.LockResourceType,
+	}
+
+	result, err := r.DatabaseClient().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	locks := []datamodel.Lock{}
+	for _, item := range result.Items {
+		lock := datamodel.Lock{}
+		if err := item.As(&lock); err != nil {
+			return nil, err
+		}
+
+		id, err := resources.Parse(lock.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matchesScope(datamodel.LockScope(id), scope) {
+			continue
+		}
+
+		locks = append(locks, lock)
+	}
+
+	slices.SortFunc(locks, func(a, b datamodel.Lock) int {
+		return strings.Compare(strings.ToLower(a.ID), strings.ToLower(b.ID))
+	})
+
+	items := v1.PaginatedList{
+		Value: []any{}, // Initialize to empty list for testability
+	}
+	for i := range locks {
+		versioned, err := converter.LockDataModelToVersioned(&locks[i], serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		items.Value = append(items.Value, versioned)
+	}
+
+	return armrpc_rest.NewOKResponse(&items), nil
+}
+
+// matchesScope returns true if the ID is the scope or one of the resources it contains. Resource IDs are compared
+// case-insensitively.
+func matchesScope(id string, scope string) bool {
+	if strings.EqualFold(id, scope) {
+		return true
+	}
+
+	prefix := strings.ToLower(scope + resources.SegmentSeparator)
+	return strings.HasPrefix(strings.ToLower(id), prefix)
+}
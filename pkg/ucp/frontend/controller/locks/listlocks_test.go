@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	resourceGroupID = "/planes/radius/local/resourceGroups/prod"
+	applicationID   = resourceGroupID + "/providers/Applications.Core/applications/app"
+	containerID     = resourceGroupID + "/providers/Applications.Core/containers/frontend"
+	locksPath       = "/providers/System.Authorization/locks"
+)
+
+func Test_ListLocks(t *testing.T) {
+	locks := []datamodel.Lock{
+		newLock(containerID + locksPath + "/container-lock"),
+		newLock(resourceGroupID + locksPath + "/group-lock"),
+		newLock(applicationID + locksPath + "/app-lock"),
+		newLock(applicationID + "-staging" + locksPath + "/staging-lock"),
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		expected []string
+	}{
+		{
+			name:     "resource group and contained resources",
+			scope:    resourceGroupID,
+			expected: []string{"staging-lock", "app-lock", "container-lock", "group-lock"},
+		},
+		{
+			name:     "resource",
+			scope:    applicationID,
+			expected: []string{"app-lock"},
+		},
+		{
+			name:     "resource without locks",
+			scope:    resourceGroupID + "/providers/Applications.Core/environments/prod",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient, ctrl := setupListLocks(t)
+
+			items := []database.Object{}
+			for _, lock := range locks {
+				items = append(items, database.Object{Data: lock})
+			}
+
+			expectedQuery := database.Query{RootScope: resourceGroupID, ResourceType: datamodel.LockResourceType}
+			databaseClient.EXPECT().
+				Query(gomock.Any(), expectedQuery).
+				Return(&database.ObjectQueryResult{Items: items}, nil).
+				Times(1)
+
+			request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+tt.scope+locksPath+"?api-version="+v20231001preview.Version, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(request)
+			response, err := ctrl.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			ok, isOK := response.(*armrpc_rest.OKResponse)
+			require.True(t, isOK)
+			list := ok.Body.(*v1.PaginatedList)
+
+			names := []string{}
+			for _, item := range list.Value {
+				names = append(names, *item.(*v20231001preview.LockResource).Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func newLock(id string) datamodel.Lock {
+	return datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: id[strings.LastIndex(id, "/")+1:],
+				Type: datamodel.LockResourceType,
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level: datamodel.LockLevelCanNotDelete,
+		},
+	}
+}
+
+func setupListLocks(t *testing.T) (*database.MockClient, *ListLocks) {
+	ctrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(ctrl)
+
+	c, err := NewListLocks(armrpc_controller.Options{DatabaseClient: databaseClient, PathBase: "/" + uuid.New().String()})
+	require.NoError(t, err)
+
+	return databaseClient, c.(*ListLocks)
+}
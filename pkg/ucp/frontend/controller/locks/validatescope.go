@@ -0,0 +1,45 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ValidateScope is an update filter that validates that the resource group or resource a lock is applied to
+// exists. Resources are read from the database UCP shares with the resource providers.
+func ValidateScope(ctx context.Context, newResource *datamodel.Lock, oldResource *datamodel.Lock, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	scope := datamodel.LockScope(serviceCtx.ResourceID)
+
+	_, err := options.DatabaseClient.Get(ctx, scope)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundMessageResponse(fmt.Sprintf("the scope %q of the lock doesn't exist", scope)), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
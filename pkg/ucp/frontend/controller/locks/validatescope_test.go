@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+func Test_ValidateScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		lockID   string
+		scope    string
+		getErr   error
+		expected armrpc_rest.Response
+	}{
+		{
+			name:   "resource group exists",
+			lockID: resourceGroupID + locksPath + "/group-lock",
+			scope:  resourceGroupID,
+		},
+		{
+			name:   "resource exists",
+			lockID: applicationID + locksPath + "/app-lock",
+			scope:  applicationID,
+		},
+		{
+			name:     "resource doesn't exist",
+			lockID:   containerID + locksPath + "/container-lock",
+			scope:    containerID,
+			getErr:   &database.ErrNotFound{ID: containerID},
+			expected: armrpc_rest.NewNotFoundMessageResponse("the scope \"" + containerID + "\" of the lock doesn't exist"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			databaseClient := database.NewMockClient(ctrl)
+
+			obj := &database.Object{Metadata: database.Metadata{ID: tt.scope}}
+			if tt.getErr != nil {
+				obj = nil
+			}
+			databaseClient.EXPECT().
+				Get(gomock.Any(), tt.scope).
+				Return(obj, tt.getErr).
+				Times(1)
+
+			ctx := v1.WithARMRequestContext(context.Background(), &v1.ARMRequestContext{
+				ResourceID: resources.MustParse(tt.lockID),
+				HTTPMethod: http.MethodPut,
+			})
+
+			response, err := ValidateScope(ctx, nil, nil, &armrpc_controller.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, response)
+		})
+	}
+}
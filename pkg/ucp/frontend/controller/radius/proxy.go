@@ -17,9 +17,12 @@ limitations under the License.
 package radius
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -32,6 +35,7 @@ import (
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/ucp/proxy"
@@ -133,6 +137,10 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return armrpc_rest.NewInternalServerErrorARMResponse(response), nil
 	}
 
+	if response, err := p.ValidateLocks(ctx, w, req, id); response != nil || err != nil {
+		return response, err
+	}
+
 	proxyReq, err := p.PrepareProxyRequest(ctx, req, downstreamURL.String(), relativePath)
 	if err != nil {
 		return nil, err
@@ -187,6 +195,50 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	return nil, nil
 }
 
+// ValidateLocks returns a ScopeLocked response if a management lock prevents the request from creating, updating or
+// deleting the resource. The stored resource and the request body are used to find the application and environment
+// the resource belongs to. Requests whose body is larger than the limit of the audit log fail with 413 (Request
+// Entity Too Large).
+func (p *ProxyController) ValidateLocks(ctx context.Context, w http.ResponseWriter, req *http.Request, id resources.ID) (armrpc_rest.Response, error) {
+	checker := p.Options().LockChecker
+	operation, ok := armrpc_controller.LockOperationForMethod(req.Method)
+	if checker == nil || !ok {
+		return nil, nil
+	}
+
+	models := []any{}
+	obj, err := p.DatabaseClient().Get(ctx, id.String())
+	if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+		return nil, err
+	} else if obj != nil {
+		models = append(models, obj.Data)
+	}
+
+	if operation == armrpc_controller.LockOperationWrite && req.Body != nil {
+		body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, audit.MaxRequestBodySize))
+		if maxBytesErr := (*http.MaxBytesError)(nil); errors.As(err, &maxBytesErr) {
+			return armrpc_rest.NewRequestEntityTooLargeResponse(fmt.Sprintf("the request body is larger than the maximum size of %d bytes", maxBytesErr.Limit)), nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+
+		// Restore the body so it can be sent to the downstream.
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		if json.Valid(body) {
+			models = append(models, json.RawMessage(body))
+		}
+	}
+
+	err = checker.Check(ctx, operation, id, models...)
+	if errors.Is(err, armrpc_controller.ErrLocked) {
+		return armrpc_rest.NewScopeLockedResponse(id.String(), err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 // PrepareProxyRequest constructs and initializes the proxy request.
 func (p *ProxyController) PrepareProxyRequest(ctx context.Context, originalReq *http.Request, downstream string, relativePath string) (*http.Request, error) {
 	proxyReq := originalReq.Clone(ctx)
@@ -19,9 +19,11 @@ package radius
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -29,7 +31,10 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locks"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/trackedresource"
 	"github.com/radius-project/radius/test/testcontext"
@@ -337,6 +342,96 @@ func Test_Run(t *testing.T) {
 
 		expected := rest.NewNotFoundResponseWithCause(id, "plane \"/planes/test/local\" not found")
 
+		response, err := p.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+	t.Run("failure (locked)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		lockClient := inmemory.NewClient()
+		lockID := id.RootScope() + "/providers/System.Authorization/locks/do-not-delete"
+		err := lockClient.Save(context.Background(), &database.Object{
+			Metadata: database.Metadata{ID: lockID},
+			Data:     &datamodel.Lock{Properties: datamodel.LockProperties{Level: datamodel.LockLevelCanNotDelete}},
+		})
+		require.NoError(t, err)
+		p.Options().LockChecker = locks.NewChecker(lockClient)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, id.String()+"?api-version="+apiVersion, nil)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(nil, &database.ErrNotFound{ID: id.String()}).Times(1)
+
+		message := "the resource \"" + id.String() + "\" can't be deleted because it's protected by the CanNotDelete lock \"" + lockID + "\""
+		expected := rest.NewScopeLockedResponse(id.String(), message)
+
+		response, err := p.Run(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+	t.Run("failure (request body too large)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+		p.Options().LockChecker = locks.NewChecker(inmemory.NewClient())
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		body := strings.NewReader(strings.Repeat("a", audit.MaxRequestBodySize+1))
+		req := httptest.NewRequest(http.MethodPut, id.String()+"?api-version="+apiVersion, body)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(nil, &database.ErrNotFound{ID: id.String()}).Times(1)
+
+		expected := rest.NewRequestEntityTooLargeResponse(fmt.Sprintf("the request body is larger than the maximum size of %d bytes", audit.MaxRequestBodySize))
+
 		response, err := p.Run(ctx, w, req)
 		require.NoError(t, err)
 		require.Equal(t, expected, response)
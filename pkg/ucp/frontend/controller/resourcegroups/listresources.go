@@ -69,9 +69,16 @@ func (r *ListResources) Run(ctx context.Context, w http.ResponseWriter, req *htt
 		return nil, err
 	}
 
+	tagFilter, err := v1.ParseTagFilter(req.URL.Query())
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	// The tags of the tracked resource are stored in its properties.
 	query := database.Query{
 		RootScope:    resourceGroupID.String(),
 		ResourceType: v20231001preview.ResourceType,
+		Filters:      armrpc_controller.TagQueryFilters(tagFilter, "properties.tags"),
 	}
 
 	result, err := r.DatabaseClient().Query(ctx, query)
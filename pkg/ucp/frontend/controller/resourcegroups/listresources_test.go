@@ -38,6 +38,7 @@ func Test_ListResources(t *testing.T) {
 		ID:   new("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"),
 		Type: new("Applications.Core/applications"),
 		Name: new("test-app"),
+		Tags: map[string]*string{"team": new("payments")},
 	}
 	entryDatamodel := datamodel.GenericResource{
 		BaseResource: v1.BaseResource{
@@ -51,6 +52,7 @@ func Test_ListResources(t *testing.T) {
 			ID:   *entryResource.ID,
 			Type: *entryResource.Type,
 			Name: *entryResource.Name,
+			Tags: map[string]string{"team": "payments"},
 		},
 	}
 
@@ -86,6 +88,54 @@ func Test_ListResources(t *testing.T) {
 		require.Equal(t, expected, response)
 	})
 
+	t.Run("success - tag filter", func(t *testing.T) {
+		databaseClient, ctrl := setupListResources(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&database.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expectedQuery := database.Query{
+			RootScope:    resourceGroupID,
+			ResourceType: v20231001preview.ResourceType,
+			Filters:      []database.QueryFilter{{Field: "properties.tags", Key: "team", Value: "payments"}},
+		}
+		databaseClient.EXPECT().
+			Query(gomock.Any(), expectedQuery).
+			Return(&database.ObjectQueryResult{Items: []database.Object{{Data: entryDatamodel}}}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewOKResponse(&v1.PaginatedList{
+			Value: []any{&entryResource},
+		})
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&tagName=team&tagValue=payments", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("invalid tag filter", func(t *testing.T) {
+		databaseClient, ctrl := setupListResources(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceGroupID).
+			Return(&database.Object{Data: resourceGroupDatamodel}, nil).
+			Times(1)
+
+		expected := armrpc_rest.NewBadRequestResponse(`the "tagValue" query parameter is required when "tagName" is specified`)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+id+"?api-version="+v20231001preview.Version+"&tagName=team", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
 	t.Run("success - empty", func(t *testing.T) {
 		databaseClient, ctrl := setupListResources(t)
 
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourceproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+	"strings"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// openAPISegment is the URL path segment preceding the API version of an OpenAPI document.
+	openAPISegment = "openapi"
+)
+
+var _ armrpc_controller.Controller = (*GetOpenAPIDocument)(nil)
+
+// GetOpenAPIDocument is the controller implementation to get the OpenAPI document of the resource types of a resource
+// provider for an API version.
+type GetOpenAPIDocument struct {
+	armrpc_controller.Operation[*datamodel.ResourceProviderSummary, datamodel.ResourceProviderSummary]
+}
+
+// NewGetOpenAPIDocument creates a new controller for getting the OpenAPI document of a resource provider.
+func NewGetOpenAPIDocument(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &GetOpenAPIDocument{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.ResourceProviderSummary]{}),
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (r *GetOpenAPIDocument) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
+
+	scope, name, apiVersion, err := r.extractScopeNameAndAPIVersion(relativePath)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := datamodel.ResourceProviderSummaryIDFromParts(scope.String(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.DatabaseClient().Get(ctx, id.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		message := fmt.Sprintf("the resource provider with name '%s' was not found", name)
+		return armrpc_rest.NewNotFoundMessageResponse(message), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	summary := datamodel.ResourceProviderSummary{}
+	err = result.As(&summary)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypes := []schema.DocumentResourceType{}
+	for typeName, resourceType := range summary.Properties.ResourceTypes {
+		version, ok := resourceType.APIVersions[apiVersion]
+		if !ok {
+			continue
+		}
+
+		actions, err := r.getActions(ctx, scope, name, typeName)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceTypes = append(resourceTypes, schema.DocumentResourceType{
+			Name:        typeName,
+			Description: to.String(resourceType.Description),
+			Schema:      version.Schema,
+			Actions:     actions,
+		})
+	}
+
+	if len(resourceTypes) == 0 {
+		message := fmt.Sprintf("the resource provider with name '%s' has no resource types with api version '%s'", name, apiVersion)
+		return armrpc_rest.NewNotFoundMessageResponse(message), nil
+	}
+
+	document, err := schema.GenerateOpenAPIDocument(name, apiVersion, resourceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(document), nil
+}
+
+// getActions returns the actions of a resource type. Actions are not part of the resource provider summary, so they
+// are read from the resource type.
+func (r *GetOpenAPIDocument) getActions(ctx context.Context, scope resources.ID, resourceProviderName string, typeName string) (map[string]schema.DocumentAction, error) {
+	id := fmt.Sprintf("%s/providers/System.Resources/resourceProviders/%s/resourceTypes/%s", scope.String(), resourceProviderName, typeName)
+
+	result, err := r.DatabaseClient().Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		// The summary can be updated before the resource type is saved. The actions will be included once it is.
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	resourceType := datamodel.ResourceType{}
+	err = result.As(&resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := map[string]schema.DocumentAction{}
+	for actionName, action := range resourceType.Properties.Actions {
+		actions[actionName] = schema.DocumentAction{
+			Description:  action.Description,
+			InputSchema:  action.InputSchema,
+			OutputSchema: action.OutputSchema,
+		}
+	}
+
+	return actions, nil
+}
+
+func (r *GetOpenAPIDocument) extractScopeNameAndAPIVersion(relativePath string) (resources.ID, string, string, error) {
+	// Trim a trailing slash if it exists.
+	relativePath = strings.TrimSuffix(relativePath, "/")
+
+	// NOTE: the URL path should be something like: /planes/radius/local/providers/Applications.Test/openapi/2025-01-01.
+	//
+	// This is NOT a valid resource id, so we can't use the parser for it.
+	prefix, apiVersion, found := strings.Cut(relativePath, resources.SegmentSeparator+openAPISegment+resources.SegmentSeparator)
+	if !found || apiVersion == "" || strings.Contains(apiVersion, resources.SegmentSeparator) {
+		return resources.ID{}, "", "", errors.New("invalid URL path")
+	}
+
+	scope, name, err := (&GetResourceProviderSummary{}).extractScopeAndName(prefix)
+	if err != nil {
+		return resources.ID{}, "", "", err
+	}
+
+	return scope, name, apiVersion, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourceproviders
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+func Test_GetOpenAPIDocument(t *testing.T) {
+	summaryID := "/planes/radius/local/providers/System.Resources/resourceProviderSummaries/Contoso.Example"
+	resourceTypeID := "/planes/radius/local/providers/System.Resources/resourceProviders/Contoso.Example/resourceTypes/databases"
+	path := "/planes/radius/local/providers/Contoso.Example/openapi/2025-01-01"
+
+	summary := datamodel.ResourceProviderSummary{
+		Properties: datamodel.ResourceProviderSummaryProperties{
+			ResourceTypes: map[string]datamodel.ResourceProviderSummaryPropertiesResourceType{
+				"databases": {
+					APIVersions: map[string]datamodel.ResourceProviderSummaryPropertiesAPIVersion{
+						"2025-01-01": {Schema: map[string]any{"type": "object", "properties": map[string]any{"size": map[string]any{"type": "string"}}}},
+					},
+				},
+			},
+		},
+	}
+
+	resourceType := datamodel.ResourceType{
+		Properties: datamodel.ResourceTypeProperties{
+			Actions: map[string]datamodel.ResourceTypeAction{
+				"backup": {Description: "Back up the database."},
+			},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		databaseClient, ctrl := setupGetOpenAPIDocument(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), summaryID).
+			Return(&database.Object{Data: summary}, nil).
+			Times(1)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID).
+			Return(&database.Object{Data: resourceType}, nil).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+path+"?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+
+		okResponse, ok := response.(*armrpc_rest.OKResponse)
+		require.True(t, ok)
+
+		document := okResponse.Body.(map[string]any)
+		require.Equal(t, map[string]any{
+			"title":       "Contoso.Example",
+			"version":     "2025-01-01",
+			"description": "API of the resource types of the Contoso.Example resource provider.",
+		}, document["info"])
+
+		paths := document["paths"].(map[string]any)
+		require.Contains(t, paths, "/{rootScope}/providers/Contoso.Example/databases/{resourceName}")
+		require.Equal(t, "Back up the database.", paths["/{rootScope}/providers/Contoso.Example/databases/{resourceName}/backup"].(map[string]any)["post"].(map[string]any)["description"])
+	})
+
+	t.Run("api version not found", func(t *testing.T) {
+		databaseClient, ctrl := setupGetOpenAPIDocument(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), summaryID).
+			Return(&database.Object{Data: summary}, nil).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+"/planes/radius/local/providers/Contoso.Example/openapi/2020-01-01?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNotFoundMessageResponse("the resource provider with name 'Contoso.Example' has no resource types with api version '2020-01-01'"), response)
+	})
+
+	t.Run("resource provider not found", func(t *testing.T) {
+		databaseClient, ctrl := setupGetOpenAPIDocument(t)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), summaryID).
+			Return(nil, &database.ErrNotFound{ID: summaryID}).
+			Times(1)
+
+		request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+path+"?api-version="+v20231001preview.Version, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(request)
+		response, err := ctrl.Run(ctx, nil, request)
+		require.NoError(t, err)
+		require.Equal(t, armrpc_rest.NewNotFoundMessageResponse("the resource provider with name 'Contoso.Example' was not found"), response)
+	})
+}
+
+func TestExtractScopeNameAndAPIVersion(t *testing.T) {
+	r := &GetOpenAPIDocument{}
+
+	scope, name, apiVersion, err := r.extractScopeNameAndAPIVersion("/planes/radius/local/providers/Applications.Test/openapi/2025-01-01/")
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local", scope.String())
+	require.Equal(t, "Applications.Test", name)
+	require.Equal(t, "2025-01-01", apiVersion)
+
+	_, _, _, err = r.extractScopeNameAndAPIVersion("/planes/radius/local/providers/Applications.Test")
+	require.EqualError(t, err, "invalid URL path")
+}
+
+func setupGetOpenAPIDocument(t *testing.T) (*database.MockClient, *GetOpenAPIDocument) {
+	ctrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(ctrl)
+
+	c, err := NewGetOpenAPIDocument(armrpc_controller.Options{DatabaseClient: databaseClient, PathBase: "/" + uuid.New().String()})
+	require.NoError(t, err)
+
+	return databaseClient, c.(*GetOpenAPIDocument)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roleassignments
+
+import (
+	"context"
+	http "net/http"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ armrpc_controller.Controller = (*ListRoleAssignments)(nil)
+
+// ListRoleAssignments is the controller implementation to list the role assignments of a resource group or resource.
+type ListRoleAssignments struct {
+	armrpc_controller.Operation[*datamodel.RoleAssignment, datamodel.RoleAssignment]
+}
+
+// NewListRoleAssignments creates a new controller for listing the role assignments of a resource group or resource.
+func NewListRoleAssignments(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &ListRoleAssignments{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.RoleAssignment]{
+				RequestConverter:  converter.RoleAssignmentDataModelFromVersioned,
+				ResponseConverter: converter.RoleAssignmentDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run implements controller.Controller.
+//
+// The role assignments of a resource group include the role assignments of the resources it contains. The role
+// assignments of the plane are listed by the plane-scoped route.
+func (r *ListRoleAssignments) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	scope := datamodel.RoleAssignmentScope(serviceCtx.ResourceID)
+
+	// Role assignments are stored at the root scope of the resource group or resource they're created at.
+	query := database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: datamodel.RoleAssignmentResourceType,
+	}
+
+	result, err := r.DatabaseClient().Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := []datamodel.RoleAssignment{}
+	for _, item := range result.Items {
+		assignment := datamodel.RoleAssignment{}
+		if err := item.As(&assignment); err != nil {
+			return nil, err
+		}
+
+		id, err := resources.Parse(assignment.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matchesScope(datamodel.RoleAssignmentScope(id), scope) {
+			continue
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	slices.SortFunc(assignments, func(a, b datamodel.RoleAssignment) int {
+		return strings.Compare(strings.ToLower(a.ID), strings.ToLower(b.ID))
+	})
+
+	items := v1.PaginatedList{
+		Value: []any{}, // Initialize to empty list for testability
+	}
+	for i := range assignments {
+		versioned, err := converter.RoleAssignmentDataModelToVersioned(&assignments[i], serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		items.Value = append(items.Value, versioned)
+	}
+
+	return armrpc_rest.NewOKResponse(&items), nil
+}
+
+// matchesScope returns true if the ID is the scope or one of the resources it contains. Resource IDs are compared
+// case-insensitively.
+func matchesScope(id string, scope string) bool {
+	if strings.EqualFold(id, scope) {
+		return true
+	}
+
+	prefix := strings.ToLower(scope + resources.SegmentSeparator)
+	return strings.HasPrefix(strings.ToLower(id), prefix)
+}
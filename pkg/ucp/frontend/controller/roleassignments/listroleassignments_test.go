@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roleassignments
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	resourceGroupID     = "/planes/radius/local/resourceGroups/prod"
+	applicationID       = resourceGroupID + "/providers/Applications.Core/applications/app"
+	containerID         = resourceGroupID + "/providers/Applications.Core/containers/frontend"
+	roleAssignmentsPath = "/providers/System.Authorization/roleAssignments"
+)
+
+func Test_ListRoleAssignments(t *testing.T) {
+	assignments := []datamodel.RoleAssignment{
+		newRoleAssignment(containerID + roleAssignmentsPath + "/container-readers"),
+		newRoleAssignment(resourceGroupID + roleAssignmentsPath + "/group-owners"),
+		newRoleAssignment(applicationID + roleAssignmentsPath + "/app-contributors"),
+		newRoleAssignment(applicationID + "-staging" + roleAssignmentsPath + "/staging-contributors"),
+	}
+
+	tests := []struct {
+		name     string
+		scope    string
+		expected []string
+	}{
+		{
+			name:     "resource group and contained resources",
+			scope:    resourceGroupID,
+			expected: []string{"staging-contributors", "app-contributors", "container-readers", "group-owners"},
+		},
+		{
+			name:     "resource",
+			scope:    applicationID,
+			expected: []string{"app-contributors"},
+		},
+		{
+			name:     "resource without role assignments",
+			scope:    resourceGroupID + "/providers/Applications.Core/environments/prod",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient, ctrl := setupListRoleAssignments(t)
+
+			items := []database.Object{}
+			for _, assignment := range assignments {
+				items = append(items, database.Object{Data: assignment})
+			}
+
+			expectedQuery := database.Query{RootScope: resourceGroupID, ResourceType: datamodel.RoleAssignmentResourceType}
+			databaseClient.EXPECT().
+				Query(gomock.Any(), expectedQuery).
+				Return(&database.ObjectQueryResult{Items: items}, nil).
+				Times(1)
+
+			request, err := http.NewRequest(http.MethodGet, ctrl.Options().PathBase+tt.scope+roleAssignmentsPath+"?api-version="+v20231001preview.Version, nil)
+			require.NoError(t, err)
+			ctx := rpctest.NewARMRequestContext(request)
+			response, err := ctrl.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			ok, isOK := response.(*armrpc_rest.OKResponse)
+			require.True(t, isOK)
+			list := ok.Body.(*v1.PaginatedList)
+
+			names := []string{}
+			for _, item := range list.Value {
+				names = append(names, *item.(*v20231001preview.RoleAssignmentResource).Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func newRoleAssignment(id string) datamodel.RoleAssignment {
+	scope := id[:strings.Index(id, roleAssignmentsPath)]
+	return datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: id[strings.LastIndex(id, "/")+1:],
+				Type: datamodel.RoleAssignmentResourceType,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalID:      "team-a",
+			PrincipalType:    datamodel.PrincipalTypeGroup,
+			RoleDefinitionID: "/planes/radius/local/providers/System.Authorization/roleDefinitions/reader",
+			Scope:            scope,
+		},
+	}
+}
+
+func setupListRoleAssignments(t *testing.T) (*database.MockClient, *ListRoleAssignments) {
+	ctrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(ctrl)
+
+	c, err := NewListRoleAssignments(armrpc_controller.Options{DatabaseClient: databaseClient, PathBase: "/" + uuid.New().String()})
+	require.NoError(t, err)
+
+	return databaseClient, c.(*ListRoleAssignments)
+}
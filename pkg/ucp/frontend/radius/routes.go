@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -27,13 +28,21 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucp_audit "github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	audit_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/audit"
+	locks_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/locks"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
+	roleassignments_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/roleassignments"
+	"github.com/radius-project/radius/pkg/ucp/locks"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/validator"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -74,6 +83,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		DatabaseClient: databaseClient,
 		PathBase:       m.options.Config.Server.PathBase,
 		StatusManager:  m.options.StatusManager,
+		LockChecker:    locks.NewChecker(databaseClient),
 
 		KubeClient:   nil, // Unused by Radius module
 		ResourceType: "",  // Set dynamically
@@ -90,6 +100,32 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			r.Route("/providers", func(r chi.Router) {
 				r.Get("/", capture(resourceProviderSummaryListHandler(ctx, ctrlOptions)))
 				r.Get("/{resourceProviderName}", capture(resourceProviderSummaryGetHandler(ctx, ctrlOptions)))
+				r.Get("/{resourceProviderName}/openapi/{apiVersionName}", capture(resourceProviderOpenAPIDocumentGetHandler(ctx, ctrlOptions)))
+
+				r.Route("/System.Audit/events", func(r chi.Router) {
+					r.With(apiValidator).Get("/", capture(auditEventListHandler(ctx, ctrlOptions, m.options.AuditChain)))
+					r.With(apiValidator).Get("/{auditEventName}", capture(auditEventGetHandler(ctx, ctrlOptions)))
+				})
+
+				r.Route("/System.Authorization", func(r chi.Router) {
+					r.Route("/roleDefinitions", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleDefinitionListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleDefinitionName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleDefinitionGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleDefinitionPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleDefinitionDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+
+					r.Route("/roleAssignments", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleAssignmentListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleAssignmentName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleAssignmentGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleAssignmentPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleAssignmentDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+				})
 
 				r.Route("/System.Resources", func(r chi.Router) {
 
@@ -153,10 +189,47 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					})
 
 					r.Route("/providers", func(r chi.Router) {
+						// NOTE: the schema of locks and role assignments isn't validated because those of resources
+						// are routed through the proxy route below, which the API validator can't match.
+						r.Route("/System.Authorization/locks", func(r chi.Router) {
+							r.Get("/", capture(lockListHandler(ctx, ctrlOptions)))
+							r.Route("/{lockName}", func(r chi.Router) {
+								r.Get("/", capture(lockGetHandler(ctx, ctrlOptions)))
+								r.Put("/", capture(lockPutHandler(ctx, ctrlOptions)))
+								r.Delete("/", capture(lockDeleteHandler(ctx, ctrlOptions)))
+							})
+						})
+
+						r.Route("/System.Authorization/roleAssignments", func(r chi.Router) {
+							r.Get("/", capture(scopedRoleAssignmentListHandler(ctx, ctrlOptions)))
+							r.Route("/{roleAssignmentName}", func(r chi.Router) {
+								r.Get("/", capture(roleAssignmentGetHandler(ctx, ctrlOptions)))
+								r.Put("/", capture(roleAssignmentPutHandler(ctx, ctrlOptions)))
+								r.Delete("/", capture(roleAssignmentDeleteHandler(ctx, ctrlOptions)))
+							})
+						})
+
 						// Proxy to resource-group-scoped ResourceProvider APIs
 						//
 						// NOTE: DO NOT validate schema for proxy routes.
-						r.Handle("/*", capture(resourceGroupScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+						r.Handle("/*", extensionResourceRouter(
+							ctrlOptions.PathBase,
+							capture(resourceGroupScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream)),
+							map[string]extensionResourceHandlers{
+								datamodel.LockResourceType: {
+									list:   capture(lockListHandler(ctx, ctrlOptions)),
+									get:    capture(lockGetHandler(ctx, ctrlOptions)),
+									put:    capture(lockPutHandler(ctx, ctrlOptions)),
+									delete: capture(lockDeleteHandler(ctx, ctrlOptions)),
+								},
+								datamodel.RoleAssignmentResourceType: {
+									list:   capture(scopedRoleAssignmentListHandler(ctx, ctrlOptions)),
+									get:    capture(roleAssignmentGetHandler(ctx, ctrlOptions)),
+									put:    capture(roleAssignmentPutHandler(ctx, ctrlOptions)),
+									delete: capture(roleAssignmentDeleteHandler(ctx, ctrlOptions)),
+								},
+							},
+						))
 					})
 				})
 
@@ -246,6 +319,12 @@ func resourceProviderSummaryGetHandler(ctx context.Context, ctrlOptions controll
 	})
 }
 
+func resourceProviderOpenAPIDocumentGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.ResourceProviderSummaryResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return resourceproviders_ctrl.NewGetOpenAPIDocument(opts)
+	})
+}
+
 var resourceProviderResourceOptions = controller.ResourceOptions[datamodel.ResourceProvider]{
 	RequestConverter:         converter.ResourceProviderDataModelFromVersioned,
 	ResponseConverter:        converter.ResourceProviderDataModelToVersioned,
@@ -366,6 +445,166 @@ func locationDeleteHandler(ctx context.Context, ctrlOptions controller.Options)
 	})
 }
 
+var roleDefinitionResourceOptions = controller.ResourceOptions[datamodel.RoleDefinition]{
+	RequestConverter:  converter.RoleDefinitionDataModelFromVersioned,
+	ResponseConverter: converter.RoleDefinitionDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.RoleDefinition]{
+		authorization.ValidateRoleDefinition,
+	},
+}
+
+func roleDefinitionListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleDefinitionResourceOptions)
+	})
+}
+
+var roleAssignmentResourceOptions = controller.ResourceOptions[datamodel.RoleAssignment]{
+	RequestConverter:  converter.RoleAssignmentDataModelFromVersioned,
+	ResponseConverter: converter.RoleAssignmentDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.RoleAssignment]{
+		authorization.ValidateRoleAssignment,
+	},
+}
+
+func roleAssignmentListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func scopedRoleAssignmentListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationList, ctrlOptions, roleassignments_ctrl.NewListRoleAssignments)
+}
+
+var lockResourceOptions = controller.ResourceOptions[datamodel.Lock]{
+	RequestConverter:  converter.LockDataModelFromVersioned,
+	ResponseConverter: converter.LockDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.Lock]{
+		locks_ctrl.ValidateScope,
+	},
+}
+
+func lockListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationList, ctrlOptions, locks_ctrl.NewListLocks)
+}
+
+func lockGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, lockResourceOptions)
+	})
+}
+
+func lockPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, lockResourceOptions)
+	})
+}
+
+func lockDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, lockResourceOptions)
+	})
+}
+
+// extensionResourceHandlers are the handlers of an extension resource type served by UCP.
+type extensionResourceHandlers struct {
+	list   http.HandlerFunc
+	get    http.HandlerFunc
+	put    http.HandlerFunc
+	delete http.HandlerFunc
+}
+
+// extensionResourceRouter routes the requests for the extension resources served by UCP, like the locks and role
+// assignments of resources, to their handlers by resource type, and other requests to the proxy. The extension
+// resources of resources share the routes of the resources they extend.
+func extensionResourceRouter(pathBase string, proxy http.HandlerFunc, handlers map[string]extensionResourceHandlers) http.HandlerFunc {
+	byType := map[string]extensionResourceHandlers{}
+	for resourceType, h := range handlers {
+		byType[strings.ToLower(resourceType)] = h
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		id, err := resources.Parse(middleware.GetRelativePath(pathBase, req.URL.Path))
+		if err != nil || len(id.ExtensionSegments()) == 0 {
+			proxy(w, req)
+			return
+		}
+
+		h, ok := byType[strings.ToLower(id.Type())]
+		if !ok {
+			proxy(w, req)
+			return
+		}
+
+		switch {
+		case id.IsExtensionCollection() && req.Method == http.MethodGet:
+			h.list(w, req)
+		case id.IsExtensionResource() && req.Method == http.MethodGet:
+			h.get(w, req)
+		case id.IsExtensionResource() && req.Method == http.MethodPut:
+			h.put(w, req)
+		case id.IsExtensionResource() && req.Method == http.MethodDelete:
+			h.delete(w, req)
+		default:
+			validator.APIMethodNotAllowedHandler()(w, req)
+		}
+	}
+}
+
+var auditEventResourceOptions = controller.ResourceOptions[datamodel.AuditEvent]{
+	RequestConverter:  converter.AuditEventDataModelFromVersioned,
+	ResponseConverter: converter.AuditEventDataModelToVersioned,
+}
+
+func auditEventListHandler(ctx context.Context, ctrlOptions controller.Options, chain *ucp_audit.Chain) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.AuditEventResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return audit_ctrl.NewListAuditEvents(opts, chain)
+	})
+}
+
+func auditEventGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.AuditEventResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, auditEventResourceOptions)
+	})
+}
+
 func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
 		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
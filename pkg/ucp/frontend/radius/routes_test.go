@@ -19,6 +19,7 @@ package radius
 import (
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
@@ -31,6 +32,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -60,6 +62,60 @@ func Test_Routes(t *testing.T) {
 			Path:          "/planes/radius/someName",
 		},
 
+		// Audit events
+		{
+			OperationType: v1.OperationType{Type: datamodel.AuditEventResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Audit/events",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.AuditEventResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Audit/events/event-00000000000000000042",
+		},
+
+		// Role definitions and role assignments
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions/reader-plus",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions/reader-plus",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleDefinitions/reader-plus",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments/team-a-contributors",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments/team-a-contributors",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/someName/providers/System.Authorization/roleAssignments/team-a-contributors",
+		},
+
 		// Resource types
 		{
 			OperationType: v1.OperationType{Type: datamodel.ResourceProviderResourceType, Method: v1.OperationList},
@@ -163,6 +219,11 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/local/resourcegroups/test-rg",
 		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceProviderSummaryResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/Applications.Test/openapi/2025-01-01",
+		},
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
@@ -170,6 +231,64 @@ func Test_Routes(t *testing.T) {
 			SkipOperationTypeValidation: true,
 		},
 
+		// Locks
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/locks",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/locks/do-not-delete",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/locks/do-not-delete",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/locks/do-not-delete",
+		},
+		{
+			// The locks of resources are routed by the proxy route.
+			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
+			Method:                      http.MethodPut,
+			Path:                        "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/test-app/providers/System.Authorization/locks/do-not-delete",
+			SkipOperationTypeValidation: true,
+		},
+
+		// Role assignments of resource groups and resources
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleAssignments",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleAssignments/team-a-owners",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleAssignments/team-a-owners",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Authorization/roleAssignments/team-a-owners",
+		},
+		{
+			// The role assignments of resources are routed by the proxy route.
+			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
+			Method:                      http.MethodPut,
+			Path:                        "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/applications/test-app/providers/System.Authorization/roleAssignments/team-a-owners",
+			SkipOperationTypeValidation: true,
+		},
+
 		// Proxy
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
@@ -213,3 +332,60 @@ func Test_Routes(t *testing.T) {
 		return handler.(chi.Router), nil
 	})
 }
+
+func Test_extensionResourceRouter(t *testing.T) {
+	handler := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(name))
+		}
+	}
+
+	router := extensionResourceRouter(pathBase, handler("proxy"), map[string]extensionResourceHandlers{
+		datamodel.LockResourceType: {
+			list:   handler("lock list"),
+			get:    handler("lock get"),
+			put:    handler("lock put"),
+			delete: handler("lock delete"),
+		},
+		datamodel.RoleAssignmentResourceType: {
+			list:   handler("role assignment list"),
+			get:    handler("role assignment get"),
+			put:    handler("role assignment put"),
+			delete: handler("role assignment delete"),
+		},
+	})
+
+	applicationID := "/planes/radius/local/resourcegroups/test-rg/providers/Applications.Core/applications/test-app"
+	tests := []struct {
+		method   string
+		path     string
+		expected string
+	}{
+		{http.MethodGet, applicationID, "proxy"},
+		{http.MethodPut, applicationID, "proxy"},
+		{http.MethodGet, applicationID + "/providers/Applications.Test/extensions/ext", "proxy"},
+		{http.MethodGet, applicationID + "/providers/System.Authorization/locks", "lock list"},
+		{http.MethodGet, applicationID + "/providers/System.Authorization/locks/do-not-delete", "lock get"},
+		{http.MethodPut, applicationID + "/providers/system.authorization/LOCKS/do-not-delete", "lock put"},
+		{http.MethodDelete, applicationID + "/providers/System.Authorization/locks/do-not-delete", "lock delete"},
+		{http.MethodPost, applicationID + "/providers/System.Authorization/locks/do-not-delete", ""},
+		{http.MethodGet, applicationID + "/providers/System.Authorization/roleAssignments", "role assignment list"},
+		{http.MethodGet, applicationID + "/providers/System.Authorization/roleAssignments/team-a-owners", "role assignment get"},
+		{http.MethodPut, applicationID + "/providers/System.Authorization/roleassignments/team-a-owners", "role assignment put"},
+		{http.MethodDelete, applicationID + "/providers/System.Authorization/roleAssignments/team-a-owners", "role assignment delete"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+"|"+tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, pathBase+tt.path, nil)
+			router(w, req)
+
+			if tt.expected == "" {
+				require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+			} else {
+				require.Equal(t, tt.expected, w.Body.String())
+			}
+		})
+	}
+}
@@ -155,6 +155,8 @@ func registerResourceProviderDirect(ctx context.Context, dbClient database.Clien
 				Capabilities:      resourceType.Capabilities,
 				DefaultAPIVersion: resourceType.DefaultAPIVersion,
 				Description:       resourceType.Description,
+				Actions:           toResourceTypeActionsDataModel(resourceType.Actions),
+				Deprecation:       toDeprecationDataModel(resourceType.Deprecation),
 			},
 		}
 
@@ -180,7 +182,9 @@ func registerResourceProviderDirect(ctx context.Context, dbClient database.Clien
 					},
 				},
 				Properties: datamodel.APIVersionProperties{
-					Schema: schema,
+					Schema:      schema,
+					Conversion:  toAPIVersionConversionDataModel(apiVersion.Conversion),
+					Deprecation: toDeprecationDataModel(apiVersion.Deprecation),
 				},
 			}
 
@@ -189,7 +193,8 @@ func registerResourceProviderDirect(ctx context.Context, dbClient database.Clien
 			}
 
 			summaryAPIVersions[apiVersionName] = datamodel.ResourceProviderSummaryPropertiesAPIVersion{
-				Schema: schema,
+				Schema:      schema,
+				Deprecation: avModel.Properties.Deprecation,
 			}
 		}
 
@@ -197,6 +202,7 @@ func registerResourceProviderDirect(ctx context.Context, dbClient database.Clien
 			DefaultAPIVersion: resourceType.DefaultAPIVersion,
 			Capabilities:      resourceType.Capabilities,
 			Description:       resourceType.Description,
+			Deprecation:       typeModel.Properties.Deprecation,
 			APIVersions:       summaryAPIVersions,
 		}
 	}
@@ -268,3 +274,74 @@ func saveResource(ctx context.Context, dbClient database.Client, id string, data
 		Data:     data,
 	})
 }
+
+// toAPIVersionConversionDataModel converts the conversion rules of a manifest API version to the datamodel.
+func toAPIVersionConversionDataModel(conversion *manifest.Conversion) *datamodel.APIVersionConversion {
+	if conversion == nil {
+		return nil
+	}
+
+	result := &datamodel.APIVersionConversion{
+		Defaults: conversion.Defaults,
+	}
+	for _, rename := range conversion.Renames {
+		result.Renames = append(result.Renames, datamodel.FieldRename{
+			From: rename.From,
+			To:   rename.To,
+		})
+	}
+
+	return result
+}
+
+// toDeprecationDataModel converts the deprecation of a manifest resource type or API version to the datamodel.
+func toDeprecationDataModel(deprecation *manifest.Deprecation) *datamodel.Deprecation {
+	if deprecation == nil {
+		return nil
+	}
+
+	// The manifest is validated when it is read, so the sunset date is valid.
+	sunsetDate, _ := deprecation.ParseSunsetDate()
+	return &datamodel.Deprecation{
+		Message:                deprecation.Message,
+		SunsetDate:             sunsetDate,
+		Replacement:            deprecation.Replacement,
+		BlockCreateAfterSunset: deprecation.BlockCreateAfterSunset,
+	}
+}
+
+// toResourceTypeActionsDataModel converts the actions of a manifest resource type to the datamodel.
+func toResourceTypeActionsDataModel(actions map[string]*manifest.Action) map[string]datamodel.ResourceTypeAction {
+	if actions == nil {
+		return nil
+	}
+
+	result := map[string]datamodel.ResourceTypeAction{}
+	for name, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		dm := datamodel.ResourceTypeAction{
+			InputSchema:  action.InputSchema,
+			OutputSchema: action.OutputSchema,
+		}
+		if action.Description != nil {
+			dm.Description = *action.Description
+		}
+		if action.Recipe != nil {
+			dm.Recipe = &datamodel.ActionRecipe{
+				TemplateKind:    action.Recipe.TemplateKind,
+				TemplatePath:    action.Recipe.TemplatePath,
+				TemplateVersion: action.Recipe.TemplateVersion,
+			}
+		}
+		if action.Callback != nil {
+			dm.Callback = &datamodel.ActionCallback{URL: action.Callback.URL}
+		}
+
+		result[name] = dm
+	}
+
+	return result
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/manifest"
@@ -126,6 +127,93 @@ func Test_registerResourceProviderDirect(t *testing.T) {
 		assert.Len(t, summaryModel.Properties.ResourceTypes["typeB"].APIVersions, 1)
 	})
 
+	t.Run("registers API version conversion rules", func(t *testing.T) {
+		t.Parallel()
+		dbClient := inmemory.NewClient()
+
+		rp := createTestResourceProviderMultiType()
+		rp.Types["typeA"].DefaultAPIVersion = new("2024-01-01")
+		rp.Types["typeA"].APIVersions["2023-01-01"].Conversion = &manifest.Conversion{
+			Renames:  []*manifest.FieldRename{{From: "size", To: "sku.size"}},
+			Defaults: map[string]any{"sku.tier": "standard"},
+		}
+		err := registerResourceProviderDirect(context.Background(), dbClient, "local", rp)
+		require.NoError(t, err)
+
+		obj, err := dbClient.Get(context.Background(), "/planes/radius/local/providers/System.Resources/resourceProviders/Multi.Provider/resourceTypes/typeA/apiVersions/2023-01-01")
+		require.NoError(t, err)
+
+		avModel := &datamodel.APIVersion{}
+		require.NoError(t, obj.As(avModel))
+		assert.Equal(t, &datamodel.APIVersionConversion{
+			Renames:  []datamodel.FieldRename{{From: "size", To: "sku.size"}},
+			Defaults: map[string]any{"sku.tier": "standard"},
+		}, avModel.Properties.Conversion)
+	})
+
+	t.Run("registers resource type actions", func(t *testing.T) {
+		t.Parallel()
+		dbClient := inmemory.NewClient()
+
+		rp := createTestResourceProviderMultiType()
+		rp.Types["typeA"].Actions = map[string]*manifest.Action{
+			"restart": {
+				Description: new("Restarts the resource."),
+				Callback:    &manifest.ActionCallback{URL: "https://example.com/restart"},
+			},
+		}
+		err := registerResourceProviderDirect(context.Background(), dbClient, "local", rp)
+		require.NoError(t, err)
+
+		obj, err := dbClient.Get(context.Background(), "/planes/radius/local/providers/System.Resources/resourceProviders/Multi.Provider/resourceTypes/typeA")
+		require.NoError(t, err)
+
+		typeModel := &datamodel.ResourceType{}
+		require.NoError(t, obj.As(typeModel))
+		assert.Equal(t, map[string]datamodel.ResourceTypeAction{
+			"restart": {
+				Description: "Restarts the resource.",
+				Callback:    &datamodel.ActionCallback{URL: "https://example.com/restart"},
+			},
+		}, typeModel.Properties.Actions)
+	})
+
+	t.Run("registers resource type deprecation", func(t *testing.T) {
+		t.Parallel()
+		dbClient := inmemory.NewClient()
+
+		rp := createTestResourceProviderMultiType()
+		rp.Types["typeA"].Deprecation = &manifest.Deprecation{
+			SunsetDate:             "2026-01-01",
+			Replacement:            "Multi.Provider/typeB",
+			BlockCreateAfterSunset: true,
+		}
+		rp.Types["typeA"].APIVersions["2023-01-01"].Deprecation = &manifest.Deprecation{Replacement: "2024-01-01"}
+		err := registerResourceProviderDirect(context.Background(), dbClient, "local", rp)
+		require.NoError(t, err)
+
+		obj, err := dbClient.Get(context.Background(), "/planes/radius/local/providers/System.Resources/resourceProviders/Multi.Provider/resourceTypes/typeA")
+		require.NoError(t, err)
+
+		typeModel := &datamodel.ResourceType{}
+		require.NoError(t, obj.As(typeModel))
+		expected := &datamodel.Deprecation{
+			SunsetDate:             new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Replacement:            "Multi.Provider/typeB",
+			BlockCreateAfterSunset: true,
+		}
+		assert.Equal(t, expected, typeModel.Properties.Deprecation)
+
+		obj, err = dbClient.Get(context.Background(), "/planes/radius/local/providers/System.Resources/resourceProviderSummaries/Multi.Provider")
+		require.NoError(t, err)
+
+		summaryModel := &datamodel.ResourceProviderSummary{}
+		require.NoError(t, obj.As(summaryModel))
+		assert.Equal(t, expected, summaryModel.Properties.ResourceTypes["typeA"].Deprecation)
+		assert.Equal(t, &datamodel.Deprecation{Replacement: "2024-01-01"}, summaryModel.Properties.ResourceTypes["typeA"].APIVersions["2023-01-01"].Deprecation)
+		assert.Nil(t, summaryModel.Properties.ResourceTypes["typeA"].APIVersions["2024-01-01"].Deprecation)
+	})
+
 	t.Run("registers provider with no location defaults to global", func(t *testing.T) {
 		t.Parallel()
 		dbClient := inmemory.NewClient()
@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Operation is an operation on a resource that can be prevented by a lock.
+type Operation = controller.LockOperation
+
+const (
+	// OperationWrite creates or updates a resource. It's prevented by 'ReadOnly' locks.
+	OperationWrite = controller.LockOperationWrite
+
+	// OperationDelete deletes a resource. It's prevented by all locks.
+	OperationDelete = controller.LockOperationDelete
+)
+
+// LockedError is returned when a lock prevents an operation on a resource.
+type LockedError struct {
+	// ResourceID is the ID of the resource the operation was requested on.
+	ResourceID string
+
+	// Operation is the operation that was prevented.
+	Operation Operation
+
+	// LockID is the ID of the lock that prevents the operation.
+	LockID string
+
+	// Level is the level of the lock that prevents the operation.
+	Level datamodel.LockLevel
+}
+
+// Error returns the error message.
+func (e *LockedError) Error() string {
+	verb := "updated"
+	if e.Operation == OperationDelete {
+		verb = "deleted"
+	}
+
+	return fmt.Sprintf("the resource %q can't be %s because it's protected by the %s lock %q", e.ResourceID, verb, e.Level, e.LockID)
+}
+
+// Unwrap returns controller.ErrLocked, so that the controllers report the error as a locked scope.
+func (e *LockedError) Unwrap() error {
+	return controller.ErrLocked
+}
+
+var _ controller.LockChecker = (*Checker)(nil)
+
+// Checker checks the locks of resources before they're changed.
+type Checker struct {
+	client database.Client
+}
+
+// NewChecker creates a Checker that reads locks from the given database.
+func NewChecker(client database.Client) *Checker {
+	return &Checker{client: client}
+}
+
+// Check returns a *LockedError if a lock prevents the operation on the resource with the given ID.
+//
+// The models are the stored and requested versions of the resource, used to find the application and environment
+// the resource belongs to. They're optional, and nil values are ignored.
+func (c *Checker) Check(ctx context.Context, operation Operation, id resources.ID, models ...any) error {
+	if datamodel.IsLock(id) {
+		return nil
+	}
+
+	associated, err := c.associatedScopes(ctx, models...)
+	if err != nil {
+		return err
+	}
+
+	// Locks are stored at the root scope of the resource they protect, so the locks of a resource group and of
+	// the resources it contains share the same root scope.
+	rootScopes := []string{id.RootScope()}
+	for _, scope := range associated {
+		parsed, err := resources.ParseResource(scope)
+		if err != nil {
+			continue
+		}
+
+		if !slices.ContainsFunc(rootScopes, func(s string) bool { return strings.EqualFold(s, parsed.RootScope()) }) {
+			rootScopes = append(rootScopes, parsed.RootScope())
+		}
+	}
+
+	for _, rootScope := range rootScopes {
+		result, err := c.client.Query(ctx, database.Query{
+			RootScope: rootScope,
+			// Deleting a plane or resource group deletes the resource groups and resources it contains.
+			ScopeRecursive: id.IsScope() && operation == OperationDelete,
+			ResourceType:   datamodel.LockResourceType,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Sort the locks so that the same lock is reported when several locks apply.
+		slices.SortFunc(result.Items, func(a database.Object, b database.Object) int {
+			return strings.Compare(strings.ToLower(a.ID), strings.ToLower(b.ID))
+		})
+
+		for _, item := range result.Items {
+			lock := datamodel.Lock{}
+			if err := item.As(&lock); err != nil {
+				return err
+			}
+
+			lockID, err := resources.Parse(item.ID)
+			if err != nil {
+				return err
+			}
+
+			if Applies(datamodel.LockScope(lockID), lock.Properties.Level, operation, id.String(), associated) {
+				return &LockedError{
+					ResourceID: id.String(),
+					Operation:  operation,
+					LockID:     item.ID,
+					Level:      lock.Properties.Level,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Applies returns true if a lock with the given scope and level prevents the operation on the resource with the
+// given ID. The associated scopes are the IDs of the application and environment the resource belongs to.
+func Applies(scope string, level datamodel.LockLevel, operation Operation, id string, associated []string) bool {
+	if operation == OperationWrite && !strings.EqualFold(string(level), string(datamodel.LockLevelReadOnly)) {
+		return false
+	}
+
+	// The lock is on the resource, or on a resource group or resource that contains it.
+	if isWithin(id, scope) {
+		return true
+	}
+
+	// The lock is on a resource that's contained by the resource being deleted.
+	if operation == OperationDelete && isWithin(scope, id) {
+		return true
+	}
+
+	// The lock is on the application or environment the resource belongs to.
+	return slices.ContainsFunc(associated, func(s string) bool { return strings.EqualFold(s, scope) })
+}
+
+// isWithin returns true if the ID is the same as the scope or is contained by the scope.
+func isWithin(id string, scope string) bool {
+	id = strings.ToLower(strings.TrimSuffix(id, resources.SegmentSeparator))
+	scope = strings.ToLower(strings.TrimSuffix(scope, resources.SegmentSeparator))
+	return id == scope || strings.HasPrefix(id, scope+resources.SegmentSeparator)
+}
+
+// associatedScopes returns the IDs of the applications and environments referenced by the 'application' and
+// 'environment' properties of the models. The environments of the applications are included, so that the locks of
+// an environment apply to the resources that are only linked to it through their application.
+func (c *Checker) associatedScopes(ctx context.Context, models ...any) ([]string, error) {
+	scopes := []string{}
+	applications := []string{}
+	for _, model := range models {
+		properties := modelProperties(model)
+		if environment, ok := scopeProperty(properties, "environment"); ok {
+			scopes = appendScope(scopes, environment)
+		}
+
+		if application, ok := scopeProperty(properties, "application"); ok {
+			scopes = appendScope(scopes, application)
+			applications = appendScope(applications, application)
+		}
+	}
+
+	for _, application := range applications {
+		obj, err := c.client.Get(ctx, application)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if environment, ok := scopeProperty(modelProperties(obj.Data), "environment"); ok {
+			scopes = appendScope(scopes, environment)
+		}
+	}
+
+	return scopes, nil
+}
+
+// modelProperties returns the 'properties' of the model, or nil if the model has no properties.
+func modelProperties(model any) map[string]any {
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil
+	}
+
+	resource := struct {
+		Properties map[string]any `json:"properties"`
+	}{}
+	if err := json.Unmarshal(b, &resource); err != nil {
+		return nil
+	}
+
+	return resource.Properties
+}
+
+// scopeProperty returns the resource ID of the given property, or false if it's not set to a valid resource ID.
+func scopeProperty(properties map[string]any, property string) (string, bool) {
+	value, ok := properties[property].(string)
+	if !ok || value == "" {
+		return "", false
+	}
+
+	parsed, err := resources.ParseResource(value)
+	if err != nil {
+		return "", false
+	}
+
+	return parsed.String(), true
+}
+
+// appendScope appends the scope to the scopes, unless it's already one of them.
+func appendScope(scopes []string, scope string) []string {
+	if slices.ContainsFunc(scopes, func(s string) bool { return strings.EqualFold(s, scope) }) {
+		return scopes
+	}
+
+	return append(scopes, scope)
+}
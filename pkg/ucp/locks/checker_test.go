@@ -0,0 +1,266 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locks
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceGroupID = "/planes/radius/local/resourceGroups/test-group"
+	testEnvironmentID   = "/planes/radius/shared/resourceGroups/shared-group/providers/Applications.Core/environments/test-env"
+	testApplicationID   = testResourceGroupID + "/providers/Applications.Core/applications/test-app"
+	testContainerID     = testResourceGroupID + "/providers/Applications.Core/containers/test-container"
+)
+
+func saveLock(t *testing.T, client database.Client, id string, level datamodel.LockLevel) {
+	lock := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: resources.MustParse(id).Name(),
+				Type: datamodel.LockResourceType,
+			},
+		},
+		Properties: datamodel.LockProperties{Level: level},
+	}
+
+	err := client.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: lock})
+	require.NoError(t, err)
+}
+
+func Test_Applies(t *testing.T) {
+	tests := []struct {
+		name       string
+		scope      string
+		level      datamodel.LockLevel
+		operation  Operation
+		id         string
+		associated []string
+		expected   bool
+	}{
+		{
+			name:      "delete of locked resource",
+			scope:     testContainerID,
+			level:     datamodel.LockLevelCanNotDelete,
+			operation: OperationDelete,
+			id:        testContainerID,
+			expected:  true,
+		},
+		{
+			name:      "write of resource with CanNotDelete lock",
+			scope:     testContainerID,
+			level:     datamodel.LockLevelCanNotDelete,
+			operation: OperationWrite,
+			id:        testContainerID,
+			expected:  false,
+		},
+		{
+			name:      "write of resource with ReadOnly lock",
+			scope:     testContainerID,
+			level:     datamodel.LockLevelReadOnly,
+			operation: OperationWrite,
+			id:        testContainerID,
+			expected:  true,
+		},
+		{
+			name:      "resource in locked resource group",
+			scope:     testResourceGroupID,
+			level:     datamodel.LockLevelCanNotDelete,
+			operation: OperationDelete,
+			id:        testContainerID,
+			expected:  true,
+		},
+		{
+			name:      "resource group case-insensitive",
+			scope:     "/planes/radius/local/resourcegroups/TEST-GROUP",
+			level:     datamodel.LockLevelCanNotDelete,
+			operation: OperationDelete,
+			id:        testContainerID,
+			expected:  true,
+		},
+		{
+			name:      "resource group with a similar name",
+			scope:     testResourceGroupID + "-2",
+			level:     datamodel.LockLevelCanNotDelete,
+			operation: OperationDelete,
+			id:        testContainerID,
+			expected:  false,
+		},
+		{
+			name:      "delete of resource group containing locked resource",
+			scope:     testContainerID,
+			level:     datamodel.LockLevelCanNotDelete,
+			operation: OperationDelete,
+			id:        testResourceGroupID,
+			expected:  true,
+		},
+		{
+			name:      "write of resource group containing locked resource",
+			scope:     testContainerID,
+			level:     datamodel.LockLevelReadOnly,
+			operation: OperationWrite,
+			id:        testResourceGroupID,
+			expected:  false,
+		},
+		{
+			name:       "resource of locked application",
+			scope:      testApplicationID,
+			level:      datamodel.LockLevelCanNotDelete,
+			operation:  OperationDelete,
+			id:         testContainerID,
+			associated: []string{testApplicationID, testEnvironmentID},
+			expected:   true,
+		},
+		{
+			name:       "resource of locked environment",
+			scope:      testEnvironmentID,
+			level:      datamodel.LockLevelReadOnly,
+			operation:  OperationWrite,
+			id:         testContainerID,
+			associated: []string{testApplicationID, testEnvironmentID},
+			expected:   true,
+		},
+		{
+			name:       "resource of other application",
+			scope:      testResourceGroupID + "/providers/Applications.Core/applications/other-app",
+			level:      datamodel.LockLevelCanNotDelete,
+			operation:  OperationDelete,
+			id:         testContainerID,
+			associated: []string{testApplicationID},
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, Applies(tt.scope, tt.level, tt.operation, tt.id, tt.associated))
+		})
+	}
+}
+
+func Test_Checker_Check(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	saveLock(t, client, testResourceGroupID+"/providers/System.Authorization/locks/group-lock", datamodel.LockLevelCanNotDelete)
+	saveLock(t, client, testEnvironmentID+"/providers/System.Authorization/locks/env-lock", datamodel.LockLevelReadOnly)
+
+	checker := NewChecker(client)
+
+	container := map[string]any{
+		"properties": map[string]any{
+			"application": testApplicationID,
+			"environment": testEnvironmentID,
+		},
+	}
+
+	t.Run("delete of resource in locked resource group", func(t *testing.T) {
+		err := checker.Check(ctx, OperationDelete, resources.MustParse(testApplicationID))
+		require.Equal(t, &LockedError{
+			ResourceID: testApplicationID,
+			Operation:  OperationDelete,
+			LockID:     testResourceGroupID + "/providers/System.Authorization/locks/group-lock",
+			Level:      datamodel.LockLevelCanNotDelete,
+		}, err)
+		require.Equal(t, `the resource "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app" can't be deleted because it's protected by the CanNotDelete lock "/planes/radius/local/resourceGroups/test-group/providers/System.Authorization/locks/group-lock"`, err.Error())
+	})
+
+	t.Run("write of resource in locked resource group", func(t *testing.T) {
+		err := checker.Check(ctx, OperationWrite, resources.MustParse(testApplicationID))
+		require.NoError(t, err)
+	})
+
+	t.Run("write of resource of locked environment", func(t *testing.T) {
+		err := checker.Check(ctx, OperationWrite, resources.MustParse(testContainerID), nil, container)
+		require.Equal(t, &LockedError{
+			ResourceID: testContainerID,
+			Operation:  OperationWrite,
+			LockID:     testEnvironmentID + "/providers/System.Authorization/locks/env-lock",
+			Level:      datamodel.LockLevelReadOnly,
+		}, err)
+	})
+
+	t.Run("delete of resource group", func(t *testing.T) {
+		err := checker.Check(ctx, OperationDelete, resources.MustParse(testResourceGroupID))
+		require.ErrorAs(t, err, new(*LockedError))
+		require.ErrorIs(t, err, controller.ErrLocked)
+	})
+
+	t.Run("delete of resource group containing locked resource", func(t *testing.T) {
+		err := checker.Check(ctx, OperationDelete, resources.MustParse("/planes/radius/shared/resourceGroups/shared-group"))
+		require.Equal(t, &LockedError{
+			ResourceID: "/planes/radius/shared/resourceGroups/shared-group",
+			Operation:  OperationDelete,
+			LockID:     testEnvironmentID + "/providers/System.Authorization/locks/env-lock",
+			Level:      datamodel.LockLevelReadOnly,
+		}, err)
+	})
+
+	t.Run("delete of lock", func(t *testing.T) {
+		err := checker.Check(ctx, OperationDelete, resources.MustParse(testResourceGroupID+"/providers/System.Authorization/locks/group-lock"))
+		require.NoError(t, err)
+	})
+
+	t.Run("write of resource of application in locked environment", func(t *testing.T) {
+		// The resource is only linked to the environment through its application.
+		application := map[string]any{
+			"id": testApplicationID,
+			"properties": map[string]any{
+				"environment": testEnvironmentID,
+			},
+		}
+		err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: testApplicationID}, Data: application})
+		require.NoError(t, err)
+
+		resource := map[string]any{
+			"properties": map[string]any{
+				"application": testApplicationID,
+			},
+		}
+		err = checker.Check(ctx, OperationWrite, resources.MustParse(testContainerID), resource)
+		require.Equal(t, &LockedError{
+			ResourceID: testContainerID,
+			Operation:  OperationWrite,
+			LockID:     testEnvironmentID + "/providers/System.Authorization/locks/env-lock",
+			Level:      datamodel.LockLevelReadOnly,
+		}, err)
+	})
+
+	t.Run("resource of application that doesn't exist", func(t *testing.T) {
+		resource := map[string]any{
+			"properties": map[string]any{
+				"application": testResourceGroupID + "/providers/Applications.Core/applications/other-app",
+			},
+		}
+		err := checker.Check(ctx, OperationWrite, resources.MustParse(testContainerID), resource)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource in other resource group", func(t *testing.T) {
+		err := checker.Check(ctx, OperationDelete, resources.MustParse("/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/containers/test-container"))
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// locks enforces management locks (System.Authorization/locks) on resources.
+//
+// A lock is an extension resource of the resource group or resource it protects. A 'CanNotDelete' lock prevents its
+// scope from being deleted, and a 'ReadOnly' lock prevents its scope from being updated or deleted. A lock applies to
+// its scope, to the resources the scope contains and to the resources that belong to it: a lock on an application or
+// environment applies to the resources whose 'application' or 'environment' property references it. Deleting a
+// resource group or resource is also prevented by the locks of the resources it contains.
+//
+// Locks themselves are never locked, so they can always be removed by a user that is authorized to do so.
+package locks
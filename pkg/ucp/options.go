@@ -22,10 +22,12 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/validator"
@@ -38,12 +40,22 @@ import (
 // For testability, all fields on this struct MUST be constructed from the NewOptions function without any
 // additional initialization required.
 type Options struct {
+	// AuditChain links and verifies audit events. This field is nil when auditing is disabled.
+	AuditChain *audit.Chain
+
+	// AuditRecorder records audit events. This field is nil when auditing is disabled.
+	AuditRecorder audit.Recorder
+
 	// Config is the configuration for the server.
 	Config *Config
 
 	// DatabaseProvider provides access to the database used for resource data.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
+	// KubernetesProvider provides access to the Kubernetes clients used to authenticate requests. This field is nil
+	// when the Kubernetes client is not configured.
+	KubernetesProvider *kubernetesclientprovider.KubernetesClientProvider
+
 	// Modules is the list of modules to initialize. This will default to nil (implying the default set), and
 	// can be overridden by tests.
 	Modules []modules.Initializer
@@ -89,6 +101,28 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 
 	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
 
+	if config.Audit.Enabled {
+		options.AuditChain, err = audit.LoadChain(config.Audit.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure audit: %w", err)
+		}
+
+		recorder, err := audit.NewRecorder(config.Audit, options.AuditChain, databaseClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure audit: %w", err)
+		}
+
+		// Events are recorded outside of the request path by the recorder service.
+		options.AuditRecorder = audit.NewAsyncRecorder(recorder, audit.DefaultQueueSize)
+	}
+
+	if config.Kubernetes.Kind != "" {
+		options.KubernetesProvider, err = kubernetesclientprovider.FromOptions(config.Kubernetes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure the Kubernetes client: %w", err)
+		}
+	}
+
 	options.SpecLoader, err = validator.LoadSpec(ctx, "ucp", swagger.SpecFilesUCP, []string{config.Server.PathBase}, "")
 	if err != nil {
 		return nil, err
@@ -22,6 +22,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/profiler/profilerservice"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
 	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/backend"
 	"github.com/radius-project/radius/pkg/ucp/frontend/api"
 	"github.com/radius-project/radius/pkg/ucp/initializer"
@@ -34,6 +35,10 @@ func NewServer(options *ucp.Options) (*hosting.Host, error) {
 		backend.NewService(options),
 	}
 
+	if recorder, ok := options.AuditRecorder.(*audit.AsyncRecorder); ok {
+		services = append(services, recorder)
+	}
+
 	if options.Config.Metrics.Enabled {
 		services = append(services, &metricsservice.Service{Options: &options.Config.Metrics})
 	}
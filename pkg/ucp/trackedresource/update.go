@@ -91,6 +91,7 @@ type trackedResourceState struct {
 	ID         string                         `json:"id"`
 	Name       string                         `json:"name"`
 	Type       string                         `json:"type"`
+	Tags       map[string]string              `json:"tags,omitempty"`
 	Properties trackedResourceStateProperties `json:"properties"`
 }
 
@@ -201,6 +202,8 @@ func (u *Updater) run(ctx context.Context, id resources.ID, trackingID resources
 		entry.AsyncProvisioningState = *data.Properties.ProvisioningState
 	}
 
+	entry.Properties.Tags = data.Tags
+
 	obj = &database.Object{
 		Metadata: database.Metadata{
 			ID: trackingID.String(),
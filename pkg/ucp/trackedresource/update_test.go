@@ -258,12 +258,14 @@ func Test_run(t *testing.T) {
 			"id":         testID.String(),
 			"name":       testID.Name(),
 			"type":       testID.Type(),
+			"tags":       map[string]any{"team": "payments"},
 			"properties": map[string]any{},
 		}
 
 		etag := "some-etag"
 		dm := datamodel.GenericResourceFromID(testID, IDFor(testID))
 		dm.Properties.APIVersion = apiVersion
+		dm.Properties.Tags = map[string]string{"team": "checkout"}
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), IDFor(testID).String()).
@@ -282,6 +284,7 @@ func Test_run(t *testing.T) {
 				require.Equal(t, IDFor(testID).String(), dm.ID)
 				require.Equal(t, testID.String(), dm.Properties.ID)
 				require.Equal(t, apiVersion, dm.Properties.APIVersion)
+				require.Equal(t, map[string]string{"team": "payments"}, dm.Properties.Tags)
 				return nil
 			}).
 			Times(1)
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// testoidc provides a local OpenID Connect issuer for testing the authentication of Radius APIs.
+package testoidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// KeyID is the ID of the signing key of the issuer.
+	KeyID = "test-key"
+
+	// DeviceCode is the device code issued by the device authorization endpoint of the issuer.
+	DeviceCode = "test-device-code"
+
+	// UserCode is the user code issued by the device authorization endpoint of the issuer.
+	UserCode = "TEST-CODE"
+
+	// RefreshToken is the refresh token issued by the token endpoint of the issuer.
+	RefreshToken = "test-refresh-token"
+)
+
+// Issuer is a local OpenID Connect issuer. It serves the OpenID configuration and signing keys used to validate
+// tokens, and the device authorization and token endpoints used to acquire tokens. Device authorization requests
+// are approved immediately.
+type Issuer struct {
+	// URL is the issuer URL.
+	URL string
+
+	// ClientID is the client ID of the tokens issued by the token endpoint, used as their audience.
+	ClientID string
+
+	// Claims are additional claims of the tokens issued by the token endpoint, e.g. 'sub' and 'groups'.
+	Claims jwt.MapClaims
+
+	key           *rsa.PrivateKey
+	tokenRequests atomic.Int32
+}
+
+// NewIssuer starts a local OpenID Connect issuer for the duration of the test.
+func NewIssuer(t *testing.T) *Issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &Issuer{
+		ClientID: "radius",
+		Claims:   jwt.MapClaims{"sub": "alice", "groups": []string{"team-a"}},
+		key:      key,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"issuer":                        issuer.URL,
+			"jwks_uri":                      issuer.URL + "/keys",
+			"device_authorization_endpoint": issuer.URL + "/device/code",
+			"token_endpoint":                issuer.URL + "/token",
+		})
+	})
+	mux.HandleFunc("GET /keys", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: KeyID, Algorithm: "RS256", Use: "sig"}},
+		})
+	})
+	mux.HandleFunc("POST /device/code", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"device_code":      DeviceCode,
+			"user_code":        UserCode,
+			"verification_uri": issuer.URL + "/device",
+			"expires_in":       300,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.FormValue("grant_type") == "urn:ietf:params:oauth:grant-type:device_code" && r.FormValue("device_code") == DeviceCode:
+		case r.FormValue("grant_type") == "refresh_token" && r.FormValue("refresh_token") == RefreshToken:
+		default:
+			writeJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid_grant"})
+			return
+		}
+
+		issuer.tokenRequests.Add(1)
+		token, err := issuer.sign(issuer.Claims)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]any{"error": "server_error"})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"access_token":  token,
+			"id_token":      token,
+			"refresh_token": RefreshToken,
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	issuer.URL = server.URL
+
+	return issuer
+}
+
+// TokenRequests returns the number of tokens issued by the token endpoint.
+func (i *Issuer) TokenRequests() int {
+	return int(i.tokenRequests.Load())
+}
+
+// Token issues a token with the given claims. The 'iss', 'aud', 'iat' and 'exp' claims default to the issuer URL,
+// the client ID, the current time and an hour from the current time.
+func (i *Issuer) Token(t *testing.T, claims jwt.MapClaims) string {
+	token, err := i.sign(claims)
+	require.NoError(t, err)
+	return token
+}
+
+func (i *Issuer) sign(claims jwt.MapClaims) (string, error) {
+	now := time.Now()
+	all := jwt.MapClaims{
+		"iss": i.URL,
+		"aud": i.ClientID,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	maps.Copy(all, claims)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, all)
+	token.Header["kid"] = KeyID
+	return token.SignedString(i.key)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}